@@ -38,6 +38,17 @@ type LaunchResultBase struct {
 	// The string is an id that can be used to obtain the status of the
 	// asynchronous job.
 	AsyncJobId string `json:"async_job_id,omitempty"`
+	raw        string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u LaunchResultBase) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for LaunchResultBase
@@ -49,29 +60,61 @@ const (
 func (u *LaunchResultBase) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// AsyncJobId : This response indicates that the processing is
+		// asynchronous. The string is an id that can be used to obtain the
+		// status of the asynchronous job.
+		AsyncJobId json.RawMessage `json:"async_job_id,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "async_job_id":
-		err = json.Unmarshal(body, &u.AsyncJobId)
+		err = json.Unmarshal(w.AsyncJobId, &u.AsyncJobId)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a LaunchResultBase instance
+func (u LaunchResultBase) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // LaunchEmptyResult : Result returned by methods that may either launch an
 // asynchronous job or complete synchronously. Upon synchronous completion of
 // the job, no additional information is returned.
 type LaunchEmptyResult struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u LaunchEmptyResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for LaunchEmptyResult
@@ -79,11 +122,39 @@ const (
 	LaunchEmptyResultComplete = "complete"
 )
 
+// UnmarshalJSON deserializes into a LaunchEmptyResult instance
+func (u *LaunchEmptyResult) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "complete":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a LaunchEmptyResult instance
+func (u LaunchEmptyResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PollArg : Arguments for methods that poll the status of an asynchronous job.
 type PollArg struct {
 	// AsyncJobId : Id of the asynchronous job. This is the value of a response
 	// returned from the method that launched the job.
 	AsyncJobId string `json:"async_job_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPollArg returns a new PollArg instance
@@ -93,12 +164,38 @@ func NewPollArg(AsyncJobId string) *PollArg {
 	return s
 }
 
+// MarshalJSON serializes a PollArg instance
+func (u PollArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PollArg instance
+func (u *PollArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *PollArg) Validate() error {
+	return nil
+}
+
 // PollResultBase : Result returned by methods that poll for the status of an
 // asynchronous job. Unions that extend this union should add a 'complete' field
 // with a type of the information returned upon job completion. See
 // `PollEmptyResult` for an example.
 type PollResultBase struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PollResultBase) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PollResultBase
@@ -106,11 +203,48 @@ const (
 	PollResultBaseInProgress = "in_progress"
 )
 
+// UnmarshalJSON deserializes into a PollResultBase instance
+func (u *PollResultBase) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "in_progress":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PollResultBase instance
+func (u PollResultBase) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PollEmptyResult : Result returned by methods that poll for the status of an
 // asynchronous job. Upon completion of the job, no additional information is
 // returned.
 type PollEmptyResult struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PollEmptyResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PollEmptyResult
@@ -118,10 +252,47 @@ const (
 	PollEmptyResultComplete = "complete"
 )
 
+// UnmarshalJSON deserializes into a PollEmptyResult instance
+func (u *PollEmptyResult) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "complete":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PollEmptyResult instance
+func (u PollEmptyResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PollError : Error returned by methods for polling the status of asynchronous
 // job.
 type PollError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PollError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PollError
@@ -130,3 +301,36 @@ const (
 	PollErrorInternalError     = "internal_error"
 	PollErrorOther             = "other"
 )
+
+// UnmarshalJSON deserializes into a PollError instance
+func (u *PollError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_async_job_id", "internal_error", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PollError instance
+func (u PollError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsPollError reports whether err carries a PollError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsPollError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*PollError)(nil), tags...)
+}
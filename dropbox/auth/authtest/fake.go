@@ -0,0 +1,108 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package authtest provides a fake auth.Client for tests.
+package authtest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/auth"
+)
+
+// ErrNotStubbed is returned by the routes of a Fake that are not stubbed
+var ErrNotStubbed = errors.New("authtest: route not stubbed")
+
+// Call is a call made to a Fake
+type Call struct {
+	// Name of the route, e.g. "token/from_oauth1"
+	Route string
+	// Argument of the call (nil for routes without one)
+	Arg interface{}
+	// Content passed to upload routes
+	Content io.Reader
+}
+
+// Fake is a auth.Client for tests. Each route calls the function in the
+// matching field, e.g. TokenFromOauth1Func, or returns ErrNotStubbed if the
+// field is nil. All calls are recorded. The zero value is ready to use.
+type Fake struct {
+	// Stubs TokenFromOauth1 and TokenFromOauth1Context
+	TokenFromOauth1Func func(ctx context.Context, arg *auth.TokenFromOAuth1Arg) (res *auth.TokenFromOAuth1Result, err error)
+	// Stubs TokenRevoke and TokenRevokeContext
+	TokenRevokeFunc func(ctx context.Context) (err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ auth.Client = (*Fake)(nil)
+
+// Calls returns the calls made so far, or only those to the given route
+func (f *Fake) Calls(route ...string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []Call
+	for _, c := range f.calls {
+		if len(route) == 0 || c.Route == route[0] {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (f *Fake) record(route string, arg interface{}, content io.Reader) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, Call{route, arg, content})
+}
+
+// TokenFromOauth1 implements auth.Client
+func (f *Fake) TokenFromOauth1(arg *auth.TokenFromOAuth1Arg) (res *auth.TokenFromOAuth1Result, err error) {
+	return f.TokenFromOauth1Context(context.Background(), arg)
+}
+
+// TokenFromOauth1Context implements auth.Client
+func (f *Fake) TokenFromOauth1Context(ctx context.Context, arg *auth.TokenFromOAuth1Arg) (res *auth.TokenFromOAuth1Result, err error) {
+	f.record("token/from_oauth1", arg, nil)
+	if f.TokenFromOauth1Func == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TokenFromOauth1Func(ctx, arg)
+}
+
+// TokenRevoke implements auth.Client
+func (f *Fake) TokenRevoke() (err error) {
+	return f.TokenRevokeContext(context.Background())
+}
+
+// TokenRevokeContext implements auth.Client
+func (f *Fake) TokenRevokeContext(ctx context.Context) (err error) {
+	f.record("token/revoke", nil, nil)
+	if f.TokenRevokeFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TokenRevokeFunc(ctx)
+}
@@ -22,12 +22,13 @@ package auth
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
-	"log"
 	"net/http"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/common"
 )
 
 // Client interface describes all routes in this namespace
@@ -35,23 +36,38 @@ type Client interface {
 	// TokenFromOauth1 : Creates an OAuth 2.0 access token from the supplied
 	// OAuth 1.0 access token.
 	TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error)
+	// TokenFromOauth1Context : Like `tokenFromOauth1`, but the request is bound
+	// to ctx.
+	TokenFromOauth1Context(ctx context.Context, arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error)
 	// TokenRevoke : Disables the access token used to authenticate the call.
 	TokenRevoke() (err error)
+	// TokenRevokeContext : Like `tokenRevoke`, but the request is bound to ctx.
+	TokenRevokeContext(ctx context.Context) (err error)
 }
 
-type apiImpl dropbox.Context
+// ClientImpl implements Client by calling the Dropbox API
+type ClientImpl dropbox.Context
 
-//TokenFromOauth1APIError is an error-wrapper for the token/from_oauth1 route
+var _ Client = (*ClientImpl)(nil)
+
+// TokenFromOauth1APIError is an error-wrapper for the token/from_oauth1 route
 type TokenFromOauth1APIError struct {
 	dropbox.APIError
 	EndpointError *TokenFromOAuth1Error `json:"error"`
 }
 
-func (dbx *apiImpl) TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TokenFromOauth1APIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error) {
+	return dbx.TokenFromOauth1Context(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TokenFromOauth1Context(ctx context.Context, arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -65,18 +81,12 @@ func (dbx *apiImpl) TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAut
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "auth", "token/from_oauth1", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "app", "auth", "token/from_oauth1", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -87,9 +97,6 @@ func (dbx *apiImpl) TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAut
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -107,46 +114,48 @@ func (dbx *apiImpl) TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAut
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TokenRevokeAPIError is an error-wrapper for the token/revoke route
+// TokenRevokeAPIError is an error-wrapper for the token/revoke route
 type TokenRevokeAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) TokenRevoke() (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TokenRevokeAPIError) Unwrap() error {
+	return e.APIError
+}
 
+func (dbx *ClientImpl) TokenRevoke() (err error) {
+	return dbx.TokenRevokeContext(context.Background())
+}
+
+func (dbx *ClientImpl) TokenRevokeContext(ctx context.Context) (err error) {
 	headers := map[string]string{}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "auth", "token/revoke", headers, nil)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "auth", "token/revoke", headers, nil)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -157,9 +166,6 @@ func (dbx *apiImpl) TokenRevoke() (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -172,22 +178,26 @@ func (dbx *apiImpl) TokenRevoke() (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
 // New returns a Client implementation for this namespace
-func New(c dropbox.Config) *apiImpl {
-	ctx := apiImpl(dropbox.NewContext(c))
+func New(c dropbox.Config) *ClientImpl {
+	return NewFromContext(dropbox.NewContext(c))
+}
+
+// NewFromContext returns a Client implementation for this namespace
+// that shares the http.Client and tokens of c
+func NewFromContext(c dropbox.Context) *ClientImpl {
+	ctx := ClientImpl(c)
 	return &ctx
 }
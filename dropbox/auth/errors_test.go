@@ -0,0 +1,124 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestHandleCommonAuthErrors(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		status  int
+		header  http.Header
+		body    string
+		summary string
+		check   func(t *testing.T, err error)
+	}{
+		{
+			name:    "invalid access token",
+			status:  http.StatusUnauthorized,
+			body:    `{"error_summary": "invalid_access_token/..", "error": {".tag": "invalid_access_token"}}`,
+			summary: "invalid_access_token/..",
+			check: func(t *testing.T, err error) {
+				e, ok := err.(AuthAPIError)
+				if !ok || e.AuthError == nil || e.AuthError.Tag != AuthErrorInvalidAccessToken {
+					t.Errorf("got %#v", err)
+				}
+			},
+		},
+		{
+			name:    "unauthorized with a plain text body",
+			status:  http.StatusUnauthorized,
+			body:    "Error in call to API function: invalid token",
+			summary: "Error in call to API function: invalid token",
+			check: func(t *testing.T, err error) {
+				if e, ok := err.(AuthAPIError); !ok || e.AuthError != nil {
+					t.Errorf("got %#v", err)
+				}
+			},
+		},
+		{
+			name:   "invalid account type",
+			status: http.StatusForbidden,
+			body: `{"error_summary": "invalid_account_type/endpoint/..", "error": {".tag": "invalid_account_type",
+				"invalid_account_type": {".tag": "endpoint"}}}`,
+			summary: "invalid_account_type/endpoint/..",
+			check: func(t *testing.T, err error) {
+				e, ok := err.(AccessAPIError)
+				if !ok || e.AccessError == nil || e.AccessError.Tag != AccessErrorInvalidAccountType ||
+					e.AccessError.InvalidAccountType.Tag != InvalidAccountTypeErrorEndpoint {
+					t.Errorf("got %#v", err)
+				}
+			},
+		},
+		{
+			name:   "too many write operations",
+			status: http.StatusTooManyRequests,
+			body: `{"error_summary": "too_many_write_operations/..", "error": {"reason":
+				{".tag": "too_many_write_operations"}, "retry_after": 7}}`,
+			summary: "too_many_write_operations/..",
+			check: func(t *testing.T, err error) {
+				e, ok := err.(RateLimitAPIError)
+				if !ok || e.RateLimitError.Reason.Tag != RateLimitReasonTooManyWriteOperations ||
+					e.RateLimitError.RetryAfter != 7 {
+					t.Errorf("got %#v", err)
+				}
+			},
+		},
+		{
+			name:    "too many requests with a Retry-After header",
+			status:  http.StatusTooManyRequests,
+			header:  http.Header{"Retry-After": {"12"}},
+			body:    "Too many requests",
+			summary: "Too many requests",
+			check: func(t *testing.T, err error) {
+				e, ok := err.(RateLimitAPIError)
+				if !ok || e.RateLimitError.Reason.Tag != RateLimitReasonTooManyRequests ||
+					e.RateLimitError.RetryAfter != 12 {
+					t.Errorf("got %#v", err)
+				}
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: test.status, Header: test.header}
+			err := HandleCommonAuthErrors(resp, []byte(test.body))
+			if err == nil {
+				t.Fatal("no error")
+			}
+			if err.Error() != test.summary {
+				t.Errorf("summary %q, want %q", err.Error(), test.summary)
+			}
+			test.check(t, err)
+		})
+	}
+}
+
+func TestHandleCommonAuthErrorsIgnoresOtherStatuses(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusBadRequest, http.StatusConflict, http.StatusInternalServerError} {
+		resp := &http.Response{StatusCode: status}
+		if err := HandleCommonAuthErrors(resp, []byte(`{"error_summary": "x"}`)); err != nil {
+			t.Errorf("%d: got %v", status, err)
+		}
+	}
+}
@@ -35,6 +35,17 @@ type AccessError struct {
 	InvalidAccountType *InvalidAccountTypeError `json:"invalid_account_type,omitempty"`
 	// PaperAccessDenied : Current account cannot access Paper.
 	PaperAccessDenied *PaperAccessError `json:"paper_access_denied,omitempty"`
+	raw               string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AccessError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AccessError
@@ -58,7 +69,7 @@ func (u *AccessError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "invalid_account_type":
 		err = json.Unmarshal(w.InvalidAccountType, &u.InvalidAccountType)
@@ -72,13 +83,55 @@ func (u *AccessError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a AccessError instance
+func (u AccessError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "invalid_account_type":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidAccountType *InvalidAccountTypeError `json:"invalid_account_type"`
+		}{u.Tagged, u.InvalidAccountType})
+
+	case "paper_access_denied":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PaperAccessDenied *PaperAccessError `json:"paper_access_denied"`
+		}{u.Tagged, u.PaperAccessDenied})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsAccessError reports whether err carries a AccessError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsAccessError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*AccessError)(nil), tags...)
+}
+
 // AuthError : Errors occurred during authentication.
 type AuthError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AuthError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AuthError
@@ -90,9 +143,53 @@ const (
 	AuthErrorOther              = "other"
 )
 
+// UnmarshalJSON deserializes into a AuthError instance
+func (u *AuthError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_access_token", "invalid_select_user", "invalid_select_admin", "user_suspended", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a AuthError instance
+func (u AuthError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsAuthError reports whether err carries a AuthError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsAuthError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*AuthError)(nil), tags...)
+}
+
 // InvalidAccountTypeError : has no documentation (yet)
 type InvalidAccountTypeError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u InvalidAccountTypeError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for InvalidAccountTypeError
@@ -102,9 +199,53 @@ const (
 	InvalidAccountTypeErrorOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a InvalidAccountTypeError instance
+func (u *InvalidAccountTypeError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "endpoint", "feature", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a InvalidAccountTypeError instance
+func (u InvalidAccountTypeError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsInvalidAccountTypeError reports whether err carries a InvalidAccountTypeError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsInvalidAccountTypeError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*InvalidAccountTypeError)(nil), tags...)
+}
+
 // PaperAccessError : has no documentation (yet)
 type PaperAccessError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PaperAccessError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PaperAccessError
@@ -114,6 +255,39 @@ const (
 	PaperAccessErrorOther         = "other"
 )
 
+// UnmarshalJSON deserializes into a PaperAccessError instance
+func (u *PaperAccessError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "paper_disabled", "not_paper_user", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PaperAccessError instance
+func (u PaperAccessError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsPaperAccessError reports whether err carries a PaperAccessError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsPaperAccessError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*PaperAccessError)(nil), tags...)
+}
+
 // RateLimitError : Error occurred because the app is being rate limited.
 type RateLimitError struct {
 	// Reason : The reason why the app is being rate limited.
@@ -121,6 +295,8 @@ type RateLimitError struct {
 	// RetryAfter : The number of seconds that the app should wait before making
 	// another request.
 	RetryAfter uint64 `json:"retry_after"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRateLimitError returns a new RateLimitError instance
@@ -131,9 +307,30 @@ func NewRateLimitError(Reason *RateLimitReason) *RateLimitError {
 	return s
 }
 
+// MarshalJSON serializes a RateLimitError instance
+func (u RateLimitError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RateLimitError instance
+func (u *RateLimitError) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RateLimitReason : has no documentation (yet)
 type RateLimitReason struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RateLimitReason) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RateLimitReason
@@ -143,6 +340,32 @@ const (
 	RateLimitReasonOther                  = "other"
 )
 
+// UnmarshalJSON deserializes into a RateLimitReason instance
+func (u *RateLimitReason) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "too_many_requests", "too_many_write_operations", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a RateLimitReason instance
+func (u RateLimitReason) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TokenFromOAuth1Arg : has no documentation (yet)
 type TokenFromOAuth1Arg struct {
 	// Oauth1Token : The supplied OAuth 1.0 access token.
@@ -150,6 +373,8 @@ type TokenFromOAuth1Arg struct {
 	// Oauth1TokenSecret : The token secret associated with the supplied access
 	// token.
 	Oauth1TokenSecret string `json:"oauth1_token_secret"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTokenFromOAuth1Arg returns a new TokenFromOAuth1Arg instance
@@ -160,9 +385,35 @@ func NewTokenFromOAuth1Arg(Oauth1Token string, Oauth1TokenSecret string) *TokenF
 	return s
 }
 
+// MarshalJSON serializes a TokenFromOAuth1Arg instance
+func (u TokenFromOAuth1Arg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TokenFromOAuth1Arg instance
+func (u *TokenFromOAuth1Arg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *TokenFromOAuth1Arg) Validate() error {
+	return nil
+}
+
 // TokenFromOAuth1Error : has no documentation (yet)
 type TokenFromOAuth1Error struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TokenFromOAuth1Error) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TokenFromOAuth1Error
@@ -172,11 +423,46 @@ const (
 	TokenFromOAuth1ErrorOther                  = "other"
 )
 
+// UnmarshalJSON deserializes into a TokenFromOAuth1Error instance
+func (u *TokenFromOAuth1Error) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_oauth1_token_info", "app_id_mismatch", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TokenFromOAuth1Error instance
+func (u TokenFromOAuth1Error) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTokenFromOAuth1Error reports whether err carries a TokenFromOAuth1Error, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTokenFromOAuth1Error(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TokenFromOAuth1Error)(nil), tags...)
+}
+
 // TokenFromOAuth1Result : has no documentation (yet)
 type TokenFromOAuth1Result struct {
 	// Oauth2Token : The OAuth 2.0 token generated from the supplied OAuth 1.0
 	// token.
 	Oauth2Token string `json:"oauth2_token"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTokenFromOAuth1Result returns a new TokenFromOAuth1Result instance
@@ -185,3 +471,13 @@ func NewTokenFromOAuth1Result(Oauth2Token string) *TokenFromOAuth1Result {
 	s.Oauth2Token = Oauth2Token
 	return s
 }
+
+// MarshalJSON serializes a TokenFromOAuth1Result instance
+func (u TokenFromOAuth1Result) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TokenFromOAuth1Result instance
+func (u *TokenFromOAuth1Result) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/users"
+)
+
+func TestValidation(t *testing.T) {
+	rec := &recorder{}
+	c := New(dropbox.Config{Token: "token", Client: &http.Client{Transport: rec}})
+	accountID := "dbid:" + strings.Repeat("a", 35)
+	search := files.NewSearchArg("/a", "q")
+	search.MaxResults = 1001
+	batch := files.NewUploadSessionFinishBatchArg([]*files.UploadSessionFinishArg{
+		files.NewUploadSessionFinishArg(files.NewUploadSessionCursor("s", 0), files.NewCommitInfo("/a")),
+		files.NewUploadSessionFinishArg(files.NewUploadSessionCursor("s", 0), files.NewCommitInfo("b")),
+	})
+	revisions := files.NewListRevisionsArg("/a")
+	revisions.Limit = 0
+
+	for _, test := range []struct {
+		name  string
+		call  func() error
+		field string // empty if valid
+	}{
+		{"search path", func() error {
+			_, err := c.Files().Search(files.NewSearchArg("relative", "q"))
+			return err
+		}, "path"},
+		{"search max_results", func() error {
+			_, err := c.Files().Search(search)
+			return err
+		}, "max_results"},
+		{"list_revisions limit", func() error {
+			_, err := c.Files().ListRevisions(revisions)
+			return err
+		}, "limit"},
+		{"download rev", func() error {
+			arg := files.NewDownloadArg("/a")
+			arg.Rev = "abc"
+			_, _, err := c.Files().Download(arg)
+			return err
+		}, "rev"},
+		{"nested path", func() error {
+			_, err := c.Files().UploadSessionFinishBatch(batch)
+			return err
+		}, "entries[1].commit.path"},
+		{"account_ids", func() error {
+			_, err := c.Users().GetAccountBatch(users.NewGetAccountBatchArg(nil))
+			return err
+		}, "account_ids"},
+		{"account_ids item", func() error {
+			_, err := c.Users().GetAccountBatch(users.NewGetAccountBatchArg([]string{accountID, "dbid:short"}))
+			return err
+		}, "account_ids[1]"},
+		{"valid", func() error {
+			_, err := c.Users().GetAccountBatch(users.NewGetAccountBatchArg([]string{accountID}))
+			return err
+		}, ""},
+	} {
+		rec.header = nil
+		err := test.call()
+		if test.field == "" {
+			if rec.header == nil {
+				t.Errorf("%s: valid request not sent: %v", test.name, err)
+			}
+			continue
+		}
+		if rec.header != nil {
+			t.Errorf("%s: invalid request sent", test.name)
+		}
+		if e, ok := err.(*dropbox.ValidationError); !ok || e.Field != test.field {
+			t.Errorf("%s: error %#v, want *dropbox.ValidationError for %s", test.name, err, test.field)
+		}
+	}
+}
+
+func TestValidationNil(t *testing.T) {
+	if err := (*files.SearchArg)(nil).Validate(); err != nil {
+		t.Errorf("Validate on nil = %v", err)
+	}
+	rec := &recorder{}
+	c := New(dropbox.Config{Token: "token", Client: &http.Client{Transport: rec}})
+	// A nil argument is sent as is, for the server to reject
+	if _, err := c.Files().Search(nil); err == nil || rec.header == nil {
+		t.Errorf("nil argument not sent: %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+)
+
+// PathRootAPIError is returned when a request fails with 422 because the
+// Dropbox-API-Path-Root header is invalid or the user may not access it.
+type PathRootAPIError struct {
+	dropbox.APIError
+	PathRootError *PathRootError `json:"error"`
+}
+
+// Unwrap returns the embedded dropbox.APIError
+func (e PathRootAPIError) Unwrap() error {
+	return e.APIError
+}
+
+// HandleCommonPathRootErrors decodes the error returned for an unusable path
+// root. It returns nil if resp is not such an error.
+func HandleCommonPathRootErrors(resp *http.Response, body []byte) error {
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		return nil
+	}
+	var apiError PathRootAPIError
+	if err := json.Unmarshal(body, &apiError); err != nil {
+		apiError.ErrorSummary = string(body)
+	}
+	return apiError
+}
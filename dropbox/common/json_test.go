@@ -0,0 +1,56 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+)
+
+func TestPathRootRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		value *PathRoot
+		json  string
+	}{
+		{&PathRoot{Tagged: dropbox.Tagged{Tag: PathRootHome}}, `{".tag":"home"}`},
+		{&PathRoot{Tagged: dropbox.Tagged{Tag: PathRootTeam}, Team: "123"}, `{".tag":"team","team":"123"}`},
+		{&PathRoot{Tagged: dropbox.Tagged{Tag: PathRootSharedFolder}, SharedFolder: "456"},
+			`{".tag":"shared_folder","shared_folder":"456"}`},
+	} {
+		b, err := json.Marshal(test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != test.json {
+			t.Errorf("marshaled to %s, want %s", b, test.json)
+		}
+		var decoded PathRoot
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(&decoded, test.value) {
+			t.Errorf("decoded %+v, want %+v", decoded, test.value)
+		}
+	}
+}
@@ -32,6 +32,8 @@ type InvalidPathRootError struct {
 	// PathRoot : The latest path root id for user's team if the user is still
 	// in a team.
 	PathRoot string `json:"path_root,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewInvalidPathRootError returns a new InvalidPathRootError instance
@@ -40,6 +42,16 @@ func NewInvalidPathRootError() *InvalidPathRootError {
 	return s
 }
 
+// MarshalJSON serializes a InvalidPathRootError instance
+func (u InvalidPathRootError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a InvalidPathRootError instance
+func (u *InvalidPathRootError) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PathRoot : has no documentation (yet)
 type PathRoot struct {
 	dropbox.Tagged
@@ -51,6 +63,17 @@ type PathRoot struct {
 	// in `PathRootError.no_permission` if you don't have access to  this shared
 	// folder.)
 	SharedFolder string `json:"shared_folder,omitempty"`
+	raw          string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PathRoot) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PathRoot
@@ -67,36 +90,79 @@ const (
 func (u *PathRoot) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// Team : Paths are relative to the given team directory. (This results
+		// in `PathRootError.invalid` if the user is not a member of the team
+		// associated with that path root id.)
+		Team json.RawMessage `json:"team,omitempty"`
+		// SharedFolder : Paths are relative to given shared folder id (This
+		// results in `PathRootError.no_permission` if you don't have access to
+		// this shared folder.)
+		SharedFolder json.RawMessage `json:"shared_folder,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "team":
-		err = json.Unmarshal(body, &u.Team)
+		err = json.Unmarshal(w.Team, &u.Team)
 
 		if err != nil {
 			return err
 		}
 	case "shared_folder":
-		err = json.Unmarshal(body, &u.SharedFolder)
+		err = json.Unmarshal(w.SharedFolder, &u.SharedFolder)
 
 		if err != nil {
 			return err
 		}
+	case "home", "member_home", "user_home", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a PathRoot instance
+func (u PathRoot) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Team string `json:"team"`
+		}{u.Tagged, u.Team})
+
+	case "shared_folder":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SharedFolder string `json:"shared_folder"`
+		}{u.Tagged, u.SharedFolder})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PathRootError : has no documentation (yet)
 type PathRootError struct {
 	dropbox.Tagged
 	// Invalid : The path root id value in Dropbox-API-Path-Root header is no
 	// longer valid.
 	Invalid *InvalidPathRootError `json:"invalid,omitempty"`
+	raw     string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PathRootError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PathRootError
@@ -119,7 +185,7 @@ func (u *PathRootError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "invalid":
 		err = json.Unmarshal(body, &u.Invalid)
@@ -127,6 +193,28 @@ func (u *PathRootError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "no_permission", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
+
+// MarshalJSON serializes a PathRootError instance
+func (u PathRootError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "invalid":
+		return dropbox.MarshalTagged(u.Tag, u.Invalid)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsPathRootError reports whether err carries a PathRootError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsPathRootError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*PathRootError)(nil), tags...)
+}
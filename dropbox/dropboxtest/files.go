@@ -0,0 +1,1061 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/async"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+// Unit that the chunks appended to a concurrent upload session must be a
+// multiple of
+const chunkUnit = 4 << 20
+
+var routes = map[string]route{
+	"copy":                              {"rpc", false, (*Server).copy},
+	"copy_batch":                        {"rpc", false, (*Server).copyBatch},
+	"copy_batch/check":                  {"rpc", false, (*Server).checkJob},
+	"create_folder":                     {"rpc", false, (*Server).createFolder},
+	"delete":                            {"rpc", false, (*Server).delete},
+	"delete_batch":                      {"rpc", false, (*Server).deleteBatch},
+	"delete_batch/check":                {"rpc", false, (*Server).checkJob},
+	"download":                          {"download", false, (*Server).download},
+	"get_metadata":                      {"rpc", false, (*Server).getMetadata},
+	"list_folder":                       {"rpc", false, (*Server).listFolder},
+	"list_folder/continue":              {"rpc", false, (*Server).listFolderContinue},
+	"list_folder/get_latest_cursor":     {"rpc", false, (*Server).listFolderGetLatestCursor},
+	"list_folder/longpoll":              {"rpc", true, (*Server).listFolderLongpoll},
+	"list_revisions":                    {"rpc", false, (*Server).listRevisions},
+	"move":                              {"rpc", false, (*Server).move},
+	"move_batch":                        {"rpc", false, (*Server).moveBatch},
+	"move_batch/check":                  {"rpc", false, (*Server).checkJob},
+	"permanently_delete":                {"rpc", false, (*Server).permanentlyDelete},
+	"restore":                           {"rpc", false, (*Server).restore},
+	"upload":                            {"upload", false, (*Server).upload},
+	"upload_session/append":             {"upload", false, (*Server).uploadSessionAppend},
+	"upload_session/append_v2":          {"upload", false, (*Server).uploadSessionAppendV2},
+	"upload_session/finish":             {"upload", false, (*Server).uploadSessionFinish},
+	"upload_session/finish_batch":       {"rpc", false, (*Server).uploadSessionFinishBatch},
+	"upload_session/finish_batch/check": {"rpc", false, (*Server).checkJob},
+	"upload_session/start":              {"upload", false, (*Server).uploadSessionStart},
+}
+
+func tagged(tag string) dropbox.Tagged {
+	return dropbox.Tagged{Tag: tag}
+}
+
+func lookupError(tag string) *files.LookupError {
+	return &files.LookupError{Tagged: tagged(tag)}
+}
+
+func writeError(tag string) *files.WriteError {
+	return &files.WriteError{Tagged: tagged(tag)}
+}
+
+func conflict(tag string) *files.WriteError {
+	return &files.WriteError{
+		Tagged:   tagged(files.WriteErrorConflict),
+		Conflict: &files.WriteConflictError{Tagged: tagged(tag)},
+	}
+}
+
+// conflictWith returns the conflict error for writing over n.
+func conflictWith(n *node) *files.WriteError {
+	if n.folder {
+		return conflict(files.WriteConflictErrorFolder)
+	}
+	return conflict(files.WriteConflictErrorFile)
+}
+
+// cleanPath validates a path of the form "/a/b", returning it and its lower
+// cased form.
+func cleanPath(p string) (display string, lower string, ok bool) {
+	if !strings.HasPrefix(p, "/") || strings.HasSuffix(p, "/") ||
+		strings.Contains(p, "//") {
+		return "", "", false
+	}
+	return p, strings.ToLower(p), true
+}
+
+// now returns the current time with the precision of Dropbox timestamps.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// contentHash returns the content_hash of b.
+func contentHash(b []byte) string {
+	h := files.NewContentHash()
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (s *Server) next() int {
+	s.seq++
+	return s.seq
+}
+
+func (s *Server) newID() string {
+	return fmt.Sprintf("id:dropboxtest%06d", s.next())
+}
+
+func (s *Server) newRev() string {
+	return fmt.Sprintf("%09x", s.next())
+}
+
+// metadata returns the metadata of n.
+func (n *node) metadata() files.IsMetadata {
+	m := files.Metadata{
+		Name:        path.Base(n.path),
+		PathLower:   strings.ToLower(n.path),
+		PathDisplay: n.path,
+	}
+	if n.folder {
+		return &files.FolderMetadata{Metadata: m, Id: n.id}
+	}
+	return &files.FileMetadata{
+		Metadata:       m,
+		Id:             n.id,
+		ClientModified: n.clientModified,
+		ServerModified: n.serverModified,
+		Rev:            n.rev,
+		Size:           uint64(len(n.content)),
+		ContentHash:    contentHash(n.content),
+	}
+}
+
+func deletedMetadata(display string) *files.DeletedMetadata {
+	return &files.DeletedMetadata{Metadata: files.Metadata{
+		Name:        path.Base(display),
+		PathLower:   strings.ToLower(display),
+		PathDisplay: display,
+	}}
+}
+
+// notify records a change to the entry at lower.
+func (s *Server) notify(lower string, m files.IsMetadata) {
+	s.journal = append(s.journal, change{lower, m})
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// put stores n, replacing any entry at its path, and records it as a
+// revision if it is a file.
+func (s *Server) put(n *node) {
+	if !n.folder {
+		lower := strings.ToLower(n.path)
+		s.history[lower] = append(s.history[lower], n)
+	}
+	s.place(n)
+}
+
+// place stores n, replacing any entry at its path, without recording a
+// revision.
+func (s *Server) place(n *node) {
+	lower := strings.ToLower(n.path)
+	s.nodes[lower] = n
+	delete(s.deleted, lower)
+	s.notify(lower, n.metadata())
+}
+
+// lookup finds the entry for a path, an "id:" or a "rev:". The root is
+// returned as a folder with an empty path.
+func (s *Server) lookup(p string) (*node, *files.LookupError) {
+	switch {
+	case p == "":
+		return &node{folder: true}, nil
+	case strings.HasPrefix(p, "id:"):
+		for _, n := range s.nodes {
+			if n.id == p {
+				return n, nil
+			}
+		}
+		return nil, lookupError(files.LookupErrorNotFound)
+	case strings.HasPrefix(p, "rev:"):
+		for _, revs := range s.history {
+			for _, n := range revs {
+				if n.rev == p[len("rev:"):] {
+					return n, nil
+				}
+			}
+		}
+		return nil, lookupError(files.LookupErrorNotFound)
+	}
+	_, lower, ok := cleanPath(p)
+	if !ok {
+		return nil, lookupError(files.LookupErrorMalformedPath)
+	}
+	if n := s.nodes[lower]; n != nil {
+		return n, nil
+	}
+	return nil, lookupError(files.LookupErrorNotFound)
+}
+
+// lookupFile is like lookup but fails for folders.
+func (s *Server) lookupFile(p string) (*node, *files.LookupError) {
+	n, err := s.lookup(p)
+	if err == nil && n.folder {
+		return nil, lookupError(files.LookupErrorNotFile)
+	}
+	return n, err
+}
+
+// subtree returns the entries at and below lower, sorted by path.
+func (s *Server) subtree(lower string) []*node {
+	var nodes []*node
+	for k, n := range s.nodes {
+		if k == lower || strings.HasPrefix(k, lower+"/") {
+			nodes = append(nodes, n)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return strings.ToLower(nodes[i].path) < strings.ToLower(nodes[j].path)
+	})
+	return nodes
+}
+
+// canonical returns display with the names of existing folders cased as
+// they were created.
+func (s *Server) canonical(display string) string {
+	parts := strings.Split(display, "/")
+	for i := len(parts) - 1; i > 1; i-- {
+		if n := s.nodes[strings.ToLower(strings.Join(parts[:i], "/"))]; n != nil {
+			return n.path + "/" + strings.Join(parts[i:], "/")
+		}
+	}
+	return display
+}
+
+// makeParents creates the missing folders above display.
+func (s *Server) makeParents(display string) *files.WriteError {
+	parts := strings.Split(display, "/")
+	for i := 2; i < len(parts); i++ {
+		dir := strings.Join(parts[:i], "/")
+		if n := s.nodes[strings.ToLower(dir)]; n != nil {
+			if !n.folder {
+				return conflict(files.WriteConflictErrorFileAncestor)
+			}
+			continue
+		}
+		s.put(&node{path: dir, id: s.newID(), folder: true})
+	}
+	return nil
+}
+
+// autorename returns a free path like "/a/b (1).txt" for display.
+func (s *Server) autorename(display string) string {
+	dir, base := path.Split(display)
+	ext := path.Ext(base)
+	name := strings.TrimSuffix(base, ext)
+	for i := 1; ; i++ {
+		p := fmt.Sprintf("%s%s (%d)%s", dir, name, i, ext)
+		if s.nodes[strings.ToLower(p)] == nil {
+			return p
+		}
+	}
+}
+
+// write commits content as described by commit.
+func (s *Server) write(commit *files.CommitInfo, content []byte) (*files.FileMetadata, *files.WriteError) {
+	display, lower, ok := cleanPath(commit.Path)
+	if !ok {
+		return nil, writeError(files.WriteErrorMalformedPath)
+	}
+	display = s.canonical(display)
+	mode := files.WriteModeAdd
+	if commit.Mode != nil && commit.Mode.Tag != "" {
+		mode = commit.Mode.Tag
+	}
+	id := s.newID()
+	if old := s.nodes[lower]; old != nil {
+		var err *files.WriteError
+		switch {
+		case old.folder:
+			err = conflictWith(old)
+		case mode == files.WriteModeOverwrite,
+			mode == files.WriteModeUpdate && commit.Mode.Update == old.rev:
+			id = old.id
+		case mode == files.WriteModeAdd && bytes.Equal(old.content, content):
+			// Dropbox doesn't consider identical contents a conflict
+			return old.metadata().(*files.FileMetadata), nil
+		default:
+			err = conflictWith(old)
+		}
+		if err != nil {
+			if !commit.Autorename {
+				return nil, err
+			}
+			display = s.autorename(display)
+		}
+	}
+	if err := s.makeParents(display); err != nil {
+		return nil, err
+	}
+	n := &node{
+		path:           display,
+		id:             id,
+		content:        content,
+		rev:            s.newRev(),
+		clientModified: commit.ClientModified.UTC().Truncate(time.Second),
+		serverModified: now(),
+	}
+	if commit.ClientModified.IsZero() {
+		n.clientModified = n.serverModified
+	}
+	s.put(n)
+	return n.metadata().(*files.FileMetadata), nil
+}
+
+// remove deletes the entry at lower and everything below it.
+func (s *Server) remove(lower string) {
+	n := s.nodes[lower]
+	for _, c := range s.subtree(lower) {
+		l := strings.ToLower(c.path)
+		delete(s.nodes, l)
+		s.deleted[l] = c.path
+	}
+	s.notify(lower, deletedMetadata(n.path))
+}
+
+// newJob stores the result of a batch job and returns its ID.
+func (s *Server) newJob(result interface{}) string {
+	id := fmt.Sprintf("dbjid:dropboxtest%06d", s.next())
+	s.jobs[id] = result
+	return id
+}
+
+func (s *Server) checkJob(r *request) (interface{}, []byte, error) {
+	var arg async.PollArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	res, ok := s.jobs[arg.AsyncJobId]
+	if !ok {
+		return nil, nil, endpointError{&async.PollError{
+			Tagged: tagged(async.PollErrorInvalidAsyncJobId)}}
+	}
+	return res, nil, nil
+}
+
+func (s *Server) getMetadata(r *request) (interface{}, []byte, error) {
+	var arg files.GetMetadataArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	if arg.Path == "" {
+		return nil, nil, badRequest("the root folder is unsupported")
+	}
+	n, err := s.lookup(arg.Path)
+	if err != nil {
+		_, lower, _ := cleanPath(arg.Path)
+		if display, ok := s.deleted[lower]; ok && arg.IncludeDeleted {
+			return deletedMetadata(display), nil, nil
+		}
+		return nil, nil, endpointError{&files.GetMetadataError{
+			Tagged: tagged(files.GetMetadataErrorPath), Path: err}}
+	}
+	return n.metadata(), nil, nil
+}
+
+func (s *Server) createFolder(r *request) (interface{}, []byte, error) {
+	var arg files.CreateFolderArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	fail := func(err *files.WriteError) (interface{}, []byte, error) {
+		return nil, nil, endpointError{&files.CreateFolderError{
+			Tagged: tagged(files.CreateFolderErrorPath), Path: err}}
+	}
+	display, lower, ok := cleanPath(arg.Path)
+	if !ok {
+		return fail(writeError(files.WriteErrorMalformedPath))
+	}
+	display = s.canonical(display)
+	if old := s.nodes[lower]; old != nil {
+		if !arg.Autorename {
+			return fail(conflictWith(old))
+		}
+		display = s.autorename(display)
+	}
+	if err := s.makeParents(display); err != nil {
+		return fail(err)
+	}
+	n := &node{path: display, id: s.newID(), folder: true}
+	s.put(n)
+	return n.metadata(), nil, nil
+}
+
+func (s *Server) upload(r *request) (interface{}, []byte, error) {
+	var arg files.CommitInfo
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	res, err := s.write(&arg, r.content)
+	if err != nil {
+		return nil, nil, endpointError{&files.UploadError{
+			Tagged: tagged(files.UploadErrorPath),
+			Path:   &files.UploadWriteFailed{Reason: err},
+		}}
+	}
+	return res, nil, nil
+}
+
+func (s *Server) uploadSessionStart(r *request) (interface{}, []byte, error) {
+	var arg files.UploadSessionStartArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	sess := &session{content: r.content, closed: arg.Close}
+	// session_type is newer than the spec the files package was generated
+	// from, so it arrives as an unknown field
+	var sessionType dropbox.Tagged
+	if raw, ok := arg.Extra["session_type"]; ok {
+		if err := json.Unmarshal(raw, &sessionType); err != nil {
+			return nil, nil, badRequest("invalid session_type: %v", err)
+		}
+	}
+	switch sessionType.Tag {
+	case "", "sequential":
+	case "concurrent":
+		switch {
+		case len(r.content) > 0:
+			return nil, nil, endpointError{tagged("concurrent_session_data_not_allowed")}
+		case arg.Close:
+			return nil, nil, endpointError{tagged("concurrent_session_close_not_allowed")}
+		}
+		sess = &session{concurrent: true, chunks: make(map[uint64][]byte)}
+	default:
+		return nil, nil, badRequest("unknown session_type %q", sessionType.Tag)
+	}
+	id := fmt.Sprintf("dropboxtest%06d", s.next())
+	s.sessions[id] = sess
+	return &files.UploadSessionStartResult{SessionId: id}, nil, nil
+}
+
+// appendConcurrent stores content as the chunk at offset of a concurrent
+// session. Chunks are multiples of 4 MiB, except for the one that closes
+// the session.
+func appendConcurrent(sess *session, offset uint64, content []byte, close bool) *files.UploadSessionLookupError {
+	switch {
+	case sess.closed && (close || offset >= sess.end):
+		return &files.UploadSessionLookupError{Tagged: tagged(files.UploadSessionLookupErrorClosed)}
+	case offset%chunkUnit != 0:
+		return &files.UploadSessionLookupError{Tagged: tagged("concurrent_session_invalid_offset")}
+	case !close && len(content)%chunkUnit != 0:
+		return &files.UploadSessionLookupError{Tagged: tagged("concurrent_session_invalid_data_size")}
+	}
+	sess.chunks[offset] = append([]byte(nil), content...)
+	if close {
+		sess.closed, sess.end = true, offset+uint64(len(content))
+	}
+	return nil
+}
+
+// assemble joins the chunks of a closed concurrent session into its
+// content, reporting whether none are missing.
+func assemble(sess *session) bool {
+	var content []byte
+	for offset := uint64(0); offset < sess.end; {
+		chunk, ok := sess.chunks[offset]
+		if !ok || len(chunk) == 0 {
+			return false
+		}
+		content = append(content, chunk...)
+		offset += uint64(len(chunk))
+	}
+	sess.content = content
+	return uint64(len(content)) == sess.end
+}
+
+// appendSession appends content to the session at cursor.
+func (s *Server) appendSession(cursor *files.UploadSessionCursor, content []byte, close bool) *files.UploadSessionLookupError {
+	sess, ok := s.sessions[cursor.SessionId]
+	switch {
+	case !ok:
+		return &files.UploadSessionLookupError{Tagged: tagged(files.UploadSessionLookupErrorNotFound)}
+	case sess.concurrent:
+		return appendConcurrent(sess, cursor.Offset, content, close)
+	case cursor.Offset != uint64(len(sess.content)):
+		return &files.UploadSessionLookupError{
+			Tagged:          tagged(files.UploadSessionLookupErrorIncorrectOffset),
+			IncorrectOffset: &files.UploadSessionOffsetError{CorrectOffset: uint64(len(sess.content))},
+		}
+	case sess.closed && len(content) > 0:
+		return &files.UploadSessionLookupError{Tagged: tagged(files.UploadSessionLookupErrorClosed)}
+	}
+	sess.content = append(sess.content, content...)
+	sess.closed = sess.closed || close
+	return nil
+}
+
+func (s *Server) uploadSessionAppend(r *request) (interface{}, []byte, error) {
+	var arg files.UploadSessionCursor
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	if err := s.appendSession(&arg, r.content, false); err != nil {
+		return nil, nil, endpointError{err}
+	}
+	return nil, nil, nil
+}
+
+func (s *Server) uploadSessionAppendV2(r *request) (interface{}, []byte, error) {
+	var arg files.UploadSessionAppendArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	if arg.Cursor == nil {
+		return nil, nil, badRequest("missing cursor")
+	}
+	if err := s.appendSession(arg.Cursor, r.content, arg.Close); err != nil {
+		return nil, nil, endpointError{err}
+	}
+	return nil, nil, nil
+}
+
+// finishSession commits the session at cursor after appending content.
+func (s *Server) finishSession(arg *files.UploadSessionFinishArg, content []byte, requireClosed bool) (*files.FileMetadata, *files.UploadSessionFinishError) {
+	if arg.Cursor == nil || arg.Commit == nil {
+		return nil, &files.UploadSessionFinishError{Tagged: tagged(files.UploadSessionFinishErrorOther)}
+	}
+	if sess := s.sessions[arg.Cursor.SessionId]; sess != nil && sess.concurrent {
+		if err := finishConcurrent(sess, arg.Cursor.Offset, content); err != nil {
+			return nil, err
+		}
+	} else if err := s.appendSession(arg.Cursor, content, false); err != nil {
+		return nil, &files.UploadSessionFinishError{
+			Tagged: tagged(files.UploadSessionFinishErrorLookupFailed), LookupFailed: err}
+	}
+	sess := s.sessions[arg.Cursor.SessionId]
+	if requireClosed && !sess.closed {
+		return nil, &files.UploadSessionFinishError{
+			Tagged:       tagged(files.UploadSessionFinishErrorLookupFailed),
+			LookupFailed: &files.UploadSessionLookupError{Tagged: tagged(files.UploadSessionLookupErrorNotClosed)},
+		}
+	}
+	res, err := s.write(arg.Commit, sess.content)
+	if err != nil {
+		return nil, &files.UploadSessionFinishError{
+			Tagged: tagged(files.UploadSessionFinishErrorPath), Path: err}
+	}
+	delete(s.sessions, arg.Cursor.SessionId)
+	return res, nil
+}
+
+// finishConcurrent checks that the concurrent session sess can be
+// finished at offset and assembles its content.
+func finishConcurrent(sess *session, offset uint64, content []byte) *files.UploadSessionFinishError {
+	finishError := func(tag string) *files.UploadSessionFinishError {
+		return &files.UploadSessionFinishError{Tagged: tagged(tag)}
+	}
+	switch {
+	case len(content) > 0:
+		return finishError("concurrent_session_data_not_allowed")
+	case !sess.closed:
+		return finishError("concurrent_session_not_closed")
+	case !assemble(sess):
+		return finishError("concurrent_session_missing_data")
+	case offset != sess.end:
+		return &files.UploadSessionFinishError{
+			Tagged: tagged(files.UploadSessionFinishErrorLookupFailed),
+			LookupFailed: &files.UploadSessionLookupError{
+				Tagged:          tagged(files.UploadSessionLookupErrorIncorrectOffset),
+				IncorrectOffset: &files.UploadSessionOffsetError{CorrectOffset: sess.end},
+			},
+		}
+	}
+	return nil
+}
+
+func (s *Server) uploadSessionFinish(r *request) (interface{}, []byte, error) {
+	var arg files.UploadSessionFinishArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	res, err := s.finishSession(&arg, r.content, false)
+	if err != nil {
+		return nil, nil, endpointError{err}
+	}
+	return res, nil, nil
+}
+
+func (s *Server) uploadSessionFinishBatch(r *request) (interface{}, []byte, error) {
+	var arg files.UploadSessionFinishBatchArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	result := &files.UploadSessionFinishBatchResult{
+		Entries: make([]*files.UploadSessionFinishBatchResultEntry, 0, len(arg.Entries)),
+	}
+	for _, entry := range arg.Entries {
+		res, err := s.finishSession(entry, nil, true)
+		e := &files.UploadSessionFinishBatchResultEntry{
+			Tagged: tagged(files.UploadSessionFinishBatchResultEntrySuccess), Success: res}
+		if err != nil {
+			e = &files.UploadSessionFinishBatchResultEntry{
+				Tagged: tagged(files.UploadSessionFinishBatchResultEntryFailure), Failure: err}
+		}
+		result.Entries = append(result.Entries, e)
+	}
+	id := s.newJob(&files.UploadSessionFinishBatchJobStatus{
+		Tagged: tagged(files.UploadSessionFinishBatchJobStatusComplete), Complete: result})
+	return &files.UploadSessionFinishBatchLaunch{
+		Tagged: tagged(files.UploadSessionFinishBatchLaunchAsyncJobId), AsyncJobId: id}, nil, nil
+}
+
+func (s *Server) download(r *request) (interface{}, []byte, error) {
+	var arg files.DownloadArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	p := arg.Path
+	if arg.Rev != "" {
+		p = "rev:" + arg.Rev
+	}
+	n, err := s.lookupFile(p)
+	if err != nil {
+		return nil, nil, endpointError{&files.DownloadError{
+			Tagged: tagged(files.DownloadErrorPath), Path: err}}
+	}
+	return n.metadata(), n.content, nil
+}
+
+// listing returns the current entries in the folder listed by c, sorted by
+// path.
+func (s *Server) listing(c cursor) []files.IsMetadata {
+	var keys []string
+	for k := range s.nodes {
+		if c.contains(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	entries := make([]files.IsMetadata, len(keys))
+	for i, k := range keys {
+		entries[i] = s.nodes[k].metadata()
+	}
+	return entries
+}
+
+// listPage returns the next page of results for c.
+func (s *Server) listPage(c cursor) *files.ListFolderResult {
+	res := &files.ListFolderResult{Entries: []files.IsMetadata{}}
+	if c.Offset >= 0 {
+		entries := s.listing(c)
+		start, end := c.Offset, c.Offset+s.PageSize
+		if end >= len(entries) {
+			end = len(entries)
+			c.Offset = -1
+		} else {
+			res.HasMore = true
+			c.Offset = end
+		}
+		if start < end {
+			res.Entries = entries[start:end]
+		}
+		res.Cursor = c.encode()
+		return res
+	}
+	seen := make(map[string]int)
+	for ; c.Position < len(s.journal); c.Position++ {
+		ch := s.journal[c.Position]
+		if !c.contains(ch.lower) {
+			continue
+		}
+		if i, ok := seen[ch.lower]; ok {
+			res.Entries[i] = ch.metadata
+			continue
+		}
+		if len(res.Entries) == s.PageSize {
+			res.HasMore = true
+			break
+		}
+		seen[ch.lower] = len(res.Entries)
+		res.Entries = append(res.Entries, ch.metadata)
+	}
+	res.Cursor = c.encode()
+	return res
+}
+
+// hasChanges reports whether c has results to return.
+func (s *Server) hasChanges(c cursor) bool {
+	if c.Offset >= 0 {
+		return true
+	}
+	for _, ch := range s.journal[c.Position:] {
+		if c.contains(ch.lower) {
+			return true
+		}
+	}
+	return false
+}
+
+// folderCursor returns a cursor for listing the folder at arg.Path.
+func (s *Server) folderCursor(arg *files.ListFolderArg) (cursor, error) {
+	n, err := s.lookup(arg.Path)
+	if err == nil && !n.folder {
+		err = lookupError(files.LookupErrorNotFolder)
+	}
+	if err != nil {
+		return cursor{}, endpointError{&files.ListFolderError{
+			Tagged: tagged(files.ListFolderErrorPath), Path: err}}
+	}
+	return cursor{
+		Path:      strings.ToLower(n.path),
+		Recursive: arg.Recursive,
+		Position:  len(s.journal),
+	}, nil
+}
+
+func (s *Server) listFolder(r *request) (interface{}, []byte, error) {
+	var arg files.ListFolderArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	c, err := s.folderCursor(&arg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.listPage(c), nil, nil
+}
+
+func (s *Server) listFolderContinue(r *request) (interface{}, []byte, error) {
+	var arg files.ListFolderContinueArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	c, err := decodeCursor(arg.Cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.listPage(c), nil, nil
+}
+
+func (s *Server) listFolderGetLatestCursor(r *request) (interface{}, []byte, error) {
+	var arg files.ListFolderArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	c, err := s.folderCursor(&arg)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.Offset = -1
+	return &files.ListFolderGetLatestCursorResult{Cursor: c.encode()}, nil, nil
+}
+
+func (s *Server) listFolderLongpoll(r *request) (interface{}, []byte, error) {
+	var arg files.ListFolderLongpollArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	c, err := decodeCursor(arg.Cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	timeout := time.Duration(arg.Timeout) * time.Second
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	for {
+		s.mu.Lock()
+		changes, changed := s.hasChanges(c), s.changed
+		s.mu.Unlock()
+		if changes {
+			return &files.ListFolderLongpollResult{Changes: true}, nil, nil
+		}
+		select {
+		case <-changed:
+		case <-t.C:
+			return &files.ListFolderLongpollResult{}, nil, nil
+		case <-r.ctx.Done():
+			return nil, nil, r.ctx.Err()
+		case <-s.done:
+			return &files.ListFolderLongpollResult{}, nil, nil
+		}
+	}
+}
+
+func (s *Server) listRevisions(r *request) (interface{}, []byte, error) {
+	var arg files.ListRevisionsArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	fail := func(err *files.LookupError) (interface{}, []byte, error) {
+		return nil, nil, endpointError{&files.ListRevisionsError{
+			Tagged: tagged(files.ListRevisionsErrorPath), Path: err}}
+	}
+	_, lower, ok := cleanPath(arg.Path)
+	if !ok {
+		return fail(lookupError(files.LookupErrorMalformedPath))
+	}
+	if n := s.nodes[lower]; n != nil && n.folder {
+		return fail(lookupError(files.LookupErrorNotFile))
+	}
+	revs := s.history[lower]
+	if len(revs) == 0 {
+		return fail(lookupError(files.LookupErrorNotFound))
+	}
+	limit := int(arg.Limit)
+	if limit == 0 {
+		limit = 10
+	}
+	res := &files.ListRevisionsResult{IsDeleted: s.nodes[lower] == nil}
+	for i := len(revs) - 1; i >= 0 && len(res.Entries) < limit; i-- {
+		res.Entries = append(res.Entries, revs[i].metadata().(*files.FileMetadata))
+	}
+	return res, nil, nil
+}
+
+func (s *Server) restore(r *request) (interface{}, []byte, error) {
+	var arg files.RestoreArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	display, lower, ok := cleanPath(arg.Path)
+	if !ok {
+		return nil, nil, endpointError{&files.RestoreError{
+			Tagged:     tagged(files.RestoreErrorPathLookup),
+			PathLookup: lookupError(files.LookupErrorMalformedPath)}}
+	}
+	for _, n := range s.history[lower] {
+		if n.rev == arg.Rev {
+			commit := files.NewCommitInfo(display)
+			commit.Mode = &files.WriteMode{Tagged: tagged(files.WriteModeOverwrite)}
+			res, err := s.write(commit, n.content)
+			if err != nil {
+				return nil, nil, endpointError{&files.RestoreError{
+					Tagged: tagged(files.RestoreErrorPathWrite), PathWrite: err}}
+			}
+			return res, nil, nil
+		}
+	}
+	return nil, nil, endpointError{&files.RestoreError{Tagged: tagged(files.RestoreErrorInvalidRevision)}}
+}
+
+// relocate copies or moves the entry at from to to.
+func (s *Server) relocate(from, to string, move, autorename bool) (files.IsMetadata, *files.RelocationError) {
+	src, lerr := s.lookup(from)
+	if lerr == nil && src.path == "" {
+		lerr = lookupError(files.LookupErrorNotFound)
+	}
+	if lerr != nil {
+		return nil, &files.RelocationError{
+			Tagged: tagged(files.RelocationErrorFromLookup), FromLookup: lerr}
+	}
+	toError := func(err *files.WriteError) *files.RelocationError {
+		return &files.RelocationError{Tagged: tagged(files.RelocationErrorTo), To: err}
+	}
+	display, lower, ok := cleanPath(to)
+	if !ok {
+		return nil, toError(writeError(files.WriteErrorMalformedPath))
+	}
+	display = s.canonical(display)
+	srcLower := strings.ToLower(src.path)
+	if strings.HasPrefix(lower, srcLower+"/") {
+		return nil, &files.RelocationError{Tagged: tagged(files.RelocationErrorCantMoveFolderIntoItself)}
+	}
+	// A move may change the case of a name only
+	if old := s.nodes[lower]; old != nil && !(move && lower == srcLower) {
+		if !autorename {
+			return nil, toError(conflictWith(old))
+		}
+		display = s.autorename(display)
+		lower = strings.ToLower(display)
+	}
+	if err := s.makeParents(display); err != nil {
+		return nil, toError(err)
+	}
+	nodes := s.subtree(srcLower)
+	if move {
+		s.remove(srcLower)
+	}
+	for _, n := range nodes {
+		c := *n
+		c.path = display + n.path[len(src.path):]
+		if !move {
+			c.id = s.newID()
+			c.rev = s.newRev()
+			c.serverModified = now()
+			s.put(&c)
+			continue
+		}
+		if !c.folder {
+			// The revisions move along with the file
+			old := strings.ToLower(n.path)
+			revs := make([]*node, len(s.history[old]))
+			for i, rev := range s.history[old] {
+				if rev == n {
+					revs[i] = &c
+					continue
+				}
+				moved := *rev
+				moved.path = c.path
+				revs[i] = &moved
+			}
+			delete(s.history, old)
+			s.history[strings.ToLower(c.path)] = revs
+		}
+		s.place(&c)
+	}
+	return s.nodes[lower].metadata(), nil
+}
+
+func (s *Server) copy(r *request) (interface{}, []byte, error) {
+	var arg files.RelocationArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	res, err := s.relocate(arg.FromPath, arg.ToPath, false, arg.Autorename)
+	if err != nil {
+		return nil, nil, endpointError{err}
+	}
+	return res, nil, nil
+}
+
+func (s *Server) move(r *request) (interface{}, []byte, error) {
+	var arg files.RelocationArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	res, err := s.relocate(arg.FromPath, arg.ToPath, true, arg.Autorename)
+	if err != nil {
+		return nil, nil, endpointError{err}
+	}
+	return res, nil, nil
+}
+
+// relocateBatch runs a copy_batch or move_batch job, which stops at the
+// first entry that fails.
+func (s *Server) relocateBatch(r *request, move bool) (interface{}, []byte, error) {
+	var arg files.RelocationBatchArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	status := &files.RelocationBatchJobStatus{
+		Tagged:   tagged(files.RelocationBatchJobStatusComplete),
+		Complete: &files.RelocationBatchResult{Entries: []*files.RelocationResult{}},
+	}
+	for _, entry := range arg.Entries {
+		res, err := s.relocate(entry.FromPath, entry.ToPath, move, arg.Autorename)
+		if err != nil {
+			// RelocationBatchError extends RelocationError
+			var failed files.RelocationBatchError
+			b, _ := json.Marshal(err)
+			json.Unmarshal(b, &failed)
+			status = &files.RelocationBatchJobStatus{
+				Tagged: tagged(files.RelocationBatchJobStatusFailed), Failed: &failed}
+			break
+		}
+		status.Complete.Entries = append(status.Complete.Entries,
+			&files.RelocationResult{Metadata: res})
+	}
+	return &files.RelocationBatchLaunch{
+		Tagged: tagged(files.RelocationBatchLaunchAsyncJobId), AsyncJobId: s.newJob(status)}, nil, nil
+}
+
+func (s *Server) copyBatch(r *request) (interface{}, []byte, error) {
+	return s.relocateBatch(r, false)
+}
+
+func (s *Server) moveBatch(r *request) (interface{}, []byte, error) {
+	return s.relocateBatch(r, true)
+}
+
+// deletePath deletes the entry at p, returning its metadata.
+func (s *Server) deletePath(p string) (files.IsMetadata, *files.DeleteError) {
+	n, err := s.lookup(p)
+	if err == nil && n.path == "" {
+		err = lookupError(files.LookupErrorNotFound)
+	}
+	if err != nil {
+		return nil, &files.DeleteError{Tagged: tagged(files.DeleteErrorPathLookup), PathLookup: err}
+	}
+	s.remove(strings.ToLower(n.path))
+	return n.metadata(), nil
+}
+
+func (s *Server) delete(r *request) (interface{}, []byte, error) {
+	var arg files.DeleteArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	res, err := s.deletePath(arg.Path)
+	if err != nil {
+		return nil, nil, endpointError{err}
+	}
+	return res, nil, nil
+}
+
+func (s *Server) permanentlyDelete(r *request) (interface{}, []byte, error) {
+	var arg files.DeleteArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	n, _ := s.lookup(arg.Path)
+	if _, err := s.deletePath(arg.Path); err != nil {
+		return nil, nil, endpointError{err}
+	}
+	// Unlike delete, this forgets the revisions as well
+	lower := strings.ToLower(n.path)
+	for k := range s.history {
+		if k == lower || strings.HasPrefix(k, lower+"/") {
+			delete(s.history, k)
+			delete(s.deleted, k)
+		}
+	}
+	return nil, nil, nil
+}
+
+func (s *Server) deleteBatch(r *request) (interface{}, []byte, error) {
+	var arg files.DeleteBatchArg
+	if err := r.decode(&arg); err != nil {
+		return nil, nil, err
+	}
+	result := &files.DeleteBatchResult{Entries: []*files.DeleteBatchResultEntry{}}
+	for _, entry := range arg.Entries {
+		res, err := s.deletePath(entry.Path)
+		e := &files.DeleteBatchResultEntry{
+			Tagged:  tagged(files.DeleteBatchResultEntrySuccess),
+			Success: &files.DeleteResult{Metadata: res},
+		}
+		if err != nil {
+			e = &files.DeleteBatchResultEntry{
+				Tagged: tagged(files.DeleteBatchResultEntryFailure), Failure: err}
+		}
+		result.Entries = append(result.Entries, e)
+	}
+	id := s.newJob(&files.DeleteBatchJobStatus{
+		Tagged: tagged(files.DeleteBatchJobStatusComplete), Complete: result})
+	return &files.DeleteBatchLaunch{
+		Tagged: tagged(files.DeleteBatchLaunchAsyncJobId), AsyncJobId: id}, nil, nil
+}
@@ -0,0 +1,320 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+)
+
+// Mode selects what a Recorder does with requests.
+type Mode int
+
+// Modes of a Recorder
+const (
+	// Serve responses from the golden files, failing requests that weren't
+	// recorded
+	ModeReplay Mode = iota
+	// Send requests with Recorder.Transport and save the responses,
+	// replacing earlier recording of the same requests
+	ModeRecord
+)
+
+// Recorder is an http.RoundTripper that records the responses of the Dropbox
+// API into golden files and replays them. Set it as the Transport of
+// Config.Client:
+//
+//	rec := dropboxtest.NewRecorder("testdata", dropboxtest.ModeReplay)
+//	dbx := sharing.New(dropbox.Config{Client: rec.Client()})
+//
+// Requests are identified by their namespace, route and argument, either
+// the Dropbox-API-Arg header or the body of RPC routes, with object keys
+// sorted. Repeated requests are answered with their recorded responses in
+// order, the last one being repeated. Access tokens and other credentials
+// are scrubbed from everything that is saved.
+type Recorder struct {
+	Mode Mode
+	// Directory of the golden files, one per request
+	Dir string
+	// Sends requests when recording. As Config.Client bypasses the SDK's
+	// authentication it must authenticate them, e.g. an *oauth2.Transport.
+	Transport http.RoundTripper
+	// If set, used to normalize arguments before they are matched, e.g. to
+	// drop timestamps that change between runs
+	Normalize func(route dropbox.RouteInfo, arg interface{}) interface{}
+
+	mu       sync.Mutex
+	recorded map[string]bool // golden files written in this run
+	replayed map[string]int  // responses served by golden file
+}
+
+// NewRecorder returns a Recorder for the golden files in dir.
+func NewRecorder(dir string, mode Mode) *Recorder {
+	return &Recorder{Mode: mode, Dir: dir}
+}
+
+// Client returns an http.Client that uses r.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// golden is the content of a golden file.
+type golden struct {
+	Namespace string       `json:"namespace"`
+	Route     string       `json:"route"`
+	Arg       interface{}  `json:"arg"`
+	Responses []*recording `json:"responses"`
+}
+
+// recording is a recorded response.
+type recording struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+	// Whether Body is base64 encoded, for binary content
+	Base64 bool `json:"base64,omitempty"`
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	route, ok := dropbox.RouteFromContext(req.Context())
+	if !ok {
+		// e.g. "/2/files/list_folder/continue"
+		parts := strings.SplitN(strings.TrimPrefix(req.URL.Path, "/"), "/", 3)
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("dropboxtest: not an API request: %s", req.URL)
+		}
+		route = dropbox.RouteInfo{Namespace: parts[1], Route: parts[2]}
+	}
+	arg, err := r.arg(req, route)
+	if err != nil {
+		return nil, err
+	}
+	g := &golden{Namespace: route.Namespace, Route: route.Route, Arg: arg}
+	key, err := json.Marshal(g)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(key)
+	file := filepath.Join(r.Dir, route.Namespace,
+		strings.Replace(route.Route, "/", "_", -1)+"-"+hex.EncodeToString(sum[:8])+".json")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.Mode == ModeRecord {
+		return r.record(req, file, g)
+	}
+	return r.replay(req, file, g)
+}
+
+// arg returns the normalized and scrubbed argument of req. For RPC routes
+// the body of req is read and replaced.
+func (r *Recorder) arg(req *http.Request, route dropbox.RouteInfo) (interface{}, error) {
+	b := []byte(req.Header.Get("Dropbox-API-Arg"))
+	if len(b) == 0 && req.Body != nil && req.Header.Get("Content-Type") == "application/json" {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		b = body
+	}
+	var arg interface{}
+	if len(b) > 0 {
+		if err := decodeJSON(b, &arg); err != nil {
+			return nil, fmt.Errorf("dropboxtest: invalid argument for %s/%s: %v",
+				route.Namespace, route.Route, err)
+		}
+	}
+	arg = scrub(arg)
+	if r.Normalize != nil {
+		arg = r.Normalize(route, arg)
+	}
+	return arg, nil
+}
+
+func (r *Recorder) record(req *http.Request, file string, g *golden) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if r.recorded == nil {
+		r.recorded = make(map[string]bool)
+	}
+	if r.recorded[file] {
+		if err := readGolden(file, g); err != nil {
+			return nil, err
+		}
+	}
+	rec := &recording{StatusCode: resp.StatusCode, Header: make(http.Header)}
+	for k, v := range resp.Header {
+		if k == "Set-Cookie" {
+			continue
+		}
+		rec.Header[k] = append([]string(nil), v...)
+	}
+	if res := rec.Header.Get("Dropbox-API-Result"); res != "" {
+		rec.Header.Set("Dropbox-API-Result", string(scrubJSON([]byte(res))))
+	}
+	switch {
+	case !utf8.Valid(body):
+		rec.Body, rec.Base64 = base64.StdEncoding.EncodeToString(body), true
+	case strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json"):
+		rec.Body = string(scrubJSON(body))
+	default:
+		rec.Body = string(body)
+	}
+	g.Responses = append(g.Responses, rec)
+	b, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(file, append(b, '\n'), 0644); err != nil {
+		return nil, err
+	}
+	r.recorded[file] = true
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, file string, key *golden) (*http.Response, error) {
+	var g golden
+	if err := readGolden(file, &g); err != nil {
+		if os.IsNotExist(err) {
+			arg, _ := json.Marshal(key.Arg)
+			return nil, fmt.Errorf("dropboxtest: no recording of %s/%s with %s",
+				key.Namespace, key.Route, arg)
+		}
+		return nil, err
+	}
+	if len(g.Responses) == 0 {
+		return nil, fmt.Errorf("dropboxtest: no responses in %s", file)
+	}
+	if r.replayed == nil {
+		r.replayed = make(map[string]int)
+	}
+	i := r.replayed[file]
+	if i < len(g.Responses)-1 {
+		r.replayed[file]++
+	} else {
+		i = len(g.Responses) - 1
+	}
+	rec := g.Responses[i]
+	body := []byte(rec.Body)
+	if rec.Base64 {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(rec.Body); err != nil {
+			return nil, fmt.Errorf("dropboxtest: invalid body in %s: %v", file, err)
+		}
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+		StatusCode:    rec.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        rec.Header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func readGolden(file string, g *golden) error {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, g); err != nil {
+		return fmt.Errorf("dropboxtest: invalid golden file %s: %v", file, err)
+	}
+	return nil
+}
+
+// scrub replaces the values of credential fields in v, a decoded JSON value.
+// The fields are those that dropbox never logs.
+func scrub(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if dropbox.IsCredentialField(k) {
+				v[k] = "REDACTED"
+			} else {
+				v[k] = scrub(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = scrub(e)
+		}
+	}
+	return v
+}
+
+// scrubJSON scrubs b if it is a JSON value.
+func scrubJSON(b []byte) []byte {
+	var v interface{}
+	if decodeJSON(b, &v) != nil {
+		return b
+	}
+	scrubbed, err := json.Marshal(scrub(v))
+	if err != nil {
+		return b
+	}
+	return scrubbed
+}
+
+// decodeJSON unmarshals b into v, keeping numbers exact.
+func decodeJSON(b []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	return d.Decode(v)
+}
@@ -0,0 +1,425 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+func overwrite(p string) *files.CommitInfo {
+	commit := files.NewCommitInfo(p)
+	commit.Mode = &files.WriteMode{Tagged: dropbox.Tagged{Tag: files.WriteModeOverwrite}}
+	return commit
+}
+
+func pathLower(m files.IsMetadata) string {
+	switch m := m.(type) {
+	case *files.FileMetadata:
+		return m.PathLower
+	case *files.FolderMetadata:
+		return m.PathLower
+	case *files.DeletedMetadata:
+		return m.PathLower
+	}
+	return ""
+}
+
+func TestUpload(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	dbx := files.New(s.Config())
+
+	res, err := dbx.Upload(files.NewCommitInfo("/A/b.txt"), strings.NewReader("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.PathDisplay != "/A/b.txt" || res.Size != 5 || res.ContentHash != contentHash([]byte("hello")) {
+		t.Errorf("upload = %+v", res)
+	}
+	m, body, err := dbx.Download(files.NewDownloadArg("/a/B.TXT"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadAll(body)
+	body.Close()
+	if string(b) != "hello" || m.Rev != res.Rev {
+		t.Errorf("download = %q, %+v", b, m)
+	}
+
+	start, err := dbx.UploadSessionStart(files.NewUploadSessionStartArg(), strings.NewReader("ab"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	arg := files.NewUploadSessionAppendArg(files.NewUploadSessionCursor(start.SessionId, 2))
+	if err = dbx.UploadSessionAppendV2(arg, strings.NewReader("cd")); err != nil {
+		t.Fatal(err)
+	}
+	finish := files.NewUploadSessionFinishArg(files.NewUploadSessionCursor(start.SessionId, 4), files.NewCommitInfo("/s"))
+	if res, err = dbx.UploadSessionFinish(finish, strings.NewReader("e")); err != nil || res.Size != 5 {
+		t.Errorf("finish = %+v, %v", res, err)
+	}
+}
+
+func TestListFolder(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.PageSize = 2
+	dbx := files.New(s.Config())
+	for _, p := range []string{"/a/1", "/a/2", "/a/3", "/b"} {
+		if _, err := dbx.Upload(files.NewCommitInfo(p), bytes.NewReader(nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	arg := files.NewListFolderArg("")
+	arg.Recursive = true
+	res, err := dbx.ListFolder(arg)
+	var paths []string
+	for pages := 1; ; pages++ {
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range res.Entries {
+			paths = append(paths, pathLower(e))
+		}
+		if !res.HasMore {
+			if pages != 3 {
+				t.Errorf("%d pages, want 3", pages)
+			}
+			break
+		}
+		res, err = dbx.ListFolderContinue(files.NewListFolderContinueArg(res.Cursor))
+	}
+	if got := strings.Join(paths, " "); got != "/a /a/1 /a/2 /a/3 /b" {
+		t.Errorf("listed %s", got)
+	}
+
+	if _, err = dbx.Upload(files.NewCommitInfo("/a/4"), bytes.NewReader(nil)); err != nil {
+		t.Fatal(err)
+	}
+	res, err = dbx.ListFolderContinue(files.NewListFolderContinueArg(res.Cursor))
+	if err != nil || len(res.Entries) != 1 || res.HasMore {
+		t.Errorf("changes = %+v, %v", res, err)
+	}
+}
+
+func TestLongpoll(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	dbx := files.New(s.Config())
+	latest, err := dbx.ListFolderGetLatestCursor(files.NewListFolderArg(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	longpoll := func() chan *files.ListFolderLongpollResult {
+		done := make(chan *files.ListFolderLongpollResult, 1)
+		go func() {
+			res, err := dbx.ListFolderLongpoll(files.NewListFolderLongpollArg(latest.Cursor))
+			if err != nil {
+				t.Error(err)
+			}
+			done <- res
+		}()
+		return done
+	}
+
+	done := longpoll()
+	time.Sleep(20 * time.Millisecond)
+	if _, err = dbx.Upload(files.NewCommitInfo("/a"), bytes.NewReader(nil)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case res := <-done:
+		if res == nil || !res.Changes {
+			t.Errorf("longpoll = %+v, want changes", res)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("longpoll not woken by a change")
+	}
+
+	// The cursor predates the change, so a new poll returns at once
+	select {
+	case res := <-longpoll():
+		if res == nil || !res.Changes {
+			t.Errorf("longpoll = %+v, want changes", res)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("longpoll not returning pending changes")
+	}
+}
+
+func TestCloseTwice(t *testing.T) {
+	s := NewServer()
+	dbx := files.New(s.Config())
+	latest, err := dbx.ListFolderGetLatestCursor(files.NewListFolderArg(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		dbx.ListFolderLongpoll(files.NewListFolderLongpollArg(latest.Cursor))
+		close(done)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	s.Close()
+	s.Close()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("longpoll not ended by Close")
+	}
+}
+
+func revisions(t *testing.T, dbx files.Client, p string) []string {
+	res, err := dbx.ListRevisions(files.NewListRevisionsArg(p))
+	if err != nil {
+		t.Fatalf("list_revisions %s: %v", p, err)
+	}
+	var revs []string
+	for _, e := range res.Entries {
+		revs = append(revs, e.Rev)
+	}
+	return revs
+}
+
+func TestRelocate(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	dbx := files.New(s.Config())
+	var revs []string
+	for _, content := range []string{"1", "2"} {
+		res, err := dbx.Upload(overwrite("/a"), strings.NewReader(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		revs = append([]string{res.Rev}, revs...)
+	}
+	want := strings.Join(revs, " ")
+
+	if _, err := dbx.Move(files.NewRelocationArg("/a", "/b")); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(revisions(t, dbx, "/b"), " "); got != want {
+		t.Errorf("revisions after move = %s, want %s", got, want)
+	}
+	if _, err := dbx.ListRevisions(files.NewListRevisionsArg("/a")); !files.IsNotFound(err) {
+		t.Errorf("revisions of the source after move: %v, want not_found", err)
+	}
+
+	if _, err := dbx.Move(files.NewRelocationArg("/b", "/B")); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(revisions(t, dbx, "/B"), " "); got != want {
+		t.Errorf("revisions after renaming case = %s, want %s", got, want)
+	}
+
+	res, err := dbx.Copy(files.NewRelocationArg("/B", "/c"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	copied := res.(*files.FileMetadata)
+	if got := revisions(t, dbx, "/c"); len(got) != 1 || got[0] != copied.Rev || copied.Rev == revs[0] {
+		t.Errorf("revisions of copy = %v, want only %s", got, copied.Rev)
+	}
+	if got := strings.Join(revisions(t, dbx, "/B"), " "); got != want {
+		t.Errorf("revisions of copied file = %s, want %s", got, want)
+	}
+
+	if _, err = dbx.Upload(files.NewCommitInfo("/d/f"), strings.NewReader("x")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dbx.Move(files.NewRelocationArg("/d", "/e")); err != nil {
+		t.Fatal(err)
+	}
+	if got := revisions(t, dbx, "/e/f"); len(got) != 1 {
+		t.Errorf("revisions of file in moved folder = %v, want 1", got)
+	}
+}
+
+func TestRelocateRevisionPaths(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	dbx := files.New(s.Config())
+	var revs []string
+	for _, content := range []string{"1", "2"} {
+		res, err := dbx.Upload(overwrite("/d/a"), strings.NewReader(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		revs = append(revs, res.Rev)
+	}
+	for _, move := range []struct{ from, to, want string }{
+		{"/d/a", "/d/b", "/d/b"},
+		{"/d/b", "/d/B", "/d/B"},
+		{"/d", "/e", "/e/B"},
+	} {
+		if _, err := dbx.Move(files.NewRelocationArg(move.from, move.to)); err != nil {
+			t.Fatal(err)
+		}
+		res, err := dbx.ListRevisions(files.NewListRevisionsArg(move.want))
+		if err != nil {
+			t.Fatalf("list_revisions %s: %v", move.want, err)
+		}
+		if len(res.Entries) != len(revs) {
+			t.Errorf("%s: %d revisions, want %d", move.want, len(res.Entries), len(revs))
+		}
+		for _, e := range res.Entries {
+			if e.PathDisplay != move.want || e.PathLower != strings.ToLower(move.want) || e.Name != path.Base(move.want) {
+				t.Errorf("revision %s listed as %s, want %s", e.Rev, e.PathDisplay, move.want)
+			}
+		}
+		for _, rev := range revs {
+			m, err := dbx.GetMetadata(files.NewGetMetadataArg("rev:" + rev))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := m.(*files.FileMetadata).PathDisplay; got != move.want {
+				t.Errorf("rev:%s found at %s, want %s", rev, got, move.want)
+			}
+		}
+	}
+}
+
+func TestErrorSummary(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	dbx := files.New(s.Config())
+	if _, err := dbx.Upload(files.NewCommitInfo("/a"), strings.NewReader("1")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbx.CreateFolder(files.NewCreateFolderArg("/d")); err != nil {
+		t.Fatal(err)
+	}
+	start, err := dbx.UploadSessionStart(files.NewUploadSessionStartArg(), strings.NewReader("ab"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor := files.NewUploadSessionCursor(start.SessionId, 5)
+
+	for _, test := range []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{"get_metadata", func() error {
+			_, err := dbx.GetMetadata(files.NewGetMetadataArg("/nope"))
+			return err
+		}, "path/not_found/.."},
+		{"upload", func() error {
+			_, err := dbx.Upload(files.NewCommitInfo("/a"), strings.NewReader("2"))
+			return err
+		}, "path/conflict/file/.."},
+		{"move to", func() error {
+			_, err := dbx.Move(files.NewRelocationArg("/d", "/a"))
+			return err
+		}, "to/conflict/file/.."},
+		{"move from", func() error {
+			_, err := dbx.Move(files.NewRelocationArg("/nope", "/b"))
+			return err
+		}, "from_lookup/not_found/.."},
+		{"list_revisions", func() error {
+			_, err := dbx.ListRevisions(files.NewListRevisionsArg("/d"))
+			return err
+		}, "path/not_file/.."},
+		{"append", func() error {
+			return dbx.UploadSessionAppendV2(files.NewUploadSessionAppendArg(cursor), strings.NewReader("c"))
+		}, "incorrect_offset/.."},
+		{"finish", func() error {
+			_, err := dbx.UploadSessionFinish(files.NewUploadSessionFinishArg(cursor, files.NewCommitInfo("/s")), nil)
+			return err
+		}, "lookup_failed/incorrect_offset/.."},
+	} {
+		err := test.call()
+		if err == nil || err.Error() != test.want {
+			t.Errorf("%s: error %v, want %s", test.name, err, test.want)
+		}
+	}
+}
+
+func TestConcurrentSession(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	dbx := files.New(s.Config())
+	concurrent := func() *files.UploadSessionStartArg {
+		arg := files.NewUploadSessionStartArg()
+		arg.Extra = map[string]json.RawMessage{"session_type": json.RawMessage(`{".tag":"concurrent"}`)}
+		return arg
+	}
+	if _, err := dbx.UploadSessionStart(concurrent(), strings.NewReader("a")); err == nil || err.Error() != "concurrent_session_data_not_allowed/.." {
+		t.Errorf("start with data: error %v", err)
+	}
+	start, err := dbx.UploadSessionStart(concurrent(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendAt := func(offset uint64, content []byte, close bool) error {
+		arg := files.NewUploadSessionAppendArg(files.NewUploadSessionCursor(start.SessionId, offset))
+		arg.Close = close
+		return dbx.UploadSessionAppendV2(arg, bytes.NewReader(content))
+	}
+	finishAt := func(offset uint64) error {
+		cursor := files.NewUploadSessionCursor(start.SessionId, offset)
+		_, err := dbx.UploadSessionFinish(files.NewUploadSessionFinishArg(cursor, files.NewCommitInfo("/c")), nil)
+		return err
+	}
+	first, last := bytes.Repeat([]byte("a"), chunkUnit), []byte("bc")
+
+	for _, test := range []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{"unaligned offset", func() error { return appendAt(1, first, false) }, "concurrent_session_invalid_offset/.."},
+		{"partial chunk", func() error { return appendAt(0, last, false) }, "concurrent_session_invalid_data_size/.."},
+		{"not closed", func() error { return finishAt(0) }, "concurrent_session_not_closed/.."},
+		{"close", func() error { return appendAt(chunkUnit, last, true) }, ""},
+		{"missing data", func() error { return finishAt(chunkUnit + 2) }, "concurrent_session_missing_data/.."},
+		{"first chunk", func() error { return appendAt(0, first, false) }, ""},
+		{"wrong offset", func() error { return finishAt(chunkUnit) }, "lookup_failed/incorrect_offset/.."},
+		{"finish", func() error { return finishAt(chunkUnit + 2) }, ""},
+	} {
+		got := ""
+		if err := test.call(); err != nil {
+			got = err.Error()
+		}
+		if got != test.want {
+			t.Errorf("%s: error %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	_, body, err := dbx.Download(files.NewDownloadArg("/c"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+	if b, _ := ioutil.ReadAll(body); !bytes.Equal(b, append(first, last...)) {
+		t.Errorf("got %d bytes, want %d", len(b), len(first)+len(last))
+	}
+}
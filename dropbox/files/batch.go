@@ -0,0 +1,266 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/async"
+)
+
+const (
+	// Most entries that upload_session/finish_batch commits at once
+	maxBatchEntries = 1000
+	// DefaultBatchWorkers is the number of files that a BatchUploader
+	// uploads at once by default.
+	DefaultBatchWorkers = 4
+	// DefaultPollInterval is how often a BatchUploader checks whether a
+	// batch has been committed by default.
+	DefaultPollInterval = time.Second
+)
+
+// BatchItem is a file uploaded by a BatchUploader.
+type BatchItem struct {
+	Commit  *CommitInfo
+	Content io.Reader
+}
+
+// BatchResult is the outcome of uploading a BatchItem: either the metadata
+// of the file or the error that prevented uploading it.
+type BatchResult struct {
+	Metadata *FileMetadata
+	Err      error
+}
+
+// BatchError is the error of a file that upload_session/finish_batch
+// failed to commit. Its Failure can be inspected with
+// dropbox.IsEndpointError, IsConflict etc.
+type BatchError struct {
+	Path    string
+	Failure *UploadSessionFinishError
+}
+
+func (e BatchError) Error() string {
+	tags := []string{"unknown"}
+	if f := e.Failure; f != nil {
+		tags = []string{f.Tag}
+		switch {
+		case f.LookupFailed != nil:
+			tags = append(tags, f.LookupFailed.Tag)
+		case f.Path != nil:
+			tags = append(tags, f.Path.Tag)
+			if f.Path.Conflict != nil {
+				tags = append(tags, f.Path.Conflict.Tag)
+			}
+		}
+	}
+	return fmt.Sprintf("files: committing %q failed: %s", e.Path, strings.Join(tags, "/"))
+}
+
+// BatchUploader uploads many files, committing them together with
+// upload_session/finish_batch. This takes the lock of the namespace once
+// per batch rather than once per file, which avoids the
+// too_many_write_operations errors of uploading files one by one.
+//
+// Every file is uploaded with an upload session, by up to Workers
+// goroutines at once. Recovery from failed requests works as in an
+// Uploader.
+type BatchUploader struct {
+	Client Client
+	// Size of the chunks, a multiple of 4 MiB up to MaxChunkSize
+	// (DefaultChunkSize if 0)
+	ChunkSize int
+	// Number of times the upload of a file recovers from failed requests
+	// (DefaultMaxResumes if 0, none if negative)
+	MaxResumes int
+	// Number of files uploaded at once (DefaultBatchWorkers if 0)
+	Workers int
+	// How often to check whether a batch has been committed
+	// (DefaultPollInterval if 0)
+	PollInterval time.Duration
+	// If set, called after every chunk with the index of the item and the
+	// number of its bytes uploaded. It is called concurrently.
+	Progress func(item int, uploaded int64)
+}
+
+// NewBatchUploader returns a BatchUploader that uses c.
+func NewBatchUploader(c Client) *BatchUploader {
+	return &BatchUploader{Client: c}
+}
+
+// Upload uploads items, reading every Content until EOF, and returns a
+// result for each of them in the same order. Files are committed in
+// batches of up to 1000.
+//
+// Errors of individual files are reported in their BatchResult. An error
+// is only returned if ctx is done or a batch couldn't be committed, in which
+// case the results of the items that weren't committed may be nil.
+func (b *BatchUploader) Upload(ctx context.Context, items []*BatchItem) ([]*BatchResult, error) {
+	u := &Uploader{Client: b.Client, ChunkSize: b.ChunkSize, MaxResumes: b.MaxResumes}
+	size, err := u.chunkSize()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*BatchResult, len(items))
+	for start := 0; start < len(items); start += maxBatchEntries {
+		end := start + maxBatchEntries
+		if end > len(items) {
+			end = len(items)
+		}
+		cursors := b.uploadSessions(ctx, u, size, items[start:end], results[start:end], start)
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		if err := b.commit(ctx, items[start:end], cursors, results[start:end]); err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// uploadSessions uploads the content of items to closed sessions and
+// returns their cursors. The results of the items that fail are set. Once
+// ctx is done, no more items are started.
+func (b *BatchUploader) uploadSessions(ctx context.Context, u *Uploader, size int,
+	items []*BatchItem, results []*BatchResult, first int) []*UploadSessionCursor {
+	workers := b.Workers
+	if workers <= 0 {
+		workers = DefaultBatchWorkers
+	}
+	cursors := make([]*UploadSessionCursor, len(items))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			chunk := make([]byte, size)
+			for i := range next {
+				up := &upload{
+					Uploader:  u,
+					ctx:       ctx,
+					commit:    items[i].Commit,
+					src:       newSource(items[i].Content),
+					chunk:     chunk,
+					closeOnly: true,
+				}
+				if b.Progress != nil {
+					item := first + i
+					up.report = func(uploaded int64) { b.Progress(item, uploaded) }
+				}
+				if _, err := up.run(); err != nil {
+					results[i] = &BatchResult{Err: err}
+					continue
+				}
+				cursors[i] = up.cursor
+			}
+		}()
+	}
+feed:
+	for i := range items {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+	return cursors
+}
+
+// commit commits the sessions at cursors with finish_batch, and sets the
+// results of the items from its entries.
+func (b *BatchUploader) commit(ctx context.Context, items []*BatchItem,
+	cursors []*UploadSessionCursor, results []*BatchResult) error {
+	var entries []*UploadSessionFinishArg
+	var index []int // of the item of each entry
+	for i, cursor := range cursors {
+		if cursor != nil {
+			entries = append(entries, NewUploadSessionFinishArg(cursor, items[i].Commit))
+			index = append(index, i)
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	launch, err := b.Client.UploadSessionFinishBatchContext(ctx,
+		NewUploadSessionFinishBatchArg(entries))
+	if err != nil {
+		return err
+	}
+	var res *UploadSessionFinishBatchResult
+	switch launch.Tag {
+	case UploadSessionFinishBatchLaunchComplete:
+		res = launch.Complete
+	case UploadSessionFinishBatchLaunchAsyncJobId:
+		if res, err = b.wait(ctx, launch.AsyncJobId); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("files: unexpected result of upload_session/finish_batch: %q", launch.Tag)
+	}
+	if len(res.Entries) != len(entries) {
+		return fmt.Errorf("files: upload_session/finish_batch returned %d entries for %d files",
+			len(res.Entries), len(entries))
+	}
+	for j, entry := range res.Entries {
+		i := index[j]
+		switch entry.Tag {
+		case UploadSessionFinishBatchResultEntrySuccess:
+			results[i] = &BatchResult{Metadata: entry.Success}
+		default:
+			results[i] = &BatchResult{Err: BatchError{Path: items[i].Commit.Path, Failure: entry.Failure}}
+		}
+	}
+	return nil
+}
+
+// wait polls upload_session/finish_batch/check until the job is complete.
+func (b *BatchUploader) wait(ctx context.Context, jobID string) (*UploadSessionFinishBatchResult, error) {
+	interval := b.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	for {
+		status, err := b.Client.UploadSessionFinishBatchCheckContext(ctx, async.NewPollArg(jobID))
+		if err != nil {
+			return nil, err
+		}
+		switch status.Tag {
+		case UploadSessionFinishBatchJobStatusComplete:
+			return status.Complete, nil
+		case UploadSessionFinishBatchJobStatusInProgress:
+		default:
+			return nil, fmt.Errorf("files: unexpected status of upload_session/finish_batch: %q", status.Tag)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/async"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/dropboxtest"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+func (c *client) UploadSessionFinishBatchContext(ctx context.Context, arg *files.UploadSessionFinishBatchArg) (res *files.UploadSessionFinishBatchLaunch, err error) {
+	c.mu.Lock()
+	c.batches = append(c.batches, len(arg.Entries))
+	c.mu.Unlock()
+	err = c.do("finish_batch", func() error {
+		res, err = c.Client.UploadSessionFinishBatchContext(ctx, arg)
+		return err
+	})
+	return res, err
+}
+
+func (c *client) UploadSessionFinishBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *files.UploadSessionFinishBatchJobStatus, err error) {
+	err = c.do("check", func() error {
+		c.mu.Lock()
+		pending := c.pending > 0
+		c.pending--
+		c.mu.Unlock()
+		if pending {
+			res = &files.UploadSessionFinishBatchJobStatus{
+				Tagged: dropbox.Tagged{Tag: files.UploadSessionFinishBatchJobStatusInProgress}}
+			return nil
+		}
+		res, err = c.Client.UploadSessionFinishBatchCheckContext(ctx, arg)
+		return err
+	})
+	return res, err
+}
+
+// errReader fails to read.
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func newBatchUploader(c *client) *files.BatchUploader {
+	b := files.NewBatchUploader(c)
+	b.ChunkSize = chunk
+	b.PollInterval = time.Millisecond
+	return b
+}
+
+func TestBatchUploader(t *testing.T) {
+	s := dropboxtest.NewServer()
+	defer s.Close()
+	c := newClient(s)
+	if _, err := c.Upload(files.NewCommitInfo("/exists"), strings.NewReader("x")); err != nil {
+		t.Fatal(err)
+	}
+	c.routes = nil
+	c.pending = 2
+	big := content(chunk + 1)
+	items := []*files.BatchItem{
+		{Commit: files.NewCommitInfo("/a"), Content: strings.NewReader("a")},
+		{Commit: files.NewCommitInfo("/exists"), Content: strings.NewReader("b")},
+		{Commit: files.NewCommitInfo("/unread"), Content: errReader{}},
+		{Commit: files.NewCommitInfo("/big"), Content: bytes.NewReader(big)},
+		{Commit: files.NewCommitInfo("/empty"), Content: bytes.NewReader(nil)},
+	}
+
+	results, err := newBatchUploader(c).Upload(context.Background(), items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []int{0, 3, 4} {
+		if r := results[i]; r == nil || r.Err != nil || r.Metadata.PathLower != items[i].Commit.Path {
+			t.Errorf("result %d = %+v", i, r)
+		}
+	}
+	if r := results[1]; r == nil || !files.IsConflict(r.Err) {
+		t.Errorf("result of a conflict = %+v", r)
+	} else if _, ok := r.Err.(files.BatchError); !ok {
+		t.Errorf("error of a conflict is %T, want a BatchError", r.Err)
+	}
+	if r := results[2]; r == nil || r.Err == nil || r.Err.Error() != "read failed" {
+		t.Errorf("result of an unreadable item = %+v", r)
+	}
+	// The item that failed to upload isn't committed
+	if fmt.Sprint(c.batches) != "[4]" {
+		t.Errorf("finish_batch entries %v, want [4]", c.batches)
+	}
+	if got := c.called(); !strings.HasSuffix(got, "finish_batch check check check") {
+		t.Errorf("called %s, want finish_batch to be checked until complete", got)
+	}
+	if !bytes.Equal(download(t, s, "/big"), big) || string(download(t, s, "/exists")) != "x" {
+		t.Error("content differs")
+	}
+}
+
+func TestBatchUploaderBatches(t *testing.T) {
+	s := dropboxtest.NewServer()
+	defer s.Close()
+	c := newClient(s)
+	items := make([]*files.BatchItem, 1001)
+	for i := range items {
+		items[i] = &files.BatchItem{
+			Commit:  files.NewCommitInfo(fmt.Sprintf("/f%d", i)),
+			Content: strings.NewReader(fmt.Sprint(i)),
+		}
+	}
+	b := newBatchUploader(c)
+	b.Workers = 16
+	results, err := b.Upload(context.Background(), items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(c.batches) != "[1000 1]" {
+		t.Errorf("finish_batch entries %v, want [1000 1]", c.batches)
+	}
+	for i, r := range results {
+		if r == nil || r.Err != nil || r.Metadata.PathLower != items[i].Commit.Path {
+			t.Fatalf("result %d = %+v", i, r)
+		}
+	}
+}
+
+// cancelReader cancels a context when it is read.
+type cancelReader struct {
+	cancel func()
+}
+
+func (r cancelReader) Read(p []byte) (int, error) {
+	r.cancel()
+	return 0, io.EOF
+}
+
+// countReader counts the readers that are read.
+type countReader struct {
+	n *int32
+	r io.Reader
+}
+
+func (r *countReader) Read(p []byte) (int, error) {
+	if r.r == nil {
+		atomic.AddInt32(r.n, 1)
+		r.r = strings.NewReader("x")
+	}
+	return r.r.Read(p)
+}
+
+func TestBatchUploaderCancel(t *testing.T) {
+	s := dropboxtest.NewServer()
+	defer s.Close()
+	c := newClient(s)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var read int32
+	items := []*files.BatchItem{{Commit: files.NewCommitInfo("/0"), Content: cancelReader{cancel}}}
+	for i := 1; i < 100; i++ {
+		items = append(items, &files.BatchItem{
+			Commit:  files.NewCommitInfo(fmt.Sprintf("/%d", i)),
+			Content: &countReader{n: &read},
+		})
+	}
+	b := newBatchUploader(c)
+	b.Workers = 1
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := b.Upload(ctx, items)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Errorf("Upload = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Upload not ended by the context")
+	}
+	if n := atomic.LoadInt32(&read); n == int32(len(items)-1) {
+		t.Errorf("all %d items read after the context was done", n)
+	}
+	if len(c.batches) != 0 {
+		t.Errorf("finish_batch called after the context was done")
+	}
+}
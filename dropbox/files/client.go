@@ -22,14 +22,16 @@ package files
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/async"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/auth"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/common"
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/properties"
 )
 
@@ -39,15 +41,22 @@ type Client interface {
 	// alpha endpoint compatible with the properties API. Note: Metadata for the
 	// root folder is unsupported.
 	AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata, err error)
+	// AlphaGetMetadataContext : Like `alphaGetMetadata`, but the request is
+	// bound to ctx.
+	AlphaGetMetadataContext(ctx context.Context, arg *AlphaGetMetadataArg) (res IsMetadata, err error)
 	// AlphaUpload : Create a new file with the contents provided in the
 	// request. Note that this endpoint is part of the properties API alpha and
 	// is slightly different from `upload`. Do not use this to upload a file
 	// larger than 150 MB. Instead, create an upload session with
 	// `uploadSessionStart`.
 	AlphaUpload(arg *CommitInfoWithProperties, content io.Reader) (res *FileMetadata, err error)
+	// AlphaUploadContext : Like `alphaUpload`, but the request is bound to ctx.
+	AlphaUploadContext(ctx context.Context, arg *CommitInfoWithProperties, content io.Reader) (res *FileMetadata, err error)
 	// Copy : Copy a file or folder to a different location in the user's
 	// Dropbox. If the source path is a folder all its contents will be copied.
 	Copy(arg *RelocationArg) (res IsMetadata, err error)
+	// CopyContext : Like `copy`, but the request is bound to ctx.
+	CopyContext(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error)
 	// CopyBatch : Copy multiple files or folders to different locations at once
 	// in the user's Dropbox. If `RelocationBatchArg.allow_shared_folder` is
 	// false, this route is atomic. If on entry failes, the whole transaction
@@ -57,36 +66,61 @@ type Client interface {
 	// immediately and do the async copy job in background. Please use
 	// `copyBatchCheck` to check the job status.
 	CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error)
+	// CopyBatchContext : Like `copyBatch`, but the request is bound to ctx.
+	CopyBatchContext(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error)
 	// CopyBatchCheck : Returns the status of an asynchronous job for
 	// `copyBatch`. If success, it returns list of results for each entry.
 	CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error)
+	// CopyBatchCheckContext : Like `copyBatchCheck`, but the request is bound
+	// to ctx.
+	CopyBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error)
 	// CopyReferenceGet : Get a copy reference to a file or folder. This
 	// reference string can be used to save that file or folder to another
 	// user's Dropbox by passing it to `copyReferenceSave`.
 	CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error)
+	// CopyReferenceGetContext : Like `copyReferenceGet`, but the request is
+	// bound to ctx.
+	CopyReferenceGetContext(ctx context.Context, arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error)
 	// CopyReferenceSave : Save a copy reference returned by `copyReferenceGet`
 	// to the user's Dropbox.
 	CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error)
+	// CopyReferenceSaveContext : Like `copyReferenceSave`, but the request is
+	// bound to ctx.
+	CopyReferenceSaveContext(ctx context.Context, arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error)
 	// CreateFolder : Create a folder at a given path.
 	CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err error)
+	// CreateFolderContext : Like `createFolder`, but the request is bound to
+	// ctx.
+	CreateFolderContext(ctx context.Context, arg *CreateFolderArg) (res *FolderMetadata, err error)
 	// Delete : Delete the file or folder at a given path. If the path is a
 	// folder, all its contents will be deleted too. A successful response
 	// indicates that the file or folder was deleted. The returned metadata will
 	// be the corresponding `FileMetadata` or `FolderMetadata` for the item at
 	// time of deletion, and not a `DeletedMetadata` object.
 	Delete(arg *DeleteArg) (res IsMetadata, err error)
+	// DeleteContext : Like `delete`, but the request is bound to ctx.
+	DeleteContext(ctx context.Context, arg *DeleteArg) (res IsMetadata, err error)
 	// DeleteBatch : Delete multiple files/folders at once. This route is
 	// asynchronous, which returns a job ID immediately and runs the delete
 	// batch asynchronously. Use `deleteBatchCheck` to check the job status.
 	DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error)
+	// DeleteBatchContext : Like `deleteBatch`, but the request is bound to ctx.
+	DeleteBatchContext(ctx context.Context, arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error)
 	// DeleteBatchCheck : Returns the status of an asynchronous job for
 	// `deleteBatch`. If success, it returns list of result for each entry.
 	DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobStatus, err error)
+	// DeleteBatchCheckContext : Like `deleteBatchCheck`, but the request is
+	// bound to ctx.
+	DeleteBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *DeleteBatchJobStatus, err error)
 	// Download : Download a file from a user's Dropbox.
 	Download(arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error)
+	// DownloadContext : Like `download`, but the request is bound to ctx.
+	DownloadContext(ctx context.Context, arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error)
 	// GetMetadata : Returns the metadata for a file or folder. Note: Metadata
 	// for the root folder is unsupported.
 	GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error)
+	// GetMetadataContext : Like `getMetadata`, but the request is bound to ctx.
+	GetMetadataContext(ctx context.Context, arg *GetMetadataArg) (res IsMetadata, err error)
 	// GetPreview : Get a preview for a file. Currently, PDF previews are
 	// generated for files with the following extensions: .ai, .doc, .docm,
 	// .docx, .eps, .odp, .odt, .pps, .ppsm, .ppsx, .ppt, .pptm, .pptx, .rtf.
@@ -94,16 +128,24 @@ type Client interface {
 	// .csv, .ods, .xls, .xlsm, .xlsx. Other formats will return an unsupported
 	// extension error.
 	GetPreview(arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error)
+	// GetPreviewContext : Like `getPreview`, but the request is bound to ctx.
+	GetPreviewContext(ctx context.Context, arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error)
 	// GetTemporaryLink : Get a temporary link to stream content of a file. This
 	// link will expire in four hours and afterwards you will get 410 Gone.
 	// Content-Type of the link is determined automatically by the file's mime
 	// type.
 	GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error)
+	// GetTemporaryLinkContext : Like `getTemporaryLink`, but the request is
+	// bound to ctx.
+	GetTemporaryLinkContext(ctx context.Context, arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error)
 	// GetThumbnail : Get a thumbnail for an image. This method currently
 	// supports files with the following file extensions: jpg, jpeg, png, tiff,
 	// tif, gif and bmp. Photos that are larger than 20MB in size won't be
 	// converted to a thumbnail.
 	GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error)
+	// GetThumbnailContext : Like `getThumbnail`, but the request is bound to
+	// ctx.
+	GetThumbnailContext(ctx context.Context, arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error)
 	// ListFolder : Starts returning the contents of a folder. If the result's
 	// `ListFolderResult.has_more` field is true, call `listFolderContinue` with
 	// the returned `ListFolderResult.cursor` to retrieve more entries. If
@@ -126,16 +168,24 @@ type Client interface {
 	// by same API app for same user. If your app implements retry logic, please
 	// hold off the retry until the previous request finishes.
 	ListFolder(arg *ListFolderArg) (res *ListFolderResult, err error)
+	// ListFolderContext : Like `listFolder`, but the request is bound to ctx.
+	ListFolderContext(ctx context.Context, arg *ListFolderArg) (res *ListFolderResult, err error)
 	// ListFolderContinue : Once a cursor has been retrieved from `listFolder`,
 	// use this to paginate through all files and retrieve updates to the
 	// folder, following the same rules as documented for `listFolder`.
 	ListFolderContinue(arg *ListFolderContinueArg) (res *ListFolderResult, err error)
+	// ListFolderContinueContext : Like `listFolderContinue`, but the request is
+	// bound to ctx.
+	ListFolderContinueContext(ctx context.Context, arg *ListFolderContinueArg) (res *ListFolderResult, err error)
 	// ListFolderGetLatestCursor : A way to quickly get a cursor for the
 	// folder's state. Unlike `listFolder`, `listFolderGetLatestCursor` doesn't
 	// return any entries. This endpoint is for app which only needs to know
 	// about new files and modifications and doesn't need to know about files
 	// that already exist in Dropbox.
 	ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error)
+	// ListFolderGetLatestCursorContext : Like `listFolderGetLatestCursor`, but
+	// the request is bound to ctx.
+	ListFolderGetLatestCursorContext(ctx context.Context, arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error)
 	// ListFolderLongpoll : A longpoll endpoint to wait for changes on an
 	// account. In conjunction with `listFolderContinue`, this call gives you a
 	// low-latency way to monitor an account for file changes. The connection
@@ -144,72 +194,126 @@ type Client interface {
 	// server-side notifications, check out our `webhooks documentation`
 	// <https://www.dropbox.com/developers/reference/webhooks>.
 	ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error)
+	// ListFolderLongpollContext : Like `listFolderLongpoll`, but the request is
+	// bound to ctx.
+	ListFolderLongpollContext(ctx context.Context, arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error)
 	// ListRevisions : Return revisions of a file.
 	ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResult, err error)
+	// ListRevisionsContext : Like `listRevisions`, but the request is bound to
+	// ctx.
+	ListRevisionsContext(ctx context.Context, arg *ListRevisionsArg) (res *ListRevisionsResult, err error)
 	// Move : Move a file or folder to a different location in the user's
 	// Dropbox. If the source path is a folder all its contents will be moved.
 	Move(arg *RelocationArg) (res IsMetadata, err error)
+	// MoveContext : Like `move`, but the request is bound to ctx.
+	MoveContext(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error)
 	// MoveBatch : Move multiple files or folders to different locations at once
 	// in the user's Dropbox. This route is 'all or nothing', which means if one
 	// entry fails, the whole transaction will abort. This route will return job
 	// ID immediately and do the async moving job in background. Please use
 	// `moveBatchCheck` to check the job status.
 	MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error)
+	// MoveBatchContext : Like `moveBatch`, but the request is bound to ctx.
+	MoveBatchContext(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error)
 	// MoveBatchCheck : Returns the status of an asynchronous job for
 	// `moveBatch`. If success, it returns list of results for each entry.
 	MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error)
+	// MoveBatchCheckContext : Like `moveBatchCheck`, but the request is bound
+	// to ctx.
+	MoveBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error)
 	// PermanentlyDelete : Permanently delete the file or folder at a given path
 	// (see https://www.dropbox.com/en/help/40). Note: This endpoint is only
 	// available for Dropbox Business apps.
 	PermanentlyDelete(arg *DeleteArg) (err error)
+	// PermanentlyDeleteContext : Like `permanentlyDelete`, but the request is
+	// bound to ctx.
+	PermanentlyDeleteContext(ctx context.Context, arg *DeleteArg) (err error)
 	// PropertiesAdd : Add custom properties to a file using a filled property
 	// template. See properties/template/add to create new property templates.
 	PropertiesAdd(arg *PropertyGroupWithPath) (err error)
+	// PropertiesAddContext : Like `propertiesAdd`, but the request is bound to
+	// ctx.
+	PropertiesAddContext(ctx context.Context, arg *PropertyGroupWithPath) (err error)
 	// PropertiesOverwrite : Overwrite custom properties from a specified
 	// template associated with a file.
 	PropertiesOverwrite(arg *PropertyGroupWithPath) (err error)
+	// PropertiesOverwriteContext : Like `propertiesOverwrite`, but the request
+	// is bound to ctx.
+	PropertiesOverwriteContext(ctx context.Context, arg *PropertyGroupWithPath) (err error)
 	// PropertiesRemove : Remove all custom properties from a specified template
 	// associated with a file. To remove specific property key value pairs, see
 	// `propertiesUpdate`. To update a property template, see
 	// properties/template/update. Property templates can't be removed once
 	// created.
 	PropertiesRemove(arg *RemovePropertiesArg) (err error)
+	// PropertiesRemoveContext : Like `propertiesRemove`, but the request is
+	// bound to ctx.
+	PropertiesRemoveContext(ctx context.Context, arg *RemovePropertiesArg) (err error)
 	// PropertiesTemplateGet : Get the schema for a specified template.
 	PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error)
+	// PropertiesTemplateGetContext : Like `propertiesTemplateGet`, but the
+	// request is bound to ctx.
+	PropertiesTemplateGetContext(ctx context.Context, arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error)
 	// PropertiesTemplateList : Get the property template identifiers for a
 	// user. To get the schema of each template use `propertiesTemplateGet`.
 	PropertiesTemplateList() (res *properties.ListPropertyTemplateIds, err error)
+	// PropertiesTemplateListContext : Like `propertiesTemplateList`, but the
+	// request is bound to ctx.
+	PropertiesTemplateListContext(ctx context.Context) (res *properties.ListPropertyTemplateIds, err error)
 	// PropertiesUpdate : Add, update or remove custom properties from a
 	// specified template associated with a file. Fields that already exist and
 	// not described in the request will not be modified.
 	PropertiesUpdate(arg *UpdatePropertyGroupArg) (err error)
+	// PropertiesUpdateContext : Like `propertiesUpdate`, but the request is
+	// bound to ctx.
+	PropertiesUpdateContext(ctx context.Context, arg *UpdatePropertyGroupArg) (err error)
 	// Restore : Restore a file to a specific revision.
 	Restore(arg *RestoreArg) (res *FileMetadata, err error)
+	// RestoreContext : Like `restore`, but the request is bound to ctx.
+	RestoreContext(ctx context.Context, arg *RestoreArg) (res *FileMetadata, err error)
 	// SaveUrl : Save a specified URL into a file in user's Dropbox. If the
 	// given path already exists, the file will be renamed to avoid the conflict
 	// (e.g. myfile (1).txt).
 	SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error)
+	// SaveUrlContext : Like `saveUrl`, but the request is bound to ctx.
+	SaveUrlContext(ctx context.Context, arg *SaveUrlArg) (res *SaveUrlResult, err error)
 	// SaveUrlCheckJobStatus : Check the status of a `saveUrl` job.
 	SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobStatus, err error)
+	// SaveUrlCheckJobStatusContext : Like `saveUrlCheckJobStatus`, but the
+	// request is bound to ctx.
+	SaveUrlCheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *SaveUrlJobStatus, err error)
 	// Search : Searches for files and folders. Note: Recent changes may not
 	// immediately be reflected in search results due to a short delay in
 	// indexing.
 	Search(arg *SearchArg) (res *SearchResult, err error)
+	// SearchContext : Like `search`, but the request is bound to ctx.
+	SearchContext(ctx context.Context, arg *SearchArg) (res *SearchResult, err error)
 	// Upload : Create a new file with the contents provided in the request. Do
 	// not use this to upload a file larger than 150 MB. Instead, create an
 	// upload session with `uploadSessionStart`.
 	Upload(arg *CommitInfo, content io.Reader) (res *FileMetadata, err error)
+	// UploadContext : Like `upload`, but the request is bound to ctx.
+	UploadContext(ctx context.Context, arg *CommitInfo, content io.Reader) (res *FileMetadata, err error)
 	// UploadSessionAppend : Append more data to an upload session. A single
 	// request should not upload more than 150 MB.
 	UploadSessionAppend(arg *UploadSessionCursor, content io.Reader) (err error)
+	// UploadSessionAppendContext : Like `uploadSessionAppend`, but the request
+	// is bound to ctx.
+	UploadSessionAppendContext(ctx context.Context, arg *UploadSessionCursor, content io.Reader) (err error)
 	// UploadSessionAppendV2 : Append more data to an upload session. When the
 	// parameter close is set, this call will close the session. A single
 	// request should not upload more than 150 MB.
 	UploadSessionAppendV2(arg *UploadSessionAppendArg, content io.Reader) (err error)
+	// UploadSessionAppendV2Context : Like `uploadSessionAppendV2`, but the
+	// request is bound to ctx.
+	UploadSessionAppendV2Context(ctx context.Context, arg *UploadSessionAppendArg, content io.Reader) (err error)
 	// UploadSessionFinish : Finish an upload session and save the uploaded data
 	// to the given file path. A single request should not upload more than 150
 	// MB.
 	UploadSessionFinish(arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error)
+	// UploadSessionFinishContext : Like `uploadSessionFinish`, but the request
+	// is bound to ctx.
+	UploadSessionFinishContext(ctx context.Context, arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error)
 	// UploadSessionFinishBatch : This route helps you commit many files at once
 	// into a user's Dropbox. Use `uploadSessionStart` and
 	// `uploadSessionAppendV2` to upload file contents. We recommend uploading
@@ -224,10 +328,16 @@ type Client interface {
 	// That means you should not start the next job before current job finishes.
 	// We allow up to 1000 entries in a single request.
 	UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error)
+	// UploadSessionFinishBatchContext : Like `uploadSessionFinishBatch`, but
+	// the request is bound to ctx.
+	UploadSessionFinishBatchContext(ctx context.Context, arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error)
 	// UploadSessionFinishBatchCheck : Returns the status of an asynchronous job
 	// for `uploadSessionFinishBatch`. If success, it returns list of result for
 	// each entry.
 	UploadSessionFinishBatchCheck(arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error)
+	// UploadSessionFinishBatchCheckContext : Like
+	// `uploadSessionFinishBatchCheck`, but the request is bound to ctx.
+	UploadSessionFinishBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error)
 	// UploadSessionStart : Upload sessions allow you to upload a single file in
 	// one or more requests, for example where the size of the file is greater
 	// than 150 MB.  This call starts a new upload session with the given data.
@@ -239,21 +349,34 @@ type Client interface {
 	// `uploadSessionFinish` more than 48 hours after its creation will return a
 	// `UploadSessionLookupError.not_found`.
 	UploadSessionStart(arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error)
+	// UploadSessionStartContext : Like `uploadSessionStart`, but the request is
+	// bound to ctx.
+	UploadSessionStartContext(ctx context.Context, arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error)
 }
 
-type apiImpl dropbox.Context
+// ClientImpl implements Client by calling the Dropbox API
+type ClientImpl dropbox.Context
 
-//AlphaGetMetadataAPIError is an error-wrapper for the alpha/get_metadata route
+var _ Client = (*ClientImpl)(nil)
+
+// AlphaGetMetadataAPIError is an error-wrapper for the alpha/get_metadata route
 type AlphaGetMetadataAPIError struct {
 	dropbox.APIError
 	EndpointError *AlphaGetMetadataError `json:"error"`
 }
 
-func (dbx *apiImpl) AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e AlphaGetMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata, err error) {
+	return dbx.AlphaGetMetadataContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) AlphaGetMetadataContext(ctx context.Context, arg *AlphaGetMetadataArg) (res IsMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -265,20 +388,23 @@ func (dbx *apiImpl) AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata,
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "alpha/get_metadata", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "alpha/get_metadata", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -289,9 +415,6 @@ func (dbx *apiImpl) AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata,
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		var tmp metadataUnion
 		err = json.Unmarshal(body, &tmp)
@@ -320,31 +443,36 @@ func (dbx *apiImpl) AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata,
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//AlphaUploadAPIError is an error-wrapper for the alpha/upload route
+// AlphaUploadAPIError is an error-wrapper for the alpha/upload route
 type AlphaUploadAPIError struct {
 	dropbox.APIError
 	EndpointError *UploadErrorWithProperties `json:"error"`
 }
 
-func (dbx *apiImpl) AlphaUpload(arg *CommitInfoWithProperties, content io.Reader) (res *FileMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e AlphaUploadAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) AlphaUpload(arg *CommitInfoWithProperties, content io.Reader) (res *FileMetadata, err error) {
+	return dbx.AlphaUploadContext(context.Background(), arg, content)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) AlphaUploadContext(ctx context.Context, arg *CommitInfoWithProperties, content io.Reader) (res *FileMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -357,20 +485,23 @@ func (dbx *apiImpl) AlphaUpload(arg *CommitInfoWithProperties, content io.Reader
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "upload", true, "files", "alpha/upload", headers, content)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "upload", "user", "files", "alpha/upload", headers, content)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -381,9 +512,6 @@ func (dbx *apiImpl) AlphaUpload(arg *CommitInfoWithProperties, content io.Reader
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -401,31 +529,36 @@ func (dbx *apiImpl) AlphaUpload(arg *CommitInfoWithProperties, content io.Reader
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CopyAPIError is an error-wrapper for the copy route
+// CopyAPIError is an error-wrapper for the copy route
 type CopyAPIError struct {
 	dropbox.APIError
 	EndpointError *RelocationError `json:"error"`
 }
 
-func (dbx *apiImpl) Copy(arg *RelocationArg) (res IsMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CopyAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) Copy(arg *RelocationArg) (res IsMetadata, err error) {
+	return dbx.CopyContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CopyContext(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -437,20 +570,23 @@ func (dbx *apiImpl) Copy(arg *RelocationArg) (res IsMetadata, err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "copy", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "copy", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -461,9 +597,6 @@ func (dbx *apiImpl) Copy(arg *RelocationArg) (res IsMetadata, err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		var tmp metadataUnion
 		err = json.Unmarshal(body, &tmp)
@@ -492,31 +625,36 @@ func (dbx *apiImpl) Copy(arg *RelocationArg) (res IsMetadata, err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CopyBatchAPIError is an error-wrapper for the copy_batch route
+// CopyBatchAPIError is an error-wrapper for the copy_batch route
 type CopyBatchAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CopyBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+	return dbx.CopyBatchContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CopyBatchContext(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -528,20 +666,23 @@ func (dbx *apiImpl) CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaun
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "copy_batch", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "copy_batch", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -552,9 +693,6 @@ func (dbx *apiImpl) CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaun
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -572,31 +710,36 @@ func (dbx *apiImpl) CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaun
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CopyBatchCheckAPIError is an error-wrapper for the copy_batch/check route
+// CopyBatchCheckAPIError is an error-wrapper for the copy_batch/check route
 type CopyBatchCheckAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CopyBatchCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+	return dbx.CopyBatchCheckContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CopyBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -608,20 +751,23 @@ func (dbx *apiImpl) CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobS
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "copy_batch/check", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "copy_batch/check", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -632,9 +778,6 @@ func (dbx *apiImpl) CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobS
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -652,31 +795,36 @@ func (dbx *apiImpl) CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobS
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CopyReferenceGetAPIError is an error-wrapper for the copy_reference/get route
+// CopyReferenceGetAPIError is an error-wrapper for the copy_reference/get route
 type CopyReferenceGetAPIError struct {
 	dropbox.APIError
 	EndpointError *GetCopyReferenceError `json:"error"`
 }
 
-func (dbx *apiImpl) CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CopyReferenceGetAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error) {
+	return dbx.CopyReferenceGetContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) CopyReferenceGetContext(ctx context.Context, arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -688,20 +836,23 @@ func (dbx *apiImpl) CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyRefe
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "copy_reference/get", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "copy_reference/get", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -712,9 +863,6 @@ func (dbx *apiImpl) CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyRefe
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -732,31 +880,36 @@ func (dbx *apiImpl) CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyRefe
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CopyReferenceSaveAPIError is an error-wrapper for the copy_reference/save route
+// CopyReferenceSaveAPIError is an error-wrapper for the copy_reference/save route
 type CopyReferenceSaveAPIError struct {
 	dropbox.APIError
 	EndpointError *SaveCopyReferenceError `json:"error"`
 }
 
-func (dbx *apiImpl) CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CopyReferenceSaveAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error) {
+	return dbx.CopyReferenceSaveContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) CopyReferenceSaveContext(ctx context.Context, arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -768,20 +921,23 @@ func (dbx *apiImpl) CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyR
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "copy_reference/save", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "copy_reference/save", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -792,9 +948,6 @@ func (dbx *apiImpl) CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyR
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -812,31 +965,36 @@ func (dbx *apiImpl) CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyR
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CreateFolderAPIError is an error-wrapper for the create_folder route
+// CreateFolderAPIError is an error-wrapper for the create_folder route
 type CreateFolderAPIError struct {
 	dropbox.APIError
 	EndpointError *CreateFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CreateFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err error) {
+	return dbx.CreateFolderContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CreateFolderContext(ctx context.Context, arg *CreateFolderArg) (res *FolderMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -848,20 +1006,23 @@ func (dbx *apiImpl) CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "create_folder", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "create_folder", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -872,9 +1033,6 @@ func (dbx *apiImpl) CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -892,31 +1050,36 @@ func (dbx *apiImpl) CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DeleteAPIError is an error-wrapper for the delete route
+// DeleteAPIError is an error-wrapper for the delete route
 type DeleteAPIError struct {
 	dropbox.APIError
 	EndpointError *DeleteError `json:"error"`
 }
 
-func (dbx *apiImpl) Delete(arg *DeleteArg) (res IsMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DeleteAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) Delete(arg *DeleteArg) (res IsMetadata, err error) {
+	return dbx.DeleteContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) DeleteContext(ctx context.Context, arg *DeleteArg) (res IsMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -928,20 +1091,23 @@ func (dbx *apiImpl) Delete(arg *DeleteArg) (res IsMetadata, err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "delete", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "delete", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -952,9 +1118,6 @@ func (dbx *apiImpl) Delete(arg *DeleteArg) (res IsMetadata, err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		var tmp metadataUnion
 		err = json.Unmarshal(body, &tmp)
@@ -983,31 +1146,36 @@ func (dbx *apiImpl) Delete(arg *DeleteArg) (res IsMetadata, err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DeleteBatchAPIError is an error-wrapper for the delete_batch route
+// DeleteBatchAPIError is an error-wrapper for the delete_batch route
 type DeleteBatchAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DeleteBatchAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error) {
+	return dbx.DeleteBatchContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) DeleteBatchContext(ctx context.Context, arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1019,20 +1187,23 @@ func (dbx *apiImpl) DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, er
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "delete_batch", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "delete_batch", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1043,9 +1214,6 @@ func (dbx *apiImpl) DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, er
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1063,31 +1231,36 @@ func (dbx *apiImpl) DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, er
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DeleteBatchCheckAPIError is an error-wrapper for the delete_batch/check route
+// DeleteBatchCheckAPIError is an error-wrapper for the delete_batch/check route
 type DeleteBatchCheckAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DeleteBatchCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobStatus, err error) {
+	return dbx.DeleteBatchCheckContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DeleteBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *DeleteBatchJobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1099,20 +1272,23 @@ func (dbx *apiImpl) DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobSta
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "delete_batch/check", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "delete_batch/check", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1123,9 +1299,6 @@ func (dbx *apiImpl) DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobSta
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1143,31 +1316,36 @@ func (dbx *apiImpl) DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobSta
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DownloadAPIError is an error-wrapper for the download route
+// DownloadAPIError is an error-wrapper for the download route
 type DownloadAPIError struct {
 	dropbox.APIError
 	EndpointError *DownloadError `json:"error"`
 }
 
-func (dbx *apiImpl) Download(arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DownloadAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) Download(arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	return dbx.DownloadContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DownloadContext(ctx context.Context, arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1182,30 +1360,30 @@ func (dbx *apiImpl) Download(arg *DownloadArg) (res *FileMetadata, content io.Re
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "download", true, "files", "download", headers, nil)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "download", "user", "files", "download", headers, nil)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
 
 	body := []byte(resp.Header.Get("Dropbox-API-Result"))
-	content = resp.Body
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
+		content = resp.Body
 		err = json.Unmarshal(body, &res)
 		if err != nil {
 			return
@@ -1213,6 +1391,11 @@ func (dbx *apiImpl) Download(arg *DownloadArg) (res *FileMetadata, content io.Re
 
 		return
 	}
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
 	if resp.StatusCode == http.StatusConflict {
 		var apiError DownloadAPIError
 		err = json.Unmarshal(body, &apiError)
@@ -1222,31 +1405,36 @@ func (dbx *apiImpl) Download(arg *DownloadArg) (res *FileMetadata, content io.Re
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetMetadataAPIError is an error-wrapper for the get_metadata route
+// GetMetadataAPIError is an error-wrapper for the get_metadata route
 type GetMetadataAPIError struct {
 	dropbox.APIError
 	EndpointError *GetMetadataError `json:"error"`
 }
 
-func (dbx *apiImpl) GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error) {
+	return dbx.GetMetadataContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetMetadataContext(ctx context.Context, arg *GetMetadataArg) (res IsMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1258,20 +1446,23 @@ func (dbx *apiImpl) GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error)
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "get_metadata", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "get_metadata", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1282,9 +1473,6 @@ func (dbx *apiImpl) GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error)
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		var tmp metadataUnion
 		err = json.Unmarshal(body, &tmp)
@@ -1313,31 +1501,36 @@ func (dbx *apiImpl) GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error)
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetPreviewAPIError is an error-wrapper for the get_preview route
+// GetPreviewAPIError is an error-wrapper for the get_preview route
 type GetPreviewAPIError struct {
 	dropbox.APIError
 	EndpointError *PreviewError `json:"error"`
 }
 
-func (dbx *apiImpl) GetPreview(arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetPreviewAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetPreview(arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	return dbx.GetPreviewContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetPreviewContext(ctx context.Context, arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1349,30 +1542,30 @@ func (dbx *apiImpl) GetPreview(arg *PreviewArg) (res *FileMetadata, content io.R
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "download", true, "files", "get_preview", headers, nil)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "download", "user", "files", "get_preview", headers, nil)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
 
 	body := []byte(resp.Header.Get("Dropbox-API-Result"))
-	content = resp.Body
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
+		content = resp.Body
 		err = json.Unmarshal(body, &res)
 		if err != nil {
 			return
@@ -1380,6 +1573,11 @@ func (dbx *apiImpl) GetPreview(arg *PreviewArg) (res *FileMetadata, content io.R
 
 		return
 	}
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
 	if resp.StatusCode == http.StatusConflict {
 		var apiError GetPreviewAPIError
 		err = json.Unmarshal(body, &apiError)
@@ -1389,31 +1587,36 @@ func (dbx *apiImpl) GetPreview(arg *PreviewArg) (res *FileMetadata, content io.R
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetTemporaryLinkAPIError is an error-wrapper for the get_temporary_link route
+// GetTemporaryLinkAPIError is an error-wrapper for the get_temporary_link route
 type GetTemporaryLinkAPIError struct {
 	dropbox.APIError
 	EndpointError *GetTemporaryLinkError `json:"error"`
 }
 
-func (dbx *apiImpl) GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetTemporaryLinkAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error) {
+	return dbx.GetTemporaryLinkContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetTemporaryLinkContext(ctx context.Context, arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1425,20 +1628,23 @@ func (dbx *apiImpl) GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporar
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "get_temporary_link", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "get_temporary_link", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1449,9 +1655,6 @@ func (dbx *apiImpl) GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporar
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1469,31 +1672,36 @@ func (dbx *apiImpl) GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporar
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetThumbnailAPIError is an error-wrapper for the get_thumbnail route
+// GetThumbnailAPIError is an error-wrapper for the get_thumbnail route
 type GetThumbnailAPIError struct {
 	dropbox.APIError
 	EndpointError *ThumbnailError `json:"error"`
 }
 
-func (dbx *apiImpl) GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetThumbnailAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	return dbx.GetThumbnailContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) GetThumbnailContext(ctx context.Context, arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1505,30 +1713,30 @@ func (dbx *apiImpl) GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "download", true, "files", "get_thumbnail", headers, nil)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "download", "user", "files", "get_thumbnail", headers, nil)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
 
 	body := []byte(resp.Header.Get("Dropbox-API-Result"))
-	content = resp.Body
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
+		content = resp.Body
 		err = json.Unmarshal(body, &res)
 		if err != nil {
 			return
@@ -1536,6 +1744,11 @@ func (dbx *apiImpl) GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content
 
 		return
 	}
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
 	if resp.StatusCode == http.StatusConflict {
 		var apiError GetThumbnailAPIError
 		err = json.Unmarshal(body, &apiError)
@@ -1545,31 +1758,36 @@ func (dbx *apiImpl) GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFolderAPIError is an error-wrapper for the list_folder route
+// ListFolderAPIError is an error-wrapper for the list_folder route
 type ListFolderAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolder(arg *ListFolderArg) (res *ListFolderResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFolderAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFolder(arg *ListFolderArg) (res *ListFolderResult, err error) {
+	return dbx.ListFolderContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) ListFolderContext(ctx context.Context, arg *ListFolderArg) (res *ListFolderResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1581,20 +1799,23 @@ func (dbx *apiImpl) ListFolder(arg *ListFolderArg) (res *ListFolderResult, err e
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "list_folder", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "list_folder", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1605,9 +1826,6 @@ func (dbx *apiImpl) ListFolder(arg *ListFolderArg) (res *ListFolderResult, err e
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1625,31 +1843,36 @@ func (dbx *apiImpl) ListFolder(arg *ListFolderArg) (res *ListFolderResult, err e
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFolderContinueAPIError is an error-wrapper for the list_folder/continue route
+// ListFolderContinueAPIError is an error-wrapper for the list_folder/continue route
 type ListFolderContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFolderContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderContinue(arg *ListFolderContinueArg) (res *ListFolderResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFolderContinueAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFolderContinue(arg *ListFolderContinueArg) (res *ListFolderResult, err error) {
+	return dbx.ListFolderContinueContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) ListFolderContinueContext(ctx context.Context, arg *ListFolderContinueArg) (res *ListFolderResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1661,20 +1884,23 @@ func (dbx *apiImpl) ListFolderContinue(arg *ListFolderContinueArg) (res *ListFol
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "list_folder/continue", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "list_folder/continue", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1685,9 +1911,6 @@ func (dbx *apiImpl) ListFolderContinue(arg *ListFolderContinueArg) (res *ListFol
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1705,31 +1928,36 @@ func (dbx *apiImpl) ListFolderContinue(arg *ListFolderContinueArg) (res *ListFol
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFolderGetLatestCursorAPIError is an error-wrapper for the list_folder/get_latest_cursor route
+// ListFolderGetLatestCursorAPIError is an error-wrapper for the list_folder/get_latest_cursor route
 type ListFolderGetLatestCursorAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFolderGetLatestCursorAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error) {
+	return dbx.ListFolderGetLatestCursorContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFolderGetLatestCursorContext(ctx context.Context, arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1741,20 +1969,23 @@ func (dbx *apiImpl) ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFold
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "list_folder/get_latest_cursor", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "list_folder/get_latest_cursor", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1765,9 +1996,6 @@ func (dbx *apiImpl) ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFold
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1785,31 +2013,36 @@ func (dbx *apiImpl) ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFold
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFolderLongpollAPIError is an error-wrapper for the list_folder/longpoll route
+// ListFolderLongpollAPIError is an error-wrapper for the list_folder/longpoll route
 type ListFolderLongpollAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFolderLongpollError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFolderLongpollAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error) {
+	return dbx.ListFolderLongpollContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) ListFolderLongpollContext(ctx context.Context, arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1820,18 +2053,12 @@ func (dbx *apiImpl) ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFol
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("notify", "rpc", false, "files", "list_folder/longpoll", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "notify", "rpc", "noauth", "files", "list_folder/longpoll", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1842,9 +2069,6 @@ func (dbx *apiImpl) ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFol
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1862,31 +2086,32 @@ func (dbx *apiImpl) ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFol
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListRevisionsAPIError is an error-wrapper for the list_revisions route
+// ListRevisionsAPIError is an error-wrapper for the list_revisions route
 type ListRevisionsAPIError struct {
 	dropbox.APIError
 	EndpointError *ListRevisionsError `json:"error"`
 }
 
-func (dbx *apiImpl) ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListRevisionsAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResult, err error) {
+	return dbx.ListRevisionsContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListRevisionsContext(ctx context.Context, arg *ListRevisionsArg) (res *ListRevisionsResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1898,20 +2123,23 @@ func (dbx *apiImpl) ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResu
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "list_revisions", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "list_revisions", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1922,9 +2150,6 @@ func (dbx *apiImpl) ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResu
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1942,31 +2167,36 @@ func (dbx *apiImpl) ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResu
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MoveAPIError is an error-wrapper for the move route
+// MoveAPIError is an error-wrapper for the move route
 type MoveAPIError struct {
 	dropbox.APIError
 	EndpointError *RelocationError `json:"error"`
 }
 
-func (dbx *apiImpl) Move(arg *RelocationArg) (res IsMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) Move(arg *RelocationArg) (res IsMetadata, err error) {
+	return dbx.MoveContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MoveContext(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1978,20 +2208,23 @@ func (dbx *apiImpl) Move(arg *RelocationArg) (res IsMetadata, err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "move", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "move", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2002,9 +2235,6 @@ func (dbx *apiImpl) Move(arg *RelocationArg) (res IsMetadata, err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		var tmp metadataUnion
 		err = json.Unmarshal(body, &tmp)
@@ -2033,31 +2263,36 @@ func (dbx *apiImpl) Move(arg *RelocationArg) (res IsMetadata, err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MoveBatchAPIError is an error-wrapper for the move_batch route
+// MoveBatchAPIError is an error-wrapper for the move_batch route
 type MoveBatchAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MoveBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+	return dbx.MoveBatchContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MoveBatchContext(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2069,20 +2304,23 @@ func (dbx *apiImpl) MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaun
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "move_batch", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "move_batch", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2093,9 +2331,6 @@ func (dbx *apiImpl) MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaun
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2113,31 +2348,36 @@ func (dbx *apiImpl) MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaun
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MoveBatchCheckAPIError is an error-wrapper for the move_batch/check route
+// MoveBatchCheckAPIError is an error-wrapper for the move_batch/check route
 type MoveBatchCheckAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MoveBatchCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+	return dbx.MoveBatchCheckContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MoveBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2149,20 +2389,23 @@ func (dbx *apiImpl) MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobS
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "move_batch/check", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "move_batch/check", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2173,9 +2416,6 @@ func (dbx *apiImpl) MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobS
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2193,31 +2433,36 @@ func (dbx *apiImpl) MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobS
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PermanentlyDeleteAPIError is an error-wrapper for the permanently_delete route
+// PermanentlyDeleteAPIError is an error-wrapper for the permanently_delete route
 type PermanentlyDeleteAPIError struct {
 	dropbox.APIError
 	EndpointError *DeleteError `json:"error"`
 }
 
-func (dbx *apiImpl) PermanentlyDelete(arg *DeleteArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PermanentlyDeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) PermanentlyDelete(arg *DeleteArg) (err error) {
+	return dbx.PermanentlyDeleteContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) PermanentlyDeleteContext(ctx context.Context, arg *DeleteArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2229,20 +2474,23 @@ func (dbx *apiImpl) PermanentlyDelete(arg *DeleteArg) (err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "permanently_delete", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "permanently_delete", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2253,9 +2501,6 @@ func (dbx *apiImpl) PermanentlyDelete(arg *DeleteArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2268,31 +2513,36 @@ func (dbx *apiImpl) PermanentlyDelete(arg *DeleteArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesAddAPIError is an error-wrapper for the properties/add route
+// PropertiesAddAPIError is an error-wrapper for the properties/add route
 type PropertiesAddAPIError struct {
 	dropbox.APIError
 	EndpointError *AddPropertiesError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesAdd(arg *PropertyGroupWithPath) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) PropertiesAdd(arg *PropertyGroupWithPath) (err error) {
+	return dbx.PropertiesAddContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) PropertiesAddContext(ctx context.Context, arg *PropertyGroupWithPath) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2304,20 +2554,23 @@ func (dbx *apiImpl) PropertiesAdd(arg *PropertyGroupWithPath) (err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "properties/add", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "properties/add", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2328,9 +2581,6 @@ func (dbx *apiImpl) PropertiesAdd(arg *PropertyGroupWithPath) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2343,31 +2593,36 @@ func (dbx *apiImpl) PropertiesAdd(arg *PropertyGroupWithPath) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesOverwriteAPIError is an error-wrapper for the properties/overwrite route
+// PropertiesOverwriteAPIError is an error-wrapper for the properties/overwrite route
 type PropertiesOverwriteAPIError struct {
 	dropbox.APIError
 	EndpointError *InvalidPropertyGroupError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesOverwrite(arg *PropertyGroupWithPath) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesOverwriteAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) PropertiesOverwrite(arg *PropertyGroupWithPath) (err error) {
+	return dbx.PropertiesOverwriteContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) PropertiesOverwriteContext(ctx context.Context, arg *PropertyGroupWithPath) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2379,20 +2634,23 @@ func (dbx *apiImpl) PropertiesOverwrite(arg *PropertyGroupWithPath) (err error)
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "properties/overwrite", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "properties/overwrite", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2403,9 +2661,6 @@ func (dbx *apiImpl) PropertiesOverwrite(arg *PropertyGroupWithPath) (err error)
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2418,31 +2673,36 @@ func (dbx *apiImpl) PropertiesOverwrite(arg *PropertyGroupWithPath) (err error)
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesRemoveAPIError is an error-wrapper for the properties/remove route
+// PropertiesRemoveAPIError is an error-wrapper for the properties/remove route
 type PropertiesRemoveAPIError struct {
 	dropbox.APIError
 	EndpointError *RemovePropertiesError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesRemove(arg *RemovePropertiesArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) PropertiesRemove(arg *RemovePropertiesArg) (err error) {
+	return dbx.PropertiesRemoveContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) PropertiesRemoveContext(ctx context.Context, arg *RemovePropertiesArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2454,20 +2714,23 @@ func (dbx *apiImpl) PropertiesRemove(arg *RemovePropertiesArg) (err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "properties/remove", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "properties/remove", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2478,9 +2741,6 @@ func (dbx *apiImpl) PropertiesRemove(arg *RemovePropertiesArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2493,31 +2753,36 @@ func (dbx *apiImpl) PropertiesRemove(arg *RemovePropertiesArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesTemplateGetAPIError is an error-wrapper for the properties/template/get route
+// PropertiesTemplateGetAPIError is an error-wrapper for the properties/template/get route
 type PropertiesTemplateGetAPIError struct {
 	dropbox.APIError
 	EndpointError *properties.PropertyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesTemplateGetAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
+	return dbx.PropertiesTemplateGetContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) PropertiesTemplateGetContext(ctx context.Context, arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2529,20 +2794,23 @@ func (dbx *apiImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "properties/template/get", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "properties/template/get", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2553,9 +2821,6 @@ func (dbx *apiImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2573,46 +2838,54 @@ func (dbx *apiImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesTemplateListAPIError is an error-wrapper for the properties/template/list route
+// PropertiesTemplateListAPIError is an error-wrapper for the properties/template/list route
 type PropertiesTemplateListAPIError struct {
 	dropbox.APIError
 	EndpointError *properties.PropertyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateList() (res *properties.ListPropertyTemplateIds, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesTemplateListAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) PropertiesTemplateList() (res *properties.ListPropertyTemplateIds, err error) {
+	return dbx.PropertiesTemplateListContext(context.Background())
+}
 
+func (dbx *ClientImpl) PropertiesTemplateListContext(ctx context.Context) (res *properties.ListPropertyTemplateIds, err error) {
 	headers := map[string]string{}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "properties/template/list", headers, nil)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "properties/template/list", headers, nil)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2623,9 +2896,6 @@ func (dbx *apiImpl) PropertiesTemplateList() (res *properties.ListPropertyTempla
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2643,31 +2913,36 @@ func (dbx *apiImpl) PropertiesTemplateList() (res *properties.ListPropertyTempla
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesUpdateAPIError is an error-wrapper for the properties/update route
+// PropertiesUpdateAPIError is an error-wrapper for the properties/update route
 type PropertiesUpdateAPIError struct {
 	dropbox.APIError
 	EndpointError *UpdatePropertiesError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesUpdate(arg *UpdatePropertyGroupArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesUpdateAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) PropertiesUpdate(arg *UpdatePropertyGroupArg) (err error) {
+	return dbx.PropertiesUpdateContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) PropertiesUpdateContext(ctx context.Context, arg *UpdatePropertyGroupArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2679,20 +2954,23 @@ func (dbx *apiImpl) PropertiesUpdate(arg *UpdatePropertyGroupArg) (err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "properties/update", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "properties/update", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2703,9 +2981,6 @@ func (dbx *apiImpl) PropertiesUpdate(arg *UpdatePropertyGroupArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2718,31 +2993,36 @@ func (dbx *apiImpl) PropertiesUpdate(arg *UpdatePropertyGroupArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//RestoreAPIError is an error-wrapper for the restore route
+// RestoreAPIError is an error-wrapper for the restore route
 type RestoreAPIError struct {
 	dropbox.APIError
 	EndpointError *RestoreError `json:"error"`
 }
 
-func (dbx *apiImpl) Restore(arg *RestoreArg) (res *FileMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e RestoreAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) Restore(arg *RestoreArg) (res *FileMetadata, err error) {
+	return dbx.RestoreContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) RestoreContext(ctx context.Context, arg *RestoreArg) (res *FileMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2754,20 +3034,23 @@ func (dbx *apiImpl) Restore(arg *RestoreArg) (res *FileMetadata, err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "restore", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "restore", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2778,9 +3061,6 @@ func (dbx *apiImpl) Restore(arg *RestoreArg) (res *FileMetadata, err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2798,31 +3078,36 @@ func (dbx *apiImpl) Restore(arg *RestoreArg) (res *FileMetadata, err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//SaveUrlAPIError is an error-wrapper for the save_url route
+// SaveUrlAPIError is an error-wrapper for the save_url route
 type SaveUrlAPIError struct {
 	dropbox.APIError
 	EndpointError *SaveUrlError `json:"error"`
 }
 
-func (dbx *apiImpl) SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e SaveUrlAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error) {
+	return dbx.SaveUrlContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) SaveUrlContext(ctx context.Context, arg *SaveUrlArg) (res *SaveUrlResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2834,20 +3119,23 @@ func (dbx *apiImpl) SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "save_url", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "save_url", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2858,9 +3146,6 @@ func (dbx *apiImpl) SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2878,31 +3163,36 @@ func (dbx *apiImpl) SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//SaveUrlCheckJobStatusAPIError is an error-wrapper for the save_url/check_job_status route
+// SaveUrlCheckJobStatusAPIError is an error-wrapper for the save_url/check_job_status route
 type SaveUrlCheckJobStatusAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e SaveUrlCheckJobStatusAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobStatus, err error) {
+	return dbx.SaveUrlCheckJobStatusContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) SaveUrlCheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *SaveUrlJobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2914,20 +3204,23 @@ func (dbx *apiImpl) SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobSt
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "save_url/check_job_status", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "save_url/check_job_status", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2938,9 +3231,6 @@ func (dbx *apiImpl) SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobSt
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2958,31 +3248,36 @@ func (dbx *apiImpl) SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobSt
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//SearchAPIError is an error-wrapper for the search route
+// SearchAPIError is an error-wrapper for the search route
 type SearchAPIError struct {
 	dropbox.APIError
 	EndpointError *SearchError `json:"error"`
 }
 
-func (dbx *apiImpl) Search(arg *SearchArg) (res *SearchResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e SearchAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) Search(arg *SearchArg) (res *SearchResult, err error) {
+	return dbx.SearchContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) SearchContext(ctx context.Context, arg *SearchArg) (res *SearchResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2994,20 +3289,23 @@ func (dbx *apiImpl) Search(arg *SearchArg) (res *SearchResult, err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "search", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "search", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3018,9 +3316,6 @@ func (dbx *apiImpl) Search(arg *SearchArg) (res *SearchResult, err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3038,31 +3333,36 @@ func (dbx *apiImpl) Search(arg *SearchArg) (res *SearchResult, err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UploadAPIError is an error-wrapper for the upload route
+// UploadAPIError is an error-wrapper for the upload route
 type UploadAPIError struct {
 	dropbox.APIError
 	EndpointError *UploadError `json:"error"`
 }
 
-func (dbx *apiImpl) Upload(arg *CommitInfo, content io.Reader) (res *FileMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UploadAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) Upload(arg *CommitInfo, content io.Reader) (res *FileMetadata, err error) {
+	return dbx.UploadContext(context.Background(), arg, content)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UploadContext(ctx context.Context, arg *CommitInfo, content io.Reader) (res *FileMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3075,20 +3375,23 @@ func (dbx *apiImpl) Upload(arg *CommitInfo, content io.Reader) (res *FileMetadat
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "upload", true, "files", "upload", headers, content)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "upload", "user", "files", "upload", headers, content)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3099,9 +3402,6 @@ func (dbx *apiImpl) Upload(arg *CommitInfo, content io.Reader) (res *FileMetadat
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3119,31 +3419,36 @@ func (dbx *apiImpl) Upload(arg *CommitInfo, content io.Reader) (res *FileMetadat
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UploadSessionAppendAPIError is an error-wrapper for the upload_session/append route
+// UploadSessionAppendAPIError is an error-wrapper for the upload_session/append route
 type UploadSessionAppendAPIError struct {
 	dropbox.APIError
 	EndpointError *UploadSessionLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionAppend(arg *UploadSessionCursor, content io.Reader) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UploadSessionAppendAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UploadSessionAppend(arg *UploadSessionCursor, content io.Reader) (err error) {
+	return dbx.UploadSessionAppendContext(context.Background(), arg, content)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UploadSessionAppendContext(ctx context.Context, arg *UploadSessionCursor, content io.Reader) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3156,20 +3461,23 @@ func (dbx *apiImpl) UploadSessionAppend(arg *UploadSessionCursor, content io.Rea
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "upload", true, "files", "upload_session/append", headers, content)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "upload", "user", "files", "upload_session/append", headers, content)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3180,9 +3488,6 @@ func (dbx *apiImpl) UploadSessionAppend(arg *UploadSessionCursor, content io.Rea
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -3195,31 +3500,36 @@ func (dbx *apiImpl) UploadSessionAppend(arg *UploadSessionCursor, content io.Rea
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UploadSessionAppendV2APIError is an error-wrapper for the upload_session/append_v2 route
+// UploadSessionAppendV2APIError is an error-wrapper for the upload_session/append_v2 route
 type UploadSessionAppendV2APIError struct {
 	dropbox.APIError
 	EndpointError *UploadSessionLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionAppendV2(arg *UploadSessionAppendArg, content io.Reader) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UploadSessionAppendV2APIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UploadSessionAppendV2(arg *UploadSessionAppendArg, content io.Reader) (err error) {
+	return dbx.UploadSessionAppendV2Context(context.Background(), arg, content)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UploadSessionAppendV2Context(ctx context.Context, arg *UploadSessionAppendArg, content io.Reader) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3232,20 +3542,23 @@ func (dbx *apiImpl) UploadSessionAppendV2(arg *UploadSessionAppendArg, content i
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "upload", true, "files", "upload_session/append_v2", headers, content)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "upload", "user", "files", "upload_session/append_v2", headers, content)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3256,9 +3569,6 @@ func (dbx *apiImpl) UploadSessionAppendV2(arg *UploadSessionAppendArg, content i
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -3271,31 +3581,36 @@ func (dbx *apiImpl) UploadSessionAppendV2(arg *UploadSessionAppendArg, content i
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UploadSessionFinishAPIError is an error-wrapper for the upload_session/finish route
+// UploadSessionFinishAPIError is an error-wrapper for the upload_session/finish route
 type UploadSessionFinishAPIError struct {
 	dropbox.APIError
 	EndpointError *UploadSessionFinishError `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionFinish(arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UploadSessionFinishAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UploadSessionFinish(arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error) {
+	return dbx.UploadSessionFinishContext(context.Background(), arg, content)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UploadSessionFinishContext(ctx context.Context, arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3308,20 +3623,23 @@ func (dbx *apiImpl) UploadSessionFinish(arg *UploadSessionFinishArg, content io.
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "upload", true, "files", "upload_session/finish", headers, content)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "upload", "user", "files", "upload_session/finish", headers, content)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3332,9 +3650,6 @@ func (dbx *apiImpl) UploadSessionFinish(arg *UploadSessionFinishArg, content io.
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3352,31 +3667,36 @@ func (dbx *apiImpl) UploadSessionFinish(arg *UploadSessionFinishArg, content io.
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UploadSessionFinishBatchAPIError is an error-wrapper for the upload_session/finish_batch route
+// UploadSessionFinishBatchAPIError is an error-wrapper for the upload_session/finish_batch route
 type UploadSessionFinishBatchAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UploadSessionFinishBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error) {
+	return dbx.UploadSessionFinishBatchContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UploadSessionFinishBatchContext(ctx context.Context, arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3388,20 +3708,23 @@ func (dbx *apiImpl) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "upload_session/finish_batch", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "upload_session/finish_batch", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3412,9 +3735,6 @@ func (dbx *apiImpl) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3432,31 +3752,36 @@ func (dbx *apiImpl) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UploadSessionFinishBatchCheckAPIError is an error-wrapper for the upload_session/finish_batch/check route
+// UploadSessionFinishBatchCheckAPIError is an error-wrapper for the upload_session/finish_batch/check route
 type UploadSessionFinishBatchCheckAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionFinishBatchCheck(arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UploadSessionFinishBatchCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UploadSessionFinishBatchCheck(arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error) {
+	return dbx.UploadSessionFinishBatchCheckContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UploadSessionFinishBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3468,20 +3793,23 @@ func (dbx *apiImpl) UploadSessionFinishBatchCheck(arg *async.PollArg) (res *Uplo
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "files", "upload_session/finish_batch/check", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "files", "upload_session/finish_batch/check", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3492,9 +3820,6 @@ func (dbx *apiImpl) UploadSessionFinishBatchCheck(arg *async.PollArg) (res *Uplo
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3512,31 +3837,36 @@ func (dbx *apiImpl) UploadSessionFinishBatchCheck(arg *async.PollArg) (res *Uplo
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UploadSessionStartAPIError is an error-wrapper for the upload_session/start route
+// UploadSessionStartAPIError is an error-wrapper for the upload_session/start route
 type UploadSessionStartAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionStart(arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UploadSessionStartAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UploadSessionStart(arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error) {
+	return dbx.UploadSessionStartContext(context.Background(), arg, content)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UploadSessionStartContext(ctx context.Context, arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3549,20 +3879,23 @@ func (dbx *apiImpl) UploadSessionStart(arg *UploadSessionStartArg, content io.Re
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "upload", true, "files", "upload_session/start", headers, content)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "upload", "user", "files", "upload_session/start", headers, content)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3573,9 +3906,6 @@ func (dbx *apiImpl) UploadSessionStart(arg *UploadSessionStartArg, content io.Re
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3593,22 +3923,26 @@ func (dbx *apiImpl) UploadSessionStart(arg *UploadSessionStartArg, content io.Re
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
 // New returns a Client implementation for this namespace
-func New(c dropbox.Config) *apiImpl {
-	ctx := apiImpl(dropbox.NewContext(c))
+func New(c dropbox.Config) *ClientImpl {
+	return NewFromContext(dropbox.NewContext(c))
+}
+
+// NewFromContext returns a Client implementation for this namespace
+// that shares the http.Client and tokens of c
+func NewFromContext(c dropbox.Context) *ClientImpl {
+	ctx := ClientImpl(c)
 	return &ctx
 }
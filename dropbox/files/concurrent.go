@@ -0,0 +1,176 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// UploadAt uploads the first size bytes of r as described by commit.
+//
+// With more than one Worker, content larger than a chunk is uploaded with
+// a concurrent upload session: Workers chunks are appended at once, each at
+// its own offset, the last one closing the session, which is finished once
+// all of them are in. Each chunk that fails with a network or server error
+// is sent again, up to MaxResumes times in all. Checkpoints are not saved
+// for concurrent sessions.
+//
+// Otherwise UploadAt is the same as Upload.
+func (u *Uploader) UploadAt(ctx context.Context, commit *CommitInfo, r io.ReaderAt, size int64) (*FileMetadata, error) {
+	chunkSize, err := u.chunkSize()
+	if err != nil {
+		return nil, err
+	}
+	if u.Workers <= 1 || size <= int64(chunkSize) {
+		return u.Upload(ctx, commit, io.NewSectionReader(r, 0, size))
+	}
+	arg := NewUploadSessionStartArg()
+	// session_type is newer than the spec this package was generated from
+	arg.Extra = map[string]json.RawMessage{
+		"session_type": json.RawMessage(`{".tag": "concurrent"}`),
+	}
+	start, err := u.Client.UploadSessionStartContext(ctx, arg, bytes.NewReader(nil))
+	if err != nil {
+		return nil, err
+	}
+	up := &concurrentUpload{
+		Uploader:  u,
+		r:         r,
+		size:      size,
+		chunkSize: int64(chunkSize),
+		sessionID: start.SessionId,
+	}
+	if err := up.run(ctx); err != nil {
+		return nil, err
+	}
+	cursor := NewUploadSessionCursor(start.SessionId, uint64(size))
+	return u.Client.UploadSessionFinishContext(ctx,
+		NewUploadSessionFinishArg(cursor, commit), bytes.NewReader(nil))
+}
+
+// concurrentUpload is the state of the appends of an UploadAt.
+type concurrentUpload struct {
+	*Uploader
+	r         io.ReaderAt
+	size      int64
+	chunkSize int64
+	sessionID string
+
+	mu       sync.Mutex
+	err      error // of the first chunk that failed
+	resumes  int
+	uploaded int64
+}
+
+// run appends all the chunks, the last one closing the session. It stops
+// at the first chunk that fails.
+func (up *concurrentUpload) run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	chunks := int((up.size + up.chunkSize - 1) / up.chunkSize)
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < up.Workers && w < chunks; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf := make([]byte, up.chunkSize)
+			for i := range next {
+				if err := up.append(ctx, i, i == chunks-1, buf); err != nil {
+					up.fail(err)
+					cancel()
+				}
+			}
+		}()
+	}
+feed:
+	for i := 0; i < chunks; i++ {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+	up.mu.Lock()
+	defer up.mu.Unlock()
+	if up.err == nil {
+		up.err = ctx.Err()
+	}
+	return up.err
+}
+
+// append sends chunk i using buf, closing the session if it is the last.
+func (up *concurrentUpload) append(ctx context.Context, i int, last bool, buf []byte) error {
+	offset := int64(i) * up.chunkSize
+	chunk := buf[:up.chunkSize]
+	if last {
+		chunk = buf[:up.size-offset]
+	}
+	if n, err := up.r.ReadAt(chunk, offset); n < len(chunk) {
+		if err == nil || err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	for {
+		arg := NewUploadSessionAppendArg(NewUploadSessionCursor(up.sessionID, uint64(offset)))
+		arg.Close = last
+		err := up.Client.UploadSessionAppendV2Context(ctx, arg, bytes.NewReader(chunk))
+		if err == nil {
+			break
+		}
+		if ctx.Err() != nil || !transient(err) || !up.resume() {
+			return err
+		}
+	}
+	up.mu.Lock()
+	defer up.mu.Unlock()
+	up.uploaded += int64(len(chunk))
+	if up.Progress != nil {
+		up.Progress(up.uploaded)
+	}
+	return nil
+}
+
+// resume reports whether a failed chunk may be sent again.
+func (up *concurrentUpload) resume() bool {
+	up.mu.Lock()
+	defer up.mu.Unlock()
+	if up.resumes >= up.maxResumes() {
+		return false
+	}
+	up.resumes++
+	return true
+}
+
+func (up *concurrentUpload) fail(err error) {
+	up.mu.Lock()
+	defer up.mu.Unlock()
+	if up.err == nil {
+		up.err = err
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/dropboxtest"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+// activeClient records how many appends are in progress at most.
+type activeClient struct {
+	*client
+	mu     sync.Mutex
+	active int
+	max    int
+}
+
+func (c *activeClient) UploadSessionAppendV2Context(ctx context.Context, arg *files.UploadSessionAppendArg, content io.Reader) error {
+	c.mu.Lock()
+	c.active++
+	if c.active > c.max {
+		c.max = c.active
+	}
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.active--
+		c.mu.Unlock()
+	}()
+	// Give the other workers time to start theirs
+	time.Sleep(20 * time.Millisecond)
+	return c.client.UploadSessionAppendV2Context(ctx, arg, content)
+}
+
+func TestUploadAt(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		workers int
+		size    int
+		routes  string
+	}{
+		{"one chunk", 4, chunk, "upload"},
+		{"sequential", 1, 2*chunk + 3, "start append finish"},
+		{"concurrent", 3, 3*chunk + 100, "start append append append append finish"},
+		{"whole chunks", 4, 2 * chunk, "start append append finish"},
+	} {
+		s := dropboxtest.NewServer()
+		c := &activeClient{client: newClient(s)}
+		u := files.NewUploader(c)
+		u.ChunkSize = chunk
+		u.Workers = test.workers
+		var progress []int64
+		u.Progress = func(uploaded int64) { progress = append(progress, uploaded) }
+		data := content(test.size)
+
+		res, err := u.UploadAt(context.Background(), files.NewCommitInfo("/f"), bytes.NewReader(data), int64(test.size))
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if got := c.called(); got != test.routes {
+			t.Errorf("%s: called %s, want %s", test.name, got, test.routes)
+		}
+		if res.Size != uint64(test.size) || !bytes.Equal(download(t, s, "/f"), data) {
+			t.Errorf("%s: uploaded %d bytes, want %d", test.name, res.Size, test.size)
+		}
+		if len(progress) == 0 || progress[len(progress)-1] != int64(test.size) {
+			t.Errorf("%s: progress %v", test.name, progress)
+		}
+		if test.workers > 1 && strings.Count(test.routes, "append") > 1 && (c.max < 2 || c.max > test.workers) {
+			t.Errorf("%s: %d appends at once, want 2 to %d", test.name, c.max, test.workers)
+		}
+		s.Close()
+	}
+}
+
+func TestUploadAtRecover(t *testing.T) {
+	s := dropboxtest.NewServer()
+	defer s.Close()
+	c := newClient(s)
+	// One append doesn't reach Dropbox, the response to another is lost
+	c.fail = func(call int, route string) failure {
+		return map[int]failure{1: failBefore, 2: failAfter}[call]
+	}
+	u := files.NewUploader(c)
+	u.ChunkSize = chunk
+	u.Workers = 2
+	data := content(3*chunk + 100)
+
+	if _, err := u.UploadAt(context.Background(), files.NewCommitInfo("/f"), bytes.NewReader(data), int64(len(data))); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.called(), "start"+strings.Repeat(" append", 6)+" finish"; got != want {
+		t.Errorf("called %s, want %s", got, want)
+	}
+	if !bytes.Equal(download(t, s, "/f"), data) {
+		t.Error("content differs")
+	}
+}
+
+func TestUploadAtError(t *testing.T) {
+	s := dropboxtest.NewServer()
+	defer s.Close()
+	c := newClient(s)
+	c.fail = func(call int, route string) failure {
+		if call == 2 {
+			return failBefore
+		}
+		return succeed
+	}
+	u := files.NewUploader(c)
+	u.ChunkSize = chunk
+	u.Workers = 2
+	u.MaxResumes = -1
+	data := content(3*chunk + 100)
+
+	_, err := u.UploadAt(context.Background(), files.NewCommitInfo("/f"), bytes.NewReader(data), int64(len(data)))
+	if _, ok := err.(*url.Error); !ok {
+		t.Errorf("got %v, want the error of the failed append", err)
+	}
+	if got := c.called(); strings.Contains(got, "finish") {
+		t.Errorf("called %s, want no finish", got)
+	}
+
+	// Content shorter than size
+	c.routes, c.fail = nil, nil
+	if _, err = u.UploadAt(context.Background(), files.NewCommitInfo("/g"), bytes.NewReader(data[:2*chunk]), int64(len(data))); err != io.ErrUnexpectedEOF {
+		t.Errorf("got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
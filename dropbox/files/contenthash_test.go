@@ -0,0 +1,117 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"testing"
+)
+
+// pattern returns n bytes that don't repeat on block boundaries.
+func pattern(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i % 251)
+	}
+	return b
+}
+
+// Hashes computed independently with Python's hashlib
+var contentHashTests = []struct {
+	name string
+	data []byte
+	want string
+}{
+	{"empty", nil, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	{"a", []byte("a"), "bf5d3affb73efd2ec6c36ad3112dd933efed63c4e1cbffcfa88e2759c144f2d8"},
+	{"hello world", []byte("hello world"), "bc62d4b80d9e36da29c16c5d4d9f11731f36052c72401a76c23c0fb5a9b74423"},
+	{"4 MiB", pattern(4 << 20), "b9654428408015906b44a00935b70af33830aa344b780b0eabd535a133150d04"},
+	{"4 MiB + 1", pattern(4<<20 + 1), "4a6cc0a344febaa07772e7c974834b2fb1d24594d4ba15f27c97a54699709f44"},
+	{"8 MiB", pattern(8 << 20), "b76a633d9733b991a976dba723df6940bfaf789390a929fe8f68fd1b4705c8c1"},
+}
+
+func TestContentHash(t *testing.T) {
+	for _, test := range contentHashTests {
+		got, err := ContentHash(bytes.NewReader(test.data))
+		if err != nil || got != test.want {
+			t.Errorf("%s: ContentHash = %s, %v; want %s", test.name, got, err, test.want)
+		}
+
+		// Writes that straddle the block boundaries
+		h := NewContentHash()
+		for p := test.data; len(p) > 0; {
+			n := 1000003
+			if n > len(p) {
+				n = len(p)
+			}
+			h.Write(p[:n])
+			p = p[n:]
+		}
+		if got := hex.EncodeToString(h.Sum(nil)); got != test.want {
+			t.Errorf("%s: in parts = %s, want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestContentHashSum(t *testing.T) {
+	data := pattern(4<<20 + 1)
+	h := NewContentHash()
+	h.Write(data[:4<<20])
+	if got, want := hex.EncodeToString(h.Sum(nil)), contentHashTests[3].want; got != want {
+		t.Errorf("Sum of 4 MiB = %s, want %s", got, want)
+	}
+	h.Write(data[4<<20:])
+	sum := h.Sum([]byte("prefix"))
+	if got, want := hex.EncodeToString(sum[len("prefix"):]), contentHashTests[4].want; got != want || string(sum[:len("prefix")]) != "prefix" {
+		t.Errorf("Sum after another Write = %x, want prefix and %s", sum, want)
+	}
+	if got := h.Sum(nil); !bytes.Equal(got, sum[len("prefix"):]) {
+		t.Errorf("Sum changed the state: %x", got)
+	}
+	h.Reset()
+	if got, want := hex.EncodeToString(h.Sum(nil)), contentHashTests[0].want; got != want {
+		t.Errorf("Sum after Reset = %s, want %s", got, want)
+	}
+	if h.Size() != 32 {
+		t.Errorf("Size = %d", h.Size())
+	}
+}
+
+// The example of the content hash reference, at
+// https://www.dropbox.com/static/images/developers/milky-way-nasa.jpg
+// It isn't part of the repository; download it to testdata to check it.
+func TestContentHashMilkyWay(t *testing.T) {
+	f, err := os.Open("testdata/milky-way-nasa.jpg")
+	if os.IsNotExist(err) {
+		t.Skip("testdata/milky-way-nasa.jpg not present")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	got, err := ContentHash(f)
+	want := "485291fa0ee50c016982abbfa943957bcd231aae0492ccbaa22c58e3997b35e0"
+	if err != nil || got != want {
+		t.Errorf("ContentHash = %s, %v; want %s", got, err, want)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import "github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+
+// IsNotFound reports whether err was caused by a path, upload session or
+// copy reference that does not exist.
+func IsNotFound(err error) bool {
+	return dropbox.IsEndpointError(err, (*LookupError)(nil), LookupErrorNotFound) ||
+		dropbox.IsEndpointError(err, (*UploadSessionLookupError)(nil), UploadSessionLookupErrorNotFound) ||
+		dropbox.IsEndpointError(err, (*SaveCopyReferenceError)(nil), SaveCopyReferenceErrorNotFound)
+}
+
+// IsConflict reports whether err was caused by something already existing
+// where a write was attempted.
+func IsConflict(err error) bool {
+	return dropbox.IsEndpointError(err, (*WriteError)(nil), WriteErrorConflict)
+}
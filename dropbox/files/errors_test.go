@@ -0,0 +1,79 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// decode decodes the body of a 409 response like the routes do.
+func decode(t *testing.T, body string, apiError interface{}) {
+	if err := json.Unmarshal([]byte(body), apiError); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	var download DownloadAPIError
+	decode(t, `{"error_summary": "path/not_found/..", "error": {".tag": "path", "path": {".tag": "not_found"}}}`, &download)
+	var notFile DownloadAPIError
+	decode(t, `{"error_summary": "path/not_file/..", "error": {".tag": "path", "path": {".tag": "not_file"}}}`, &notFile)
+	var finish UploadSessionFinishAPIError
+	decode(t, `{"error_summary": "lookup_failed/not_found/..", "error": {".tag": "lookup_failed",
+		"lookup_failed": {".tag": "not_found"}}}`, &finish)
+	var save CopyReferenceSaveAPIError
+	decode(t, `{"error_summary": "not_found/..", "error": {".tag": "not_found"}}`, &save)
+	// The file that the URL was to be saved to is gone, which is not a
+	// lookup of the path in the request
+	var saveURL SaveUrlAPIError
+	decode(t, `{"error_summary": "not_found/..", "error": {".tag": "not_found"}}`, &saveURL)
+
+	for _, test := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nested lookup error", download, true},
+		{"other lookup error", notFile, false},
+		{"upload session", finish, true},
+		{"copy reference", save, true},
+		{"not_found of another union", saveURL, false},
+	} {
+		if got := IsNotFound(test.err); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestIsConflict(t *testing.T) {
+	var upload UploadAPIError
+	decode(t, `{"error_summary": "path/conflict/file/..", "error": {".tag": "path",
+		"reason": {".tag": "conflict", "conflict": {".tag": "file"}}, "upload_session_id": "id"}}`, &upload)
+	if !IsConflict(upload) {
+		t.Errorf("conflict not detected in %+v", upload.EndpointError)
+	}
+	var download DownloadAPIError
+	decode(t, `{"error_summary": "path/not_found/..", "error": {".tag": "path", "path": {".tag": "not_found"}}}`, &download)
+	if IsConflict(download) {
+		t.Error("not_found reported as conflict")
+	}
+}
@@ -0,0 +1,790 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package filestest provides a fake files.Client for tests.
+package filestest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/async"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/properties"
+)
+
+// ErrNotStubbed is returned by the routes of a Fake that are not stubbed
+var ErrNotStubbed = errors.New("filestest: route not stubbed")
+
+// Call is a call made to a Fake
+type Call struct {
+	// Name of the route, e.g. "alpha/get_metadata"
+	Route string
+	// Argument of the call (nil for routes without one)
+	Arg interface{}
+	// Content passed to upload routes
+	Content io.Reader
+}
+
+// Fake is a files.Client for tests. Each route calls the function in the
+// matching field, e.g. AlphaGetMetadataFunc, or returns ErrNotStubbed if the
+// field is nil. All calls are recorded. The zero value is ready to use.
+type Fake struct {
+	// Stubs AlphaGetMetadata and AlphaGetMetadataContext
+	AlphaGetMetadataFunc func(ctx context.Context, arg *files.AlphaGetMetadataArg) (res files.IsMetadata, err error)
+	// Stubs AlphaUpload and AlphaUploadContext
+	AlphaUploadFunc func(ctx context.Context, arg *files.CommitInfoWithProperties, content io.Reader) (res *files.FileMetadata, err error)
+	// Stubs Copy and CopyContext
+	CopyFunc func(ctx context.Context, arg *files.RelocationArg) (res files.IsMetadata, err error)
+	// Stubs CopyBatch and CopyBatchContext
+	CopyBatchFunc func(ctx context.Context, arg *files.RelocationBatchArg) (res *files.RelocationBatchLaunch, err error)
+	// Stubs CopyBatchCheck and CopyBatchCheckContext
+	CopyBatchCheckFunc func(ctx context.Context, arg *async.PollArg) (res *files.RelocationBatchJobStatus, err error)
+	// Stubs CopyReferenceGet and CopyReferenceGetContext
+	CopyReferenceGetFunc func(ctx context.Context, arg *files.GetCopyReferenceArg) (res *files.GetCopyReferenceResult, err error)
+	// Stubs CopyReferenceSave and CopyReferenceSaveContext
+	CopyReferenceSaveFunc func(ctx context.Context, arg *files.SaveCopyReferenceArg) (res *files.SaveCopyReferenceResult, err error)
+	// Stubs CreateFolder and CreateFolderContext
+	CreateFolderFunc func(ctx context.Context, arg *files.CreateFolderArg) (res *files.FolderMetadata, err error)
+	// Stubs Delete and DeleteContext
+	DeleteFunc func(ctx context.Context, arg *files.DeleteArg) (res files.IsMetadata, err error)
+	// Stubs DeleteBatch and DeleteBatchContext
+	DeleteBatchFunc func(ctx context.Context, arg *files.DeleteBatchArg) (res *files.DeleteBatchLaunch, err error)
+	// Stubs DeleteBatchCheck and DeleteBatchCheckContext
+	DeleteBatchCheckFunc func(ctx context.Context, arg *async.PollArg) (res *files.DeleteBatchJobStatus, err error)
+	// Stubs Download and DownloadContext
+	DownloadFunc func(ctx context.Context, arg *files.DownloadArg) (res *files.FileMetadata, content io.ReadCloser, err error)
+	// Stubs GetMetadata and GetMetadataContext
+	GetMetadataFunc func(ctx context.Context, arg *files.GetMetadataArg) (res files.IsMetadata, err error)
+	// Stubs GetPreview and GetPreviewContext
+	GetPreviewFunc func(ctx context.Context, arg *files.PreviewArg) (res *files.FileMetadata, content io.ReadCloser, err error)
+	// Stubs GetTemporaryLink and GetTemporaryLinkContext
+	GetTemporaryLinkFunc func(ctx context.Context, arg *files.GetTemporaryLinkArg) (res *files.GetTemporaryLinkResult, err error)
+	// Stubs GetThumbnail and GetThumbnailContext
+	GetThumbnailFunc func(ctx context.Context, arg *files.ThumbnailArg) (res *files.FileMetadata, content io.ReadCloser, err error)
+	// Stubs ListFolder and ListFolderContext
+	ListFolderFunc func(ctx context.Context, arg *files.ListFolderArg) (res *files.ListFolderResult, err error)
+	// Stubs ListFolderContinue and ListFolderContinueContext
+	ListFolderContinueFunc func(ctx context.Context, arg *files.ListFolderContinueArg) (res *files.ListFolderResult, err error)
+	// Stubs ListFolderGetLatestCursor and ListFolderGetLatestCursorContext
+	ListFolderGetLatestCursorFunc func(ctx context.Context, arg *files.ListFolderArg) (res *files.ListFolderGetLatestCursorResult, err error)
+	// Stubs ListFolderLongpoll and ListFolderLongpollContext
+	ListFolderLongpollFunc func(ctx context.Context, arg *files.ListFolderLongpollArg) (res *files.ListFolderLongpollResult, err error)
+	// Stubs ListRevisions and ListRevisionsContext
+	ListRevisionsFunc func(ctx context.Context, arg *files.ListRevisionsArg) (res *files.ListRevisionsResult, err error)
+	// Stubs Move and MoveContext
+	MoveFunc func(ctx context.Context, arg *files.RelocationArg) (res files.IsMetadata, err error)
+	// Stubs MoveBatch and MoveBatchContext
+	MoveBatchFunc func(ctx context.Context, arg *files.RelocationBatchArg) (res *files.RelocationBatchLaunch, err error)
+	// Stubs MoveBatchCheck and MoveBatchCheckContext
+	MoveBatchCheckFunc func(ctx context.Context, arg *async.PollArg) (res *files.RelocationBatchJobStatus, err error)
+	// Stubs PermanentlyDelete and PermanentlyDeleteContext
+	PermanentlyDeleteFunc func(ctx context.Context, arg *files.DeleteArg) (err error)
+	// Stubs PropertiesAdd and PropertiesAddContext
+	PropertiesAddFunc func(ctx context.Context, arg *files.PropertyGroupWithPath) (err error)
+	// Stubs PropertiesOverwrite and PropertiesOverwriteContext
+	PropertiesOverwriteFunc func(ctx context.Context, arg *files.PropertyGroupWithPath) (err error)
+	// Stubs PropertiesRemove and PropertiesRemoveContext
+	PropertiesRemoveFunc func(ctx context.Context, arg *files.RemovePropertiesArg) (err error)
+	// Stubs PropertiesTemplateGet and PropertiesTemplateGetContext
+	PropertiesTemplateGetFunc func(ctx context.Context, arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error)
+	// Stubs PropertiesTemplateList and PropertiesTemplateListContext
+	PropertiesTemplateListFunc func(ctx context.Context) (res *properties.ListPropertyTemplateIds, err error)
+	// Stubs PropertiesUpdate and PropertiesUpdateContext
+	PropertiesUpdateFunc func(ctx context.Context, arg *files.UpdatePropertyGroupArg) (err error)
+	// Stubs Restore and RestoreContext
+	RestoreFunc func(ctx context.Context, arg *files.RestoreArg) (res *files.FileMetadata, err error)
+	// Stubs SaveUrl and SaveUrlContext
+	SaveUrlFunc func(ctx context.Context, arg *files.SaveUrlArg) (res *files.SaveUrlResult, err error)
+	// Stubs SaveUrlCheckJobStatus and SaveUrlCheckJobStatusContext
+	SaveUrlCheckJobStatusFunc func(ctx context.Context, arg *async.PollArg) (res *files.SaveUrlJobStatus, err error)
+	// Stubs Search and SearchContext
+	SearchFunc func(ctx context.Context, arg *files.SearchArg) (res *files.SearchResult, err error)
+	// Stubs Upload and UploadContext
+	UploadFunc func(ctx context.Context, arg *files.CommitInfo, content io.Reader) (res *files.FileMetadata, err error)
+	// Stubs UploadSessionAppend and UploadSessionAppendContext
+	UploadSessionAppendFunc func(ctx context.Context, arg *files.UploadSessionCursor, content io.Reader) (err error)
+	// Stubs UploadSessionAppendV2 and UploadSessionAppendV2Context
+	UploadSessionAppendV2Func func(ctx context.Context, arg *files.UploadSessionAppendArg, content io.Reader) (err error)
+	// Stubs UploadSessionFinish and UploadSessionFinishContext
+	UploadSessionFinishFunc func(ctx context.Context, arg *files.UploadSessionFinishArg, content io.Reader) (res *files.FileMetadata, err error)
+	// Stubs UploadSessionFinishBatch and UploadSessionFinishBatchContext
+	UploadSessionFinishBatchFunc func(ctx context.Context, arg *files.UploadSessionFinishBatchArg) (res *files.UploadSessionFinishBatchLaunch, err error)
+	// Stubs UploadSessionFinishBatchCheck and UploadSessionFinishBatchCheckContext
+	UploadSessionFinishBatchCheckFunc func(ctx context.Context, arg *async.PollArg) (res *files.UploadSessionFinishBatchJobStatus, err error)
+	// Stubs UploadSessionStart and UploadSessionStartContext
+	UploadSessionStartFunc func(ctx context.Context, arg *files.UploadSessionStartArg, content io.Reader) (res *files.UploadSessionStartResult, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ files.Client = (*Fake)(nil)
+
+// Calls returns the calls made so far, or only those to the given route
+func (f *Fake) Calls(route ...string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []Call
+	for _, c := range f.calls {
+		if len(route) == 0 || c.Route == route[0] {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (f *Fake) record(route string, arg interface{}, content io.Reader) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, Call{route, arg, content})
+}
+
+// AlphaGetMetadata implements files.Client
+func (f *Fake) AlphaGetMetadata(arg *files.AlphaGetMetadataArg) (res files.IsMetadata, err error) {
+	return f.AlphaGetMetadataContext(context.Background(), arg)
+}
+
+// AlphaGetMetadataContext implements files.Client
+func (f *Fake) AlphaGetMetadataContext(ctx context.Context, arg *files.AlphaGetMetadataArg) (res files.IsMetadata, err error) {
+	f.record("alpha/get_metadata", arg, nil)
+	if f.AlphaGetMetadataFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.AlphaGetMetadataFunc(ctx, arg)
+}
+
+// AlphaUpload implements files.Client
+func (f *Fake) AlphaUpload(arg *files.CommitInfoWithProperties, content io.Reader) (res *files.FileMetadata, err error) {
+	return f.AlphaUploadContext(context.Background(), arg, content)
+}
+
+// AlphaUploadContext implements files.Client
+func (f *Fake) AlphaUploadContext(ctx context.Context, arg *files.CommitInfoWithProperties, content io.Reader) (res *files.FileMetadata, err error) {
+	f.record("alpha/upload", arg, content)
+	if f.AlphaUploadFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.AlphaUploadFunc(ctx, arg, content)
+}
+
+// Copy implements files.Client
+func (f *Fake) Copy(arg *files.RelocationArg) (res files.IsMetadata, err error) {
+	return f.CopyContext(context.Background(), arg)
+}
+
+// CopyContext implements files.Client
+func (f *Fake) CopyContext(ctx context.Context, arg *files.RelocationArg) (res files.IsMetadata, err error) {
+	f.record("copy", arg, nil)
+	if f.CopyFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CopyFunc(ctx, arg)
+}
+
+// CopyBatch implements files.Client
+func (f *Fake) CopyBatch(arg *files.RelocationBatchArg) (res *files.RelocationBatchLaunch, err error) {
+	return f.CopyBatchContext(context.Background(), arg)
+}
+
+// CopyBatchContext implements files.Client
+func (f *Fake) CopyBatchContext(ctx context.Context, arg *files.RelocationBatchArg) (res *files.RelocationBatchLaunch, err error) {
+	f.record("copy_batch", arg, nil)
+	if f.CopyBatchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CopyBatchFunc(ctx, arg)
+}
+
+// CopyBatchCheck implements files.Client
+func (f *Fake) CopyBatchCheck(arg *async.PollArg) (res *files.RelocationBatchJobStatus, err error) {
+	return f.CopyBatchCheckContext(context.Background(), arg)
+}
+
+// CopyBatchCheckContext implements files.Client
+func (f *Fake) CopyBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *files.RelocationBatchJobStatus, err error) {
+	f.record("copy_batch/check", arg, nil)
+	if f.CopyBatchCheckFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CopyBatchCheckFunc(ctx, arg)
+}
+
+// CopyReferenceGet implements files.Client
+func (f *Fake) CopyReferenceGet(arg *files.GetCopyReferenceArg) (res *files.GetCopyReferenceResult, err error) {
+	return f.CopyReferenceGetContext(context.Background(), arg)
+}
+
+// CopyReferenceGetContext implements files.Client
+func (f *Fake) CopyReferenceGetContext(ctx context.Context, arg *files.GetCopyReferenceArg) (res *files.GetCopyReferenceResult, err error) {
+	f.record("copy_reference/get", arg, nil)
+	if f.CopyReferenceGetFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CopyReferenceGetFunc(ctx, arg)
+}
+
+// CopyReferenceSave implements files.Client
+func (f *Fake) CopyReferenceSave(arg *files.SaveCopyReferenceArg) (res *files.SaveCopyReferenceResult, err error) {
+	return f.CopyReferenceSaveContext(context.Background(), arg)
+}
+
+// CopyReferenceSaveContext implements files.Client
+func (f *Fake) CopyReferenceSaveContext(ctx context.Context, arg *files.SaveCopyReferenceArg) (res *files.SaveCopyReferenceResult, err error) {
+	f.record("copy_reference/save", arg, nil)
+	if f.CopyReferenceSaveFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CopyReferenceSaveFunc(ctx, arg)
+}
+
+// CreateFolder implements files.Client
+func (f *Fake) CreateFolder(arg *files.CreateFolderArg) (res *files.FolderMetadata, err error) {
+	return f.CreateFolderContext(context.Background(), arg)
+}
+
+// CreateFolderContext implements files.Client
+func (f *Fake) CreateFolderContext(ctx context.Context, arg *files.CreateFolderArg) (res *files.FolderMetadata, err error) {
+	f.record("create_folder", arg, nil)
+	if f.CreateFolderFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CreateFolderFunc(ctx, arg)
+}
+
+// Delete implements files.Client
+func (f *Fake) Delete(arg *files.DeleteArg) (res files.IsMetadata, err error) {
+	return f.DeleteContext(context.Background(), arg)
+}
+
+// DeleteContext implements files.Client
+func (f *Fake) DeleteContext(ctx context.Context, arg *files.DeleteArg) (res files.IsMetadata, err error) {
+	f.record("delete", arg, nil)
+	if f.DeleteFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DeleteFunc(ctx, arg)
+}
+
+// DeleteBatch implements files.Client
+func (f *Fake) DeleteBatch(arg *files.DeleteBatchArg) (res *files.DeleteBatchLaunch, err error) {
+	return f.DeleteBatchContext(context.Background(), arg)
+}
+
+// DeleteBatchContext implements files.Client
+func (f *Fake) DeleteBatchContext(ctx context.Context, arg *files.DeleteBatchArg) (res *files.DeleteBatchLaunch, err error) {
+	f.record("delete_batch", arg, nil)
+	if f.DeleteBatchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DeleteBatchFunc(ctx, arg)
+}
+
+// DeleteBatchCheck implements files.Client
+func (f *Fake) DeleteBatchCheck(arg *async.PollArg) (res *files.DeleteBatchJobStatus, err error) {
+	return f.DeleteBatchCheckContext(context.Background(), arg)
+}
+
+// DeleteBatchCheckContext implements files.Client
+func (f *Fake) DeleteBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *files.DeleteBatchJobStatus, err error) {
+	f.record("delete_batch/check", arg, nil)
+	if f.DeleteBatchCheckFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DeleteBatchCheckFunc(ctx, arg)
+}
+
+// Download implements files.Client
+func (f *Fake) Download(arg *files.DownloadArg) (res *files.FileMetadata, content io.ReadCloser, err error) {
+	return f.DownloadContext(context.Background(), arg)
+}
+
+// DownloadContext implements files.Client
+func (f *Fake) DownloadContext(ctx context.Context, arg *files.DownloadArg) (res *files.FileMetadata, content io.ReadCloser, err error) {
+	f.record("download", arg, nil)
+	if f.DownloadFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DownloadFunc(ctx, arg)
+}
+
+// GetMetadata implements files.Client
+func (f *Fake) GetMetadata(arg *files.GetMetadataArg) (res files.IsMetadata, err error) {
+	return f.GetMetadataContext(context.Background(), arg)
+}
+
+// GetMetadataContext implements files.Client
+func (f *Fake) GetMetadataContext(ctx context.Context, arg *files.GetMetadataArg) (res files.IsMetadata, err error) {
+	f.record("get_metadata", arg, nil)
+	if f.GetMetadataFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetMetadataFunc(ctx, arg)
+}
+
+// GetPreview implements files.Client
+func (f *Fake) GetPreview(arg *files.PreviewArg) (res *files.FileMetadata, content io.ReadCloser, err error) {
+	return f.GetPreviewContext(context.Background(), arg)
+}
+
+// GetPreviewContext implements files.Client
+func (f *Fake) GetPreviewContext(ctx context.Context, arg *files.PreviewArg) (res *files.FileMetadata, content io.ReadCloser, err error) {
+	f.record("get_preview", arg, nil)
+	if f.GetPreviewFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetPreviewFunc(ctx, arg)
+}
+
+// GetTemporaryLink implements files.Client
+func (f *Fake) GetTemporaryLink(arg *files.GetTemporaryLinkArg) (res *files.GetTemporaryLinkResult, err error) {
+	return f.GetTemporaryLinkContext(context.Background(), arg)
+}
+
+// GetTemporaryLinkContext implements files.Client
+func (f *Fake) GetTemporaryLinkContext(ctx context.Context, arg *files.GetTemporaryLinkArg) (res *files.GetTemporaryLinkResult, err error) {
+	f.record("get_temporary_link", arg, nil)
+	if f.GetTemporaryLinkFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetTemporaryLinkFunc(ctx, arg)
+}
+
+// GetThumbnail implements files.Client
+func (f *Fake) GetThumbnail(arg *files.ThumbnailArg) (res *files.FileMetadata, content io.ReadCloser, err error) {
+	return f.GetThumbnailContext(context.Background(), arg)
+}
+
+// GetThumbnailContext implements files.Client
+func (f *Fake) GetThumbnailContext(ctx context.Context, arg *files.ThumbnailArg) (res *files.FileMetadata, content io.ReadCloser, err error) {
+	f.record("get_thumbnail", arg, nil)
+	if f.GetThumbnailFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetThumbnailFunc(ctx, arg)
+}
+
+// ListFolder implements files.Client
+func (f *Fake) ListFolder(arg *files.ListFolderArg) (res *files.ListFolderResult, err error) {
+	return f.ListFolderContext(context.Background(), arg)
+}
+
+// ListFolderContext implements files.Client
+func (f *Fake) ListFolderContext(ctx context.Context, arg *files.ListFolderArg) (res *files.ListFolderResult, err error) {
+	f.record("list_folder", arg, nil)
+	if f.ListFolderFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFolderFunc(ctx, arg)
+}
+
+// ListFolderContinue implements files.Client
+func (f *Fake) ListFolderContinue(arg *files.ListFolderContinueArg) (res *files.ListFolderResult, err error) {
+	return f.ListFolderContinueContext(context.Background(), arg)
+}
+
+// ListFolderContinueContext implements files.Client
+func (f *Fake) ListFolderContinueContext(ctx context.Context, arg *files.ListFolderContinueArg) (res *files.ListFolderResult, err error) {
+	f.record("list_folder/continue", arg, nil)
+	if f.ListFolderContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFolderContinueFunc(ctx, arg)
+}
+
+// ListFolderGetLatestCursor implements files.Client
+func (f *Fake) ListFolderGetLatestCursor(arg *files.ListFolderArg) (res *files.ListFolderGetLatestCursorResult, err error) {
+	return f.ListFolderGetLatestCursorContext(context.Background(), arg)
+}
+
+// ListFolderGetLatestCursorContext implements files.Client
+func (f *Fake) ListFolderGetLatestCursorContext(ctx context.Context, arg *files.ListFolderArg) (res *files.ListFolderGetLatestCursorResult, err error) {
+	f.record("list_folder/get_latest_cursor", arg, nil)
+	if f.ListFolderGetLatestCursorFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFolderGetLatestCursorFunc(ctx, arg)
+}
+
+// ListFolderLongpoll implements files.Client
+func (f *Fake) ListFolderLongpoll(arg *files.ListFolderLongpollArg) (res *files.ListFolderLongpollResult, err error) {
+	return f.ListFolderLongpollContext(context.Background(), arg)
+}
+
+// ListFolderLongpollContext implements files.Client
+func (f *Fake) ListFolderLongpollContext(ctx context.Context, arg *files.ListFolderLongpollArg) (res *files.ListFolderLongpollResult, err error) {
+	f.record("list_folder/longpoll", arg, nil)
+	if f.ListFolderLongpollFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFolderLongpollFunc(ctx, arg)
+}
+
+// ListRevisions implements files.Client
+func (f *Fake) ListRevisions(arg *files.ListRevisionsArg) (res *files.ListRevisionsResult, err error) {
+	return f.ListRevisionsContext(context.Background(), arg)
+}
+
+// ListRevisionsContext implements files.Client
+func (f *Fake) ListRevisionsContext(ctx context.Context, arg *files.ListRevisionsArg) (res *files.ListRevisionsResult, err error) {
+	f.record("list_revisions", arg, nil)
+	if f.ListRevisionsFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListRevisionsFunc(ctx, arg)
+}
+
+// Move implements files.Client
+func (f *Fake) Move(arg *files.RelocationArg) (res files.IsMetadata, err error) {
+	return f.MoveContext(context.Background(), arg)
+}
+
+// MoveContext implements files.Client
+func (f *Fake) MoveContext(ctx context.Context, arg *files.RelocationArg) (res files.IsMetadata, err error) {
+	f.record("move", arg, nil)
+	if f.MoveFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MoveFunc(ctx, arg)
+}
+
+// MoveBatch implements files.Client
+func (f *Fake) MoveBatch(arg *files.RelocationBatchArg) (res *files.RelocationBatchLaunch, err error) {
+	return f.MoveBatchContext(context.Background(), arg)
+}
+
+// MoveBatchContext implements files.Client
+func (f *Fake) MoveBatchContext(ctx context.Context, arg *files.RelocationBatchArg) (res *files.RelocationBatchLaunch, err error) {
+	f.record("move_batch", arg, nil)
+	if f.MoveBatchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MoveBatchFunc(ctx, arg)
+}
+
+// MoveBatchCheck implements files.Client
+func (f *Fake) MoveBatchCheck(arg *async.PollArg) (res *files.RelocationBatchJobStatus, err error) {
+	return f.MoveBatchCheckContext(context.Background(), arg)
+}
+
+// MoveBatchCheckContext implements files.Client
+func (f *Fake) MoveBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *files.RelocationBatchJobStatus, err error) {
+	f.record("move_batch/check", arg, nil)
+	if f.MoveBatchCheckFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MoveBatchCheckFunc(ctx, arg)
+}
+
+// PermanentlyDelete implements files.Client
+func (f *Fake) PermanentlyDelete(arg *files.DeleteArg) (err error) {
+	return f.PermanentlyDeleteContext(context.Background(), arg)
+}
+
+// PermanentlyDeleteContext implements files.Client
+func (f *Fake) PermanentlyDeleteContext(ctx context.Context, arg *files.DeleteArg) (err error) {
+	f.record("permanently_delete", arg, nil)
+	if f.PermanentlyDeleteFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PermanentlyDeleteFunc(ctx, arg)
+}
+
+// PropertiesAdd implements files.Client
+func (f *Fake) PropertiesAdd(arg *files.PropertyGroupWithPath) (err error) {
+	return f.PropertiesAddContext(context.Background(), arg)
+}
+
+// PropertiesAddContext implements files.Client
+func (f *Fake) PropertiesAddContext(ctx context.Context, arg *files.PropertyGroupWithPath) (err error) {
+	f.record("properties/add", arg, nil)
+	if f.PropertiesAddFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesAddFunc(ctx, arg)
+}
+
+// PropertiesOverwrite implements files.Client
+func (f *Fake) PropertiesOverwrite(arg *files.PropertyGroupWithPath) (err error) {
+	return f.PropertiesOverwriteContext(context.Background(), arg)
+}
+
+// PropertiesOverwriteContext implements files.Client
+func (f *Fake) PropertiesOverwriteContext(ctx context.Context, arg *files.PropertyGroupWithPath) (err error) {
+	f.record("properties/overwrite", arg, nil)
+	if f.PropertiesOverwriteFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesOverwriteFunc(ctx, arg)
+}
+
+// PropertiesRemove implements files.Client
+func (f *Fake) PropertiesRemove(arg *files.RemovePropertiesArg) (err error) {
+	return f.PropertiesRemoveContext(context.Background(), arg)
+}
+
+// PropertiesRemoveContext implements files.Client
+func (f *Fake) PropertiesRemoveContext(ctx context.Context, arg *files.RemovePropertiesArg) (err error) {
+	f.record("properties/remove", arg, nil)
+	if f.PropertiesRemoveFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesRemoveFunc(ctx, arg)
+}
+
+// PropertiesTemplateGet implements files.Client
+func (f *Fake) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
+	return f.PropertiesTemplateGetContext(context.Background(), arg)
+}
+
+// PropertiesTemplateGetContext implements files.Client
+func (f *Fake) PropertiesTemplateGetContext(ctx context.Context, arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
+	f.record("properties/template/get", arg, nil)
+	if f.PropertiesTemplateGetFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesTemplateGetFunc(ctx, arg)
+}
+
+// PropertiesTemplateList implements files.Client
+func (f *Fake) PropertiesTemplateList() (res *properties.ListPropertyTemplateIds, err error) {
+	return f.PropertiesTemplateListContext(context.Background())
+}
+
+// PropertiesTemplateListContext implements files.Client
+func (f *Fake) PropertiesTemplateListContext(ctx context.Context) (res *properties.ListPropertyTemplateIds, err error) {
+	f.record("properties/template/list", nil, nil)
+	if f.PropertiesTemplateListFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesTemplateListFunc(ctx)
+}
+
+// PropertiesUpdate implements files.Client
+func (f *Fake) PropertiesUpdate(arg *files.UpdatePropertyGroupArg) (err error) {
+	return f.PropertiesUpdateContext(context.Background(), arg)
+}
+
+// PropertiesUpdateContext implements files.Client
+func (f *Fake) PropertiesUpdateContext(ctx context.Context, arg *files.UpdatePropertyGroupArg) (err error) {
+	f.record("properties/update", arg, nil)
+	if f.PropertiesUpdateFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesUpdateFunc(ctx, arg)
+}
+
+// Restore implements files.Client
+func (f *Fake) Restore(arg *files.RestoreArg) (res *files.FileMetadata, err error) {
+	return f.RestoreContext(context.Background(), arg)
+}
+
+// RestoreContext implements files.Client
+func (f *Fake) RestoreContext(ctx context.Context, arg *files.RestoreArg) (res *files.FileMetadata, err error) {
+	f.record("restore", arg, nil)
+	if f.RestoreFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.RestoreFunc(ctx, arg)
+}
+
+// SaveUrl implements files.Client
+func (f *Fake) SaveUrl(arg *files.SaveUrlArg) (res *files.SaveUrlResult, err error) {
+	return f.SaveUrlContext(context.Background(), arg)
+}
+
+// SaveUrlContext implements files.Client
+func (f *Fake) SaveUrlContext(ctx context.Context, arg *files.SaveUrlArg) (res *files.SaveUrlResult, err error) {
+	f.record("save_url", arg, nil)
+	if f.SaveUrlFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.SaveUrlFunc(ctx, arg)
+}
+
+// SaveUrlCheckJobStatus implements files.Client
+func (f *Fake) SaveUrlCheckJobStatus(arg *async.PollArg) (res *files.SaveUrlJobStatus, err error) {
+	return f.SaveUrlCheckJobStatusContext(context.Background(), arg)
+}
+
+// SaveUrlCheckJobStatusContext implements files.Client
+func (f *Fake) SaveUrlCheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *files.SaveUrlJobStatus, err error) {
+	f.record("save_url/check_job_status", arg, nil)
+	if f.SaveUrlCheckJobStatusFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.SaveUrlCheckJobStatusFunc(ctx, arg)
+}
+
+// Search implements files.Client
+func (f *Fake) Search(arg *files.SearchArg) (res *files.SearchResult, err error) {
+	return f.SearchContext(context.Background(), arg)
+}
+
+// SearchContext implements files.Client
+func (f *Fake) SearchContext(ctx context.Context, arg *files.SearchArg) (res *files.SearchResult, err error) {
+	f.record("search", arg, nil)
+	if f.SearchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.SearchFunc(ctx, arg)
+}
+
+// Upload implements files.Client
+func (f *Fake) Upload(arg *files.CommitInfo, content io.Reader) (res *files.FileMetadata, err error) {
+	return f.UploadContext(context.Background(), arg, content)
+}
+
+// UploadContext implements files.Client
+func (f *Fake) UploadContext(ctx context.Context, arg *files.CommitInfo, content io.Reader) (res *files.FileMetadata, err error) {
+	f.record("upload", arg, content)
+	if f.UploadFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UploadFunc(ctx, arg, content)
+}
+
+// UploadSessionAppend implements files.Client
+func (f *Fake) UploadSessionAppend(arg *files.UploadSessionCursor, content io.Reader) (err error) {
+	return f.UploadSessionAppendContext(context.Background(), arg, content)
+}
+
+// UploadSessionAppendContext implements files.Client
+func (f *Fake) UploadSessionAppendContext(ctx context.Context, arg *files.UploadSessionCursor, content io.Reader) (err error) {
+	f.record("upload_session/append", arg, content)
+	if f.UploadSessionAppendFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UploadSessionAppendFunc(ctx, arg, content)
+}
+
+// UploadSessionAppendV2 implements files.Client
+func (f *Fake) UploadSessionAppendV2(arg *files.UploadSessionAppendArg, content io.Reader) (err error) {
+	return f.UploadSessionAppendV2Context(context.Background(), arg, content)
+}
+
+// UploadSessionAppendV2Context implements files.Client
+func (f *Fake) UploadSessionAppendV2Context(ctx context.Context, arg *files.UploadSessionAppendArg, content io.Reader) (err error) {
+	f.record("upload_session/append_v2", arg, content)
+	if f.UploadSessionAppendV2Func == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UploadSessionAppendV2Func(ctx, arg, content)
+}
+
+// UploadSessionFinish implements files.Client
+func (f *Fake) UploadSessionFinish(arg *files.UploadSessionFinishArg, content io.Reader) (res *files.FileMetadata, err error) {
+	return f.UploadSessionFinishContext(context.Background(), arg, content)
+}
+
+// UploadSessionFinishContext implements files.Client
+func (f *Fake) UploadSessionFinishContext(ctx context.Context, arg *files.UploadSessionFinishArg, content io.Reader) (res *files.FileMetadata, err error) {
+	f.record("upload_session/finish", arg, content)
+	if f.UploadSessionFinishFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UploadSessionFinishFunc(ctx, arg, content)
+}
+
+// UploadSessionFinishBatch implements files.Client
+func (f *Fake) UploadSessionFinishBatch(arg *files.UploadSessionFinishBatchArg) (res *files.UploadSessionFinishBatchLaunch, err error) {
+	return f.UploadSessionFinishBatchContext(context.Background(), arg)
+}
+
+// UploadSessionFinishBatchContext implements files.Client
+func (f *Fake) UploadSessionFinishBatchContext(ctx context.Context, arg *files.UploadSessionFinishBatchArg) (res *files.UploadSessionFinishBatchLaunch, err error) {
+	f.record("upload_session/finish_batch", arg, nil)
+	if f.UploadSessionFinishBatchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UploadSessionFinishBatchFunc(ctx, arg)
+}
+
+// UploadSessionFinishBatchCheck implements files.Client
+func (f *Fake) UploadSessionFinishBatchCheck(arg *async.PollArg) (res *files.UploadSessionFinishBatchJobStatus, err error) {
+	return f.UploadSessionFinishBatchCheckContext(context.Background(), arg)
+}
+
+// UploadSessionFinishBatchCheckContext implements files.Client
+func (f *Fake) UploadSessionFinishBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *files.UploadSessionFinishBatchJobStatus, err error) {
+	f.record("upload_session/finish_batch/check", arg, nil)
+	if f.UploadSessionFinishBatchCheckFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UploadSessionFinishBatchCheckFunc(ctx, arg)
+}
+
+// UploadSessionStart implements files.Client
+func (f *Fake) UploadSessionStart(arg *files.UploadSessionStartArg, content io.Reader) (res *files.UploadSessionStartResult, err error) {
+	return f.UploadSessionStartContext(context.Background(), arg, content)
+}
+
+// UploadSessionStartContext implements files.Client
+func (f *Fake) UploadSessionStartContext(ctx context.Context, arg *files.UploadSessionStartArg, content io.Reader) (res *files.UploadSessionStartResult, err error) {
+	f.record("upload_session/start", arg, content)
+	if f.UploadSessionStartFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UploadSessionStartFunc(ctx, arg, content)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+)
+
+// jsonEqual reports whether a and b hold the same JSON value.
+func jsonEqual(t *testing.T, a, b []byte) bool {
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		t.Fatalf("%s: %v", a, err)
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		t.Fatalf("%s: %v", b, err)
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+func TestRoundTrip(t *testing.T) {
+	modified := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		name  string
+		value interface{}
+		json  string
+		// Decodes into a new value of the same type
+		decoded interface{}
+	}{
+		{
+			name: "nested union member",
+			value: &RelocationError{
+				Tagged:     dropbox.Tagged{Tag: RelocationErrorFromLookup},
+				FromLookup: &LookupError{Tagged: dropbox.Tagged{Tag: LookupErrorNotFound}},
+			},
+			json:    `{".tag": "from_lookup", "from_lookup": {".tag": "not_found"}}`,
+			decoded: &RelocationError{},
+		},
+		{
+			name: "flattened struct member",
+			value: &UploadError{
+				Tagged: dropbox.Tagged{Tag: UploadErrorPath},
+				Path: &UploadWriteFailed{
+					Reason: &WriteError{
+						Tagged:   dropbox.Tagged{Tag: WriteErrorConflict},
+						Conflict: &WriteConflictError{Tagged: dropbox.Tagged{Tag: WriteConflictErrorFile}},
+					},
+					UploadSessionId: "session",
+				},
+			},
+			json: `{".tag": "path", "reason": {".tag": "conflict", "conflict": {".tag": "file"}},
+				"upload_session_id": "session"}`,
+			decoded: &UploadError{},
+		},
+		{
+			name:    "primitive member",
+			value:   &WriteMode{Tagged: dropbox.Tagged{Tag: WriteModeUpdate}, Update: "a1c10ce0dd78"},
+			json:    `{".tag": "update", "update": "a1c10ce0dd78"}`,
+			decoded: &WriteMode{},
+		},
+		{
+			name:    "void member",
+			value:   &WriteMode{Tagged: dropbox.Tagged{Tag: WriteModeOverwrite}},
+			json:    `{".tag": "overwrite"}`,
+			decoded: &WriteMode{},
+		},
+		{
+			name: "struct with a union field",
+			value: &CommitInfo{
+				Path:           "/a.txt",
+				Mode:           &WriteMode{Tagged: dropbox.Tagged{Tag: WriteModeAdd}},
+				Autorename:     true,
+				ClientModified: modified,
+			},
+			json: `{"path": "/a.txt", "mode": {".tag": "add"}, "autorename": true,
+				"client_modified": "2017-06-01T12:00:00Z", "mute": false}`,
+			decoded: &CommitInfo{},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := json.Marshal(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !jsonEqual(t, b, []byte(test.json)) {
+				t.Fatalf("marshaled to %s, want %s", b, test.json)
+			}
+			if err := json.Unmarshal(b, test.decoded); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(test.decoded, test.value) {
+				t.Errorf("decoded %+v, want %+v", test.decoded, test.value)
+			}
+		})
+	}
+}
+
+func TestRoundTripSubtypes(t *testing.T) {
+	modified := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+	file := &FileMetadata{
+		Metadata:       Metadata{Name: "a.txt", PathLower: "/a.txt", PathDisplay: "/a.txt"},
+		Id:             "id:a",
+		ClientModified: modified,
+		ServerModified: modified,
+		Rev:            "a1c10ce0dd78",
+		Size:           5,
+		ContentHash:    "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}
+	folder := &FolderMetadata{
+		Metadata: Metadata{Name: "b", PathLower: "/b", PathDisplay: "/B"},
+		Id:       "id:b",
+	}
+	deleted := &DeletedMetadata{Metadata: Metadata{Name: "c", PathLower: "/c", PathDisplay: "/c"}}
+	res := &ListFolderResult{Entries: []IsMetadata{file, folder, deleted}, Cursor: "cursor"}
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw struct {
+		Entries []dropbox.Tagged `json:"entries"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		t.Fatal(err)
+	}
+	var tags []string
+	for _, e := range raw.Entries {
+		tags = append(tags, e.Tag)
+	}
+	if !reflect.DeepEqual(tags, []string{"file", "folder", "deleted"}) {
+		t.Fatalf("tags %v in %s", tags, b)
+	}
+	var decoded ListFolderResult
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, res) {
+		t.Errorf("decoded %+v, want %+v", decoded, res)
+	}
+}
+
+func TestRoundTripUnknown(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		json    string
+		decoded interface{}
+	}{
+		{
+			name:    "unknown union tag",
+			json:    `{".tag": "future_mode", "future_mode": {"rev": "a1c10ce0dd78", "strict": true}}`,
+			decoded: &WriteMode{},
+		},
+		{
+			name:    "unknown nested union tag",
+			json:    `{".tag": "from_lookup", "from_lookup": {".tag": "future_error", "detail": 1}}`,
+			decoded: &RelocationError{},
+		},
+		{
+			name: "unknown struct fields",
+			json: `{"path": "/a.txt", "mode": {".tag": "add"}, "autorename": false,
+				"client_modified": "2017-06-01T12:00:00Z", "mute": false,
+				"strict_conflict": true, "content_hash": "abc"}`,
+			decoded: &CommitInfo{},
+		},
+		{
+			name: "unknown fields of a subtype",
+			json: `{".tag": "folder", "name": "b", "path_lower": "/b", "path_display": "/B", "id": "id:b",
+				"preview_url": "https://example.com"}`,
+			decoded: &FolderMetadata{},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(test.json), test.decoded); err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(test.decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !jsonEqual(t, b, []byte(test.json)) {
+				t.Errorf("marshaled back to %s, want %s", b, test.json)
+			}
+		})
+	}
+	var mode WriteMode
+	json.Unmarshal([]byte(`{".tag": "future_mode"}`), &mode)
+	if mode.Tag != "future_mode" || string(mode.Raw()) != `{".tag": "future_mode"}` {
+		t.Errorf("unknown tag not kept: %+v", mode)
+	}
+	json.Unmarshal([]byte(`{".tag": "add"}`), &mode)
+	if mode.Raw() != nil {
+		t.Errorf("Raw() = %s after decoding a known tag", mode.Raw())
+	}
+	// Unions remain comparable, and Tagged can still be written positionally
+	if mode != (WriteMode{Tagged: dropbox.Tagged{"add"}}) {
+		t.Errorf("got %+v, want add", mode)
+	}
+}
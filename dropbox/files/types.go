@@ -24,7 +24,9 @@ package files
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
+	"unicode/utf8"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/properties"
@@ -35,6 +37,17 @@ type PropertiesError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PropertiesError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PropertiesError
@@ -54,7 +67,7 @@ func (u *PropertiesError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -62,13 +75,48 @@ func (u *PropertiesError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a PropertiesError instance
+func (u PropertiesError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsPropertiesError reports whether err carries a PropertiesError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsPropertiesError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*PropertiesError)(nil), tags...)
+}
+
 // InvalidPropertyGroupError : has no documentation (yet)
 type InvalidPropertyGroupError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u InvalidPropertyGroupError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for InvalidPropertyGroupError
@@ -77,9 +125,53 @@ const (
 	InvalidPropertyGroupErrorDoesNotFitTemplate    = "does_not_fit_template"
 )
 
+// UnmarshalJSON deserializes into a InvalidPropertyGroupError instance
+func (u *InvalidPropertyGroupError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "property_field_too_large", "does_not_fit_template":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a InvalidPropertyGroupError instance
+func (u InvalidPropertyGroupError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsInvalidPropertyGroupError reports whether err carries a InvalidPropertyGroupError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsInvalidPropertyGroupError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*InvalidPropertyGroupError)(nil), tags...)
+}
+
 // AddPropertiesError : has no documentation (yet)
 type AddPropertiesError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AddPropertiesError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AddPropertiesError
@@ -87,6 +179,39 @@ const (
 	AddPropertiesErrorPropertyGroupAlreadyExists = "property_group_already_exists"
 )
 
+// UnmarshalJSON deserializes into a AddPropertiesError instance
+func (u *AddPropertiesError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "property_group_already_exists":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a AddPropertiesError instance
+func (u AddPropertiesError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsAddPropertiesError reports whether err carries a AddPropertiesError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsAddPropertiesError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*AddPropertiesError)(nil), tags...)
+}
+
 // GetMetadataArg : has no documentation (yet)
 type GetMetadataArg struct {
 	// Path : The path of a file or folder on Dropbox.
@@ -101,6 +226,8 @@ type GetMetadataArg struct {
 	// flag for each file indicating whether or not  that file has any explicit
 	// members.
 	IncludeHasExplicitSharedMembers bool `json:"include_has_explicit_shared_members"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetMetadataArg returns a new GetMetadataArg instance
@@ -113,12 +240,38 @@ func NewGetMetadataArg(Path string) *GetMetadataArg {
 	return s
 }
 
+// MarshalJSON serializes a GetMetadataArg instance
+func (u GetMetadataArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetMetadataArg instance
+func (u *GetMetadataArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetMetadataArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`,
+		}
+	}
+	return nil
+}
+
 // AlphaGetMetadataArg : has no documentation (yet)
 type AlphaGetMetadataArg struct {
 	GetMetadataArg
 	// IncludePropertyTemplates : If set to a valid list of template IDs,
 	// `FileMetadata.property_groups` is set for files with custom properties.
 	IncludePropertyTemplates []string `json:"include_property_templates,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAlphaGetMetadataArg returns a new AlphaGetMetadataArg instance
@@ -131,11 +284,46 @@ func NewAlphaGetMetadataArg(Path string) *AlphaGetMetadataArg {
 	return s
 }
 
+// MarshalJSON serializes a AlphaGetMetadataArg instance
+func (u AlphaGetMetadataArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AlphaGetMetadataArg instance
+func (u *AlphaGetMetadataArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *AlphaGetMetadataArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`,
+		}
+	}
+	return nil
+}
+
 // GetMetadataError : has no documentation (yet)
 type GetMetadataError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetMetadataError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetMetadataError
@@ -155,7 +343,7 @@ func (u *GetMetadataError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -163,15 +351,50 @@ func (u *GetMetadataError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GetMetadataError instance
+func (u GetMetadataError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetMetadataError reports whether err carries a GetMetadataError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetMetadataError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetMetadataError)(nil), tags...)
+}
+
 // AlphaGetMetadataError : has no documentation (yet)
 type AlphaGetMetadataError struct {
 	dropbox.Tagged
 	// PropertiesError : has no documentation (yet)
 	PropertiesError *LookUpPropertiesError `json:"properties_error,omitempty"`
+	raw             string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AlphaGetMetadataError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AlphaGetMetadataError
@@ -191,7 +414,7 @@ func (u *AlphaGetMetadataError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "properties_error":
 		err = json.Unmarshal(w.PropertiesError, &u.PropertiesError)
@@ -199,10 +422,34 @@ func (u *AlphaGetMetadataError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a AlphaGetMetadataError instance
+func (u AlphaGetMetadataError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "properties_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertiesError *LookUpPropertiesError `json:"properties_error"`
+		}{u.Tagged, u.PropertiesError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsAlphaGetMetadataError reports whether err carries a AlphaGetMetadataError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsAlphaGetMetadataError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*AlphaGetMetadataError)(nil), tags...)
+}
+
 // CommitInfo : has no documentation (yet)
 type CommitInfo struct {
 	// Path : Path in the user's Dropbox to save the file.
@@ -223,35 +470,87 @@ type CommitInfo struct {
 	// tells the clients that this modification shouldn't result in a user
 	// notification.
 	Mute bool `json:"mute"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewCommitInfo returns a new CommitInfo instance
 func NewCommitInfo(Path string) *CommitInfo {
 	s := new(CommitInfo)
 	s.Path = Path
-	s.Mode = &WriteMode{Tagged: dropbox.Tagged{"add"}}
+	s.Mode = &WriteMode{Tagged: dropbox.Tagged{Tag: "add"}}
 	s.Autorename = false
 	s.Mute = false
 	return s
 }
 
+// MarshalJSON serializes a CommitInfo instance
+func (u CommitInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a CommitInfo instance
+func (u *CommitInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *CommitInfo) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`,
+		}
+	}
+	return nil
+}
+
 // CommitInfoWithProperties : has no documentation (yet)
 type CommitInfoWithProperties struct {
 	CommitInfo
 	// PropertyGroups : List of custom properties to add to file.
 	PropertyGroups []*properties.PropertyGroup `json:"property_groups,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewCommitInfoWithProperties returns a new CommitInfoWithProperties instance
 func NewCommitInfoWithProperties(Path string) *CommitInfoWithProperties {
 	s := new(CommitInfoWithProperties)
 	s.Path = Path
-	s.Mode = &WriteMode{Tagged: dropbox.Tagged{"add"}}
+	s.Mode = &WriteMode{Tagged: dropbox.Tagged{Tag: "add"}}
 	s.Autorename = false
 	s.Mute = false
 	return s
 }
 
+// MarshalJSON serializes a CommitInfoWithProperties instance
+func (u CommitInfoWithProperties) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a CommitInfoWithProperties instance
+func (u *CommitInfoWithProperties) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *CommitInfoWithProperties) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`,
+		}
+	}
+	return nil
+}
+
 // CreateFolderArg : has no documentation (yet)
 type CreateFolderArg struct {
 	// Path : Path in the user's Dropbox to create.
@@ -259,6 +558,8 @@ type CreateFolderArg struct {
 	// Autorename : If there's a conflict, have the Dropbox server try to
 	// autorename the folder to avoid the conflict.
 	Autorename bool `json:"autorename"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewCreateFolderArg returns a new CreateFolderArg instance
@@ -269,11 +570,46 @@ func NewCreateFolderArg(Path string) *CreateFolderArg {
 	return s
 }
 
+// MarshalJSON serializes a CreateFolderArg instance
+func (u CreateFolderArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a CreateFolderArg instance
+func (u *CreateFolderArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *CreateFolderArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)`,
+		}
+	}
+	return nil
+}
+
 // CreateFolderError : has no documentation (yet)
 type CreateFolderError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *WriteError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u CreateFolderError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for CreateFolderError
@@ -293,7 +629,7 @@ func (u *CreateFolderError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -301,14 +637,40 @@ func (u *CreateFolderError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a CreateFolderError instance
+func (u CreateFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *WriteError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsCreateFolderError reports whether err carries a CreateFolderError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsCreateFolderError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*CreateFolderError)(nil), tags...)
+}
+
 // DeleteArg : has no documentation (yet)
 type DeleteArg struct {
 	// Path : Path in the user's Dropbox to delete.
 	Path string `json:"path"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeleteArg returns a new DeleteArg instance
@@ -318,10 +680,36 @@ func NewDeleteArg(Path string) *DeleteArg {
 	return s
 }
 
+// MarshalJSON serializes a DeleteArg instance
+func (u DeleteArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeleteArg instance
+func (u *DeleteArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *DeleteArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`,
+		}
+	}
+	return nil
+}
+
 // DeleteBatchArg : has no documentation (yet)
 type DeleteBatchArg struct {
 	// Entries : has no documentation (yet)
 	Entries []*DeleteArg `json:"entries"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeleteBatchArg returns a new DeleteBatchArg instance
@@ -331,9 +719,45 @@ func NewDeleteBatchArg(Entries []*DeleteArg) *DeleteBatchArg {
 	return s
 }
 
+// MarshalJSON serializes a DeleteBatchArg instance
+func (u DeleteBatchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeleteBatchArg instance
+func (u *DeleteBatchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *DeleteBatchArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	for i, v := range s.Entries {
+		if v != nil {
+			if err := v.Validate(); err != nil {
+				return dropbox.NestValidationError(fmt.Sprintf("entries[%d]", i), err)
+			}
+		}
+	}
+	return nil
+}
+
 // DeleteBatchError : has no documentation (yet)
 type DeleteBatchError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DeleteBatchError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DeleteBatchError
@@ -342,6 +766,39 @@ const (
 	DeleteBatchErrorOther                  = "other"
 )
 
+// UnmarshalJSON deserializes into a DeleteBatchError instance
+func (u *DeleteBatchError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "too_many_write_operations", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a DeleteBatchError instance
+func (u DeleteBatchError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsDeleteBatchError reports whether err carries a DeleteBatchError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsDeleteBatchError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*DeleteBatchError)(nil), tags...)
+}
+
 // DeleteBatchJobStatus : has no documentation (yet)
 type DeleteBatchJobStatus struct {
 	dropbox.Tagged
@@ -349,13 +806,25 @@ type DeleteBatchJobStatus struct {
 	Complete *DeleteBatchResult `json:"complete,omitempty"`
 	// Failed : The batch delete has failed.
 	Failed *DeleteBatchError `json:"failed,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DeleteBatchJobStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DeleteBatchJobStatus
 const (
-	DeleteBatchJobStatusComplete = "complete"
-	DeleteBatchJobStatusFailed   = "failed"
-	DeleteBatchJobStatusOther    = "other"
+	DeleteBatchJobStatusInProgress = "in_progress"
+	DeleteBatchJobStatusComplete   = "complete"
+	DeleteBatchJobStatusFailed     = "failed"
+	DeleteBatchJobStatusOther      = "other"
 )
 
 // UnmarshalJSON deserializes into a DeleteBatchJobStatus instance
@@ -372,7 +841,7 @@ func (u *DeleteBatchJobStatus) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
@@ -386,28 +855,69 @@ func (u *DeleteBatchJobStatus) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "in_progress", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a DeleteBatchJobStatus instance
+func (u DeleteBatchJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *DeleteBatchError `json:"failed"`
+		}{u.Tagged, u.Failed})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // DeleteBatchLaunch : Result returned by `deleteBatch` that may either launch
 // an asynchronous job or complete synchronously.
 type DeleteBatchLaunch struct {
 	dropbox.Tagged
+	// AsyncJobId : This response indicates that the processing is asynchronous.
+	// The string is an id that can be used to obtain the status of the
+	// asynchronous job.
+	AsyncJobId string `json:"async_job_id,omitempty"`
 	// Complete : has no documentation (yet)
 	Complete *DeleteBatchResult `json:"complete,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DeleteBatchLaunch) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DeleteBatchLaunch
 const (
-	DeleteBatchLaunchComplete = "complete"
-	DeleteBatchLaunchOther    = "other"
+	DeleteBatchLaunchAsyncJobId = "async_job_id"
+	DeleteBatchLaunchComplete   = "complete"
+	DeleteBatchLaunchOther      = "other"
 )
 
 // UnmarshalJSON deserializes into a DeleteBatchLaunch instance
 func (u *DeleteBatchLaunch) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// AsyncJobId : This response indicates that the processing is
+		// asynchronous. The string is an id that can be used to obtain the
+		// status of the asynchronous job.
+		AsyncJobId json.RawMessage `json:"async_job_id,omitempty"`
 		// Complete : has no documentation (yet)
 		Complete json.RawMessage `json:"complete,omitempty"`
 	}
@@ -416,22 +926,51 @@ func (u *DeleteBatchLaunch) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
+	case "async_job_id":
+		err = json.Unmarshal(w.AsyncJobId, &u.AsyncJobId)
+
+		if err != nil {
+			return err
+		}
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
 
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a DeleteBatchLaunch instance
+func (u DeleteBatchLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // DeleteBatchResult : has no documentation (yet)
 type DeleteBatchResult struct {
 	// Entries : has no documentation (yet)
 	Entries []*DeleteBatchResultEntry `json:"entries"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeleteBatchResult returns a new DeleteBatchResult instance
@@ -441,6 +980,16 @@ func NewDeleteBatchResult(Entries []*DeleteBatchResultEntry) *DeleteBatchResult
 	return s
 }
 
+// MarshalJSON serializes a DeleteBatchResult instance
+func (u DeleteBatchResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeleteBatchResult instance
+func (u *DeleteBatchResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeleteBatchResultEntry : has no documentation (yet)
 type DeleteBatchResultEntry struct {
 	dropbox.Tagged
@@ -448,6 +997,17 @@ type DeleteBatchResultEntry struct {
 	Success *DeleteResult `json:"success,omitempty"`
 	// Failure : has no documentation (yet)
 	Failure *DeleteError `json:"failure,omitempty"`
+	raw     string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DeleteBatchResultEntry) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DeleteBatchResultEntry
@@ -470,7 +1030,7 @@ func (u *DeleteBatchResultEntry) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "success":
 		err = json.Unmarshal(body, &u.Success)
@@ -484,10 +1044,30 @@ func (u *DeleteBatchResultEntry) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a DeleteBatchResultEntry instance
+func (u DeleteBatchResultEntry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return dropbox.MarshalTagged(u.Tag, u.Success)
+
+	case "failure":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failure *DeleteError `json:"failure"`
+		}{u.Tagged, u.Failure})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // DeleteError : has no documentation (yet)
 type DeleteError struct {
 	dropbox.Tagged
@@ -495,6 +1075,17 @@ type DeleteError struct {
 	PathLookup *LookupError `json:"path_lookup,omitempty"`
 	// PathWrite : has no documentation (yet)
 	PathWrite *WriteError `json:"path_write,omitempty"`
+	raw       string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DeleteError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DeleteError
@@ -518,7 +1109,7 @@ func (u *DeleteError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path_lookup":
 		err = json.Unmarshal(w.PathLookup, &u.PathLookup)
@@ -532,10 +1123,41 @@ func (u *DeleteError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a DeleteError instance
+func (u DeleteError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PathLookup *LookupError `json:"path_lookup"`
+		}{u.Tagged, u.PathLookup})
+
+	case "path_write":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PathWrite *WriteError `json:"path_write"`
+		}{u.Tagged, u.PathWrite})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsDeleteError reports whether err carries a DeleteError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsDeleteError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*DeleteError)(nil), tags...)
+}
+
 // DeleteResult : has no documentation (yet)
 type DeleteResult struct {
 	// Metadata : has no documentation (yet)
@@ -569,6 +1191,8 @@ type Metadata struct {
 	// `FileSharingInfo.parent_shared_folder_id` or
 	// `FolderSharingInfo.parent_shared_folder_id` instead.
 	ParentSharedFolderId string `json:"parent_shared_folder_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMetadata returns a new Metadata instance
@@ -578,6 +1202,16 @@ func NewMetadata(Name string) *Metadata {
 	return s
 }
 
+// MarshalJSON serializes a Metadata instance
+func (u Metadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a Metadata instance
+func (u *Metadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // IsMetadata is the interface type for Metadata and its subtypes
 type IsMetadata interface {
 	IsMetadata()
@@ -594,6 +1228,17 @@ type metadataUnion struct {
 	Folder *FolderMetadata `json:"folder,omitempty"`
 	// Deleted : has no documentation (yet)
 	Deleted *DeletedMetadata `json:"deleted,omitempty"`
+	raw     string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u metadataUnion) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for Metadata
@@ -619,7 +1264,7 @@ func (u *metadataUnion) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "file":
 		err = json.Unmarshal(body, &u.File)
@@ -639,6 +1284,10 @@ func (u *metadataUnion) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
@@ -667,6 +1316,8 @@ func IsMetadataFromJSON(data []byte) (IsMetadata, error) {
 // path, but it no longer exists.
 type DeletedMetadata struct {
 	Metadata
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeletedMetadata returns a new DeletedMetadata instance
@@ -676,12 +1327,24 @@ func NewDeletedMetadata(Name string) *DeletedMetadata {
 	return s
 }
 
+// MarshalJSON serializes a DeletedMetadata instance, tagged as a `Metadata`
+func (u DeletedMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("deleted", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeletedMetadata instance
+func (u *DeletedMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // Dimensions : Dimensions for a photo or video.
 type Dimensions struct {
 	// Height : Height of the photo/video.
 	Height uint64 `json:"height"`
 	// Width : Width of the photo/video.
 	Width uint64 `json:"width"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDimensions returns a new Dimensions instance
@@ -692,6 +1355,16 @@ func NewDimensions(Height uint64, Width uint64) *Dimensions {
 	return s
 }
 
+// MarshalJSON serializes a Dimensions instance
+func (u Dimensions) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a Dimensions instance
+func (u *Dimensions) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DownloadArg : has no documentation (yet)
 type DownloadArg struct {
 	// Path : The path of the file to download.
@@ -700,6 +1373,8 @@ type DownloadArg struct {
 	Rev string `json:"rev,omitempty"`
 	// ExtraHeaders can be used to pass Range, If-None-Match headers
 	ExtraHeaders map[string]string `json:"-"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDownloadArg returns a new DownloadArg instance
@@ -709,11 +1384,60 @@ func NewDownloadArg(Path string) *DownloadArg {
 	return s
 }
 
-// DownloadError : has no documentation (yet)
-type DownloadError struct {
+// MarshalJSON serializes a DownloadArg instance
+func (u DownloadArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DownloadArg instance
+func (u *DownloadArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *DownloadArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`,
+		}
+	}
+	if s.Rev != "" {
+		if utf8.RuneCountInString(s.Rev) < 9 {
+			return &dropbox.ValidationError{
+				Field:  "rev",
+				Reason: "must have at least 9 characters",
+			}
+		}
+		if !dropbox.MatchPattern(s.Rev, `[0-9a-f]+`) {
+			return &dropbox.ValidationError{
+				Field:  "rev",
+				Reason: `must match [0-9a-f]+`,
+			}
+		}
+	}
+	return nil
+}
+
+// DownloadError : has no documentation (yet)
+type DownloadError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DownloadError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DownloadError
@@ -734,7 +1458,7 @@ func (u *DownloadError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -742,10 +1466,35 @@ func (u *DownloadError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a DownloadError instance
+func (u DownloadError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsDownloadError reports whether err carries a DownloadError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsDownloadError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*DownloadError)(nil), tags...)
+}
+
 // FileMetadata : has no documentation (yet)
 type FileMetadata struct {
 	Metadata
@@ -783,6 +1532,8 @@ type FileMetadata struct {
 	// verify data integrity. For more information see our `Content hash`
 	// </developers/reference/content-hash> page.
 	ContentHash string `json:"content_hash,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileMetadata returns a new FileMetadata instance
@@ -797,11 +1548,23 @@ func NewFileMetadata(Name string, Id string, ClientModified time.Time, ServerMod
 	return s
 }
 
+// MarshalJSON serializes a FileMetadata instance, tagged as a `Metadata`
+func (u FileMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("file", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileMetadata instance
+func (u *FileMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharingInfo : Sharing info for a file or folder.
 type SharingInfo struct {
 	// ReadOnly : True if the file or folder is inside a read-only shared
 	// folder.
 	ReadOnly bool `json:"read_only"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharingInfo returns a new SharingInfo instance
@@ -811,6 +1574,16 @@ func NewSharingInfo(ReadOnly bool) *SharingInfo {
 	return s
 }
 
+// MarshalJSON serializes a SharingInfo instance
+func (u SharingInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharingInfo instance
+func (u *SharingInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileSharingInfo : Sharing info for a file which is contained by a shared
 // folder.
 type FileSharingInfo struct {
@@ -820,6 +1593,8 @@ type FileSharingInfo struct {
 	// ModifiedBy : The last user who modified the file. This field will be null
 	// if the user's account has been deleted.
 	ModifiedBy string `json:"modified_by,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileSharingInfo returns a new FileSharingInfo instance
@@ -830,6 +1605,16 @@ func NewFileSharingInfo(ReadOnly bool, ParentSharedFolderId string) *FileSharing
 	return s
 }
 
+// MarshalJSON serializes a FileSharingInfo instance
+func (u FileSharingInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileSharingInfo instance
+func (u *FileSharingInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FolderMetadata : has no documentation (yet)
 type FolderMetadata struct {
 	Metadata
@@ -843,6 +1628,8 @@ type FolderMetadata struct {
 	// PropertyGroups : Additional information if the file has custom properties
 	// with the property template specified.
 	PropertyGroups []*properties.PropertyGroup `json:"property_groups,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFolderMetadata returns a new FolderMetadata instance
@@ -853,6 +1640,16 @@ func NewFolderMetadata(Name string, Id string) *FolderMetadata {
 	return s
 }
 
+// MarshalJSON serializes a FolderMetadata instance, tagged as a `Metadata`
+func (u FolderMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("folder", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FolderMetadata instance
+func (u *FolderMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FolderSharingInfo : Sharing info for a folder which is contained in a shared
 // folder or is a shared folder mount point.
 type FolderSharingInfo struct {
@@ -869,6 +1666,8 @@ type FolderSharingInfo struct {
 	TraverseOnly bool `json:"traverse_only"`
 	// NoAccess : Specifies that the folder cannot be accessed by the user.
 	NoAccess bool `json:"no_access"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFolderSharingInfo returns a new FolderSharingInfo instance
@@ -880,11 +1679,23 @@ func NewFolderSharingInfo(ReadOnly bool) *FolderSharingInfo {
 	return s
 }
 
+// MarshalJSON serializes a FolderSharingInfo instance
+func (u FolderSharingInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FolderSharingInfo instance
+func (u *FolderSharingInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetCopyReferenceArg : has no documentation (yet)
 type GetCopyReferenceArg struct {
 	// Path : The path to the file or folder you want to get a copy reference
 	// to.
 	Path string `json:"path"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetCopyReferenceArg returns a new GetCopyReferenceArg instance
@@ -894,11 +1705,37 @@ func NewGetCopyReferenceArg(Path string) *GetCopyReferenceArg {
 	return s
 }
 
+// MarshalJSON serializes a GetCopyReferenceArg instance
+func (u GetCopyReferenceArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetCopyReferenceArg instance
+func (u *GetCopyReferenceArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetCopyReferenceArg) Validate() error {
+	return nil
+}
+
 // GetCopyReferenceError : has no documentation (yet)
 type GetCopyReferenceError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetCopyReferenceError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetCopyReferenceError
@@ -919,7 +1756,7 @@ func (u *GetCopyReferenceError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -927,10 +1764,35 @@ func (u *GetCopyReferenceError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GetCopyReferenceError instance
+func (u GetCopyReferenceError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetCopyReferenceError reports whether err carries a GetCopyReferenceError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetCopyReferenceError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetCopyReferenceError)(nil), tags...)
+}
+
 // GetCopyReferenceResult : has no documentation (yet)
 type GetCopyReferenceResult struct {
 	// Metadata : Metadata of the file or folder.
@@ -956,6 +1818,8 @@ func NewGetCopyReferenceResult(Metadata IsMetadata, CopyReference string, Expire
 type GetTemporaryLinkArg struct {
 	// Path : The path to the file you want a temporary link to.
 	Path string `json:"path"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetTemporaryLinkArg returns a new GetTemporaryLinkArg instance
@@ -965,11 +1829,46 @@ func NewGetTemporaryLinkArg(Path string) *GetTemporaryLinkArg {
 	return s
 }
 
+// MarshalJSON serializes a GetTemporaryLinkArg instance
+func (u GetTemporaryLinkArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetTemporaryLinkArg instance
+func (u *GetTemporaryLinkArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetTemporaryLinkArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`,
+		}
+	}
+	return nil
+}
+
 // GetTemporaryLinkError : has no documentation (yet)
 type GetTemporaryLinkError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetTemporaryLinkError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetTemporaryLinkError
@@ -990,7 +1889,7 @@ func (u *GetTemporaryLinkError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -998,16 +1897,43 @@ func (u *GetTemporaryLinkError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GetTemporaryLinkError instance
+func (u GetTemporaryLinkError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetTemporaryLinkError reports whether err carries a GetTemporaryLinkError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetTemporaryLinkError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetTemporaryLinkError)(nil), tags...)
+}
+
 // GetTemporaryLinkResult : has no documentation (yet)
 type GetTemporaryLinkResult struct {
 	// Metadata : Metadata of the file.
 	Metadata *FileMetadata `json:"metadata"`
 	// Link : The temporary link which can be used to stream content the file.
 	Link string `json:"link"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetTemporaryLinkResult returns a new GetTemporaryLinkResult instance
@@ -1018,12 +1944,24 @@ func NewGetTemporaryLinkResult(Metadata *FileMetadata, Link string) *GetTemporar
 	return s
 }
 
+// MarshalJSON serializes a GetTemporaryLinkResult instance
+func (u GetTemporaryLinkResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetTemporaryLinkResult instance
+func (u *GetTemporaryLinkResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GpsCoordinates : GPS coordinates for a photo or video.
 type GpsCoordinates struct {
 	// Latitude : Latitude of the GPS coordinates.
 	Latitude float64 `json:"latitude"`
 	// Longitude : Longitude of the GPS coordinates.
 	Longitude float64 `json:"longitude"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGpsCoordinates returns a new GpsCoordinates instance
@@ -1034,6 +1972,16 @@ func NewGpsCoordinates(Latitude float64, Longitude float64) *GpsCoordinates {
 	return s
 }
 
+// MarshalJSON serializes a GpsCoordinates instance
+func (u GpsCoordinates) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GpsCoordinates instance
+func (u *GpsCoordinates) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListFolderArg : has no documentation (yet)
 type ListFolderArg struct {
 	// Path : The path to the folder you want to see the contents of.
@@ -1052,6 +2000,8 @@ type ListFolderArg struct {
 	// flag for each file indicating whether or not  that file has any explicit
 	// members.
 	IncludeHasExplicitSharedMembers bool `json:"include_has_explicit_shared_members"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFolderArg returns a new ListFolderArg instance
@@ -1065,11 +2015,37 @@ func NewListFolderArg(Path string) *ListFolderArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFolderArg instance
+func (u ListFolderArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFolderArg instance
+func (u *ListFolderArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFolderArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*)?|id:.*|(ns:[0-9]+(/.*)?)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*)?|id:.*|(ns:[0-9]+(/.*)?)`,
+		}
+	}
+	return nil
+}
+
 // ListFolderContinueArg : has no documentation (yet)
 type ListFolderContinueArg struct {
 	// Cursor : The cursor returned by your last call to `listFolder` or
 	// `listFolderContinue`.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFolderContinueArg returns a new ListFolderContinueArg instance
@@ -1079,11 +2055,46 @@ func NewListFolderContinueArg(Cursor string) *ListFolderContinueArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFolderContinueArg instance
+func (u ListFolderContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFolderContinueArg instance
+func (u *ListFolderContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFolderContinueArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if utf8.RuneCountInString(s.Cursor) < 1 {
+		return &dropbox.ValidationError{
+			Field:  "cursor",
+			Reason: "must have at least 1 characters",
+		}
+	}
+	return nil
+}
+
 // ListFolderContinueError : has no documentation (yet)
 type ListFolderContinueError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListFolderContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListFolderContinueError
@@ -1105,7 +2116,7 @@ func (u *ListFolderContinueError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -1113,15 +2124,51 @@ func (u *ListFolderContinueError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "reset", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListFolderContinueError instance
+func (u ListFolderContinueError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListFolderContinueError reports whether err carries a ListFolderContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListFolderContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListFolderContinueError)(nil), tags...)
+}
+
 // ListFolderError : has no documentation (yet)
 type ListFolderError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListFolderError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListFolderError
@@ -1142,7 +2189,7 @@ func (u *ListFolderError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -1150,15 +2197,42 @@ func (u *ListFolderError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListFolderError instance
+func (u ListFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListFolderError reports whether err carries a ListFolderError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListFolderError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListFolderError)(nil), tags...)
+}
+
 // ListFolderGetLatestCursorResult : has no documentation (yet)
 type ListFolderGetLatestCursorResult struct {
 	// Cursor : Pass the cursor into `listFolderContinue` to see what's changed
 	// in the folder since your previous query.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFolderGetLatestCursorResult returns a new ListFolderGetLatestCursorResult instance
@@ -1168,6 +2242,16 @@ func NewListFolderGetLatestCursorResult(Cursor string) *ListFolderGetLatestCurso
 	return s
 }
 
+// MarshalJSON serializes a ListFolderGetLatestCursorResult instance
+func (u ListFolderGetLatestCursorResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFolderGetLatestCursorResult instance
+func (u *ListFolderGetLatestCursorResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListFolderLongpollArg : has no documentation (yet)
 type ListFolderLongpollArg struct {
 	// Cursor : A cursor as returned by `listFolder` or `listFolderContinue`.
@@ -1179,6 +2263,8 @@ type ListFolderLongpollArg struct {
 	// thundering herd problem. Care should be taken when using this parameter,
 	// as some network infrastructure does not support long timeouts.
 	Timeout uint64 `json:"timeout"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFolderLongpollArg returns a new ListFolderLongpollArg instance
@@ -1189,9 +2275,56 @@ func NewListFolderLongpollArg(Cursor string) *ListFolderLongpollArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFolderLongpollArg instance
+func (u ListFolderLongpollArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFolderLongpollArg instance
+func (u *ListFolderLongpollArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFolderLongpollArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if utf8.RuneCountInString(s.Cursor) < 1 {
+		return &dropbox.ValidationError{
+			Field:  "cursor",
+			Reason: "must have at least 1 characters",
+		}
+	}
+	if s.Timeout < 30 {
+		return &dropbox.ValidationError{
+			Field:  "timeout",
+			Reason: "must be at least 30",
+		}
+	}
+	if s.Timeout > 480 {
+		return &dropbox.ValidationError{
+			Field:  "timeout",
+			Reason: "must be at most 480",
+		}
+	}
+	return nil
+}
+
 // ListFolderLongpollError : has no documentation (yet)
 type ListFolderLongpollError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListFolderLongpollError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListFolderLongpollError
@@ -1200,6 +2333,39 @@ const (
 	ListFolderLongpollErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a ListFolderLongpollError instance
+func (u *ListFolderLongpollError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "reset", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListFolderLongpollError instance
+func (u ListFolderLongpollError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListFolderLongpollError reports whether err carries a ListFolderLongpollError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListFolderLongpollError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListFolderLongpollError)(nil), tags...)
+}
+
 // ListFolderLongpollResult : has no documentation (yet)
 type ListFolderLongpollResult struct {
 	// Changes : Indicates whether new changes are available. If true, call
@@ -1208,6 +2374,8 @@ type ListFolderLongpollResult struct {
 	// Backoff : If present, backoff for at least this many seconds before
 	// calling `listFolderLongpoll` again.
 	Backoff uint64 `json:"backoff,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFolderLongpollResult returns a new ListFolderLongpollResult instance
@@ -1217,6 +2385,16 @@ func NewListFolderLongpollResult(Changes bool) *ListFolderLongpollResult {
 	return s
 }
 
+// MarshalJSON serializes a ListFolderLongpollResult instance
+func (u ListFolderLongpollResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFolderLongpollResult instance
+func (u *ListFolderLongpollResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListFolderResult : has no documentation (yet)
 type ListFolderResult struct {
 	// Entries : The files and (direct) subfolders in the folder.
@@ -1244,6 +2422,8 @@ type ListRevisionsArg struct {
 	Path string `json:"path"`
 	// Limit : The maximum number of revision entries returned.
 	Limit uint64 `json:"limit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListRevisionsArg returns a new ListRevisionsArg instance
@@ -1254,11 +2434,58 @@ func NewListRevisionsArg(Path string) *ListRevisionsArg {
 	return s
 }
 
+// MarshalJSON serializes a ListRevisionsArg instance
+func (u ListRevisionsArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListRevisionsArg instance
+func (u *ListRevisionsArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListRevisionsArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`,
+		}
+	}
+	if s.Limit < 1 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at least 1",
+		}
+	}
+	if s.Limit > 100 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at most 100",
+		}
+	}
+	return nil
+}
+
 // ListRevisionsError : has no documentation (yet)
 type ListRevisionsError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListRevisionsError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListRevisionsError
@@ -1279,7 +2506,7 @@ func (u *ListRevisionsError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -1287,10 +2514,35 @@ func (u *ListRevisionsError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListRevisionsError instance
+func (u ListRevisionsError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListRevisionsError reports whether err carries a ListRevisionsError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListRevisionsError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListRevisionsError)(nil), tags...)
+}
+
 // ListRevisionsResult : has no documentation (yet)
 type ListRevisionsResult struct {
 	// IsDeleted : If the file is deleted.
@@ -1298,6 +2550,8 @@ type ListRevisionsResult struct {
 	// Entries : The revisions for the file. Only non-delete revisions will show
 	// up here.
 	Entries []*FileMetadata `json:"entries"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListRevisionsResult returns a new ListRevisionsResult instance
@@ -1308,9 +2562,30 @@ func NewListRevisionsResult(IsDeleted bool, Entries []*FileMetadata) *ListRevisi
 	return s
 }
 
+// MarshalJSON serializes a ListRevisionsResult instance
+func (u ListRevisionsResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListRevisionsResult instance
+func (u *ListRevisionsResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // LookUpPropertiesError : has no documentation (yet)
 type LookUpPropertiesError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u LookUpPropertiesError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for LookUpPropertiesError
@@ -1318,11 +2593,55 @@ const (
 	LookUpPropertiesErrorPropertyGroupNotFound = "property_group_not_found"
 )
 
+// UnmarshalJSON deserializes into a LookUpPropertiesError instance
+func (u *LookUpPropertiesError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "property_group_not_found":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a LookUpPropertiesError instance
+func (u LookUpPropertiesError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsLookUpPropertiesError reports whether err carries a LookUpPropertiesError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsLookUpPropertiesError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*LookUpPropertiesError)(nil), tags...)
+}
+
 // LookupError : has no documentation (yet)
 type LookupError struct {
 	dropbox.Tagged
 	// MalformedPath : has no documentation (yet)
 	MalformedPath string `json:"malformed_path,omitempty"`
+	raw           string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u LookupError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for LookupError
@@ -1347,23 +2666,59 @@ func (u *LookupError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "malformed_path":
-		err = json.Unmarshal(body, &u.MalformedPath)
+		err = json.Unmarshal(w.MalformedPath, &u.MalformedPath)
 
 		if err != nil {
 			return err
 		}
+	case "not_found", "not_file", "not_folder", "restricted_content", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a LookupError instance
+func (u LookupError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "malformed_path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MalformedPath string `json:"malformed_path,omitempty"`
+		}{u.Tagged, u.MalformedPath})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsLookupError reports whether err carries a LookupError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsLookupError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*LookupError)(nil), tags...)
+}
+
 // MediaInfo : has no documentation (yet)
 type MediaInfo struct {
 	dropbox.Tagged
 	// Metadata : The metadata for the photo/video.
 	Metadata IsMediaMetadata `json:"metadata,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MediaInfo) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MediaInfo
@@ -1384,18 +2739,36 @@ func (u *MediaInfo) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "metadata":
-		u.Metadata, err = IsMediaMetadataFromJSON(body)
+		u.Metadata, err = IsMediaMetadataFromJSON(w.Metadata)
 
 		if err != nil {
 			return err
 		}
+	case "pending":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a MediaInfo instance
+func (u MediaInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "metadata":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Metadata IsMediaMetadata `json:"metadata"`
+		}{u.Tagged, u.Metadata})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // MediaMetadata : Metadata for a photo or video.
 type MediaMetadata struct {
 	// Dimensions : Dimension of the photo/video.
@@ -1404,6 +2777,8 @@ type MediaMetadata struct {
 	Location *GpsCoordinates `json:"location,omitempty"`
 	// TimeTaken : The timestamp when the photo/video is taken.
 	TimeTaken time.Time `json:"time_taken,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMediaMetadata returns a new MediaMetadata instance
@@ -1412,6 +2787,16 @@ func NewMediaMetadata() *MediaMetadata {
 	return s
 }
 
+// MarshalJSON serializes a MediaMetadata instance
+func (u MediaMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MediaMetadata instance
+func (u *MediaMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // IsMediaMetadata is the interface type for MediaMetadata and its subtypes
 type IsMediaMetadata interface {
 	IsMediaMetadata()
@@ -1426,6 +2811,17 @@ type mediaMetadataUnion struct {
 	Photo *PhotoMetadata `json:"photo,omitempty"`
 	// Video : has no documentation (yet)
 	Video *VideoMetadata `json:"video,omitempty"`
+	raw   string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u mediaMetadataUnion) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MediaMetadata
@@ -1448,7 +2844,7 @@ func (u *mediaMetadataUnion) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "photo":
 		err = json.Unmarshal(body, &u.Photo)
@@ -1462,6 +2858,10 @@ func (u *mediaMetadataUnion) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
@@ -1486,6 +2886,8 @@ func IsMediaMetadataFromJSON(data []byte) (IsMediaMetadata, error) {
 // PhotoMetadata : Metadata for a photo.
 type PhotoMetadata struct {
 	MediaMetadata
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPhotoMetadata returns a new PhotoMetadata instance
@@ -1494,12 +2896,24 @@ func NewPhotoMetadata() *PhotoMetadata {
 	return s
 }
 
+// MarshalJSON serializes a PhotoMetadata instance, tagged as a `MediaMetadata`
+func (u PhotoMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("photo", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PhotoMetadata instance
+func (u *PhotoMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PreviewArg : has no documentation (yet)
 type PreviewArg struct {
 	// Path : The path of the file to preview.
 	Path string `json:"path"`
 	// Rev : Deprecated. Please specify revision in `path` instead.
 	Rev string `json:"rev,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPreviewArg returns a new PreviewArg instance
@@ -1509,11 +2923,37 @@ func NewPreviewArg(Path string) *PreviewArg {
 	return s
 }
 
+// MarshalJSON serializes a PreviewArg instance
+func (u PreviewArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PreviewArg instance
+func (u *PreviewArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *PreviewArg) Validate() error {
+	return nil
+}
+
 // PreviewError : has no documentation (yet)
 type PreviewError struct {
 	dropbox.Tagged
 	// Path : An error occurs when downloading metadata for the file.
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PreviewError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PreviewError
@@ -1536,7 +2976,7 @@ func (u *PreviewError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -1544,10 +2984,35 @@ func (u *PreviewError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "in_progress", "unsupported_extension", "unsupported_content":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a PreviewError instance
+func (u PreviewError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsPreviewError reports whether err carries a PreviewError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsPreviewError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*PreviewError)(nil), tags...)
+}
+
 // PropertyGroupUpdate : has no documentation (yet)
 type PropertyGroupUpdate struct {
 	// TemplateId : A unique identifier for a property template.
@@ -1559,6 +3024,8 @@ type PropertyGroupUpdate struct {
 	// RemoveFields : List of property field names to remove from property group
 	// if the field exists.
 	RemoveFields []string `json:"remove_fields,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPropertyGroupUpdate returns a new PropertyGroupUpdate instance
@@ -1568,12 +3035,24 @@ func NewPropertyGroupUpdate(TemplateId string) *PropertyGroupUpdate {
 	return s
 }
 
+// MarshalJSON serializes a PropertyGroupUpdate instance
+func (u PropertyGroupUpdate) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PropertyGroupUpdate instance
+func (u *PropertyGroupUpdate) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PropertyGroupWithPath : has no documentation (yet)
 type PropertyGroupWithPath struct {
 	// Path : A unique identifier for the file.
 	Path string `json:"path"`
 	// PropertyGroups : Filled custom property templates associated with a file.
 	PropertyGroups []*properties.PropertyGroup `json:"property_groups"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPropertyGroupWithPath returns a new PropertyGroupWithPath instance
@@ -1584,12 +3063,29 @@ func NewPropertyGroupWithPath(Path string, PropertyGroups []*properties.Property
 	return s
 }
 
+// MarshalJSON serializes a PropertyGroupWithPath instance
+func (u PropertyGroupWithPath) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PropertyGroupWithPath instance
+func (u *PropertyGroupWithPath) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *PropertyGroupWithPath) Validate() error {
+	return nil
+}
+
 // RelocationPath : has no documentation (yet)
 type RelocationPath struct {
 	// FromPath : Path in the user's Dropbox to be copied or moved.
 	FromPath string `json:"from_path"`
 	// ToPath : Path in the user's Dropbox that is the destination.
 	ToPath string `json:"to_path"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRelocationPath returns a new RelocationPath instance
@@ -1600,6 +3096,36 @@ func NewRelocationPath(FromPath string, ToPath string) *RelocationPath {
 	return s
 }
 
+// MarshalJSON serializes a RelocationPath instance
+func (u RelocationPath) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RelocationPath instance
+func (u *RelocationPath) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RelocationPath) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.FromPath, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`) {
+		return &dropbox.ValidationError{
+			Field:  "from_path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`,
+		}
+	}
+	if !dropbox.MatchPattern(s.ToPath, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`) {
+		return &dropbox.ValidationError{
+			Field:  "to_path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`,
+		}
+	}
+	return nil
+}
+
 // RelocationArg : has no documentation (yet)
 type RelocationArg struct {
 	RelocationPath
@@ -1610,6 +3136,8 @@ type RelocationArg struct {
 	// Autorename : If there's a conflict, have the Dropbox server try to
 	// autorename the file to avoid the conflict.
 	Autorename bool `json:"autorename"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRelocationArg returns a new RelocationArg instance
@@ -1622,6 +3150,36 @@ func NewRelocationArg(FromPath string, ToPath string) *RelocationArg {
 	return s
 }
 
+// MarshalJSON serializes a RelocationArg instance
+func (u RelocationArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RelocationArg instance
+func (u *RelocationArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RelocationArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.FromPath, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`) {
+		return &dropbox.ValidationError{
+			Field:  "from_path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`,
+		}
+	}
+	if !dropbox.MatchPattern(s.ToPath, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`) {
+		return &dropbox.ValidationError{
+			Field:  "to_path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`,
+		}
+	}
+	return nil
+}
+
 // RelocationBatchArg : has no documentation (yet)
 type RelocationBatchArg struct {
 	// Entries : List of entries to be moved or copied. Each entry is
@@ -1635,6 +3193,8 @@ type RelocationBatchArg struct {
 	// Autorename : If there's a conflict with any file, have the Dropbox server
 	// try to autorename that file to avoid the conflict.
 	Autorename bool `json:"autorename"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRelocationBatchArg returns a new RelocationBatchArg instance
@@ -1646,6 +3206,31 @@ func NewRelocationBatchArg(Entries []*RelocationPath) *RelocationBatchArg {
 	return s
 }
 
+// MarshalJSON serializes a RelocationBatchArg instance
+func (u RelocationBatchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RelocationBatchArg instance
+func (u *RelocationBatchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RelocationBatchArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	for i, v := range s.Entries {
+		if v != nil {
+			if err := v.Validate(); err != nil {
+				return dropbox.NestValidationError(fmt.Sprintf("entries[%d]", i), err)
+			}
+		}
+	}
+	return nil
+}
+
 // RelocationError : has no documentation (yet)
 type RelocationError struct {
 	dropbox.Tagged
@@ -1654,7 +3239,18 @@ type RelocationError struct {
 	// FromWrite : has no documentation (yet)
 	FromWrite *WriteError `json:"from_write,omitempty"`
 	// To : has no documentation (yet)
-	To *WriteError `json:"to,omitempty"`
+	To  *WriteError `json:"to,omitempty"`
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RelocationError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RelocationError
@@ -1686,7 +3282,7 @@ func (u *RelocationError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "from_lookup":
 		err = json.Unmarshal(w.FromLookup, &u.FromLookup)
@@ -1706,20 +3302,160 @@ func (u *RelocationError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "cant_copy_shared_folder", "cant_nest_shared_folder", "cant_move_folder_into_itself", "too_many_files", "duplicated_or_nested_paths", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RelocationError instance
+func (u RelocationError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "from_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FromLookup *LookupError `json:"from_lookup"`
+		}{u.Tagged, u.FromLookup})
+
+	case "from_write":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FromWrite *WriteError `json:"from_write"`
+		}{u.Tagged, u.FromWrite})
+
+	case "to":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			To *WriteError `json:"to"`
+		}{u.Tagged, u.To})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRelocationError reports whether err carries a RelocationError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRelocationError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RelocationError)(nil), tags...)
+}
+
 // RelocationBatchError : has no documentation (yet)
 type RelocationBatchError struct {
 	dropbox.Tagged
+	// FromLookup : has no documentation (yet)
+	FromLookup *LookupError `json:"from_lookup,omitempty"`
+	// FromWrite : has no documentation (yet)
+	FromWrite *WriteError `json:"from_write,omitempty"`
+	// To : has no documentation (yet)
+	To  *WriteError `json:"to,omitempty"`
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RelocationBatchError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RelocationBatchError
 const (
-	RelocationBatchErrorTooManyWriteOperations = "too_many_write_operations"
+	RelocationBatchErrorFromLookup               = "from_lookup"
+	RelocationBatchErrorFromWrite                = "from_write"
+	RelocationBatchErrorTo                       = "to"
+	RelocationBatchErrorCantCopySharedFolder     = "cant_copy_shared_folder"
+	RelocationBatchErrorCantNestSharedFolder     = "cant_nest_shared_folder"
+	RelocationBatchErrorCantMoveFolderIntoItself = "cant_move_folder_into_itself"
+	RelocationBatchErrorTooManyFiles             = "too_many_files"
+	RelocationBatchErrorDuplicatedOrNestedPaths  = "duplicated_or_nested_paths"
+	RelocationBatchErrorOther                    = "other"
+	RelocationBatchErrorTooManyWriteOperations   = "too_many_write_operations"
 )
 
+// UnmarshalJSON deserializes into a RelocationBatchError instance
+func (u *RelocationBatchError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+		// FromLookup : has no documentation (yet)
+		FromLookup json.RawMessage `json:"from_lookup,omitempty"`
+		// FromWrite : has no documentation (yet)
+		FromWrite json.RawMessage `json:"from_write,omitempty"`
+		// To : has no documentation (yet)
+		To json.RawMessage `json:"to,omitempty"`
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "from_lookup":
+		err = json.Unmarshal(w.FromLookup, &u.FromLookup)
+
+		if err != nil {
+			return err
+		}
+	case "from_write":
+		err = json.Unmarshal(w.FromWrite, &u.FromWrite)
+
+		if err != nil {
+			return err
+		}
+	case "to":
+		err = json.Unmarshal(w.To, &u.To)
+
+		if err != nil {
+			return err
+		}
+	case "cant_copy_shared_folder", "cant_nest_shared_folder", "cant_move_folder_into_itself", "too_many_files", "duplicated_or_nested_paths", "other", "too_many_write_operations":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a RelocationBatchError instance
+func (u RelocationBatchError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "from_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FromLookup *LookupError `json:"from_lookup"`
+		}{u.Tagged, u.FromLookup})
+
+	case "from_write":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FromWrite *WriteError `json:"from_write"`
+		}{u.Tagged, u.FromWrite})
+
+	case "to":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			To *WriteError `json:"to"`
+		}{u.Tagged, u.To})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRelocationBatchError reports whether err carries a RelocationBatchError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRelocationBatchError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RelocationBatchError)(nil), tags...)
+}
+
 // RelocationBatchJobStatus : has no documentation (yet)
 type RelocationBatchJobStatus struct {
 	dropbox.Tagged
@@ -1727,12 +3463,24 @@ type RelocationBatchJobStatus struct {
 	Complete *RelocationBatchResult `json:"complete,omitempty"`
 	// Failed : The copy or move batch job has failed with exception.
 	Failed *RelocationBatchError `json:"failed,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RelocationBatchJobStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RelocationBatchJobStatus
 const (
-	RelocationBatchJobStatusComplete = "complete"
-	RelocationBatchJobStatusFailed   = "failed"
+	RelocationBatchJobStatusInProgress = "in_progress"
+	RelocationBatchJobStatusComplete   = "complete"
+	RelocationBatchJobStatusFailed     = "failed"
 )
 
 // UnmarshalJSON deserializes into a RelocationBatchJobStatus instance
@@ -1749,7 +3497,7 @@ func (u *RelocationBatchJobStatus) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
@@ -1763,28 +3511,69 @@ func (u *RelocationBatchJobStatus) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "in_progress":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RelocationBatchJobStatus instance
+func (u RelocationBatchJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *RelocationBatchError `json:"failed"`
+		}{u.Tagged, u.Failed})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // RelocationBatchLaunch : Result returned by `copyBatch` or `moveBatch` that
 // may either launch an asynchronous job or complete synchronously.
 type RelocationBatchLaunch struct {
 	dropbox.Tagged
+	// AsyncJobId : This response indicates that the processing is asynchronous.
+	// The string is an id that can be used to obtain the status of the
+	// asynchronous job.
+	AsyncJobId string `json:"async_job_id,omitempty"`
 	// Complete : has no documentation (yet)
 	Complete *RelocationBatchResult `json:"complete,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RelocationBatchLaunch) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RelocationBatchLaunch
 const (
-	RelocationBatchLaunchComplete = "complete"
-	RelocationBatchLaunchOther    = "other"
+	RelocationBatchLaunchAsyncJobId = "async_job_id"
+	RelocationBatchLaunchComplete   = "complete"
+	RelocationBatchLaunchOther      = "other"
 )
 
 // UnmarshalJSON deserializes into a RelocationBatchLaunch instance
 func (u *RelocationBatchLaunch) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// AsyncJobId : This response indicates that the processing is
+		// asynchronous. The string is an id that can be used to obtain the
+		// status of the asynchronous job.
+		AsyncJobId json.RawMessage `json:"async_job_id,omitempty"`
 		// Complete : has no documentation (yet)
 		Complete json.RawMessage `json:"complete,omitempty"`
 	}
@@ -1793,22 +3582,51 @@ func (u *RelocationBatchLaunch) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
+	case "async_job_id":
+		err = json.Unmarshal(w.AsyncJobId, &u.AsyncJobId)
+
+		if err != nil {
+			return err
+		}
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
 
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RelocationBatchLaunch instance
+func (u RelocationBatchLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // RelocationBatchResult : has no documentation (yet)
 type RelocationBatchResult struct {
 	// Entries : has no documentation (yet)
 	Entries []*RelocationResult `json:"entries"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRelocationBatchResult returns a new RelocationBatchResult instance
@@ -1818,6 +3636,16 @@ func NewRelocationBatchResult(Entries []*RelocationResult) *RelocationBatchResul
 	return s
 }
 
+// MarshalJSON serializes a RelocationBatchResult instance
+func (u RelocationBatchResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RelocationBatchResult instance
+func (u *RelocationBatchResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RelocationResult : has no documentation (yet)
 type RelocationResult struct {
 	// Metadata : has no documentation (yet)
@@ -1838,6 +3666,8 @@ type RemovePropertiesArg struct {
 	// PropertyTemplateIds : A list of identifiers for a property template
 	// created by route properties/template/add.
 	PropertyTemplateIds []string `json:"property_template_ids"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRemovePropertiesArg returns a new RemovePropertiesArg instance
@@ -1848,11 +3678,37 @@ func NewRemovePropertiesArg(Path string, PropertyTemplateIds []string) *RemovePr
 	return s
 }
 
+// MarshalJSON serializes a RemovePropertiesArg instance
+func (u RemovePropertiesArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RemovePropertiesArg instance
+func (u *RemovePropertiesArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RemovePropertiesArg) Validate() error {
+	return nil
+}
+
 // RemovePropertiesError : has no documentation (yet)
 type RemovePropertiesError struct {
 	dropbox.Tagged
 	// PropertyGroupLookup : has no documentation (yet)
 	PropertyGroupLookup *LookUpPropertiesError `json:"property_group_lookup,omitempty"`
+	raw                 string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RemovePropertiesError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RemovePropertiesError
@@ -1872,7 +3728,7 @@ func (u *RemovePropertiesError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "property_group_lookup":
 		err = json.Unmarshal(w.PropertyGroupLookup, &u.PropertyGroupLookup)
@@ -1880,16 +3736,42 @@ func (u *RemovePropertiesError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RemovePropertiesError instance
+func (u RemovePropertiesError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "property_group_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertyGroupLookup *LookUpPropertiesError `json:"property_group_lookup"`
+		}{u.Tagged, u.PropertyGroupLookup})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRemovePropertiesError reports whether err carries a RemovePropertiesError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRemovePropertiesError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RemovePropertiesError)(nil), tags...)
+}
+
 // RestoreArg : has no documentation (yet)
 type RestoreArg struct {
 	// Path : The path to the file you want to restore.
 	Path string `json:"path"`
 	// Rev : The revision to restore for the file.
 	Rev string `json:"rev"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRestoreArg returns a new RestoreArg instance
@@ -1900,6 +3782,42 @@ func NewRestoreArg(Path string, Rev string) *RestoreArg {
 	return s
 }
 
+// MarshalJSON serializes a RestoreArg instance
+func (u RestoreArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RestoreArg instance
+func (u *RestoreArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RestoreArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)`,
+		}
+	}
+	if utf8.RuneCountInString(s.Rev) < 9 {
+		return &dropbox.ValidationError{
+			Field:  "rev",
+			Reason: "must have at least 9 characters",
+		}
+	}
+	if !dropbox.MatchPattern(s.Rev, `[0-9a-f]+`) {
+		return &dropbox.ValidationError{
+			Field:  "rev",
+			Reason: `must match [0-9a-f]+`,
+		}
+	}
+	return nil
+}
+
 // RestoreError : has no documentation (yet)
 type RestoreError struct {
 	dropbox.Tagged
@@ -1907,6 +3825,17 @@ type RestoreError struct {
 	PathLookup *LookupError `json:"path_lookup,omitempty"`
 	// PathWrite : An error occurs when trying to restore the file to that path.
 	PathWrite *WriteError `json:"path_write,omitempty"`
+	raw       string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RestoreError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RestoreError
@@ -1932,7 +3861,7 @@ func (u *RestoreError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path_lookup":
 		err = json.Unmarshal(w.PathLookup, &u.PathLookup)
@@ -1946,16 +3875,49 @@ func (u *RestoreError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "invalid_revision", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RestoreError instance
+func (u RestoreError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PathLookup *LookupError `json:"path_lookup"`
+		}{u.Tagged, u.PathLookup})
+
+	case "path_write":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PathWrite *WriteError `json:"path_write"`
+		}{u.Tagged, u.PathWrite})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRestoreError reports whether err carries a RestoreError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRestoreError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RestoreError)(nil), tags...)
+}
+
 // SaveCopyReferenceArg : has no documentation (yet)
 type SaveCopyReferenceArg struct {
 	// CopyReference : A copy reference returned by `copyReferenceGet`.
 	CopyReference string `json:"copy_reference"`
 	// Path : Path in the user's Dropbox that is the destination.
 	Path string `json:"path"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSaveCopyReferenceArg returns a new SaveCopyReferenceArg instance
@@ -1966,11 +3928,37 @@ func NewSaveCopyReferenceArg(CopyReference string, Path string) *SaveCopyReferen
 	return s
 }
 
+// MarshalJSON serializes a SaveCopyReferenceArg instance
+func (u SaveCopyReferenceArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SaveCopyReferenceArg instance
+func (u *SaveCopyReferenceArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *SaveCopyReferenceArg) Validate() error {
+	return nil
+}
+
 // SaveCopyReferenceError : has no documentation (yet)
 type SaveCopyReferenceError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *WriteError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SaveCopyReferenceError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SaveCopyReferenceError
@@ -1995,7 +3983,7 @@ func (u *SaveCopyReferenceError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -2003,10 +3991,35 @@ func (u *SaveCopyReferenceError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "invalid_copy_reference", "no_permission", "not_found", "too_many_files", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a SaveCopyReferenceError instance
+func (u SaveCopyReferenceError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *WriteError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSaveCopyReferenceError reports whether err carries a SaveCopyReferenceError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSaveCopyReferenceError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SaveCopyReferenceError)(nil), tags...)
+}
+
 // SaveCopyReferenceResult : has no documentation (yet)
 type SaveCopyReferenceResult struct {
 	// Metadata : The metadata of the saved file or folder in the user's
@@ -2027,6 +4040,8 @@ type SaveUrlArg struct {
 	Path string `json:"path"`
 	// Url : The URL to be saved.
 	Url string `json:"url"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSaveUrlArg returns a new SaveUrlArg instance
@@ -2037,11 +4052,37 @@ func NewSaveUrlArg(Path string, Url string) *SaveUrlArg {
 	return s
 }
 
+// MarshalJSON serializes a SaveUrlArg instance
+func (u SaveUrlArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SaveUrlArg instance
+func (u *SaveUrlArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *SaveUrlArg) Validate() error {
+	return nil
+}
+
 // SaveUrlError : has no documentation (yet)
 type SaveUrlError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *WriteError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SaveUrlError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SaveUrlError
@@ -2065,7 +4106,7 @@ func (u *SaveUrlError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -2073,10 +4114,35 @@ func (u *SaveUrlError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "download_failed", "invalid_url", "not_found", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a SaveUrlError instance
+func (u SaveUrlError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *WriteError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSaveUrlError reports whether err carries a SaveUrlError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSaveUrlError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SaveUrlError)(nil), tags...)
+}
+
 // SaveUrlJobStatus : has no documentation (yet)
 type SaveUrlJobStatus struct {
 	dropbox.Tagged
@@ -2084,12 +4150,24 @@ type SaveUrlJobStatus struct {
 	Complete *FileMetadata `json:"complete,omitempty"`
 	// Failed : has no documentation (yet)
 	Failed *SaveUrlError `json:"failed,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SaveUrlJobStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SaveUrlJobStatus
 const (
-	SaveUrlJobStatusComplete = "complete"
-	SaveUrlJobStatusFailed   = "failed"
+	SaveUrlJobStatusInProgress = "in_progress"
+	SaveUrlJobStatusComplete   = "complete"
+	SaveUrlJobStatusFailed     = "failed"
 )
 
 // UnmarshalJSON deserializes into a SaveUrlJobStatus instance
@@ -2106,7 +4184,7 @@ func (u *SaveUrlJobStatus) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
@@ -2120,26 +4198,67 @@ func (u *SaveUrlJobStatus) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "in_progress":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a SaveUrlJobStatus instance
+func (u SaveUrlJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *SaveUrlError `json:"failed"`
+		}{u.Tagged, u.Failed})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SaveUrlResult : has no documentation (yet)
 type SaveUrlResult struct {
 	dropbox.Tagged
+	// AsyncJobId : This response indicates that the processing is asynchronous.
+	// The string is an id that can be used to obtain the status of the
+	// asynchronous job.
+	AsyncJobId string `json:"async_job_id,omitempty"`
 	// Complete : Metadata of the file where the URL is saved to.
 	Complete *FileMetadata `json:"complete,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SaveUrlResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SaveUrlResult
 const (
-	SaveUrlResultComplete = "complete"
+	SaveUrlResultAsyncJobId = "async_job_id"
+	SaveUrlResultComplete   = "complete"
 )
 
 // UnmarshalJSON deserializes into a SaveUrlResult instance
 func (u *SaveUrlResult) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// AsyncJobId : This response indicates that the processing is
+		// asynchronous. The string is an id that can be used to obtain the
+		// status of the asynchronous job.
+		AsyncJobId json.RawMessage `json:"async_job_id,omitempty"`
 		// Complete : Metadata of the file where the URL is saved to.
 		Complete json.RawMessage `json:"complete,omitempty"`
 	}
@@ -2148,18 +4267,44 @@ func (u *SaveUrlResult) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
+	case "async_job_id":
+		err = json.Unmarshal(w.AsyncJobId, &u.AsyncJobId)
+
+		if err != nil {
+			return err
+		}
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a SaveUrlResult instance
+func (u SaveUrlResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SearchArg : has no documentation (yet)
 type SearchArg struct {
 	// Path : The path in the user's Dropbox to search. Should probably be a
@@ -2177,6 +4322,8 @@ type SearchArg struct {
 	// deleted_filename). Note that searching file content is only available for
 	// Dropbox Business accounts.
 	Mode *SearchMode `json:"mode"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSearchArg returns a new SearchArg instance
@@ -2186,15 +4333,62 @@ func NewSearchArg(Path string, Query string) *SearchArg {
 	s.Query = Query
 	s.Start = 0
 	s.MaxResults = 100
-	s.Mode = &SearchMode{Tagged: dropbox.Tagged{"filename"}}
+	s.Mode = &SearchMode{Tagged: dropbox.Tagged{Tag: "filename"}}
 	return s
 }
 
+// MarshalJSON serializes a SearchArg instance
+func (u SearchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SearchArg instance
+func (u *SearchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *SearchArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if !dropbox.MatchPattern(s.Path, `(/(.|[\r\n])*)?|id:.*|(ns:[0-9]+(/.*)?)`) {
+		return &dropbox.ValidationError{
+			Field:  "path",
+			Reason: `must match (/(.|[\r\n])*)?|id:.*|(ns:[0-9]+(/.*)?)`,
+		}
+	}
+	if s.MaxResults < 1 {
+		return &dropbox.ValidationError{
+			Field:  "max_results",
+			Reason: "must be at least 1",
+		}
+	}
+	if s.MaxResults > 1000 {
+		return &dropbox.ValidationError{
+			Field:  "max_results",
+			Reason: "must be at most 1000",
+		}
+	}
+	return nil
+}
+
 // SearchError : has no documentation (yet)
 type SearchError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SearchError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SearchError
@@ -2215,7 +4409,7 @@ func (u *SearchError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -2223,10 +4417,35 @@ func (u *SearchError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a SearchError instance
+func (u SearchError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSearchError reports whether err carries a SearchError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSearchError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SearchError)(nil), tags...)
+}
+
 // SearchMatch : has no documentation (yet)
 type SearchMatch struct {
 	// MatchType : The type of the match.
@@ -2246,6 +4465,17 @@ func NewSearchMatch(MatchType *SearchMatchType, Metadata IsMetadata) *SearchMatc
 // SearchMatchType : Indicates what type of match was found for a given item.
 type SearchMatchType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SearchMatchType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SearchMatchType
@@ -2255,9 +4485,46 @@ const (
 	SearchMatchTypeBoth     = "both"
 )
 
+// UnmarshalJSON deserializes into a SearchMatchType instance
+func (u *SearchMatchType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "filename", "content", "both":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SearchMatchType instance
+func (u SearchMatchType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SearchMode : has no documentation (yet)
 type SearchMode struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SearchMode) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SearchMode
@@ -2267,6 +4534,32 @@ const (
 	SearchModeDeletedFilename    = "deleted_filename"
 )
 
+// UnmarshalJSON deserializes into a SearchMode instance
+func (u *SearchMode) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "filename", "filename_and_content", "deleted_filename":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SearchMode instance
+func (u SearchMode) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SearchResult : has no documentation (yet)
 type SearchResult struct {
 	// Matches : A list (possibly empty) of matches for the query.
@@ -2277,6 +4570,8 @@ type SearchResult struct {
 	// Start : Used for paging. Value to set the start argument to when calling
 	// `search` to fetch the next page of results.
 	Start uint64 `json:"start"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSearchResult returns a new SearchResult instance
@@ -2288,6 +4583,16 @@ func NewSearchResult(Matches []*SearchMatch, More bool, Start uint64) *SearchRes
 	return s
 }
 
+// MarshalJSON serializes a SearchResult instance
+func (u SearchResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SearchResult instance
+func (u *SearchResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ThumbnailArg : has no documentation (yet)
 type ThumbnailArg struct {
 	// Path : The path to the image file you want to thumbnail.
@@ -2298,22 +4603,50 @@ type ThumbnailArg struct {
 	Format *ThumbnailFormat `json:"format"`
 	// Size : The size for the thumbnail image.
 	Size *ThumbnailSize `json:"size"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewThumbnailArg returns a new ThumbnailArg instance
 func NewThumbnailArg(Path string) *ThumbnailArg {
 	s := new(ThumbnailArg)
 	s.Path = Path
-	s.Format = &ThumbnailFormat{Tagged: dropbox.Tagged{"jpeg"}}
-	s.Size = &ThumbnailSize{Tagged: dropbox.Tagged{"w64h64"}}
+	s.Format = &ThumbnailFormat{Tagged: dropbox.Tagged{Tag: "jpeg"}}
+	s.Size = &ThumbnailSize{Tagged: dropbox.Tagged{Tag: "w64h64"}}
 	return s
 }
 
+// MarshalJSON serializes a ThumbnailArg instance
+func (u ThumbnailArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ThumbnailArg instance
+func (u *ThumbnailArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ThumbnailArg) Validate() error {
+	return nil
+}
+
 // ThumbnailError : has no documentation (yet)
 type ThumbnailError struct {
 	dropbox.Tagged
 	// Path : An error occurs when downloading metadata for the image.
 	Path *LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ThumbnailError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ThumbnailError
@@ -2324,44 +4657,117 @@ const (
 	ThumbnailErrorConversionError      = "conversion_error"
 )
 
-// UnmarshalJSON deserializes into a ThumbnailError instance
-func (u *ThumbnailError) UnmarshalJSON(body []byte) error {
+// UnmarshalJSON deserializes into a ThumbnailError instance
+func (u *ThumbnailError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+		// Path : An error occurs when downloading metadata for the image.
+		Path json.RawMessage `json:"path,omitempty"`
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "path":
+		err = json.Unmarshal(w.Path, &u.Path)
+
+		if err != nil {
+			return err
+		}
+	case "unsupported_extension", "unsupported_image", "conversion_error":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ThumbnailError instance
+func (u ThumbnailError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsThumbnailError reports whether err carries a ThumbnailError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsThumbnailError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ThumbnailError)(nil), tags...)
+}
+
+// ThumbnailFormat : has no documentation (yet)
+type ThumbnailFormat struct {
+	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ThumbnailFormat) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
+}
+
+// Valid tag values for ThumbnailFormat
+const (
+	ThumbnailFormatJpeg = "jpeg"
+	ThumbnailFormatPng  = "png"
+)
+
+// UnmarshalJSON deserializes into a ThumbnailFormat instance
+func (u *ThumbnailFormat) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
-		// Path : An error occurs when downloading metadata for the image.
-		Path json.RawMessage `json:"path,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
-	case "path":
-		err = json.Unmarshal(w.Path, &u.Path)
+	case "jpeg", "png":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
 
-		if err != nil {
-			return err
-		}
 	}
 	return nil
 }
 
-// ThumbnailFormat : has no documentation (yet)
-type ThumbnailFormat struct {
-	dropbox.Tagged
+// MarshalJSON serializes a ThumbnailFormat instance
+func (u ThumbnailFormat) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
 }
 
-// Valid tag values for ThumbnailFormat
-const (
-	ThumbnailFormatJpeg = "jpeg"
-	ThumbnailFormatPng  = "png"
-)
-
 // ThumbnailSize : has no documentation (yet)
 type ThumbnailSize struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ThumbnailSize) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ThumbnailSize
@@ -2373,11 +4779,48 @@ const (
 	ThumbnailSizeW1024h768 = "w1024h768"
 )
 
+// UnmarshalJSON deserializes into a ThumbnailSize instance
+func (u *ThumbnailSize) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "w32h32", "w64h64", "w128h128", "w640h480", "w1024h768":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ThumbnailSize instance
+func (u ThumbnailSize) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // UpdatePropertiesError : has no documentation (yet)
 type UpdatePropertiesError struct {
 	dropbox.Tagged
 	// PropertyGroupLookup : has no documentation (yet)
 	PropertyGroupLookup *LookUpPropertiesError `json:"property_group_lookup,omitempty"`
+	raw                 string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UpdatePropertiesError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UpdatePropertiesError
@@ -2397,7 +4840,7 @@ func (u *UpdatePropertiesError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "property_group_lookup":
 		err = json.Unmarshal(w.PropertyGroupLookup, &u.PropertyGroupLookup)
@@ -2405,10 +4848,34 @@ func (u *UpdatePropertiesError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UpdatePropertiesError instance
+func (u UpdatePropertiesError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "property_group_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertyGroupLookup *LookUpPropertiesError `json:"property_group_lookup"`
+		}{u.Tagged, u.PropertyGroupLookup})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUpdatePropertiesError reports whether err carries a UpdatePropertiesError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUpdatePropertiesError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UpdatePropertiesError)(nil), tags...)
+}
+
 // UpdatePropertyGroupArg : has no documentation (yet)
 type UpdatePropertyGroupArg struct {
 	// Path : A unique identifier for the file.
@@ -2416,6 +4883,8 @@ type UpdatePropertyGroupArg struct {
 	// UpdatePropertyGroups : Filled custom property templates associated with a
 	// file.
 	UpdatePropertyGroups []*PropertyGroupUpdate `json:"update_property_groups"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUpdatePropertyGroupArg returns a new UpdatePropertyGroupArg instance
@@ -2426,11 +4895,37 @@ func NewUpdatePropertyGroupArg(Path string, UpdatePropertyGroups []*PropertyGrou
 	return s
 }
 
+// MarshalJSON serializes a UpdatePropertyGroupArg instance
+func (u UpdatePropertyGroupArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UpdatePropertyGroupArg instance
+func (u *UpdatePropertyGroupArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UpdatePropertyGroupArg) Validate() error {
+	return nil
+}
+
 // UploadError : has no documentation (yet)
 type UploadError struct {
 	dropbox.Tagged
 	// Path : Unable to save the uploaded contents to a file.
 	Path *UploadWriteFailed `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UploadError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UploadError
@@ -2451,7 +4946,7 @@ func (u *UploadError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(body, &u.Path)
@@ -2459,15 +4954,48 @@ func (u *UploadError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UploadError instance
+func (u UploadError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return dropbox.MarshalTagged(u.Tag, u.Path)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUploadError reports whether err carries a UploadError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUploadError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UploadError)(nil), tags...)
+}
+
 // UploadErrorWithProperties : has no documentation (yet)
 type UploadErrorWithProperties struct {
 	dropbox.Tagged
 	// PropertiesError : has no documentation (yet)
 	PropertiesError *InvalidPropertyGroupError `json:"properties_error,omitempty"`
+	raw             string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UploadErrorWithProperties) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UploadErrorWithProperties
@@ -2487,7 +5015,7 @@ func (u *UploadErrorWithProperties) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "properties_error":
 		err = json.Unmarshal(w.PropertiesError, &u.PropertiesError)
@@ -2495,10 +5023,27 @@ func (u *UploadErrorWithProperties) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UploadErrorWithProperties instance
+func (u UploadErrorWithProperties) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "properties_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertiesError *InvalidPropertyGroupError `json:"properties_error"`
+		}{u.Tagged, u.PropertiesError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // UploadSessionAppendArg : has no documentation (yet)
 type UploadSessionAppendArg struct {
 	// Cursor : Contains the upload session ID and the offset.
@@ -2507,6 +5052,8 @@ type UploadSessionAppendArg struct {
 	// won't be able to call `uploadSessionAppendV2` anymore with the current
 	// session.
 	Close bool `json:"close"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUploadSessionAppendArg returns a new UploadSessionAppendArg instance
@@ -2517,6 +5064,29 @@ func NewUploadSessionAppendArg(Cursor *UploadSessionCursor) *UploadSessionAppend
 	return s
 }
 
+// MarshalJSON serializes a UploadSessionAppendArg instance
+func (u UploadSessionAppendArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UploadSessionAppendArg instance
+func (u *UploadSessionAppendArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UploadSessionAppendArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Cursor != nil {
+		if err := s.Cursor.Validate(); err != nil {
+			return dropbox.NestValidationError("cursor", err)
+		}
+	}
+	return nil
+}
+
 // UploadSessionCursor : has no documentation (yet)
 type UploadSessionCursor struct {
 	// SessionId : The upload session ID (returned by `uploadSessionStart`).
@@ -2525,6 +5095,8 @@ type UploadSessionCursor struct {
 	// make sure upload data isn't lost or duplicated in the event of a network
 	// error.
 	Offset uint64 `json:"offset"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUploadSessionCursor returns a new UploadSessionCursor instance
@@ -2535,12 +5107,29 @@ func NewUploadSessionCursor(SessionId string, Offset uint64) *UploadSessionCurso
 	return s
 }
 
+// MarshalJSON serializes a UploadSessionCursor instance
+func (u UploadSessionCursor) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UploadSessionCursor instance
+func (u *UploadSessionCursor) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UploadSessionCursor) Validate() error {
+	return nil
+}
+
 // UploadSessionFinishArg : has no documentation (yet)
 type UploadSessionFinishArg struct {
 	// Cursor : Contains the upload session ID and the offset.
 	Cursor *UploadSessionCursor `json:"cursor"`
 	// Commit : Contains the path and other optional modifiers for the commit.
 	Commit *CommitInfo `json:"commit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUploadSessionFinishArg returns a new UploadSessionFinishArg instance
@@ -2551,10 +5140,40 @@ func NewUploadSessionFinishArg(Cursor *UploadSessionCursor, Commit *CommitInfo)
 	return s
 }
 
+// MarshalJSON serializes a UploadSessionFinishArg instance
+func (u UploadSessionFinishArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UploadSessionFinishArg instance
+func (u *UploadSessionFinishArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UploadSessionFinishArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Cursor != nil {
+		if err := s.Cursor.Validate(); err != nil {
+			return dropbox.NestValidationError("cursor", err)
+		}
+	}
+	if s.Commit != nil {
+		if err := s.Commit.Validate(); err != nil {
+			return dropbox.NestValidationError("commit", err)
+		}
+	}
+	return nil
+}
+
 // UploadSessionFinishBatchArg : has no documentation (yet)
 type UploadSessionFinishBatchArg struct {
 	// Entries : Commit information for each file in the batch.
 	Entries []*UploadSessionFinishArg `json:"entries"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUploadSessionFinishBatchArg returns a new UploadSessionFinishBatchArg instance
@@ -2564,16 +5183,59 @@ func NewUploadSessionFinishBatchArg(Entries []*UploadSessionFinishArg) *UploadSe
 	return s
 }
 
+// MarshalJSON serializes a UploadSessionFinishBatchArg instance
+func (u UploadSessionFinishBatchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UploadSessionFinishBatchArg instance
+func (u *UploadSessionFinishBatchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UploadSessionFinishBatchArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if len(s.Entries) > 1000 {
+		return &dropbox.ValidationError{
+			Field:  "entries",
+			Reason: "must have at most 1000 items",
+		}
+	}
+	for i, v := range s.Entries {
+		if v != nil {
+			if err := v.Validate(); err != nil {
+				return dropbox.NestValidationError(fmt.Sprintf("entries[%d]", i), err)
+			}
+		}
+	}
+	return nil
+}
+
 // UploadSessionFinishBatchJobStatus : has no documentation (yet)
 type UploadSessionFinishBatchJobStatus struct {
 	dropbox.Tagged
 	// Complete : The `uploadSessionFinishBatch` has finished.
 	Complete *UploadSessionFinishBatchResult `json:"complete,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UploadSessionFinishBatchJobStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UploadSessionFinishBatchJobStatus
 const (
-	UploadSessionFinishBatchJobStatusComplete = "complete"
+	UploadSessionFinishBatchJobStatusInProgress = "in_progress"
+	UploadSessionFinishBatchJobStatusComplete   = "complete"
 )
 
 // UnmarshalJSON deserializes into a UploadSessionFinishBatchJobStatus instance
@@ -2588,7 +5250,7 @@ func (u *UploadSessionFinishBatchJobStatus) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
@@ -2596,29 +5258,64 @@ func (u *UploadSessionFinishBatchJobStatus) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "in_progress":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionFinishBatchJobStatus instance
+func (u UploadSessionFinishBatchJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // UploadSessionFinishBatchLaunch : Result returned by
 // `uploadSessionFinishBatch` that may either launch an asynchronous job or
 // complete synchronously.
 type UploadSessionFinishBatchLaunch struct {
 	dropbox.Tagged
+	// AsyncJobId : This response indicates that the processing is asynchronous.
+	// The string is an id that can be used to obtain the status of the
+	// asynchronous job.
+	AsyncJobId string `json:"async_job_id,omitempty"`
 	// Complete : has no documentation (yet)
 	Complete *UploadSessionFinishBatchResult `json:"complete,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UploadSessionFinishBatchLaunch) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UploadSessionFinishBatchLaunch
 const (
-	UploadSessionFinishBatchLaunchComplete = "complete"
-	UploadSessionFinishBatchLaunchOther    = "other"
+	UploadSessionFinishBatchLaunchAsyncJobId = "async_job_id"
+	UploadSessionFinishBatchLaunchComplete   = "complete"
+	UploadSessionFinishBatchLaunchOther      = "other"
 )
 
 // UnmarshalJSON deserializes into a UploadSessionFinishBatchLaunch instance
 func (u *UploadSessionFinishBatchLaunch) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// AsyncJobId : This response indicates that the processing is
+		// asynchronous. The string is an id that can be used to obtain the
+		// status of the asynchronous job.
+		AsyncJobId json.RawMessage `json:"async_job_id,omitempty"`
 		// Complete : has no documentation (yet)
 		Complete json.RawMessage `json:"complete,omitempty"`
 	}
@@ -2627,22 +5324,51 @@ func (u *UploadSessionFinishBatchLaunch) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
+	case "async_job_id":
+		err = json.Unmarshal(w.AsyncJobId, &u.AsyncJobId)
+
+		if err != nil {
+			return err
+		}
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
 
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionFinishBatchLaunch instance
+func (u UploadSessionFinishBatchLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // UploadSessionFinishBatchResult : has no documentation (yet)
 type UploadSessionFinishBatchResult struct {
 	// Entries : Commit result for each file in the batch.
 	Entries []*UploadSessionFinishBatchResultEntry `json:"entries"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUploadSessionFinishBatchResult returns a new UploadSessionFinishBatchResult instance
@@ -2652,6 +5378,16 @@ func NewUploadSessionFinishBatchResult(Entries []*UploadSessionFinishBatchResult
 	return s
 }
 
+// MarshalJSON serializes a UploadSessionFinishBatchResult instance
+func (u UploadSessionFinishBatchResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UploadSessionFinishBatchResult instance
+func (u *UploadSessionFinishBatchResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UploadSessionFinishBatchResultEntry : has no documentation (yet)
 type UploadSessionFinishBatchResultEntry struct {
 	dropbox.Tagged
@@ -2659,6 +5395,17 @@ type UploadSessionFinishBatchResultEntry struct {
 	Success *FileMetadata `json:"success,omitempty"`
 	// Failure : has no documentation (yet)
 	Failure *UploadSessionFinishError `json:"failure,omitempty"`
+	raw     string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UploadSessionFinishBatchResultEntry) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UploadSessionFinishBatchResultEntry
@@ -2681,7 +5428,7 @@ func (u *UploadSessionFinishBatchResultEntry) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "success":
 		err = json.Unmarshal(body, &u.Success)
@@ -2695,10 +5442,30 @@ func (u *UploadSessionFinishBatchResultEntry) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionFinishBatchResultEntry instance
+func (u UploadSessionFinishBatchResultEntry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return dropbox.MarshalTagged(u.Tag, u.Success)
+
+	case "failure":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failure *UploadSessionFinishError `json:"failure"`
+		}{u.Tagged, u.Failure})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // UploadSessionFinishError : has no documentation (yet)
 type UploadSessionFinishError struct {
 	dropbox.Tagged
@@ -2707,6 +5474,17 @@ type UploadSessionFinishError struct {
 	LookupFailed *UploadSessionLookupError `json:"lookup_failed,omitempty"`
 	// Path : Unable to save the uploaded contents to a file.
 	Path *WriteError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UploadSessionFinishError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UploadSessionFinishError
@@ -2733,7 +5511,7 @@ func (u *UploadSessionFinishError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "lookup_failed":
 		err = json.Unmarshal(w.LookupFailed, &u.LookupFailed)
@@ -2747,10 +5525,41 @@ func (u *UploadSessionFinishError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "too_many_shared_folder_targets", "too_many_write_operations", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionFinishError instance
+func (u UploadSessionFinishError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "lookup_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			LookupFailed *UploadSessionLookupError `json:"lookup_failed"`
+		}{u.Tagged, u.LookupFailed})
+
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *WriteError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUploadSessionFinishError reports whether err carries a UploadSessionFinishError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUploadSessionFinishError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UploadSessionFinishError)(nil), tags...)
+}
+
 // UploadSessionLookupError : has no documentation (yet)
 type UploadSessionLookupError struct {
 	dropbox.Tagged
@@ -2759,6 +5568,17 @@ type UploadSessionLookupError struct {
 	// received and processed successfully but the client did not receive the
 	// response, e.g. due to a network error.
 	IncorrectOffset *UploadSessionOffsetError `json:"incorrect_offset,omitempty"`
+	raw             string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UploadSessionLookupError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UploadSessionLookupError
@@ -2785,7 +5605,7 @@ func (u *UploadSessionLookupError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "incorrect_offset":
 		err = json.Unmarshal(body, &u.IncorrectOffset)
@@ -2793,14 +5613,38 @@ func (u *UploadSessionLookupError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "not_found", "closed", "not_closed", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionLookupError instance
+func (u UploadSessionLookupError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "incorrect_offset":
+		return dropbox.MarshalTagged(u.Tag, u.IncorrectOffset)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUploadSessionLookupError reports whether err carries a UploadSessionLookupError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUploadSessionLookupError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UploadSessionLookupError)(nil), tags...)
+}
+
 // UploadSessionOffsetError : has no documentation (yet)
 type UploadSessionOffsetError struct {
 	// CorrectOffset : The offset up to which data has been collected.
 	CorrectOffset uint64 `json:"correct_offset"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUploadSessionOffsetError returns a new UploadSessionOffsetError instance
@@ -2810,12 +5654,24 @@ func NewUploadSessionOffsetError(CorrectOffset uint64) *UploadSessionOffsetError
 	return s
 }
 
+// MarshalJSON serializes a UploadSessionOffsetError instance
+func (u UploadSessionOffsetError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UploadSessionOffsetError instance
+func (u *UploadSessionOffsetError) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UploadSessionStartArg : has no documentation (yet)
 type UploadSessionStartArg struct {
 	// Close : If true, the current session will be closed, at which point you
 	// won't be able to call `uploadSessionAppendV2` anymore with the current
 	// session.
 	Close bool `json:"close"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUploadSessionStartArg returns a new UploadSessionStartArg instance
@@ -2825,11 +5681,28 @@ func NewUploadSessionStartArg() *UploadSessionStartArg {
 	return s
 }
 
+// MarshalJSON serializes a UploadSessionStartArg instance
+func (u UploadSessionStartArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UploadSessionStartArg instance
+func (u *UploadSessionStartArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UploadSessionStartArg) Validate() error {
+	return nil
+}
+
 // UploadSessionStartResult : has no documentation (yet)
 type UploadSessionStartResult struct {
 	// SessionId : A unique identifier for the upload session. Pass this to
 	// `uploadSessionAppendV2` and `uploadSessionFinish`.
 	SessionId string `json:"session_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUploadSessionStartResult returns a new UploadSessionStartResult instance
@@ -2839,6 +5712,16 @@ func NewUploadSessionStartResult(SessionId string) *UploadSessionStartResult {
 	return s
 }
 
+// MarshalJSON serializes a UploadSessionStartResult instance
+func (u UploadSessionStartResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UploadSessionStartResult instance
+func (u *UploadSessionStartResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UploadWriteFailed : has no documentation (yet)
 type UploadWriteFailed struct {
 	// Reason : The reason why the file couldn't be saved.
@@ -2846,6 +5729,8 @@ type UploadWriteFailed struct {
 	// UploadSessionId : The upload session ID; this may be used to retry the
 	// commit.
 	UploadSessionId string `json:"upload_session_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUploadWriteFailed returns a new UploadWriteFailed instance
@@ -2856,11 +5741,23 @@ func NewUploadWriteFailed(Reason *WriteError, UploadSessionId string) *UploadWri
 	return s
 }
 
+// MarshalJSON serializes a UploadWriteFailed instance
+func (u UploadWriteFailed) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UploadWriteFailed instance
+func (u *UploadWriteFailed) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // VideoMetadata : Metadata for a video.
 type VideoMetadata struct {
 	MediaMetadata
 	// Duration : The duration of the video in milliseconds.
 	Duration uint64 `json:"duration,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewVideoMetadata returns a new VideoMetadata instance
@@ -2869,9 +5766,30 @@ func NewVideoMetadata() *VideoMetadata {
 	return s
 }
 
+// MarshalJSON serializes a VideoMetadata instance, tagged as a `MediaMetadata`
+func (u VideoMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("video", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a VideoMetadata instance
+func (u *VideoMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // WriteConflictError : has no documentation (yet)
 type WriteConflictError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u WriteConflictError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for WriteConflictError
@@ -2882,6 +5800,39 @@ const (
 	WriteConflictErrorOther        = "other"
 )
 
+// UnmarshalJSON deserializes into a WriteConflictError instance
+func (u *WriteConflictError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "file", "folder", "file_ancestor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a WriteConflictError instance
+func (u WriteConflictError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsWriteConflictError reports whether err carries a WriteConflictError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsWriteConflictError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*WriteConflictError)(nil), tags...)
+}
+
 // WriteError : has no documentation (yet)
 type WriteError struct {
 	dropbox.Tagged
@@ -2890,6 +5841,17 @@ type WriteError struct {
 	// Conflict : Couldn't write to the target path because there was something
 	// in the way.
 	Conflict *WriteConflictError `json:"conflict,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u WriteError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for WriteError
@@ -2918,10 +5880,10 @@ func (u *WriteError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "malformed_path":
-		err = json.Unmarshal(body, &u.MalformedPath)
+		err = json.Unmarshal(w.MalformedPath, &u.MalformedPath)
 
 		if err != nil {
 			return err
@@ -2932,10 +5894,41 @@ func (u *WriteError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "no_write_permission", "insufficient_space", "disallowed_name", "team_folder", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a WriteError instance
+func (u WriteError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "malformed_path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MalformedPath string `json:"malformed_path,omitempty"`
+		}{u.Tagged, u.MalformedPath})
+
+	case "conflict":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Conflict *WriteConflictError `json:"conflict"`
+		}{u.Tagged, u.Conflict})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsWriteError reports whether err carries a WriteError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsWriteError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*WriteError)(nil), tags...)
+}
+
 // WriteMode : Your intent when writing a file to some path. This is used to
 // determine what constitutes a conflict and what the autorename strategy is. In
 // some situations, the conflict behavior is identical: (a) If the target path
@@ -2951,6 +5944,17 @@ type WriteMode struct {
 	// file name. For example, "document.txt" might become "document (conflicted
 	// copy).txt" or "document (Panda's conflicted copy).txt".
 	Update string `json:"update,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u WriteMode) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for WriteMode
@@ -2964,20 +5968,44 @@ const (
 func (u *WriteMode) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// Update : Overwrite if the given "rev" matches the existing file's
+		// "rev". The autorename strategy is to append the string "conflicted
+		// copy" to the file name. For example, "document.txt" might become
+		// "document (conflicted copy).txt" or "document (Panda's conflicted
+		// copy).txt".
+		Update json.RawMessage `json:"update,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "update":
-		err = json.Unmarshal(body, &u.Update)
+		err = json.Unmarshal(w.Update, &u.Update)
 
 		if err != nil {
 			return err
 		}
+	case "add", "overwrite":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
+
+// MarshalJSON serializes a WriteMode instance
+func (u WriteMode) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "update":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Update string `json:"update"`
+		}{u.Tagged, u.Update})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
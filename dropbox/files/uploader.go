@@ -0,0 +1,399 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"math"
+	"net/url"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+)
+
+const (
+	// Unit that the chunks of an upload session must be a multiple of
+	chunkUnit = 4 << 20
+	// DefaultChunkSize is the size of the chunks sent by an Uploader by
+	// default.
+	DefaultChunkSize = 4 * chunkUnit
+	// MaxChunkSize is the largest chunk that Dropbox accepts in one request.
+	MaxChunkSize = 150 << 20
+	// DefaultMaxResumes is the number of times an Uploader recovers from
+	// failed requests by default.
+	DefaultMaxResumes = 5
+)
+
+// Uploader uploads content of any size. Content that fits in one chunk is
+// sent with `upload`, anything larger with an upload session.
+//
+// If the content is an io.Seeker or an io.ReaderAt, the upload recovers from
+// network errors and from Dropbox reporting a different offset for the
+// session by sending the content again from the offset that Dropbox has.
+// With a CheckpointStore, it also resumes the session of an earlier
+// Upload of the same path that failed, unless the content that was already
+// uploaded has changed since.
+type Uploader struct {
+	Client Client
+	// Size of the chunks, a multiple of 4 MiB up to MaxChunkSize
+	// (DefaultChunkSize if 0)
+	ChunkSize int
+	// If set, called after every chunk with the number of bytes uploaded
+	Progress func(uploaded int64)
+	// If set, upload sessions are saved here after every chunk
+	Checkpoints CheckpointStore
+	// Number of times an upload recovers from failed requests
+	// (DefaultMaxResumes if 0, none if negative)
+	MaxResumes int
+	// Number of chunks that UploadAt sends at once (one at a time if 0)
+	Workers int
+}
+
+// NewUploader returns an Uploader that uses c.
+func NewUploader(c Client) *Uploader {
+	return &Uploader{Client: c}
+}
+
+func (u *Uploader) chunkSize() (int, error) {
+	size := u.ChunkSize
+	if size == 0 {
+		size = DefaultChunkSize
+	}
+	if size < 0 || size%chunkUnit != 0 || size > MaxChunkSize {
+		return 0, fmt.Errorf("files: chunk size %d is not a multiple of 4 MiB up to 150 MiB", size)
+	}
+	return size, nil
+}
+
+// Upload uploads the content read from r as described by commit, reading
+// until EOF.
+func (u *Uploader) Upload(ctx context.Context, commit *CommitInfo, r io.Reader) (*FileMetadata, error) {
+	size, err := u.chunkSize()
+	if err != nil {
+		return nil, err
+	}
+	up := &upload{
+		Uploader: u,
+		ctx:      ctx,
+		commit:   commit,
+		src:      newSource(r),
+		chunk:    make([]byte, size),
+		report:   u.Progress,
+	}
+	if u.Checkpoints != nil && up.src.seekable() {
+		up.hash = sha256.New()
+	}
+	if err := up.resume(); err != nil {
+		return nil, err
+	}
+	return up.run()
+}
+
+// upload is the state of an Upload.
+type upload struct {
+	*Uploader
+	ctx     context.Context
+	commit  *CommitInfo
+	src     *source
+	chunk   []byte
+	cursor  *UploadSessionCursor // nil until a session is started
+	resumes int
+	// Hash of the content up to the cursor, if checkpoints are saved
+	hash hash.Hash
+	// Only close the session, to commit it with finish_batch
+	closeOnly bool
+	report    func(uploaded int64)
+}
+
+func (up *upload) run() (*FileMetadata, error) {
+	for {
+		n, last, err := up.src.read(up.chunk)
+		if err != nil {
+			return nil, err
+		}
+		content := bytes.NewReader(up.chunk[:n])
+		var res *FileMetadata
+		switch {
+		case up.cursor == nil && last && !up.closeOnly:
+			res, err = up.Client.UploadContext(up.ctx, up.commit, content)
+		case up.cursor == nil:
+			arg := NewUploadSessionStartArg()
+			arg.Close = last
+			var start *UploadSessionStartResult
+			start, err = up.Client.UploadSessionStartContext(up.ctx, arg, content)
+			if err == nil {
+				up.cursor = NewUploadSessionCursor(start.SessionId, 0)
+			}
+		case last && up.closeOnly:
+			arg := NewUploadSessionAppendArg(up.cursor)
+			arg.Close = true
+			err = up.Client.UploadSessionAppendV2Context(up.ctx, arg, content)
+		case last:
+			res, err = up.Client.UploadSessionFinishContext(up.ctx,
+				NewUploadSessionFinishArg(up.cursor, up.commit), content)
+		default:
+			err = up.Client.UploadSessionAppendV2Context(up.ctx,
+				NewUploadSessionAppendArg(up.cursor), content)
+		}
+		if err != nil {
+			if err = up.recover(err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		var offset uint64
+		if up.cursor != nil {
+			offset = up.cursor.Offset
+		}
+		offset += uint64(n)
+		if last && up.closeOnly {
+			up.cursor = NewUploadSessionCursor(up.cursor.SessionId, offset)
+			up.progress(int64(offset))
+			return nil, nil
+		}
+		if last {
+			if err := up.forget(); err != nil {
+				return nil, err
+			}
+			up.progress(int64(offset))
+			return res, nil
+		}
+		up.cursor = NewUploadSessionCursor(up.cursor.SessionId, offset)
+		if up.hash != nil {
+			up.hash.Write(up.chunk[:n])
+		}
+		if err := up.checkpoint(); err != nil {
+			return nil, err
+		}
+		up.progress(int64(offset))
+	}
+}
+
+func (up *upload) progress(uploaded int64) {
+	if up.report != nil {
+		up.report(uploaded)
+	}
+}
+
+// resume continues the session of an earlier upload, if one was saved for
+// the same content.
+func (up *upload) resume() error {
+	if up.hash == nil {
+		return nil
+	}
+	cp, err := up.Checkpoints.Load(up.commit.Path)
+	if err != nil || cp == nil {
+		return err
+	}
+	ok, err := up.rehash(cp.Offset)
+	if err != nil {
+		return err
+	}
+	if !ok || up.sum() != cp.Hash {
+		// The content changed, start over
+		up.hash.Reset()
+		if err := up.src.seek(0); err != nil {
+			return err
+		}
+		return up.forget()
+	}
+	up.cursor = NewUploadSessionCursor(cp.SessionID, cp.Offset)
+	up.progress(int64(cp.Offset))
+	return nil
+}
+
+func (up *upload) checkpoint() error {
+	if up.hash == nil {
+		return nil
+	}
+	return up.Checkpoints.Save(up.commit.Path, &Checkpoint{
+		SessionID: up.cursor.SessionId,
+		Offset:    up.cursor.Offset,
+		Hash:      up.sum(),
+	})
+}
+
+func (up *upload) forget() error {
+	if up.hash == nil {
+		return nil
+	}
+	return up.Checkpoints.Delete(up.commit.Path)
+}
+
+func (up *upload) sum() string {
+	return hex.EncodeToString(up.hash.Sum(nil))
+}
+
+// rehash hashes the first offset bytes of the content again, leaving the
+// content at offset. It reports false if the content is shorter.
+func (up *upload) rehash(offset uint64) (bool, error) {
+	up.hash.Reset()
+	if err := up.src.seek(0); err != nil {
+		return false, err
+	}
+	n, err := io.CopyN(up.hash, up.src.r, int64(offset))
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	return uint64(n) == offset, up.src.seek(int64(offset))
+}
+
+func (u *Uploader) maxResumes() int {
+	if u.MaxResumes == 0 {
+		return DefaultMaxResumes
+	}
+	return u.MaxResumes
+}
+
+// recover prepares sending the content again from where Dropbox has it
+// after err, or returns err if that isn't possible.
+func (up *upload) recover(err error) error {
+	if up.ctx.Err() != nil || !up.src.seekable() || up.resumes >= up.maxResumes() {
+		return err
+	}
+	var prev, offset uint64
+	if up.cursor != nil {
+		prev = up.cursor.Offset
+	}
+	switch {
+	case up.cursor == nil:
+		if !transient(err) {
+			return err
+		}
+	case dropbox.IsEndpointError(err, (*UploadSessionLookupError)(nil),
+		UploadSessionLookupErrorNotFound):
+		// The session expired, start over
+		if err := up.forget(); err != nil {
+			return err
+		}
+		up.cursor = nil
+	default:
+		var ok bool
+		if offset, ok = correctOffset(err); !ok {
+			if !transient(err) {
+				return err
+			}
+			offset = up.cursor.Offset
+		}
+		up.cursor = NewUploadSessionCursor(up.cursor.SessionId, offset)
+	}
+	up.resumes++
+	if up.hash != nil && offset != prev {
+		ok, err := up.rehash(offset)
+		if err == nil && !ok {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return up.src.seek(int64(offset))
+}
+
+// correctOffset returns the offset that Dropbox has for the session if err
+// reports that the upload sent a different one.
+func correctOffset(err error) (uint64, bool) {
+	var lookup *UploadSessionLookupError
+	switch e := err.(type) {
+	case UploadSessionAppendV2APIError:
+		lookup = e.EndpointError
+	case UploadSessionFinishAPIError:
+		if e.EndpointError != nil {
+			lookup = e.EndpointError.LookupFailed
+		}
+	}
+	if lookup == nil || lookup.Tag != UploadSessionLookupErrorIncorrectOffset ||
+		lookup.IncorrectOffset == nil {
+		return 0, false
+	}
+	return lookup.IncorrectOffset.CorrectOffset, true
+}
+
+// transient reports whether err may not happen again: a network error or
+// a server error.
+func transient(err error) bool {
+	switch err.(type) {
+	case *url.Error, dropbox.ServerError:
+		return true
+	}
+	return false
+}
+
+// source is the content of an upload, read in chunks. It can be rewound if
+// it is an io.Seeker or an io.ReaderAt.
+type source struct {
+	r      io.Reader
+	seeker io.Seeker
+	start  int64  // offset of the content in seeker
+	next   []byte // read ahead of the previous chunk
+}
+
+func newSource(r io.Reader) *source {
+	s := &source{r: r}
+	switch v := r.(type) {
+	case io.Seeker:
+		if start, err := v.Seek(0, io.SeekCurrent); err == nil {
+			s.seeker, s.start = v, start
+		}
+	case io.ReaderAt:
+		sr := io.NewSectionReader(v, 0, math.MaxInt64)
+		s.r, s.seeker = sr, sr
+	}
+	return s
+}
+
+func (s *source) seekable() bool {
+	return s.seeker != nil
+}
+
+// seek rewinds the content to offset.
+func (s *source) seek(offset int64) error {
+	s.next = nil
+	_, err := s.seeker.Seek(s.start+offset, io.SeekStart)
+	return err
+}
+
+// read fills chunk, reporting whether it is the last one.
+func (s *source) read(chunk []byte) (n int, last bool, err error) {
+	n = copy(chunk, s.next)
+	s.next = nil
+	m, err := io.ReadFull(s.r, chunk[n:])
+	n += m
+	switch err {
+	case nil:
+	case io.EOF, io.ErrUnexpectedEOF:
+		return n, true, nil
+	default:
+		return n, false, err
+	}
+	var b [1]byte
+	switch _, err = io.ReadFull(s.r, b[:]); err {
+	case nil:
+		s.next = b[:]
+		return n, false, nil
+	case io.EOF:
+		return n, true, nil
+	}
+	return n, false, err
+}
@@ -0,0 +1,202 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// LogLevel is the severity of a log entry.
+type LogLevel int
+
+// Log levels, in increasing order of severity
+const (
+	LogDebug LogLevel = iota
+	LogInfo
+	LogWarn
+	LogError
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogDebug:
+		return "DEBUG"
+	case LogInfo:
+		return "INFO"
+	case LogWarn:
+		return "WARN"
+	case LogError:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LogLevel(%d)", int(l))
+}
+
+// Field is a named value attached to a log entry, e.g. the route or the
+// status code of a response.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// Logger receives the SDK's log entries. Entries never contain credentials,
+// and contain paths only if Config.LogPaths is set.
+//
+// Entries about requests carry the fields "namespace", "route", "status",
+// "duration" and "request_id", plus "error_summary" for failed requests and
+// "arg" (at LogDebug) for the route's argument.
+type Logger interface {
+	Log(level LogLevel, msg string, fields ...Field)
+}
+
+// StdLogger is a Logger that writes entries at or above Level using the
+// standard log package.
+type StdLogger struct {
+	// Destination of the entries (uses the standard logger if nil)
+	Logger *log.Logger
+	// Entries below this level are discarded
+	Level LogLevel
+}
+
+// Log implements Logger.
+func (l StdLogger) Log(level LogLevel, msg string, fields ...Field) {
+	if level < l.Level {
+		return
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "[%v] dropbox: %s", level, msg)
+	for _, f := range fields {
+		fmt.Fprintf(&b, " %s=%v", f.Key, f.Value)
+	}
+	if l.Logger == nil {
+		log.Print(b.String())
+	} else {
+		l.Logger.Print(b.String())
+	}
+}
+
+// redacted replaces sensitive values in logged arguments.
+const redacted = "REDACTED"
+
+// Argument and result fields that always hold credentials
+var credentialFields = map[string]bool{
+	"access_token":        true,
+	"refresh_token":       true,
+	"id_token":            true,
+	"oauth1_token":        true,
+	"oauth1_token_secret": true,
+	"password":            true,
+	"link_password":       true,
+}
+
+// IsCredentialField reports whether the JSON field key of an argument or a
+// result holds a credential, e.g. "access_token". The values of such fields
+// are never logged.
+func IsCredentialField(key string) bool {
+	return credentialFields[key]
+}
+
+// isPathField reports whether an argument field holds a path.
+func isPathField(key string) bool {
+	return key == "path" || strings.HasSuffix(key, "_path") ||
+		strings.HasPrefix(key, "path_")
+}
+
+// logger returns the Logger configured for c, or nil if logging is off.
+func (c *Context) logger() Logger {
+	if c.Config.Logger != nil {
+		return c.Config.Logger
+	}
+	if c.Config.Verbose {
+		return StdLogger{Level: LogDebug}
+	}
+	return nil
+}
+
+// requestFields returns the fields describing the request sent for route.
+func requestFields(route RouteInfo) []Field {
+	return []Field{{"namespace", route.Namespace}, {"route", route.Route}}
+}
+
+// responseFields returns the fields identifying resp and the error it
+// reports, if any. The body of resp remains readable.
+func responseFields(resp *http.Response) []Field {
+	var fields []Field
+	if id := resp.Header.Get("X-Dropbox-Request-Id"); id != "" {
+		fields = append(fields, Field{"request_id", id})
+	}
+	if resp.StatusCode >= 400 {
+		var apiError struct {
+			ErrorSummary string `json:"error_summary"`
+		}
+		if json.Unmarshal(peekBody(resp), &apiError) == nil && apiError.ErrorSummary != "" {
+			fields = append(fields, Field{"error_summary", apiError.ErrorSummary})
+		}
+	}
+	return fields
+}
+
+// argField returns the redacted argument of req.
+func (c *Context) argField(req *http.Request, route RouteInfo) (Field, bool) {
+	arg := []byte(req.Header.Get("Dropbox-API-Arg"))
+	if len(arg) == 0 && route.Style == "rpc" && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return Field{}, false
+		}
+		arg, _ = ioutil.ReadAll(body)
+		body.Close()
+	}
+	var v interface{}
+	if len(arg) == 0 || json.Unmarshal(arg, &v) != nil {
+		return Field{}, false
+	}
+	b, err := json.Marshal(redact(v, c.Config.LogPaths))
+	if err != nil {
+		return Field{}, false
+	}
+	return Field{"arg", string(b)}, true
+}
+
+// redact replaces credentials and, unless keepPaths is set, paths found in
+// the decoded JSON value v.
+func redact(v interface{}, keepPaths bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if credentialFields[k] || !keepPaths && isPathField(k) {
+				v[k] = redacted
+			} else {
+				v[k] = redact(e, keepPaths)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redact(e, keepPaths)
+		}
+	}
+	return v
+}
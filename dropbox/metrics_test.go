@@ -0,0 +1,164 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox_test
+
+import (
+	"encoding/json"
+	"expvar"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+// metrics records the measurements it receives.
+type metrics struct {
+	mu       sync.Mutex
+	requests []dropbox.RequestStats
+	received []int64
+}
+
+func (m *metrics) Request(route dropbox.RouteInfo, stats dropbox.RequestStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, stats)
+}
+
+func (m *metrics) BytesReceived(route dropbox.RouteInfo, n int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.received = append(m.received, n)
+}
+
+func TestMetricsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error_summary": "path/not_found/..", "error": {".tag": "path", "path": {".tag": "not_found"}}}`))
+	}))
+	defer server.Close()
+	m := &metrics{}
+	dbx := filesClient(server, dropbox.Config{Metrics: m})
+	if _, err := dbx.GetMetadata(files.NewGetMetadataArg("/a")); err == nil {
+		t.Fatal("no error")
+	}
+	if len(m.requests) != 1 {
+		t.Fatalf("got %+v", m.requests)
+	}
+	stats := m.requests[0]
+	if stats.StatusCode != http.StatusConflict || stats.ErrorTag != "path/not_found" || stats.Retries != 0 {
+		t.Errorf("got %+v, want status 409 and error_tag path/not_found", stats)
+	}
+	// The client reads the whole error body
+	if len(m.received) != 1 || m.received[0] == 0 {
+		t.Errorf("bytes received %v", m.received)
+	}
+}
+
+func TestMetricsRetries(t *testing.T) {
+	server := filesServer(2, http.StatusServiceUnavailable)
+	defer server.Close()
+	m := &metrics{}
+	dbx := filesClient(server, dropbox.Config{
+		Metrics: m,
+		Retry:   dropbox.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	if _, err := dbx.Upload(files.NewCommitInfo("/a"), strings.NewReader("content")); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.requests) != 1 {
+		t.Fatalf("got %+v", m.requests)
+	}
+	stats := m.requests[0]
+	if stats.StatusCode != http.StatusOK || stats.ErrorTag != "" || stats.Retries != 2 {
+		t.Errorf("got %+v, want status 200 after 2 retries", stats)
+	}
+	// Every attempt sent the whole body
+	if stats.BytesSent != 3*int64(len("content")) {
+		t.Errorf("bytes sent %d, want %d", stats.BytesSent, 3*len("content"))
+	}
+}
+
+func TestMetricsBytesReceived(t *testing.T) {
+	server := filesServer(0, 0)
+	defer server.Close()
+	m := &metrics{}
+	dbx := filesClient(server, dropbox.Config{Metrics: m})
+
+	for _, n := range []int{len("content"), 3, 0} {
+		_, content, err := dbx.Download(files.NewDownloadArg("/a"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = io.ReadFull(content, make([]byte, n)); err != nil {
+			t.Fatal(err)
+		}
+		m.mu.Lock()
+		if len(m.received) != 0 {
+			t.Errorf("bytes received reported before the body was closed")
+		}
+		m.mu.Unlock()
+		content.Close()
+		content.Close()
+		if len(m.received) != 1 || m.received[0] != int64(n) {
+			t.Errorf("read %d bytes: bytes received %v", n, m.received)
+		}
+		if len(m.requests) != 1 || m.requests[0].StatusCode != http.StatusOK || m.requests[0].BytesSent != 0 {
+			t.Errorf("read %d bytes: got %+v", n, m.requests)
+		}
+		*m = metrics{}
+	}
+}
+
+func TestExpvarMetrics(t *testing.T) {
+	server := filesServer(1, http.StatusInternalServerError)
+	defer server.Close()
+	m := dropbox.NewExpvarMetrics("dropbox_test_metrics")
+	dbx := filesClient(server, dropbox.Config{
+		Metrics: m,
+		Retry:   dropbox.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	})
+	if _, err := dbx.Upload(files.NewCommitInfo("/a"), strings.NewReader("content")); err != nil {
+		t.Fatal(err)
+	}
+	var vars map[string]map[string]int64
+	if err := json.Unmarshal([]byte(expvar.Get("dropbox_test_metrics").String()), &vars); err != nil {
+		t.Fatal(err)
+	}
+	got := vars["files/upload"]
+	for k, want := range map[string]int64{
+		"requests":       1,
+		"status_200":     1,
+		"retries":        1,
+		"bytes_sent":     14,
+		"bytes_received": int64(len(`{".tag": "file", "name": "a"}`)),
+	} {
+		if got[k] != want {
+			t.Errorf("%s = %d, want %d", k, got[k], want)
+		}
+	}
+}
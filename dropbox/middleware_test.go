@@ -0,0 +1,169 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+// filesServer answers every request with status until it has failed the
+// given number of times, and with an empty result after that.
+func filesServer(failures int32, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		if atomic.AddInt32(&failures, -1) >= 0 {
+			http.Error(w, "oops", status)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/download") {
+			w.Header().Set("Dropbox-API-Result", `{".tag": "file", "name": "a"}`)
+			w.Write([]byte("content"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{".tag": "file", "name": "a"}`))
+	}))
+}
+
+func filesClient(server *httptest.Server, conf dropbox.Config) files.Client {
+	conf.Token = "token"
+	conf.URLGenerator = func(hostType, style, namespace, route string) string {
+		return server.URL + "/2/" + namespace + "/" + route
+	}
+	return files.New(conf)
+}
+
+// trace returns a Middleware that appends name to log before and after
+// sending each request.
+func trace(name string, log *[]string) dropbox.Middleware {
+	return func(next dropbox.RoundTripFunc) dropbox.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			*log = append(*log, name+">")
+			resp, err := next(req)
+			*log = append(*log, "<"+name)
+			return resp, err
+		}
+	}
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+	server := filesServer(0, 0)
+	defer server.Close()
+	var log []string
+	dbx := filesClient(server, dropbox.Config{
+		Middleware: []dropbox.Middleware{trace("a", &log), trace("b", &log), trace("c", &log)},
+	})
+	if _, err := dbx.GetMetadata(files.NewGetMetadataArg("/a")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(log, " "), "a> b> c> <c <b <a"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestMiddlewareRetries(t *testing.T) {
+	server := filesServer(2, http.StatusServiceUnavailable)
+	defer server.Close()
+	var bodies []string
+	var statuses []int
+	dbx := filesClient(server, dropbox.Config{
+		Retry: dropbox.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+		Middleware: []dropbox.Middleware{func(next dropbox.RoundTripFunc) dropbox.RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				body, _ := ioutil.ReadAll(req.Body)
+				bodies = append(bodies, string(body))
+				req.Body = ioutil.NopCloser(strings.NewReader(string(body)))
+				resp, err := next(req)
+				if err == nil {
+					statuses = append(statuses, resp.StatusCode)
+				}
+				return resp, err
+			}
+		}},
+	})
+	if _, err := dbx.GetMetadata(files.NewGetMetadataArg("/a")); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("middleware called %d times, want 3", len(bodies))
+	}
+	for i, body := range bodies {
+		if !strings.Contains(body, `"path":"/a"`) {
+			t.Errorf("attempt %d sent %q", i+1, body)
+		}
+	}
+	if got, want := fmt.Sprint(statuses), "[503 503 200]"; got != want {
+		t.Errorf("statuses %s, want %s", got, want)
+	}
+}
+
+func TestRouteFromContext(t *testing.T) {
+	server := filesServer(0, 0)
+	defer server.Close()
+	var routes []dropbox.RouteInfo
+	dbx := filesClient(server, dropbox.Config{
+		Middleware: []dropbox.Middleware{func(next dropbox.RoundTripFunc) dropbox.RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				route, ok := dropbox.RouteFromContext(req.Context())
+				if !ok {
+					t.Errorf("no route for %s", req.URL)
+				}
+				routes = append(routes, route)
+				return next(req)
+			}
+		}},
+	})
+	if _, err := dbx.GetMetadata(files.NewGetMetadataArg("/a")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbx.Upload(files.NewCommitInfo("/a"), strings.NewReader("content")); err != nil {
+		t.Fatal(err)
+	}
+	_, content, err := dbx.Download(files.NewDownloadArg("/a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content.Close()
+
+	want := []dropbox.RouteInfo{
+		{Namespace: "files", Route: "get_metadata", Host: "api", Style: "rpc", Auth: "user"},
+		{Namespace: "files", Route: "upload", Host: "content", Style: "upload", Auth: "user"},
+		{Namespace: "files", Route: "download", Host: "content", Style: "download", Auth: "user"},
+	}
+	if len(routes) != len(want) {
+		t.Fatalf("got %+v, want %+v", routes, want)
+	}
+	for i := range want {
+		if routes[i] != want[i] {
+			t.Errorf("got %+v, want %+v", routes[i], want[i])
+		}
+	}
+}
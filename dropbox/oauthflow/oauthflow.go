@@ -0,0 +1,271 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package oauthflow implements the OAuth2 authorization code flow for
+// Dropbox, including PKCE and an optional loopback server to capture the
+// redirect.
+package oauthflow
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"golang.org/x/oauth2"
+)
+
+// Valid values for Config.TokenAccessType
+const (
+	// Short-lived access token plus a refresh token
+	TokenAccessTypeOffline = "offline"
+	// Short-lived access token only
+	TokenAccessTypeOnline = "online"
+	// Long-lived access token
+	TokenAccessTypeLegacy = "legacy"
+)
+
+// Config describes the app that requests authorization.
+type Config struct {
+	// App key from the developers console
+	AppKey string
+	// App secret; may be empty if UsePKCE is set
+	AppSecret string
+	// Where Dropbox redirects after authorization. If empty, the user is
+	// shown the code and must paste it into the app
+	RedirectURL string
+	// Sent as the `token_access_type` parameter if not empty
+	TokenAccessType string
+	// Whether to send a PKCE code challenge
+	UsePKCE bool
+	// Used for testing, see dropbox.Config.Domain
+	Domain string
+	// Overrides the endpoint derived from Domain if not empty
+	Endpoint oauth2.Endpoint
+	// Used to exchange the code (uses http.DefaultClient if nil)
+	Client *http.Client
+}
+
+// Token is the result of a successful authorization.
+type Token struct {
+	*oauth2.Token
+	// ID of the account that authorized the app, if it was a user app
+	AccountID string
+	// ID of the team that authorized the app, if it was a team app
+	TeamID string
+}
+
+// Flow is a single authorization attempt. It holds the state and PKCE
+// verifier that the code exchange is checked against.
+type Flow struct {
+	conf     Config
+	oauth    *oauth2.Config
+	state    string
+	verifier string
+}
+
+// NewFlow starts a new authorization attempt for conf.
+func NewFlow(conf Config) (*Flow, error) {
+	endpoint := conf.Endpoint
+	if endpoint.AuthURL == "" && endpoint.TokenURL == "" {
+		endpoint = dropbox.OAuthEndpoint(conf.Domain)
+	}
+	f := &Flow{
+		conf: conf,
+		oauth: &oauth2.Config{
+			ClientID:     conf.AppKey,
+			ClientSecret: conf.AppSecret,
+			Endpoint:     endpoint,
+			RedirectURL:  conf.RedirectURL,
+		},
+	}
+	var err error
+	if f.state, err = randomString(16); err != nil {
+		return nil, err
+	}
+	if conf.UsePKCE {
+		if f.verifier, err = randomString(32); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// State returns the `state` parameter sent with the authorize URL.
+func (f *Flow) State() string {
+	return f.state
+}
+
+// AuthCodeURL returns the URL the user must visit to authorize the app.
+func (f *Flow) AuthCodeURL() string {
+	var opts []oauth2.AuthCodeOption
+	if f.conf.TokenAccessType != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("token_access_type", f.conf.TokenAccessType))
+	}
+	if f.verifier != "" {
+		sum := sha256.Sum256([]byte(f.verifier))
+		opts = append(opts,
+			oauth2.SetAuthURLParam("code_challenge", base64.RawURLEncoding.EncodeToString(sum[:])),
+			oauth2.SetAuthURLParam("code_challenge_method", "S256"))
+	}
+	return f.oauth.AuthCodeURL(f.state, opts...)
+}
+
+// Exchange trades the authorization code for a token. state is the value
+// received with the redirect; pass State() if there was no redirect.
+func (f *Flow) Exchange(ctx context.Context, code, state string) (*Token, error) {
+	if state != f.state {
+		return nil, errors.New("oauthflow: state mismatch")
+	}
+	if f.conf.Client != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, f.conf.Client)
+	}
+	var opts []oauth2.AuthCodeOption
+	if f.verifier != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("code_verifier", f.verifier))
+	}
+	tok, err := f.oauth.Exchange(ctx, code, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := &Token{Token: tok}
+	res.AccountID, _ = tok.Extra("account_id").(string)
+	res.TeamID, _ = tok.Extra("team_id").(string)
+	return res, nil
+}
+
+// Redirect is the outcome of a redirect to the loopback server.
+type Redirect struct {
+	Code  string
+	State string
+	Err   error
+}
+
+// Loopback is an HTTP server on the loopback interface that captures the
+// redirect at the end of the flow.
+type Loopback struct {
+	// URL to use as Config.RedirectURL
+	URL string
+
+	listener net.Listener
+	server   *http.Server
+	redirect chan Redirect
+}
+
+// NewLoopback starts a server listening on addr, which should be a loopback
+// address such as "127.0.0.1:0". The redirect URL must be registered in the
+// developers console, so a fixed port is usually needed.
+func NewLoopback(addr string) (*Loopback, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	// Keep the host as given, since the redirect URL must match the
+	// registered one exactly, and only take the port from the listener
+	host, _, _ := net.SplitHostPort(addr)
+	listenHost, port, _ := net.SplitHostPort(l.Addr().String())
+	if host == "" {
+		host = listenHost
+	}
+	lb := &Loopback{
+		URL:      fmt.Sprintf("http://%s/", net.JoinHostPort(host, port)),
+		listener: l,
+		redirect: make(chan Redirect, 1),
+	}
+	lb.server = &http.Server{Handler: http.HandlerFunc(lb.serveHTTP)}
+	go lb.server.Serve(l)
+	return lb, nil
+}
+
+func (lb *Loopback) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	q := r.URL.Query()
+	res := Redirect{Code: q.Get("code"), State: q.Get("state")}
+	if e := q.Get("error"); e != "" {
+		res.Err = fmt.Errorf("oauthflow: %s: %s", e, q.Get("error_description"))
+	} else if res.Code == "" {
+		res.Err = errors.New("oauthflow: no code in redirect")
+	}
+	if res.Err != nil {
+		http.Error(w, res.Err.Error(), http.StatusBadRequest)
+	} else {
+		fmt.Fprintln(w, "Authorization complete, you may close this window.")
+	}
+	select {
+	case lb.redirect <- res:
+	default:
+	}
+}
+
+// Wait blocks until the server receives a redirect or ctx is done.
+func (lb *Loopback) Wait(ctx context.Context) (Redirect, error) {
+	select {
+	case res := <-lb.redirect:
+		return res, res.Err
+	case <-ctx.Done():
+		return Redirect{}, ctx.Err()
+	}
+}
+
+// Close stops the server.
+func (lb *Loopback) Close() error {
+	return lb.server.Close()
+}
+
+// Authorize runs the whole flow using a loopback server listening on addr.
+// open is called with the URL the user must visit, e.g. to launch a browser.
+func Authorize(ctx context.Context, conf Config, addr string, open func(authURL string) error) (*Token, error) {
+	lb, err := NewLoopback(addr)
+	if err != nil {
+		return nil, err
+	}
+	defer lb.Close()
+	if conf.RedirectURL == "" {
+		conf.RedirectURL = lb.URL
+	}
+	f, err := NewFlow(conf)
+	if err != nil {
+		return nil, err
+	}
+	if err = open(f.AuthCodeURL()); err != nil {
+		return nil, err
+	}
+	res, err := lb.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return f.Exchange(ctx, res.Code, res.State)
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -22,13 +22,15 @@ package paper
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/auth"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/common"
 )
 
 // Client interface describes all routes in this namespace
@@ -36,18 +38,29 @@ type Client interface {
 	// DocsArchive : Marks the given Paper doc as archived. Note: This action
 	// can be performed or undone by anyone with edit permissions to the doc.
 	DocsArchive(arg *RefPaperDoc) (err error)
+	// DocsArchiveContext : Like `docsArchive`, but the request is bound to ctx.
+	DocsArchiveContext(ctx context.Context, arg *RefPaperDoc) (err error)
 	// DocsDownload : Exports and downloads Paper doc either as HTML or
 	// markdown.
 	DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error)
+	// DocsDownloadContext : Like `docsDownload`, but the request is bound to
+	// ctx.
+	DocsDownloadContext(ctx context.Context, arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error)
 	// DocsFolderUsersList : Lists the users who are explicitly invited to the
 	// Paper folder in which the Paper doc is contained. For private folders all
 	// users (including owner) shared on the folder are listed and for team
 	// folders all non-team users shared on the folder are returned.
 	DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error)
+	// DocsFolderUsersListContext : Like `docsFolderUsersList`, but the request
+	// is bound to ctx.
+	DocsFolderUsersListContext(ctx context.Context, arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error)
 	// DocsFolderUsersListContinue : Once a cursor has been retrieved from
 	// `docsFolderUsersList`, use this to paginate through all users on the
 	// Paper folder.
 	DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error)
+	// DocsFolderUsersListContinueContext : Like `docsFolderUsersListContinue`,
+	// but the request is bound to ctx.
+	DocsFolderUsersListContinueContext(ctx context.Context, arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error)
 	// DocsGetFolderInfo : Retrieves folder information for the given Paper doc.
 	// This includes:   - folder sharing policy; permissions for subfolders are
 	// set by the top-level folder.   - full 'filepath', i.e. the list of
@@ -55,58 +68,97 @@ type Client interface {
 	// directly containing the Paper doc.  Note: If the Paper doc is not in any
 	// folder (aka unfiled) the response will be empty.
 	DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error)
+	// DocsGetFolderInfoContext : Like `docsGetFolderInfo`, but the request is
+	// bound to ctx.
+	DocsGetFolderInfoContext(ctx context.Context, arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error)
 	// DocsList : Return the list of all Paper docs according to the argument
 	// specifications. To iterate over through the full pagination, pass the
 	// cursor to `docsListContinue`.
 	DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error)
+	// DocsListContext : Like `docsList`, but the request is bound to ctx.
+	DocsListContext(ctx context.Context, arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error)
 	// DocsListContinue : Once a cursor has been retrieved from `docsList`, use
 	// this to paginate through all Paper doc.
 	DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error)
+	// DocsListContinueContext : Like `docsListContinue`, but the request is
+	// bound to ctx.
+	DocsListContinueContext(ctx context.Context, arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error)
 	// DocsPermanentlyDelete : Permanently deletes the given Paper doc. This
 	// operation is final as the doc cannot be recovered.  Note: This action can
 	// be performed only by the doc owner.
 	DocsPermanentlyDelete(arg *RefPaperDoc) (err error)
+	// DocsPermanentlyDeleteContext : Like `docsPermanentlyDelete`, but the
+	// request is bound to ctx.
+	DocsPermanentlyDeleteContext(ctx context.Context, arg *RefPaperDoc) (err error)
 	// DocsSharingPolicyGet : Gets the default sharing policy for the given
 	// Paper doc.
 	DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy, err error)
+	// DocsSharingPolicyGetContext : Like `docsSharingPolicyGet`, but the
+	// request is bound to ctx.
+	DocsSharingPolicyGetContext(ctx context.Context, arg *RefPaperDoc) (res *SharingPolicy, err error)
 	// DocsSharingPolicySet : Sets the default sharing policy for the given
 	// Paper doc. The default 'team_sharing_policy' can be changed only by
 	// teams, omit this field for personal accounts.  Note:
 	// 'public_sharing_policy' cannot be set to the value 'disabled' because
 	// this setting can be changed only via the team admin console.
 	DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error)
+	// DocsSharingPolicySetContext : Like `docsSharingPolicySet`, but the
+	// request is bound to ctx.
+	DocsSharingPolicySetContext(ctx context.Context, arg *PaperDocSharingPolicy) (err error)
 	// DocsUsersAdd : Allows an owner or editor to add users to a Paper doc or
 	// change their permissions using their email address or Dropbox account ID.
 	// Note: The Doc owner's permissions cannot be changed.
 	DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error)
+	// DocsUsersAddContext : Like `docsUsersAdd`, but the request is bound to
+	// ctx.
+	DocsUsersAddContext(ctx context.Context, arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error)
 	// DocsUsersList : Lists all users who visited the Paper doc or users with
 	// explicit access. This call excludes users who have been removed. The list
 	// is sorted by the date of the visit or the share date. The list will
 	// include both users, the explicitly shared ones as well as those who came
 	// in using the Paper url link.
 	DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error)
+	// DocsUsersListContext : Like `docsUsersList`, but the request is bound to
+	// ctx.
+	DocsUsersListContext(ctx context.Context, arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error)
 	// DocsUsersListContinue : Once a cursor has been retrieved from
 	// `docsUsersList`, use this to paginate through all users on the Paper doc.
 	DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error)
+	// DocsUsersListContinueContext : Like `docsUsersListContinue`, but the
+	// request is bound to ctx.
+	DocsUsersListContinueContext(ctx context.Context, arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error)
 	// DocsUsersRemove : Allows an owner or editor to remove users from a Paper
 	// doc using their email address or Dropbox account ID.  Note: Doc owner
 	// cannot be removed.
 	DocsUsersRemove(arg *RemovePaperDocUser) (err error)
+	// DocsUsersRemoveContext : Like `docsUsersRemove`, but the request is bound
+	// to ctx.
+	DocsUsersRemoveContext(ctx context.Context, arg *RemovePaperDocUser) (err error)
 }
 
-type apiImpl dropbox.Context
+// ClientImpl implements Client by calling the Dropbox API
+type ClientImpl dropbox.Context
 
-//DocsArchiveAPIError is an error-wrapper for the docs/archive route
+var _ Client = (*ClientImpl)(nil)
+
+// DocsArchiveAPIError is an error-wrapper for the docs/archive route
 type DocsArchiveAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsArchive(arg *RefPaperDoc) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsArchiveAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsArchive(arg *RefPaperDoc) (err error) {
+	return dbx.DocsArchiveContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsArchiveContext(ctx context.Context, arg *RefPaperDoc) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -119,19 +171,20 @@ func (dbx *apiImpl) DocsArchive(arg *RefPaperDoc) (err error) {
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/archive", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/archive", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -142,9 +195,6 @@ func (dbx *apiImpl) DocsArchive(arg *RefPaperDoc) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -157,31 +207,36 @@ func (dbx *apiImpl) DocsArchive(arg *RefPaperDoc) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsDownloadAPIError is an error-wrapper for the docs/download route
+// DocsDownloadAPIError is an error-wrapper for the docs/download route
 type DocsDownloadAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsDownloadAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error) {
+	return dbx.DocsDownloadContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) DocsDownloadContext(ctx context.Context, arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -194,29 +249,27 @@ func (dbx *apiImpl) DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "download", true, "paper", "docs/download", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "download", "user", "paper", "docs/download", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
 
 	body := []byte(resp.Header.Get("Dropbox-API-Result"))
-	content = resp.Body
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
+		content = resp.Body
 		err = json.Unmarshal(body, &res)
 		if err != nil {
 			return
@@ -224,6 +277,11 @@ func (dbx *apiImpl) DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult
 
 		return
 	}
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
 	if resp.StatusCode == http.StatusConflict {
 		var apiError DocsDownloadAPIError
 		err = json.Unmarshal(body, &apiError)
@@ -233,31 +291,36 @@ func (dbx *apiImpl) DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsFolderUsersListAPIError is an error-wrapper for the docs/folder_users/list route
+// DocsFolderUsersListAPIError is an error-wrapper for the docs/folder_users/list route
 type DocsFolderUsersListAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsFolderUsersListAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error) {
+	return dbx.DocsFolderUsersListContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) DocsFolderUsersListContext(ctx context.Context, arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -270,19 +333,20 @@ func (dbx *apiImpl) DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUs
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/folder_users/list", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/folder_users/list", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -293,9 +357,6 @@ func (dbx *apiImpl) DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUs
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -313,31 +374,36 @@ func (dbx *apiImpl) DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUs
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsFolderUsersListContinueAPIError is an error-wrapper for the docs/folder_users/list/continue route
+// DocsFolderUsersListContinueAPIError is an error-wrapper for the docs/folder_users/list/continue route
 type DocsFolderUsersListContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *ListUsersCursorError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsFolderUsersListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error) {
+	return dbx.DocsFolderUsersListContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsFolderUsersListContinueContext(ctx context.Context, arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -350,19 +416,20 @@ func (dbx *apiImpl) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueAr
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/folder_users/list/continue", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/folder_users/list/continue", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -373,9 +440,6 @@ func (dbx *apiImpl) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueAr
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -393,31 +457,36 @@ func (dbx *apiImpl) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueAr
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsGetFolderInfoAPIError is an error-wrapper for the docs/get_folder_info route
+// DocsGetFolderInfoAPIError is an error-wrapper for the docs/get_folder_info route
 type DocsGetFolderInfoAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsGetFolderInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error) {
+	return dbx.DocsGetFolderInfoContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsGetFolderInfoContext(ctx context.Context, arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -430,19 +499,20 @@ func (dbx *apiImpl) DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingP
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/get_folder_info", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/get_folder_info", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -453,9 +523,6 @@ func (dbx *apiImpl) DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingP
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -473,31 +540,36 @@ func (dbx *apiImpl) DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingP
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsListAPIError is an error-wrapper for the docs/list route
+// DocsListAPIError is an error-wrapper for the docs/list route
 type DocsListAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsListAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error) {
+	return dbx.DocsListContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsListContext(ctx context.Context, arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -510,19 +582,20 @@ func (dbx *apiImpl) DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/list", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/list", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -533,9 +606,6 @@ func (dbx *apiImpl) DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -553,31 +623,36 @@ func (dbx *apiImpl) DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsListContinueAPIError is an error-wrapper for the docs/list/continue route
+// DocsListContinueAPIError is an error-wrapper for the docs/list/continue route
 type DocsListContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *ListDocsCursorError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error) {
+	return dbx.DocsListContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsListContinueContext(ctx context.Context, arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -590,19 +665,20 @@ func (dbx *apiImpl) DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListP
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/list/continue", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/list/continue", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -613,9 +689,6 @@ func (dbx *apiImpl) DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListP
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -633,31 +706,36 @@ func (dbx *apiImpl) DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListP
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsPermanentlyDeleteAPIError is an error-wrapper for the docs/permanently_delete route
+// DocsPermanentlyDeleteAPIError is an error-wrapper for the docs/permanently_delete route
 type DocsPermanentlyDeleteAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsPermanentlyDelete(arg *RefPaperDoc) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsPermanentlyDeleteAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsPermanentlyDelete(arg *RefPaperDoc) (err error) {
+	return dbx.DocsPermanentlyDeleteContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) DocsPermanentlyDeleteContext(ctx context.Context, arg *RefPaperDoc) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -670,19 +748,20 @@ func (dbx *apiImpl) DocsPermanentlyDelete(arg *RefPaperDoc) (err error) {
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/permanently_delete", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/permanently_delete", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -693,9 +772,6 @@ func (dbx *apiImpl) DocsPermanentlyDelete(arg *RefPaperDoc) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -708,31 +784,36 @@ func (dbx *apiImpl) DocsPermanentlyDelete(arg *RefPaperDoc) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsSharingPolicyGetAPIError is an error-wrapper for the docs/sharing_policy/get route
+// DocsSharingPolicyGetAPIError is an error-wrapper for the docs/sharing_policy/get route
 type DocsSharingPolicyGetAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsSharingPolicyGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy, err error) {
+	return dbx.DocsSharingPolicyGetContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsSharingPolicyGetContext(ctx context.Context, arg *RefPaperDoc) (res *SharingPolicy, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -745,19 +826,20 @@ func (dbx *apiImpl) DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy,
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/sharing_policy/get", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/sharing_policy/get", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -768,9 +850,6 @@ func (dbx *apiImpl) DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy,
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -788,31 +867,36 @@ func (dbx *apiImpl) DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy,
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsSharingPolicySetAPIError is an error-wrapper for the docs/sharing_policy/set route
+// DocsSharingPolicySetAPIError is an error-wrapper for the docs/sharing_policy/set route
 type DocsSharingPolicySetAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsSharingPolicySetAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error) {
+	return dbx.DocsSharingPolicySetContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsSharingPolicySetContext(ctx context.Context, arg *PaperDocSharingPolicy) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -825,19 +909,20 @@ func (dbx *apiImpl) DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error)
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/sharing_policy/set", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/sharing_policy/set", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -848,9 +933,6 @@ func (dbx *apiImpl) DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error)
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -863,31 +945,36 @@ func (dbx *apiImpl) DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error)
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsUsersAddAPIError is an error-wrapper for the docs/users/add route
+// DocsUsersAddAPIError is an error-wrapper for the docs/users/add route
 type DocsUsersAddAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsUsersAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error) {
+	return dbx.DocsUsersAddContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsUsersAddContext(ctx context.Context, arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -900,19 +987,20 @@ func (dbx *apiImpl) DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMe
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/users/add", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/users/add", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -923,9 +1011,6 @@ func (dbx *apiImpl) DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMe
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -943,31 +1028,36 @@ func (dbx *apiImpl) DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMe
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsUsersListAPIError is an error-wrapper for the docs/users/list route
+// DocsUsersListAPIError is an error-wrapper for the docs/users/list route
 type DocsUsersListAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsUsersListAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error) {
+	return dbx.DocsUsersListContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsUsersListContext(ctx context.Context, arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -980,19 +1070,20 @@ func (dbx *apiImpl) DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersO
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/users/list", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/users/list", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1003,9 +1094,6 @@ func (dbx *apiImpl) DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersO
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1023,31 +1111,36 @@ func (dbx *apiImpl) DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersO
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsUsersListContinueAPIError is an error-wrapper for the docs/users/list/continue route
+// DocsUsersListContinueAPIError is an error-wrapper for the docs/users/list/continue route
 type DocsUsersListContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *ListUsersCursorError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsUsersListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error) {
+	return dbx.DocsUsersListContinueContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) DocsUsersListContinueContext(ctx context.Context, arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1060,19 +1153,20 @@ func (dbx *apiImpl) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs)
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/users/list/continue", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/users/list/continue", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1083,9 +1177,6 @@ func (dbx *apiImpl) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs)
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1103,31 +1194,36 @@ func (dbx *apiImpl) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs)
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DocsUsersRemoveAPIError is an error-wrapper for the docs/users/remove route
+// DocsUsersRemoveAPIError is an error-wrapper for the docs/users/remove route
 type DocsUsersRemoveAPIError struct {
 	dropbox.APIError
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsUsersRemove(arg *RemovePaperDocUser) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DocsUsersRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DocsUsersRemove(arg *RemovePaperDocUser) (err error) {
+	return dbx.DocsUsersRemoveContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DocsUsersRemoveContext(ctx context.Context, arg *RemovePaperDocUser) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1140,19 +1236,20 @@ func (dbx *apiImpl) DocsUsersRemove(arg *RemovePaperDocUser) (err error) {
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "paper", "docs/users/remove", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "paper", "docs/users/remove", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1163,9 +1260,6 @@ func (dbx *apiImpl) DocsUsersRemove(arg *RemovePaperDocUser) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -1178,22 +1272,26 @@ func (dbx *apiImpl) DocsUsersRemove(arg *RemovePaperDocUser) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
 // New returns a Client implementation for this namespace
-func New(c dropbox.Config) *apiImpl {
-	ctx := apiImpl(dropbox.NewContext(c))
+func New(c dropbox.Config) *ClientImpl {
+	return NewFromContext(dropbox.NewContext(c))
+}
+
+// NewFromContext returns a Client implementation for this namespace
+// that shares the http.Client and tokens of c
+func NewFromContext(c dropbox.Context) *ClientImpl {
+	ctx := ClientImpl(c)
 	return &ctx
 }
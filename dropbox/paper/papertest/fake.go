@@ -0,0 +1,312 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package papertest provides a fake paper.Client for tests.
+package papertest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/paper"
+)
+
+// ErrNotStubbed is returned by the routes of a Fake that are not stubbed
+var ErrNotStubbed = errors.New("papertest: route not stubbed")
+
+// Call is a call made to a Fake
+type Call struct {
+	// Name of the route, e.g. "docs/archive"
+	Route string
+	// Argument of the call (nil for routes without one)
+	Arg interface{}
+	// Content passed to upload routes
+	Content io.Reader
+}
+
+// Fake is a paper.Client for tests. Each route calls the function in the
+// matching field, e.g. DocsArchiveFunc, or returns ErrNotStubbed if the field
+// is nil. All calls are recorded. The zero value is ready to use.
+type Fake struct {
+	// Stubs DocsArchive and DocsArchiveContext
+	DocsArchiveFunc func(ctx context.Context, arg *paper.RefPaperDoc) (err error)
+	// Stubs DocsDownload and DocsDownloadContext
+	DocsDownloadFunc func(ctx context.Context, arg *paper.PaperDocExport) (res *paper.PaperDocExportResult, content io.ReadCloser, err error)
+	// Stubs DocsFolderUsersList and DocsFolderUsersListContext
+	DocsFolderUsersListFunc func(ctx context.Context, arg *paper.ListUsersOnFolderArgs) (res *paper.ListUsersOnFolderResponse, err error)
+	// Stubs DocsFolderUsersListContinue and DocsFolderUsersListContinueContext
+	DocsFolderUsersListContinueFunc func(ctx context.Context, arg *paper.ListUsersOnFolderContinueArgs) (res *paper.ListUsersOnFolderResponse, err error)
+	// Stubs DocsGetFolderInfo and DocsGetFolderInfoContext
+	DocsGetFolderInfoFunc func(ctx context.Context, arg *paper.RefPaperDoc) (res *paper.FoldersContainingPaperDoc, err error)
+	// Stubs DocsList and DocsListContext
+	DocsListFunc func(ctx context.Context, arg *paper.ListPaperDocsArgs) (res *paper.ListPaperDocsResponse, err error)
+	// Stubs DocsListContinue and DocsListContinueContext
+	DocsListContinueFunc func(ctx context.Context, arg *paper.ListPaperDocsContinueArgs) (res *paper.ListPaperDocsResponse, err error)
+	// Stubs DocsPermanentlyDelete and DocsPermanentlyDeleteContext
+	DocsPermanentlyDeleteFunc func(ctx context.Context, arg *paper.RefPaperDoc) (err error)
+	// Stubs DocsSharingPolicyGet and DocsSharingPolicyGetContext
+	DocsSharingPolicyGetFunc func(ctx context.Context, arg *paper.RefPaperDoc) (res *paper.SharingPolicy, err error)
+	// Stubs DocsSharingPolicySet and DocsSharingPolicySetContext
+	DocsSharingPolicySetFunc func(ctx context.Context, arg *paper.PaperDocSharingPolicy) (err error)
+	// Stubs DocsUsersAdd and DocsUsersAddContext
+	DocsUsersAddFunc func(ctx context.Context, arg *paper.AddPaperDocUser) (res []*paper.AddPaperDocUserMemberResult, err error)
+	// Stubs DocsUsersList and DocsUsersListContext
+	DocsUsersListFunc func(ctx context.Context, arg *paper.ListUsersOnPaperDocArgs) (res *paper.ListUsersOnPaperDocResponse, err error)
+	// Stubs DocsUsersListContinue and DocsUsersListContinueContext
+	DocsUsersListContinueFunc func(ctx context.Context, arg *paper.ListUsersOnPaperDocContinueArgs) (res *paper.ListUsersOnPaperDocResponse, err error)
+	// Stubs DocsUsersRemove and DocsUsersRemoveContext
+	DocsUsersRemoveFunc func(ctx context.Context, arg *paper.RemovePaperDocUser) (err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ paper.Client = (*Fake)(nil)
+
+// Calls returns the calls made so far, or only those to the given route
+func (f *Fake) Calls(route ...string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []Call
+	for _, c := range f.calls {
+		if len(route) == 0 || c.Route == route[0] {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (f *Fake) record(route string, arg interface{}, content io.Reader) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, Call{route, arg, content})
+}
+
+// DocsArchive implements paper.Client
+func (f *Fake) DocsArchive(arg *paper.RefPaperDoc) (err error) {
+	return f.DocsArchiveContext(context.Background(), arg)
+}
+
+// DocsArchiveContext implements paper.Client
+func (f *Fake) DocsArchiveContext(ctx context.Context, arg *paper.RefPaperDoc) (err error) {
+	f.record("docs/archive", arg, nil)
+	if f.DocsArchiveFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsArchiveFunc(ctx, arg)
+}
+
+// DocsDownload implements paper.Client
+func (f *Fake) DocsDownload(arg *paper.PaperDocExport) (res *paper.PaperDocExportResult, content io.ReadCloser, err error) {
+	return f.DocsDownloadContext(context.Background(), arg)
+}
+
+// DocsDownloadContext implements paper.Client
+func (f *Fake) DocsDownloadContext(ctx context.Context, arg *paper.PaperDocExport) (res *paper.PaperDocExportResult, content io.ReadCloser, err error) {
+	f.record("docs/download", arg, nil)
+	if f.DocsDownloadFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsDownloadFunc(ctx, arg)
+}
+
+// DocsFolderUsersList implements paper.Client
+func (f *Fake) DocsFolderUsersList(arg *paper.ListUsersOnFolderArgs) (res *paper.ListUsersOnFolderResponse, err error) {
+	return f.DocsFolderUsersListContext(context.Background(), arg)
+}
+
+// DocsFolderUsersListContext implements paper.Client
+func (f *Fake) DocsFolderUsersListContext(ctx context.Context, arg *paper.ListUsersOnFolderArgs) (res *paper.ListUsersOnFolderResponse, err error) {
+	f.record("docs/folder_users/list", arg, nil)
+	if f.DocsFolderUsersListFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsFolderUsersListFunc(ctx, arg)
+}
+
+// DocsFolderUsersListContinue implements paper.Client
+func (f *Fake) DocsFolderUsersListContinue(arg *paper.ListUsersOnFolderContinueArgs) (res *paper.ListUsersOnFolderResponse, err error) {
+	return f.DocsFolderUsersListContinueContext(context.Background(), arg)
+}
+
+// DocsFolderUsersListContinueContext implements paper.Client
+func (f *Fake) DocsFolderUsersListContinueContext(ctx context.Context, arg *paper.ListUsersOnFolderContinueArgs) (res *paper.ListUsersOnFolderResponse, err error) {
+	f.record("docs/folder_users/list/continue", arg, nil)
+	if f.DocsFolderUsersListContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsFolderUsersListContinueFunc(ctx, arg)
+}
+
+// DocsGetFolderInfo implements paper.Client
+func (f *Fake) DocsGetFolderInfo(arg *paper.RefPaperDoc) (res *paper.FoldersContainingPaperDoc, err error) {
+	return f.DocsGetFolderInfoContext(context.Background(), arg)
+}
+
+// DocsGetFolderInfoContext implements paper.Client
+func (f *Fake) DocsGetFolderInfoContext(ctx context.Context, arg *paper.RefPaperDoc) (res *paper.FoldersContainingPaperDoc, err error) {
+	f.record("docs/get_folder_info", arg, nil)
+	if f.DocsGetFolderInfoFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsGetFolderInfoFunc(ctx, arg)
+}
+
+// DocsList implements paper.Client
+func (f *Fake) DocsList(arg *paper.ListPaperDocsArgs) (res *paper.ListPaperDocsResponse, err error) {
+	return f.DocsListContext(context.Background(), arg)
+}
+
+// DocsListContext implements paper.Client
+func (f *Fake) DocsListContext(ctx context.Context, arg *paper.ListPaperDocsArgs) (res *paper.ListPaperDocsResponse, err error) {
+	f.record("docs/list", arg, nil)
+	if f.DocsListFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsListFunc(ctx, arg)
+}
+
+// DocsListContinue implements paper.Client
+func (f *Fake) DocsListContinue(arg *paper.ListPaperDocsContinueArgs) (res *paper.ListPaperDocsResponse, err error) {
+	return f.DocsListContinueContext(context.Background(), arg)
+}
+
+// DocsListContinueContext implements paper.Client
+func (f *Fake) DocsListContinueContext(ctx context.Context, arg *paper.ListPaperDocsContinueArgs) (res *paper.ListPaperDocsResponse, err error) {
+	f.record("docs/list/continue", arg, nil)
+	if f.DocsListContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsListContinueFunc(ctx, arg)
+}
+
+// DocsPermanentlyDelete implements paper.Client
+func (f *Fake) DocsPermanentlyDelete(arg *paper.RefPaperDoc) (err error) {
+	return f.DocsPermanentlyDeleteContext(context.Background(), arg)
+}
+
+// DocsPermanentlyDeleteContext implements paper.Client
+func (f *Fake) DocsPermanentlyDeleteContext(ctx context.Context, arg *paper.RefPaperDoc) (err error) {
+	f.record("docs/permanently_delete", arg, nil)
+	if f.DocsPermanentlyDeleteFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsPermanentlyDeleteFunc(ctx, arg)
+}
+
+// DocsSharingPolicyGet implements paper.Client
+func (f *Fake) DocsSharingPolicyGet(arg *paper.RefPaperDoc) (res *paper.SharingPolicy, err error) {
+	return f.DocsSharingPolicyGetContext(context.Background(), arg)
+}
+
+// DocsSharingPolicyGetContext implements paper.Client
+func (f *Fake) DocsSharingPolicyGetContext(ctx context.Context, arg *paper.RefPaperDoc) (res *paper.SharingPolicy, err error) {
+	f.record("docs/sharing_policy/get", arg, nil)
+	if f.DocsSharingPolicyGetFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsSharingPolicyGetFunc(ctx, arg)
+}
+
+// DocsSharingPolicySet implements paper.Client
+func (f *Fake) DocsSharingPolicySet(arg *paper.PaperDocSharingPolicy) (err error) {
+	return f.DocsSharingPolicySetContext(context.Background(), arg)
+}
+
+// DocsSharingPolicySetContext implements paper.Client
+func (f *Fake) DocsSharingPolicySetContext(ctx context.Context, arg *paper.PaperDocSharingPolicy) (err error) {
+	f.record("docs/sharing_policy/set", arg, nil)
+	if f.DocsSharingPolicySetFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsSharingPolicySetFunc(ctx, arg)
+}
+
+// DocsUsersAdd implements paper.Client
+func (f *Fake) DocsUsersAdd(arg *paper.AddPaperDocUser) (res []*paper.AddPaperDocUserMemberResult, err error) {
+	return f.DocsUsersAddContext(context.Background(), arg)
+}
+
+// DocsUsersAddContext implements paper.Client
+func (f *Fake) DocsUsersAddContext(ctx context.Context, arg *paper.AddPaperDocUser) (res []*paper.AddPaperDocUserMemberResult, err error) {
+	f.record("docs/users/add", arg, nil)
+	if f.DocsUsersAddFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsUsersAddFunc(ctx, arg)
+}
+
+// DocsUsersList implements paper.Client
+func (f *Fake) DocsUsersList(arg *paper.ListUsersOnPaperDocArgs) (res *paper.ListUsersOnPaperDocResponse, err error) {
+	return f.DocsUsersListContext(context.Background(), arg)
+}
+
+// DocsUsersListContext implements paper.Client
+func (f *Fake) DocsUsersListContext(ctx context.Context, arg *paper.ListUsersOnPaperDocArgs) (res *paper.ListUsersOnPaperDocResponse, err error) {
+	f.record("docs/users/list", arg, nil)
+	if f.DocsUsersListFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsUsersListFunc(ctx, arg)
+}
+
+// DocsUsersListContinue implements paper.Client
+func (f *Fake) DocsUsersListContinue(arg *paper.ListUsersOnPaperDocContinueArgs) (res *paper.ListUsersOnPaperDocResponse, err error) {
+	return f.DocsUsersListContinueContext(context.Background(), arg)
+}
+
+// DocsUsersListContinueContext implements paper.Client
+func (f *Fake) DocsUsersListContinueContext(ctx context.Context, arg *paper.ListUsersOnPaperDocContinueArgs) (res *paper.ListUsersOnPaperDocResponse, err error) {
+	f.record("docs/users/list/continue", arg, nil)
+	if f.DocsUsersListContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsUsersListContinueFunc(ctx, arg)
+}
+
+// DocsUsersRemove implements paper.Client
+func (f *Fake) DocsUsersRemove(arg *paper.RemovePaperDocUser) (err error) {
+	return f.DocsUsersRemoveContext(context.Background(), arg)
+}
+
+// DocsUsersRemoveContext implements paper.Client
+func (f *Fake) DocsUsersRemoveContext(ctx context.Context, arg *paper.RemovePaperDocUser) (err error) {
+	f.record("docs/users/remove", arg, nil)
+	if f.DocsUsersRemoveFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DocsUsersRemoveFunc(ctx, arg)
+}
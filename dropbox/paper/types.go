@@ -37,20 +37,34 @@ type AddMember struct {
 	// Member : User which should be added to the Paper doc. Specify only email
 	// address or Dropbox account ID.
 	Member *sharing.MemberSelector `json:"member"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAddMember returns a new AddMember instance
 func NewAddMember(Member *sharing.MemberSelector) *AddMember {
 	s := new(AddMember)
 	s.Member = Member
-	s.PermissionLevel = &PaperDocPermissionLevel{Tagged: dropbox.Tagged{"edit"}}
+	s.PermissionLevel = &PaperDocPermissionLevel{Tagged: dropbox.Tagged{Tag: "edit"}}
 	return s
 }
 
+// MarshalJSON serializes a AddMember instance
+func (u AddMember) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AddMember instance
+func (u *AddMember) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RefPaperDoc : has no documentation (yet)
 type RefPaperDoc struct {
 	// DocId : The Paper doc ID.
 	DocId string `json:"doc_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRefPaperDoc returns a new RefPaperDoc instance
@@ -60,6 +74,21 @@ func NewRefPaperDoc(DocId string) *RefPaperDoc {
 	return s
 }
 
+// MarshalJSON serializes a RefPaperDoc instance
+func (u RefPaperDoc) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RefPaperDoc instance
+func (u *RefPaperDoc) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RefPaperDoc) Validate() error {
+	return nil
+}
+
 // AddPaperDocUser : has no documentation (yet)
 type AddPaperDocUser struct {
 	RefPaperDoc
@@ -72,6 +101,8 @@ type AddPaperDocUser struct {
 	// Quiet : Clients should set this to true if no email message shall be sent
 	// to added users.
 	Quiet bool `json:"quiet"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAddPaperDocUser returns a new AddPaperDocUser instance
@@ -83,12 +114,29 @@ func NewAddPaperDocUser(DocId string, Members []*AddMember) *AddPaperDocUser {
 	return s
 }
 
+// MarshalJSON serializes a AddPaperDocUser instance
+func (u AddPaperDocUser) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AddPaperDocUser instance
+func (u *AddPaperDocUser) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *AddPaperDocUser) Validate() error {
+	return nil
+}
+
 // AddPaperDocUserMemberResult : Per-member result for `docsUsersAdd`.
 type AddPaperDocUserMemberResult struct {
 	// Member : One of specified input members.
 	Member *sharing.MemberSelector `json:"member"`
 	// Result : The outcome of the action on this member.
 	Result *AddPaperDocUserResult `json:"result"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAddPaperDocUserMemberResult returns a new AddPaperDocUserMemberResult instance
@@ -99,9 +147,30 @@ func NewAddPaperDocUserMemberResult(Member *sharing.MemberSelector, Result *AddP
 	return s
 }
 
+// MarshalJSON serializes a AddPaperDocUserMemberResult instance
+func (u AddPaperDocUserMemberResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AddPaperDocUserMemberResult instance
+func (u *AddPaperDocUserMemberResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // AddPaperDocUserResult : has no documentation (yet)
 type AddPaperDocUserResult struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AddPaperDocUserResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AddPaperDocUserResult
@@ -116,6 +185,32 @@ const (
 	AddPaperDocUserResultOther                      = "other"
 )
 
+// UnmarshalJSON deserializes into a AddPaperDocUserResult instance
+func (u *AddPaperDocUserResult) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "success", "unknown_error", "sharing_outside_team_disabled", "daily_limit_reached", "user_is_owner", "failed_user_data_retrieval", "permission_already_granted", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a AddPaperDocUserResult instance
+func (u AddPaperDocUserResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // Cursor : has no documentation (yet)
 type Cursor struct {
 	// Value : The actual cursor value.
@@ -134,6 +229,8 @@ type Cursor struct {
 	// and the modified time can be changed while the iteration is happening
 	// thus altering the results.
 	Expiration time.Time `json:"expiration,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewCursor returns a new Cursor instance
@@ -143,9 +240,30 @@ func NewCursor(Value string) *Cursor {
 	return s
 }
 
+// MarshalJSON serializes a Cursor instance
+func (u Cursor) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a Cursor instance
+func (u *Cursor) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperApiBaseError : has no documentation (yet)
 type PaperApiBaseError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PaperApiBaseError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PaperApiBaseError
@@ -154,9 +272,53 @@ const (
 	PaperApiBaseErrorOther                   = "other"
 )
 
+// UnmarshalJSON deserializes into a PaperApiBaseError instance
+func (u *PaperApiBaseError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "insufficient_permissions", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PaperApiBaseError instance
+func (u PaperApiBaseError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsPaperApiBaseError reports whether err carries a PaperApiBaseError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsPaperApiBaseError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*PaperApiBaseError)(nil), tags...)
+}
+
 // DocLookupError : has no documentation (yet)
 type DocLookupError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DocLookupError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DocLookupError
@@ -164,9 +326,53 @@ const (
 	DocLookupErrorDocNotFound = "doc_not_found"
 )
 
+// UnmarshalJSON deserializes into a DocLookupError instance
+func (u *DocLookupError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "doc_not_found":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a DocLookupError instance
+func (u DocLookupError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsDocLookupError reports whether err carries a DocLookupError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsDocLookupError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*DocLookupError)(nil), tags...)
+}
+
 // DocSubscriptionLevel : The subscription level of a Paper doc.
 type DocSubscriptionLevel struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DocSubscriptionLevel) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DocSubscriptionLevel
@@ -177,9 +383,46 @@ const (
 	DocSubscriptionLevelNoEmail = "no_email"
 )
 
+// UnmarshalJSON deserializes into a DocSubscriptionLevel instance
+func (u *DocSubscriptionLevel) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "default", "ignore", "every", "no_email":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a DocSubscriptionLevel instance
+func (u DocSubscriptionLevel) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ExportFormat : The desired export format of the Paper doc.
 type ExportFormat struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ExportFormat) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ExportFormat
@@ -189,12 +432,40 @@ const (
 	ExportFormatOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a ExportFormat instance
+func (u *ExportFormat) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "html", "markdown", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ExportFormat instance
+func (u ExportFormat) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // Folder : Data structure representing a Paper folder.
 type Folder struct {
 	// Id : Paper folder ID. This ID uniquely identifies the folder.
 	Id string `json:"id"`
 	// Name : Paper folder name.
 	Name string `json:"name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFolder returns a new Folder instance
@@ -205,10 +476,31 @@ func NewFolder(Id string, Name string) *Folder {
 	return s
 }
 
+// MarshalJSON serializes a Folder instance
+func (u Folder) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a Folder instance
+func (u *Folder) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FolderSharingPolicyType : The sharing policy of a Paper folder.  Note: The
 // sharing policy of subfolders is inherited from the root folder.
 type FolderSharingPolicyType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FolderSharingPolicyType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FolderSharingPolicyType
@@ -217,9 +509,46 @@ const (
 	FolderSharingPolicyTypeInviteOnly = "invite_only"
 )
 
+// UnmarshalJSON deserializes into a FolderSharingPolicyType instance
+func (u *FolderSharingPolicyType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "team", "invite_only":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a FolderSharingPolicyType instance
+func (u FolderSharingPolicyType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // FolderSubscriptionLevel : The subscription level of a Paper folder.
 type FolderSubscriptionLevel struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FolderSubscriptionLevel) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FolderSubscriptionLevel
@@ -230,6 +559,32 @@ const (
 	FolderSubscriptionLevelWeeklyEmails = "weekly_emails"
 )
 
+// UnmarshalJSON deserializes into a FolderSubscriptionLevel instance
+func (u *FolderSubscriptionLevel) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "none", "activity_only", "daily_emails", "weekly_emails":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a FolderSubscriptionLevel instance
+func (u FolderSubscriptionLevel) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // FoldersContainingPaperDoc : Metadata about Paper folders containing the
 // specififed Paper doc.
 type FoldersContainingPaperDoc struct {
@@ -239,6 +594,8 @@ type FoldersContainingPaperDoc struct {
 	// Folders : The folder path. If present the first folder is the root
 	// folder.
 	Folders []*Folder `json:"folders,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFoldersContainingPaperDoc returns a new FoldersContainingPaperDoc instance
@@ -247,12 +604,24 @@ func NewFoldersContainingPaperDoc() *FoldersContainingPaperDoc {
 	return s
 }
 
+// MarshalJSON serializes a FoldersContainingPaperDoc instance
+func (u FoldersContainingPaperDoc) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FoldersContainingPaperDoc instance
+func (u *FoldersContainingPaperDoc) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // InviteeInfoWithPermissionLevel : has no documentation (yet)
 type InviteeInfoWithPermissionLevel struct {
 	// Invitee : Email address invited to the Paper doc.
 	Invitee *sharing.InviteeInfo `json:"invitee"`
 	// PermissionLevel : Permission level for the invitee.
 	PermissionLevel *PaperDocPermissionLevel `json:"permission_level"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewInviteeInfoWithPermissionLevel returns a new InviteeInfoWithPermissionLevel instance
@@ -263,11 +632,32 @@ func NewInviteeInfoWithPermissionLevel(Invitee *sharing.InviteeInfo, PermissionL
 	return s
 }
 
+// MarshalJSON serializes a InviteeInfoWithPermissionLevel instance
+func (u InviteeInfoWithPermissionLevel) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a InviteeInfoWithPermissionLevel instance
+func (u *InviteeInfoWithPermissionLevel) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListDocsCursorError : has no documentation (yet)
 type ListDocsCursorError struct {
 	dropbox.Tagged
 	// CursorError : has no documentation (yet)
 	CursorError *PaperApiCursorError `json:"cursor_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListDocsCursorError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListDocsCursorError
@@ -288,7 +678,7 @@ func (u *ListDocsCursorError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "cursor_error":
 		err = json.Unmarshal(w.CursorError, &u.CursorError)
@@ -296,10 +686,35 @@ func (u *ListDocsCursorError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListDocsCursorError instance
+func (u ListDocsCursorError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "cursor_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			CursorError *PaperApiCursorError `json:"cursor_error"`
+		}{u.Tagged, u.CursorError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListDocsCursorError reports whether err carries a ListDocsCursorError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListDocsCursorError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListDocsCursorError)(nil), tags...)
+}
+
 // ListPaperDocsArgs : has no documentation (yet)
 type ListPaperDocsArgs struct {
 	// FilterBy : Allows user to specify how the Paper docs should be filtered.
@@ -312,23 +727,42 @@ type ListPaperDocsArgs struct {
 	// retrieved per batch is 1000. Higher value results in invalid arguments
 	// error.
 	Limit int32 `json:"limit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListPaperDocsArgs returns a new ListPaperDocsArgs instance
 func NewListPaperDocsArgs() *ListPaperDocsArgs {
 	s := new(ListPaperDocsArgs)
-	s.FilterBy = &ListPaperDocsFilterBy{Tagged: dropbox.Tagged{"docs_accessed"}}
-	s.SortBy = &ListPaperDocsSortBy{Tagged: dropbox.Tagged{"accessed"}}
-	s.SortOrder = &ListPaperDocsSortOrder{Tagged: dropbox.Tagged{"ascending"}}
+	s.FilterBy = &ListPaperDocsFilterBy{Tagged: dropbox.Tagged{Tag: "docs_accessed"}}
+	s.SortBy = &ListPaperDocsSortBy{Tagged: dropbox.Tagged{Tag: "accessed"}}
+	s.SortOrder = &ListPaperDocsSortOrder{Tagged: dropbox.Tagged{Tag: "ascending"}}
 	s.Limit = 1000
 	return s
 }
 
+// MarshalJSON serializes a ListPaperDocsArgs instance
+func (u ListPaperDocsArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListPaperDocsArgs instance
+func (u *ListPaperDocsArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListPaperDocsArgs) Validate() error {
+	return nil
+}
+
 // ListPaperDocsContinueArgs : has no documentation (yet)
 type ListPaperDocsContinueArgs struct {
 	// Cursor : The cursor obtained from `docsList` or `docsListContinue`.
 	// Allows for pagination.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListPaperDocsContinueArgs returns a new ListPaperDocsContinueArgs instance
@@ -338,9 +772,35 @@ func NewListPaperDocsContinueArgs(Cursor string) *ListPaperDocsContinueArgs {
 	return s
 }
 
+// MarshalJSON serializes a ListPaperDocsContinueArgs instance
+func (u ListPaperDocsContinueArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListPaperDocsContinueArgs instance
+func (u *ListPaperDocsContinueArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListPaperDocsContinueArgs) Validate() error {
+	return nil
+}
+
 // ListPaperDocsFilterBy : has no documentation (yet)
 type ListPaperDocsFilterBy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListPaperDocsFilterBy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListPaperDocsFilterBy
@@ -350,6 +810,32 @@ const (
 	ListPaperDocsFilterByOther        = "other"
 )
 
+// UnmarshalJSON deserializes into a ListPaperDocsFilterBy instance
+func (u *ListPaperDocsFilterBy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "docs_accessed", "docs_created", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListPaperDocsFilterBy instance
+func (u ListPaperDocsFilterBy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ListPaperDocsResponse : has no documentation (yet)
 type ListPaperDocsResponse struct {
 	// DocIds : The list of Paper doc IDs that can be used to access the given
@@ -365,6 +851,8 @@ type ListPaperDocsResponse struct {
 	// set to False please allow some delay before making another call to
 	// `docsListContinue`.
 	HasMore bool `json:"has_more"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListPaperDocsResponse returns a new ListPaperDocsResponse instance
@@ -376,9 +864,30 @@ func NewListPaperDocsResponse(DocIds []string, Cursor *Cursor, HasMore bool) *Li
 	return s
 }
 
+// MarshalJSON serializes a ListPaperDocsResponse instance
+func (u ListPaperDocsResponse) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListPaperDocsResponse instance
+func (u *ListPaperDocsResponse) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListPaperDocsSortBy : has no documentation (yet)
 type ListPaperDocsSortBy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListPaperDocsSortBy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListPaperDocsSortBy
@@ -389,9 +898,46 @@ const (
 	ListPaperDocsSortByOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a ListPaperDocsSortBy instance
+func (u *ListPaperDocsSortBy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "accessed", "modified", "created", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListPaperDocsSortBy instance
+func (u ListPaperDocsSortBy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ListPaperDocsSortOrder : has no documentation (yet)
 type ListPaperDocsSortOrder struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListPaperDocsSortOrder) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListPaperDocsSortOrder
@@ -401,11 +947,48 @@ const (
 	ListPaperDocsSortOrderOther      = "other"
 )
 
+// UnmarshalJSON deserializes into a ListPaperDocsSortOrder instance
+func (u *ListPaperDocsSortOrder) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "ascending", "descending", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListPaperDocsSortOrder instance
+func (u ListPaperDocsSortOrder) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ListUsersCursorError : has no documentation (yet)
 type ListUsersCursorError struct {
 	dropbox.Tagged
 	// CursorError : has no documentation (yet)
 	CursorError *PaperApiCursorError `json:"cursor_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListUsersCursorError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListUsersCursorError
@@ -426,7 +1009,7 @@ func (u *ListUsersCursorError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "cursor_error":
 		err = json.Unmarshal(w.CursorError, &u.CursorError)
@@ -434,10 +1017,35 @@ func (u *ListUsersCursorError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "doc_not_found":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListUsersCursorError instance
+func (u ListUsersCursorError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "cursor_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			CursorError *PaperApiCursorError `json:"cursor_error"`
+		}{u.Tagged, u.CursorError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListUsersCursorError reports whether err carries a ListUsersCursorError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListUsersCursorError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListUsersCursorError)(nil), tags...)
+}
+
 // ListUsersOnFolderArgs : has no documentation (yet)
 type ListUsersOnFolderArgs struct {
 	RefPaperDoc
@@ -445,6 +1053,8 @@ type ListUsersOnFolderArgs struct {
 	// retrieved per batch is 1000. Higher value results in invalid arguments
 	// error.
 	Limit int32 `json:"limit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListUsersOnFolderArgs returns a new ListUsersOnFolderArgs instance
@@ -455,12 +1065,29 @@ func NewListUsersOnFolderArgs(DocId string) *ListUsersOnFolderArgs {
 	return s
 }
 
+// MarshalJSON serializes a ListUsersOnFolderArgs instance
+func (u ListUsersOnFolderArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListUsersOnFolderArgs instance
+func (u *ListUsersOnFolderArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListUsersOnFolderArgs) Validate() error {
+	return nil
+}
+
 // ListUsersOnFolderContinueArgs : has no documentation (yet)
 type ListUsersOnFolderContinueArgs struct {
 	RefPaperDoc
 	// Cursor : The cursor obtained from `docsFolderUsersList` or
 	// `docsFolderUsersListContinue`. Allows for pagination.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListUsersOnFolderContinueArgs returns a new ListUsersOnFolderContinueArgs instance
@@ -471,6 +1098,21 @@ func NewListUsersOnFolderContinueArgs(DocId string, Cursor string) *ListUsersOnF
 	return s
 }
 
+// MarshalJSON serializes a ListUsersOnFolderContinueArgs instance
+func (u ListUsersOnFolderContinueArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListUsersOnFolderContinueArgs instance
+func (u *ListUsersOnFolderContinueArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListUsersOnFolderContinueArgs) Validate() error {
+	return nil
+}
+
 // ListUsersOnFolderResponse : has no documentation (yet)
 type ListUsersOnFolderResponse struct {
 	// Invitees : List of email addresses that are invited on the Paper folder.
@@ -486,6 +1128,8 @@ type ListUsersOnFolderResponse struct {
 	// results. If set to False please allow some delay before making another
 	// call to `docsFolderUsersListContinue`.
 	HasMore bool `json:"has_more"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListUsersOnFolderResponse returns a new ListUsersOnFolderResponse instance
@@ -498,6 +1142,16 @@ func NewListUsersOnFolderResponse(Invitees []*sharing.InviteeInfo, Users []*shar
 	return s
 }
 
+// MarshalJSON serializes a ListUsersOnFolderResponse instance
+func (u ListUsersOnFolderResponse) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListUsersOnFolderResponse instance
+func (u *ListUsersOnFolderResponse) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListUsersOnPaperDocArgs : has no documentation (yet)
 type ListUsersOnPaperDocArgs struct {
 	RefPaperDoc
@@ -508,6 +1162,8 @@ type ListUsersOnPaperDocArgs struct {
 	// FilterBy : Specify this attribute if you want to obtain users that have
 	// already accessed the Paper doc.
 	FilterBy *UserOnPaperDocFilter `json:"filter_by"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListUsersOnPaperDocArgs returns a new ListUsersOnPaperDocArgs instance
@@ -515,16 +1171,33 @@ func NewListUsersOnPaperDocArgs(DocId string) *ListUsersOnPaperDocArgs {
 	s := new(ListUsersOnPaperDocArgs)
 	s.DocId = DocId
 	s.Limit = 1000
-	s.FilterBy = &UserOnPaperDocFilter{Tagged: dropbox.Tagged{"shared"}}
+	s.FilterBy = &UserOnPaperDocFilter{Tagged: dropbox.Tagged{Tag: "shared"}}
 	return s
 }
 
+// MarshalJSON serializes a ListUsersOnPaperDocArgs instance
+func (u ListUsersOnPaperDocArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListUsersOnPaperDocArgs instance
+func (u *ListUsersOnPaperDocArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListUsersOnPaperDocArgs) Validate() error {
+	return nil
+}
+
 // ListUsersOnPaperDocContinueArgs : has no documentation (yet)
 type ListUsersOnPaperDocContinueArgs struct {
 	RefPaperDoc
 	// Cursor : The cursor obtained from `docsUsersList` or
 	// `docsUsersListContinue`. Allows for pagination.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListUsersOnPaperDocContinueArgs returns a new ListUsersOnPaperDocContinueArgs instance
@@ -535,6 +1208,21 @@ func NewListUsersOnPaperDocContinueArgs(DocId string, Cursor string) *ListUsersO
 	return s
 }
 
+// MarshalJSON serializes a ListUsersOnPaperDocContinueArgs instance
+func (u ListUsersOnPaperDocContinueArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListUsersOnPaperDocContinueArgs instance
+func (u *ListUsersOnPaperDocContinueArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListUsersOnPaperDocContinueArgs) Validate() error {
+	return nil
+}
+
 // ListUsersOnPaperDocResponse : has no documentation (yet)
 type ListUsersOnPaperDocResponse struct {
 	// Invitees : List of email addresses with their respective permission
@@ -555,6 +1243,8 @@ type ListUsersOnPaperDocResponse struct {
 	// If set to False please allow some delay before making another call to
 	// `docsUsersListContinue`.
 	HasMore bool `json:"has_more"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListUsersOnPaperDocResponse returns a new ListUsersOnPaperDocResponse instance
@@ -568,9 +1258,30 @@ func NewListUsersOnPaperDocResponse(Invitees []*InviteeInfoWithPermissionLevel,
 	return s
 }
 
+// MarshalJSON serializes a ListUsersOnPaperDocResponse instance
+func (u ListUsersOnPaperDocResponse) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListUsersOnPaperDocResponse instance
+func (u *ListUsersOnPaperDocResponse) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperApiCursorError : has no documentation (yet)
 type PaperApiCursorError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PaperApiCursorError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PaperApiCursorError
@@ -582,11 +1293,46 @@ const (
 	PaperApiCursorErrorOther             = "other"
 )
 
+// UnmarshalJSON deserializes into a PaperApiCursorError instance
+func (u *PaperApiCursorError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "expired_cursor", "invalid_cursor", "wrong_user_in_cursor", "reset", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PaperApiCursorError instance
+func (u PaperApiCursorError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsPaperApiCursorError reports whether err carries a PaperApiCursorError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsPaperApiCursorError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*PaperApiCursorError)(nil), tags...)
+}
+
 // PaperDocExport : has no documentation (yet)
 type PaperDocExport struct {
 	RefPaperDoc
 	// ExportFormat : has no documentation (yet)
 	ExportFormat *ExportFormat `json:"export_format"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocExport returns a new PaperDocExport instance
@@ -597,6 +1343,21 @@ func NewPaperDocExport(DocId string, ExportFormat *ExportFormat) *PaperDocExport
 	return s
 }
 
+// MarshalJSON serializes a PaperDocExport instance
+func (u PaperDocExport) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocExport instance
+func (u *PaperDocExport) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *PaperDocExport) Validate() error {
+	return nil
+}
+
 // PaperDocExportResult : has no documentation (yet)
 type PaperDocExportResult struct {
 	// Owner : The Paper doc owner's email address.
@@ -608,6 +1369,8 @@ type PaperDocExportResult struct {
 	// MimeType : MIME type of the export. This corresponds to `ExportFormat`
 	// specified in the request.
 	MimeType string `json:"mime_type"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocExportResult returns a new PaperDocExportResult instance
@@ -620,9 +1383,30 @@ func NewPaperDocExportResult(Owner string, Title string, Revision int64, MimeTyp
 	return s
 }
 
+// MarshalJSON serializes a PaperDocExportResult instance
+func (u PaperDocExportResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocExportResult instance
+func (u *PaperDocExportResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocPermissionLevel : has no documentation (yet)
 type PaperDocPermissionLevel struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PaperDocPermissionLevel) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PaperDocPermissionLevel
@@ -632,11 +1416,39 @@ const (
 	PaperDocPermissionLevelOther          = "other"
 )
 
+// UnmarshalJSON deserializes into a PaperDocPermissionLevel instance
+func (u *PaperDocPermissionLevel) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "edit", "view_and_comment", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PaperDocPermissionLevel instance
+func (u PaperDocPermissionLevel) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PaperDocSharingPolicy : has no documentation (yet)
 type PaperDocSharingPolicy struct {
 	RefPaperDoc
 	// SharingPolicy : The default sharing policy to be set for the Paper doc.
 	SharingPolicy *SharingPolicy `json:"sharing_policy"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocSharingPolicy returns a new PaperDocSharingPolicy instance
@@ -647,12 +1459,29 @@ func NewPaperDocSharingPolicy(DocId string, SharingPolicy *SharingPolicy) *Paper
 	return s
 }
 
+// MarshalJSON serializes a PaperDocSharingPolicy instance
+func (u PaperDocSharingPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocSharingPolicy instance
+func (u *PaperDocSharingPolicy) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *PaperDocSharingPolicy) Validate() error {
+	return nil
+}
+
 // RemovePaperDocUser : has no documentation (yet)
 type RemovePaperDocUser struct {
 	RefPaperDoc
 	// Member : User which should be removed from the Paper doc. Specify only
 	// email address or Dropbox account ID.
 	Member *sharing.MemberSelector `json:"member"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRemovePaperDocUser returns a new RemovePaperDocUser instance
@@ -663,6 +1492,21 @@ func NewRemovePaperDocUser(DocId string, Member *sharing.MemberSelector) *Remove
 	return s
 }
 
+// MarshalJSON serializes a RemovePaperDocUser instance
+func (u RemovePaperDocUser) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RemovePaperDocUser instance
+func (u *RemovePaperDocUser) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RemovePaperDocUser) Validate() error {
+	return nil
+}
+
 // SharingPolicy : Sharing policy of Paper doc.
 type SharingPolicy struct {
 	// PublicSharingPolicy : This value applies to the non-team members.
@@ -670,6 +1514,8 @@ type SharingPolicy struct {
 	// TeamSharingPolicy : This value applies to the team members only. The
 	// value is null for all personal accounts.
 	TeamSharingPolicy *SharingTeamPolicyType `json:"team_sharing_policy,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharingPolicy returns a new SharingPolicy instance
@@ -678,9 +1524,30 @@ func NewSharingPolicy() *SharingPolicy {
 	return s
 }
 
+// MarshalJSON serializes a SharingPolicy instance
+func (u SharingPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharingPolicy instance
+func (u *SharingPolicy) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharingTeamPolicyType : The sharing policy type of the Paper doc.
 type SharingTeamPolicyType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharingTeamPolicyType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharingTeamPolicyType
@@ -690,9 +1557,46 @@ const (
 	SharingTeamPolicyTypeInviteOnly                      = "invite_only"
 )
 
+// UnmarshalJSON deserializes into a SharingTeamPolicyType instance
+func (u *SharingTeamPolicyType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "people_with_link_can_edit", "people_with_link_can_view_and_comment", "invite_only":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharingTeamPolicyType instance
+func (u SharingTeamPolicyType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharingPublicPolicyType : has no documentation (yet)
 type SharingPublicPolicyType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharingPublicPolicyType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharingPublicPolicyType
@@ -700,12 +1604,40 @@ const (
 	SharingPublicPolicyTypeDisabled = "disabled"
 )
 
+// UnmarshalJSON deserializes into a SharingPublicPolicyType instance
+func (u *SharingPublicPolicyType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "disabled":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharingPublicPolicyType instance
+func (u SharingPublicPolicyType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // UserInfoWithPermissionLevel : has no documentation (yet)
 type UserInfoWithPermissionLevel struct {
 	// User : User shared on the Paper doc.
 	User *sharing.UserInfo `json:"user"`
 	// PermissionLevel : Permission level for the user.
 	PermissionLevel *PaperDocPermissionLevel `json:"permission_level"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUserInfoWithPermissionLevel returns a new UserInfoWithPermissionLevel instance
@@ -716,9 +1648,30 @@ func NewUserInfoWithPermissionLevel(User *sharing.UserInfo, PermissionLevel *Pap
 	return s
 }
 
+// MarshalJSON serializes a UserInfoWithPermissionLevel instance
+func (u UserInfoWithPermissionLevel) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UserInfoWithPermissionLevel instance
+func (u *UserInfoWithPermissionLevel) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UserOnPaperDocFilter : has no documentation (yet)
 type UserOnPaperDocFilter struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UserOnPaperDocFilter) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UserOnPaperDocFilter
@@ -727,3 +1680,29 @@ const (
 	UserOnPaperDocFilterShared  = "shared"
 	UserOnPaperDocFilterOther   = "other"
 )
+
+// UnmarshalJSON deserializes into a UserOnPaperDocFilter instance
+func (u *UserOnPaperDocFilter) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "visited", "shared", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a UserOnPaperDocFilter instance
+func (u UserOnPaperDocFilter) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
@@ -33,6 +33,8 @@ type GetPropertyTemplateArg struct {
 	// TemplateId : An identifier for property template added by route
 	// properties/template/add.
 	TemplateId string `json:"template_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetPropertyTemplateArg returns a new GetPropertyTemplateArg instance
@@ -42,6 +44,21 @@ func NewGetPropertyTemplateArg(TemplateId string) *GetPropertyTemplateArg {
 	return s
 }
 
+// MarshalJSON serializes a GetPropertyTemplateArg instance
+func (u GetPropertyTemplateArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetPropertyTemplateArg instance
+func (u *GetPropertyTemplateArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetPropertyTemplateArg) Validate() error {
+	return nil
+}
+
 // PropertyGroupTemplate : Describes property templates that can be filled and
 // associated with a file.
 type PropertyGroupTemplate struct {
@@ -54,6 +71,8 @@ type PropertyGroupTemplate struct {
 	// Fields : This is a list of custom properties associated with a property
 	// template. There can be up to 64 properties in a single property template.
 	Fields []*PropertyFieldTemplate `json:"fields"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPropertyGroupTemplate returns a new PropertyGroupTemplate instance
@@ -65,9 +84,21 @@ func NewPropertyGroupTemplate(Name string, Description string, Fields []*Propert
 	return s
 }
 
+// MarshalJSON serializes a PropertyGroupTemplate instance
+func (u PropertyGroupTemplate) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PropertyGroupTemplate instance
+func (u *PropertyGroupTemplate) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetPropertyTemplateResult : The Property template for the specified template.
 type GetPropertyTemplateResult struct {
 	PropertyGroupTemplate
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetPropertyTemplateResult returns a new GetPropertyTemplateResult instance
@@ -79,11 +110,23 @@ func NewGetPropertyTemplateResult(Name string, Description string, Fields []*Pro
 	return s
 }
 
+// MarshalJSON serializes a GetPropertyTemplateResult instance
+func (u GetPropertyTemplateResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetPropertyTemplateResult instance
+func (u *GetPropertyTemplateResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListPropertyTemplateIds : has no documentation (yet)
 type ListPropertyTemplateIds struct {
 	// TemplateIds : List of identifiers for templates added by route
 	// properties/template/add.
 	TemplateIds []string `json:"template_ids"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListPropertyTemplateIds returns a new ListPropertyTemplateIds instance
@@ -93,11 +136,32 @@ func NewListPropertyTemplateIds(TemplateIds []string) *ListPropertyTemplateIds {
 	return s
 }
 
+// MarshalJSON serializes a ListPropertyTemplateIds instance
+func (u ListPropertyTemplateIds) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListPropertyTemplateIds instance
+func (u *ListPropertyTemplateIds) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PropertyTemplateError : has no documentation (yet)
 type PropertyTemplateError struct {
 	dropbox.Tagged
 	// TemplateNotFound : Property template does not exist for given identifier.
 	TemplateNotFound string `json:"template_not_found,omitempty"`
+	raw              string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PropertyTemplateError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PropertyTemplateError
@@ -111,27 +175,66 @@ const (
 func (u *PropertyTemplateError) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// TemplateNotFound : Property template does not exist for given
+		// identifier.
+		TemplateNotFound json.RawMessage `json:"template_not_found,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "template_not_found":
-		err = json.Unmarshal(body, &u.TemplateNotFound)
+		err = json.Unmarshal(w.TemplateNotFound, &u.TemplateNotFound)
 
 		if err != nil {
 			return err
 		}
+	case "restricted_content", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a PropertyTemplateError instance
+func (u PropertyTemplateError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "template_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TemplateNotFound string `json:"template_not_found"`
+		}{u.Tagged, u.TemplateNotFound})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsPropertyTemplateError reports whether err carries a PropertyTemplateError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsPropertyTemplateError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*PropertyTemplateError)(nil), tags...)
+}
+
 // ModifyPropertyTemplateError : has no documentation (yet)
 type ModifyPropertyTemplateError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ModifyPropertyTemplateError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ModifyPropertyTemplateError
@@ -142,6 +245,39 @@ const (
 	ModifyPropertyTemplateErrorTemplateAttributeTooLarge = "template_attribute_too_large"
 )
 
+// UnmarshalJSON deserializes into a ModifyPropertyTemplateError instance
+func (u *ModifyPropertyTemplateError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "conflicting_property_names", "too_many_properties", "too_many_templates", "template_attribute_too_large":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ModifyPropertyTemplateError instance
+func (u ModifyPropertyTemplateError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsModifyPropertyTemplateError reports whether err carries a ModifyPropertyTemplateError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsModifyPropertyTemplateError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ModifyPropertyTemplateError)(nil), tags...)
+}
+
 // PropertyField : has no documentation (yet)
 type PropertyField struct {
 	// Name : This is the name or key of a custom property in a property
@@ -150,6 +286,8 @@ type PropertyField struct {
 	// Value : Value of a custom property attached to a file. Values can be up
 	// to 1024 bytes.
 	Value string `json:"value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPropertyField returns a new PropertyField instance
@@ -160,6 +298,16 @@ func NewPropertyField(Name string, Value string) *PropertyField {
 	return s
 }
 
+// MarshalJSON serializes a PropertyField instance
+func (u PropertyField) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PropertyField instance
+func (u *PropertyField) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PropertyFieldTemplate : Describe a single property field type which that can
 // be part of a property template.
 type PropertyFieldTemplate struct {
@@ -172,6 +320,8 @@ type PropertyFieldTemplate struct {
 	// Type : This is the data type of the value of this property. This type
 	// will be enforced upon property creation and modifications.
 	Type *PropertyType `json:"type"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPropertyFieldTemplate returns a new PropertyFieldTemplate instance
@@ -183,6 +333,16 @@ func NewPropertyFieldTemplate(Name string, Description string, Type *PropertyTyp
 	return s
 }
 
+// MarshalJSON serializes a PropertyFieldTemplate instance
+func (u PropertyFieldTemplate) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PropertyFieldTemplate instance
+func (u *PropertyFieldTemplate) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PropertyGroup : Collection of custom properties in filled property templates.
 type PropertyGroup struct {
 	// TemplateId : A unique identifier for a property template type.
@@ -190,6 +350,8 @@ type PropertyGroup struct {
 	// Fields : This is a list of custom properties associated with a file.
 	// There can be up to 32 properties for a template.
 	Fields []*PropertyField `json:"fields"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPropertyGroup returns a new PropertyGroup instance
@@ -200,10 +362,31 @@ func NewPropertyGroup(TemplateId string, Fields []*PropertyField) *PropertyGroup
 	return s
 }
 
+// MarshalJSON serializes a PropertyGroup instance
+func (u PropertyGroup) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PropertyGroup instance
+func (u *PropertyGroup) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PropertyType : Data type of the given property added. This endpoint is in
 // beta and  only properties of type strings is supported.
 type PropertyType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PropertyType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PropertyType
@@ -211,3 +394,29 @@ const (
 	PropertyTypeString = "string"
 	PropertyTypeOther  = "other"
 )
+
+// UnmarshalJSON deserializes into a PropertyType instance
+func (u *PropertyType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "string", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PropertyType instance
+func (u PropertyType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
@@ -0,0 +1,270 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Route classes used by DefaultRouteClass
+const (
+	RouteClassRead    = "read"
+	RouteClassWrite   = "write"
+	RouteClassContent = "content"
+)
+
+// Routes that modify the contents of a namespace. Dropbox limits how many of
+// them run concurrently and fails the rest with too_many_write_operations.
+var writeRoutes = map[string]bool{
+	"files/alpha/upload":                true,
+	"files/copy":                        true,
+	"files/copy_batch":                  true,
+	"files/copy_reference/save":         true,
+	"files/create_folder":               true,
+	"files/delete":                      true,
+	"files/delete_batch":                true,
+	"files/move":                        true,
+	"files/move_batch":                  true,
+	"files/permanently_delete":          true,
+	"files/properties/add":              true,
+	"files/properties/overwrite":        true,
+	"files/properties/remove":           true,
+	"files/properties/update":           true,
+	"files/restore":                     true,
+	"files/save_url":                    true,
+	"files/upload":                      true,
+	"files/upload_session/finish":       true,
+	"files/upload_session/finish_batch": true,
+}
+
+// DefaultRouteClass classifies routes that write to a namespace as
+// RouteClassWrite, other routes on the content host as RouteClassContent and
+// everything else as RouteClassRead.
+func DefaultRouteClass(route RouteInfo) string {
+	name := strings.TrimSuffix(route.Route, "_v2")
+	if writeRoutes[route.Namespace+"/"+name] {
+		return RouteClassWrite
+	}
+	if route.Host == hostContent {
+		return RouteClassContent
+	}
+	return RouteClassRead
+}
+
+// RateLimiter is a token bucket rate limiter for requests. Share one
+// RateLimiter between the Configs of all clients acting on the same account
+// to coordinate their requests.
+//
+// Besides the global limit, each class of routes (see DefaultRouteClass) can
+// have its own limit. When Dropbox responds with a RateLimitError the
+// affected bucket waits for the requested time and halves its rate, which
+// then recovers gradually with successful requests.
+//
+// The zero value has no global limit and is ready to use.
+type RateLimiter struct {
+	// Maps routes to the classes that SetClassLimit configures
+	// (DefaultRouteClass if nil)
+	Classify func(route RouteInfo) string
+
+	mu      sync.Mutex
+	global  *bucket
+	classes map[string]*bucket
+}
+
+// NewRateLimiter returns a RateLimiter that allows rate requests per second
+// overall, in bursts of up to burst requests. A rate of 0 means no global
+// limit.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		global:  newBucket(rate, burst),
+		classes: make(map[string]*bucket),
+	}
+}
+
+// SetClassLimit limits the requests of routes in class to rate per second,
+// in bursts of up to burst requests, in addition to the global limit.
+func (l *RateLimiter) SetClassLimit(class string, rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.init()
+	l.classes[class] = newBucket(rate, burst)
+}
+
+// init sets up the buckets of a zero RateLimiter. l.mu must be held.
+func (l *RateLimiter) init() {
+	if l.global == nil {
+		l.global = newBucket(0, 0)
+	}
+	if l.classes == nil {
+		l.classes = make(map[string]*bucket)
+	}
+}
+
+// class returns the bucket for route's class. l.mu must be held.
+func (l *RateLimiter) class(route RouteInfo) *bucket {
+	l.init()
+	classify := l.Classify
+	if classify == nil {
+		classify = DefaultRouteClass
+	}
+	name := classify(route)
+	b, ok := l.classes[name]
+	if !ok {
+		b = newBucket(0, 0)
+		l.classes[name] = b
+	}
+	return b
+}
+
+// Wait blocks until a request to route is allowed or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context, route RouteInfo) error {
+	now := time.Now()
+	l.mu.Lock()
+	class := l.class(route)
+	global := l.global
+	delay := global.reserve(now)
+	if d := class.reserve(now); d > delay {
+		delay = d
+	}
+	l.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		global.cancel()
+		class.cancel()
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// observe adapts the limits to the response to a request to route.
+func (l *RateLimiter) observe(route RouteInfo, resp *http.Response) {
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	class := l.class(route)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		if resp.StatusCode < 400 {
+			l.global.restore(now)
+			class.restore(now)
+		}
+		return
+	}
+	body := peekBody(resp)
+	delay, ok := retryAfter(resp, body)
+	if !ok {
+		delay = time.Second
+	}
+	var rateLimit struct {
+		Error struct {
+			Reason Tagged `json:"reason"`
+		} `json:"error"`
+	}
+	json.Unmarshal(body, &rateLimit)
+	if rateLimit.Error.Reason.Tag == "too_many_write_operations" {
+		class.penalize(now, delay)
+	} else {
+		l.global.penalize(now, delay)
+	}
+}
+
+// bucket is a token bucket. Its rate drops when penalized and recovers with
+// every success. A bucket with a zero rate admits everything, except while
+// paused by a penalty.
+type bucket struct {
+	base   float64 // configured rate
+	rate   float64 // current rate
+	burst  float64
+	tokens float64
+	last   time.Time
+	paused time.Time
+}
+
+func newBucket(rate float64, burst int) *bucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &bucket{base: rate, rate: rate, burst: float64(burst), tokens: float64(burst)}
+}
+
+// refill adds the tokens accumulated since the last call.
+func (b *bucket) refill(now time.Time) {
+	if !b.last.IsZero() && b.rate > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+}
+
+// reserve takes a token and returns how long to wait before using it.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	var delay time.Duration
+	if b.rate > 0 {
+		b.refill(now)
+		b.tokens--
+		if b.tokens < 0 {
+			delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+		}
+	}
+	if d := b.paused.Sub(now); d > delay {
+		delay = d
+	}
+	return delay
+}
+
+// cancel returns a token taken by reserve.
+func (b *bucket) cancel() {
+	if b.rate > 0 {
+		b.tokens++
+	}
+}
+
+// penalize pauses the bucket for delay and halves its rate.
+func (b *bucket) penalize(now time.Time, delay time.Duration) {
+	if until := now.Add(delay); until.After(b.paused) {
+		b.paused = until
+	}
+	if b.rate > 0 {
+		b.refill(now)
+		b.rate = math.Max(b.rate/2, b.base/16)
+	}
+}
+
+// restore raises the rate of a penalized bucket back towards the
+// configured rate.
+func (b *bucket) restore(now time.Time) {
+	if b.rate < b.base {
+		b.refill(now)
+		b.rate = math.Min(b.base, b.rate+b.base/20)
+	}
+}
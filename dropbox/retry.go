@@ -0,0 +1,248 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how requests that fail with a transport error, a 429
+// or a 5xx response are retried. The zero value disables retries.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one
+	MaxAttempts int
+	// Delay before the first retry, doubled on every subsequent retry
+	BaseDelay time.Duration
+	// Upper bound for the computed delay (0 means no bound)
+	MaxDelay time.Duration
+	// Fraction in [0, 1] of each delay that is randomised
+	Jitter float64
+}
+
+// DefaultRetryPolicy is a reasonable RetryPolicy for most applications.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+	Jitter:      0.5,
+}
+
+// backoff returns the delay to wait after the given (1-based) failed attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay == 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(rand.Float64() * p.Jitter * float64(d))
+	}
+	return d
+}
+
+// shouldRetry reports whether a request that produced resp/err may be retried.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// retryAfter extracts the server-requested delay from the `Retry-After` header
+// or, failing that, the `retry_after` field of a rate limit error body.
+func retryAfter(resp *http.Response, body []byte) (time.Duration, bool) {
+	if s := resp.Header.Get("Retry-After"); s != "" {
+		if secs, err := strconv.Atoi(s); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(s); err == nil {
+			return time.Until(t), true
+		}
+	}
+	var rateLimit struct {
+		Error struct {
+			RetryAfter *uint64 `json:"retry_after"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &rateLimit) == nil && rateLimit.Error.RetryAfter != nil {
+		return time.Duration(*rateLimit.Error.RetryAfter) * time.Second, true
+	}
+	return 0, false
+}
+
+// rewind prepares req to be sent again. It fails if the body cannot be replayed.
+func rewind(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = body
+	return true
+}
+
+// setGetBody allows replaying bodies that can seek back to where they started.
+func setGetBody(req *http.Request, body io.Reader) {
+	if req.GetBody != nil {
+		return
+	}
+	s, ok := body.(io.Seeker)
+	if !ok {
+		return
+	}
+	start, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return
+	}
+	req.GetBody = func() (io.ReadCloser, error) {
+		if _, err := s.Seek(start, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(body), nil
+	}
+}
+
+// Do sends req using the Context's http.Client and Config.Middleware,
+// retrying as configured by Config.Retry and after refreshing an expired
+// access token. Requests whose bodies cannot be rewound are sent only once.
+func (c *Context) Do(req *http.Request) (*http.Response, error) {
+	if c.Config.Metrics != nil {
+		return c.measure(req)
+	}
+	return c.do(req, c.roundTrip())
+}
+
+// do implements Do, sending every attempt with send.
+func (c *Context) do(req *http.Request, send RoundTripFunc) (*http.Response, error) {
+	policy := c.Config.Retry
+	logger := c.logger()
+	route, _ := RouteFromContext(req.Context())
+	if logger != nil {
+		fields := requestFields(route)
+		if arg, ok := c.argField(req, route); ok {
+			fields = append(fields, arg)
+		}
+		logger.Log(LogDebug, "sending request", fields...)
+	}
+	// An expired token is refreshed and the request sent again, once
+	refreshable := c.tokens != nil && usesToken(req)
+	for attempt := 1; ; attempt++ {
+		var accessToken string
+		if refreshable {
+			tok, err := c.tokens.Token()
+			if err != nil {
+				return nil, err
+			}
+			accessToken = tok.AccessToken
+		}
+		if l := c.Config.RateLimiter; l != nil {
+			if err := l.Wait(req.Context(), route); err != nil {
+				return nil, err
+			}
+		}
+		start := time.Now()
+		resp, err := send(req)
+		if l := c.Config.RateLimiter; l != nil && err == nil {
+			l.observe(route, resp)
+		}
+		if logger != nil {
+			c.logResponse(logger, route, resp, err, time.Since(start))
+		}
+		if err == nil && refreshable && isExpiredToken(resp) && rewind(req) {
+			refreshed, err := c.tokens.refresh(accessToken)
+			if err != nil {
+				resp.Body.Close()
+				return nil, err
+			}
+			if refreshed {
+				resp.Body.Close()
+				refreshable = false
+				attempt--
+				if logger != nil {
+					logger.Log(LogInfo, "refreshed expired access token", requestFields(route)...)
+				}
+				continue
+			}
+		}
+		if attempt >= policy.MaxAttempts || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+		if !rewind(req) {
+			return resp, err
+		}
+		delay := policy.backoff(attempt)
+		if resp != nil {
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if d, ok := retryAfter(resp, body); ok {
+				delay = d
+			}
+		}
+		if logger != nil {
+			logger.Log(LogInfo, "retrying request", append(requestFields(route),
+				Field{"attempt", attempt}, Field{"delay", delay})...)
+		}
+		t := time.NewTimer(delay)
+		select {
+		case <-t.C:
+		case <-req.Context().Done():
+			t.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// logResponse logs the outcome of sending a request for route.
+func (c *Context) logResponse(logger Logger, route RouteInfo, resp *http.Response, err error, d time.Duration) {
+	fields := requestFields(route)
+	if err != nil {
+		logger.Log(LogWarn, "request failed", append(fields,
+			Field{"duration", d}, Field{"error", err})...)
+		return
+	}
+	fields = append(fields, Field{"status", resp.StatusCode}, Field{"duration", d})
+	fields = append(fields, responseFields(resp)...)
+	switch {
+	case resp.StatusCode < 400:
+		logger.Log(LogDebug, "received response", fields...)
+	case resp.StatusCode == http.StatusConflict:
+		// Endpoint specific errors are part of normal operation
+		logger.Log(LogInfo, "received error response", fields...)
+	default:
+		logger.Log(LogWarn, "received error response", fields...)
+	}
+}
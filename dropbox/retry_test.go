@@ -0,0 +1,198 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testServer serves every route with handler. It counts the requests it
+// receives.
+type testServer struct {
+	*httptest.Server
+	calls int32
+}
+
+func newTestServer(handler http.HandlerFunc) *testServer {
+	s := &testServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.calls, 1)
+		handler(w, r)
+	}))
+	return s
+}
+
+func (s *testServer) count() int {
+	return int(atomic.LoadInt32(&s.calls))
+}
+
+// context returns a Context for conf that sends every request to s.
+func (s *testServer) context(conf Config) Context {
+	if conf.Token == "" && conf.TokenSource == nil {
+		conf.Token = "token"
+	}
+	conf.URLGenerator = func(hostType string, style string, namespace string, route string) string {
+		return s.URL + "/2/" + namespace + "/" + route
+	}
+	return NewContext(conf)
+}
+
+// rpc sends an RPC request to files/get_metadata with body as argument.
+func rpc(ctx context.Context, c Context, body io.Reader) (*http.Response, error) {
+	req, err := c.NewRequestContext(ctx, "api", "rpc", "user", "files", "get_metadata",
+		map[string]string{"Content-Type": "application/json"}, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req)
+}
+
+func TestRetryAfter(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		header string
+		body   string
+	}{
+		{"header", "1", `{"error_summary": "too_many_requests/..", "error": {"reason": {".tag": "too_many_requests"}}}`},
+		{"body", "", `{"error_summary": "too_many_requests/..", "error": {"reason": {".tag": "too_many_requests"}, "retry_after": 1}}`},
+	} {
+		var s *testServer
+		s = newTestServer(func(w http.ResponseWriter, r *http.Request) {
+			if s.count() > 1 {
+				w.Write([]byte(`{}`))
+				return
+			}
+			if test.header != "" {
+				w.Header().Set("Retry-After", test.header)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(test.body))
+		})
+		// The base delay is far longer than Retry-After, so that the
+		// retry only happens in time if Retry-After is used
+		c := s.context(Config{Retry: RetryPolicy{MaxAttempts: 2, BaseDelay: time.Hour}})
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		start := time.Now()
+		resp, err := rpc(ctx, c, strings.NewReader(`{"path": "/a"}`))
+		cancel()
+		if err != nil || resp.StatusCode != http.StatusOK {
+			t.Errorf("%s: %v, %v", test.name, resp, err)
+		} else if d := time.Since(start); d < time.Second {
+			t.Errorf("%s: retried after %v, want 1s", test.name, d)
+		}
+		if s.count() != 2 {
+			t.Errorf("%s: %d requests, want 2", test.name, s.count())
+		}
+		s.Close()
+	}
+}
+
+func TestRetryServerError(t *testing.T) {
+	s := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "oops", http.StatusInternalServerError)
+	})
+	defer s.Close()
+	c := s.context(Config{Retry: RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}})
+	resp, err := rpc(context.Background(), c, strings.NewReader(`{"path": "/a"}`))
+	if err != nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("got %v, %v; want the last 500", resp, err)
+	}
+	if s.count() != 3 {
+		t.Errorf("%d requests, want 3", s.count())
+	}
+}
+
+func TestRetryUnrewindable(t *testing.T) {
+	var bodies []string
+	s := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		http.Error(w, "oops", http.StatusServiceUnavailable)
+	})
+	defer s.Close()
+	c := s.context(Config{Retry: RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}})
+	// A reader that can't seek back can't be sent again
+	body := io.MultiReader(strings.NewReader(`{"path": `), strings.NewReader(`"/a"}`))
+	if _, err := rpc(context.Background(), c, body); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 1 || bodies[0] != `{"path": "/a"}` {
+		t.Errorf("sent %q, want the body once", bodies)
+	}
+
+	// A seekable one is sent again in full
+	bodies = nil
+	if _, err := rpc(context.Background(), c, bytes.NewReader([]byte(`{"path": "/a"}`))); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 3 || bodies[2] != `{"path": "/a"}` {
+		t.Errorf("sent %q, want the body 3 times", bodies)
+	}
+}
+
+func TestRetryCancel(t *testing.T) {
+	s := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer s.Close()
+	c := s.context(Config{Retry: RetryPolicy{MaxAttempts: 5}})
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	if _, err := rpc(ctx, c, strings.NewReader(`{}`)); err != context.Canceled {
+		t.Errorf("err = %v, want %v", err, context.Canceled)
+	}
+	if d := time.Since(start); d > 10*time.Second {
+		t.Errorf("returned after %v", d)
+	}
+	if s.count() != 1 {
+		t.Errorf("%d requests, want 1", s.count())
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for attempt, want := range []time.Duration{0, 1, 2, 4, 5, 5} {
+		if attempt == 0 {
+			continue
+		}
+		if got := p.backoff(attempt); got != want*time.Second {
+			t.Errorf("backoff(%d) = %v, want %v", attempt, got, want*time.Second)
+		}
+	}
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if d := p.backoff(2); d < time.Second || d > 2*time.Second {
+			t.Fatalf("backoff with jitter = %v, want within [1s, 2s]", d)
+		}
+	}
+}
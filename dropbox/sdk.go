@@ -21,6 +21,9 @@
 package dropbox
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -47,10 +50,39 @@ func Version() (string, string) {
 type Config struct {
 	// OAuth2 access token
 	Token string
-	// Enable verbose logging in SDK
+	// Source of OAuth2 tokens, used instead of Token if set
+	TokenSource oauth2.TokenSource
+	// OAuth2 refresh token, used with AppKey and AppSecret to obtain new
+	// access tokens when Token expires
+	RefreshToken string
+	// App key and secret from the app console, used to refresh tokens and
+	// to call routes that use app authentication
+	AppKey    string
+	AppSecret string
+	// Called with every new token obtained, e.g. to persist a refreshed token
+	OnTokenRefresh func(token *oauth2.Token)
+	// Enable verbose logging in SDK to the standard logger, if Logger is nil
 	Verbose bool
+	// Receives the SDK's log entries (logging is off if nil)
+	Logger Logger
+	// Include paths in the arguments that are logged at LogDebug
+	LogPaths bool
+	// Wraps the sending of every request, the first one outermost
+	Middleware []Middleware
+	// Receives measurements of every request (e.g. an *ExpvarMetrics)
+	Metrics Metrics
+	// Limits the rate of requests; may be shared between Configs
+	RateLimiter *RateLimiter
 	// Used with APIs that support operations as another user
 	AsMemberID string
+	// Used with the files and sharing APIs that a team app calls as a team
+	// admin, e.g. to access team folders; ignored if AsMemberID is set
+	AsAdminID string
+	// Policy for retrying rate limited and failed requests (no retries by default)
+	Retry RetryPolicy
+	// Root that paths are relative to, e.g. a *common.PathRoot for a team space.
+	// It is sent as the Dropbox-API-Path-Root header by user routes.
+	PathRoot json.Marshaler
 	// No need to set -- for testing only
 	Domain string
 	// No need to set -- for testing only
@@ -67,6 +99,8 @@ type Context struct {
 	Client          *http.Client
 	HeaderGenerator func(hostType string, style string, namespace string, route string) map[string]string
 	URLGenerator    func(hostType string, style string, namespace string, route string) string
+
+	tokens *tokenSource
 }
 
 // NewRequest returns an appropriate Request object for the given namespace/route.
@@ -78,12 +112,42 @@ func (c *Context) NewRequest(
 	route string,
 	headers map[string]string,
 	body io.Reader,
+) (*http.Request, error) {
+	auth := "user"
+	if !authed {
+		auth = "noauth"
+	}
+	return c.NewRequestContext(context.Background(), hostType, style, auth,
+		namespace, route, headers, body)
+}
+
+// NewRequestContext is like NewRequest but the returned Request is bound to
+// ctx, so cancelling ctx or letting its deadline expire aborts the request.
+// auth is the route's `auth` attribute, e.g. "user", "team", "app" or
+// "noauth", and selects how the request is authenticated.
+func (c *Context) NewRequestContext(
+	ctx context.Context,
+	hostType string,
+	style string,
+	auth string,
+	namespace string,
+	route string,
+	headers map[string]string,
+	body io.Reader,
 ) (*http.Request, error) {
 	url := c.URLGenerator(hostType, style, namespace, route)
 	req, err := http.NewRequest("POST", url, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(context.WithValue(ctx, routeInfoKey{}, RouteInfo{
+		Namespace: namespace,
+		Route:     route,
+		Host:      hostType,
+		Style:     style,
+		Auth:      auth,
+	}))
+	setGetBody(req, body)
 	for k, v := range headers {
 		req.Header.Add(k, v)
 	}
@@ -93,12 +157,30 @@ func (c *Context) NewRequest(
 	if req.Header.Get("Host") != "" {
 		req.Host = req.Header.Get("Host")
 	}
-	if !authed {
+	switch {
+	// Routes that accept either app or user authentication use the token
+	// if there is one
+	case hasAuth(auth, "app") && c.Config.AppKey != "" && (auth == "app" || !c.hasToken()):
+		req.SetBasicAuth(c.Config.AppKey, c.Config.AppSecret)
+	case auth == "app":
+		return nil, errors.New("dropbox: AppKey and AppSecret are required by " +
+			namespace + "/" + route)
+	case auth == "noauth":
 		req.Header.Del("Authorization")
 	}
+	if !usesToken(req) {
+		// Only a team token can select a member or admin
+		req.Header.Del("Dropbox-API-Select-User")
+		req.Header.Del("Dropbox-API-Select-Admin")
+	}
 	return req, nil
 }
 
+// hasToken reports whether c is configured with a user or team access token.
+func (c *Context) hasToken() bool {
+	return c.Config.Token != "" || c.Config.TokenSource != nil || c.Config.RefreshToken != ""
+}
+
 // NewContext returns a new Context with the given Config.
 func NewContext(c Config) Context {
 	domain := c.Domain
@@ -107,10 +189,12 @@ func NewContext(c Config) Context {
 	}
 
 	client := c.Client
+	var tokens *tokenSource
 	if client == nil {
-		var conf = &oauth2.Config{Endpoint: OAuthEndpoint(domain)}
-		tok := &oauth2.Token{AccessToken: c.Token}
-		client = conf.Client(oauth2.NoContext, tok)
+		tokens = newTokenSource(c, OAuthEndpoint(domain))
+		// tokens caches tokens itself; oauth2.NewClient would wrap it in a
+		// ReuseTokenSource that hides refreshes
+		client = &http.Client{Transport: &authTransport{&oauth2.Transport{Source: tokens}}}
 	}
 
 	headerGenerator := c.HeaderGenerator
@@ -133,7 +217,7 @@ func NewContext(c Config) Context {
 		}
 	}
 
-	return Context{c, client, headerGenerator, urlGenerator}
+	return Context{c, client, headerGenerator, urlGenerator, tokens}
 }
 
 // OAuthEndpoint constructs an `oauth2.Endpoint` for the given domain
@@ -141,10 +225,10 @@ func OAuthEndpoint(domain string) oauth2.Endpoint {
 	if domain == "" {
 		domain = defaultDomain
 	}
-	authURL := fmt.Sprintf("https://meta%s/1/oauth2/authorize", domain)
-	tokenURL := fmt.Sprintf("https://api%s/1/oauth2/token", domain)
+	authURL := fmt.Sprintf("https://meta%s/oauth2/authorize", domain)
+	tokenURL := fmt.Sprintf("https://api%s/oauth2/token", domain)
 	if domain == defaultDomain {
-		authURL = "https://www.dropbox.com/1/oauth2/authorize"
+		authURL = "https://www.dropbox.com/oauth2/authorize"
 	}
 	return oauth2.Endpoint{AuthURL: authURL, TokenURL: tokenURL}
 }
@@ -154,6 +238,27 @@ type Tagged struct {
 	Tag string `json:".tag"`
 }
 
+// MarshalTagged serializes v, which must encode as a JSON object or null,
+// with its ".tag" set to tag. This is how struct union members and subtypes
+// of structs with enumerated subtypes are serialized.
+func MarshalTagged(tag string, v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		fields = make(map[string]json.RawMessage)
+	}
+	if fields[".tag"], err = json.Marshal(tag); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
 // APIError is the base type for endpoint-specific errors.
 type APIError struct {
 	ErrorSummary string `json:"error_summary"`
@@ -163,6 +268,37 @@ func (e APIError) Error() string {
 	return e.ErrorSummary
 }
 
+// ServerError is returned when the Dropbox servers fail with a 5xx response.
+type ServerError struct {
+	StatusCode int
+	// Value of the X-Dropbox-Request-Id header, useful when contacting support
+	RequestID string
+	Body      string
+}
+
+func (e ServerError) Error() string {
+	return fmt.Sprintf("dropbox: server error %d (request id %q): %s",
+		e.StatusCode, e.RequestID, e.Body)
+}
+
+// HandleCommonAPIErrors converts a non-2xx response that is not specific to
+// a route into an error.
+func HandleCommonAPIErrors(resp *http.Response, body []byte) error {
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return ServerError{
+			StatusCode: resp.StatusCode,
+			RequestID:  resp.Header.Get("X-Dropbox-Request-Id"),
+			Body:       string(body),
+		}
+	}
+	var apiError APIError
+	// 400 responses and some others have a plain text body
+	if resp.StatusCode == http.StatusBadRequest || json.Unmarshal(body, &apiError) != nil {
+		apiError.ErrorSummary = string(body)
+	}
+	return apiError
+}
+
 func init() {
 	// These are not registered in the oauth library by default
 	oauth2.RegisterBrokenAuthHeaderProvider("https://api.dropboxapi.com")
@@ -0,0 +1,101 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestNewRequestContextSelectHeaders(t *testing.T) {
+	headers := map[string]string{
+		"Dropbox-API-Select-User":  "dbmid:member",
+		"Dropbox-API-Select-Admin": "dbmid:admin",
+	}
+	for _, test := range []struct {
+		auth   string
+		token  string
+		appKey string
+		keep   bool
+	}{
+		{"user", "token", "key", true},
+		{"team", "token", "key", true},
+		{"app, user", "token", "", true},
+		{"app, user", "token", "key", true},
+		{"app, user", "", "key", false},
+		{"app", "token", "key", false},
+		{"noauth", "token", "", false},
+	} {
+		ctx := NewContext(Config{Token: test.token, AppKey: test.appKey, AppSecret: "secret"})
+		req, err := ctx.NewRequestContext(context.Background(), "api", "rpc", test.auth, "ns", "route", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k := range headers {
+			if got := req.Header.Get(k) != ""; got != test.keep {
+				t.Errorf("auth %q, token %q, app key %q: %s sent = %v, want %v", test.auth, test.token, test.appKey, k, got, test.keep)
+			}
+		}
+	}
+}
+
+func TestNewRequestContextAuth(t *testing.T) {
+	var authorization string
+	s := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Write([]byte(`{}`))
+	})
+	defer s.Close()
+	basic := "Basic " + base64.StdEncoding.EncodeToString([]byte("key:secret"))
+	for _, test := range []struct {
+		auth string
+		conf Config
+		want string
+	}{
+		{"app, user", Config{Token: "token", AppKey: "key", AppSecret: "secret"}, "Bearer token"},
+		{"app, user", Config{Token: "token"}, "Bearer token"},
+		{"app, user", Config{TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "source"}), AppKey: "key", AppSecret: "secret"}, "Bearer source"},
+		{"app, user", Config{AppKey: "key", AppSecret: "secret"}, basic},
+		{"app", Config{Token: "token", AppKey: "key", AppSecret: "secret"}, basic},
+		{"user", Config{Token: "token", AppKey: "key", AppSecret: "secret"}, "Bearer token"},
+		{"noauth", Config{Token: "token", AppKey: "key", AppSecret: "secret"}, ""},
+	} {
+		c := s.context(test.conf)
+		// Undo the token that context adds when there is none
+		c.Config = test.conf
+		req, err := c.NewRequestContext(context.Background(), "api", "rpc", test.auth, "ns", "route", nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := c.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if authorization != test.want {
+			t.Errorf("auth %q, %+v: Authorization = %q, want %q", test.auth, test.conf, authorization, test.want)
+		}
+	}
+}
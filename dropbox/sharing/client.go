@@ -22,40 +22,60 @@ package sharing
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/async"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/auth"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/common"
 )
 
 // Client interface describes all routes in this namespace
 type Client interface {
 	// AddFileMember : Adds specified members to a file.
 	AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error)
+	// AddFileMemberContext : Like `addFileMember`, but the request is bound to
+	// ctx.
+	AddFileMemberContext(ctx context.Context, arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error)
 	// AddFolderMember : Allows an owner or editor (if the ACL update policy
 	// allows) of a shared folder to add another member. For the new member to
 	// get access to all the functionality for this folder, you will need to
 	// call `mountFolder` on their behalf. Apps must have full Dropbox access to
 	// use this endpoint.
 	AddFolderMember(arg *AddFolderMemberArg) (err error)
+	// AddFolderMemberContext : Like `addFolderMember`, but the request is bound
+	// to ctx.
+	AddFolderMemberContext(ctx context.Context, arg *AddFolderMemberArg) (err error)
 	// ChangeFileMemberAccess : Identical to update_file_member but with less
 	// information returned.
 	ChangeFileMemberAccess(arg *ChangeFileMemberAccessArgs) (res *FileMemberActionResult, err error)
+	// ChangeFileMemberAccessContext : Like `changeFileMemberAccess`, but the
+	// request is bound to ctx.
+	ChangeFileMemberAccessContext(ctx context.Context, arg *ChangeFileMemberAccessArgs) (res *FileMemberActionResult, err error)
 	// CheckJobStatus : Returns the status of an asynchronous job. Apps must
 	// have full Dropbox access to use this endpoint.
 	CheckJobStatus(arg *async.PollArg) (res *JobStatus, err error)
+	// CheckJobStatusContext : Like `checkJobStatus`, but the request is bound
+	// to ctx.
+	CheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *JobStatus, err error)
 	// CheckRemoveMemberJobStatus : Returns the status of an asynchronous job
 	// for sharing a folder. Apps must have full Dropbox access to use this
 	// endpoint.
 	CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveMemberJobStatus, err error)
+	// CheckRemoveMemberJobStatusContext : Like `checkRemoveMemberJobStatus`,
+	// but the request is bound to ctx.
+	CheckRemoveMemberJobStatusContext(ctx context.Context, arg *async.PollArg) (res *RemoveMemberJobStatus, err error)
 	// CheckShareJobStatus : Returns the status of an asynchronous job for
 	// sharing a folder. Apps must have full Dropbox access to use this
 	// endpoint.
 	CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJobStatus, err error)
+	// CheckShareJobStatusContext : Like `checkShareJobStatus`, but the request
+	// is bound to ctx.
+	CheckShareJobStatusContext(ctx context.Context, arg *async.PollArg) (res *ShareFolderJobStatus, err error)
 	// CreateSharedLink : Create a shared link. If a shared link already exists
 	// for the given path, that link is returned. Note that in the returned
 	// `PathLinkMetadata`, the `PathLinkMetadata.url` field is the shortened URL
@@ -65,23 +85,44 @@ type Client interface {
 	// the case, so your app shouldn't rely on this behavior. Instead, if your
 	// app needs to revoke a shared link, use `revokeSharedLink`.
 	CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error)
+	// CreateSharedLinkContext : Like `createSharedLink`, but the request is
+	// bound to ctx.
+	CreateSharedLinkContext(ctx context.Context, arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error)
 	// CreateSharedLinkWithSettings : Create a shared link with custom settings.
 	// If no settings are given then the default visibility is
 	// `RequestedVisibility.public` (The resolved visibility, though, may depend
 	// on other aspects such as team and shared folder settings).
 	CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error)
+	// CreateSharedLinkWithSettingsContext : Like
+	// `createSharedLinkWithSettings`, but the request is bound to ctx.
+	CreateSharedLinkWithSettingsContext(ctx context.Context, arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error)
 	// GetFileMetadata : Returns shared file metadata.
 	GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMetadata, err error)
+	// GetFileMetadataContext : Like `getFileMetadata`, but the request is bound
+	// to ctx.
+	GetFileMetadataContext(ctx context.Context, arg *GetFileMetadataArg) (res *SharedFileMetadata, err error)
 	// GetFileMetadataBatch : Returns shared file metadata.
 	GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error)
+	// GetFileMetadataBatchContext : Like `getFileMetadataBatch`, but the
+	// request is bound to ctx.
+	GetFileMetadataBatchContext(ctx context.Context, arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error)
 	// GetFolderMetadata : Returns shared folder metadata by its folder ID. Apps
 	// must have full Dropbox access to use this endpoint.
 	GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMetadata, err error)
+	// GetFolderMetadataContext : Like `getFolderMetadata`, but the request is
+	// bound to ctx.
+	GetFolderMetadataContext(ctx context.Context, arg *GetMetadataArgs) (res *SharedFolderMetadata, err error)
 	// GetSharedLinkFile : Download the shared link's file from a user's
 	// Dropbox.
 	GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error)
+	// GetSharedLinkFileContext : Like `getSharedLinkFile`, but the request is
+	// bound to ctx.
+	GetSharedLinkFileContext(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error)
 	// GetSharedLinkMetadata : Get the shared link's metadata.
 	GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error)
+	// GetSharedLinkMetadataContext : Like `getSharedLinkMetadata`, but the
+	// request is bound to ctx.
+	GetSharedLinkMetadataContext(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error)
 	// GetSharedLinks : Returns a list of `LinkMetadata` objects for this user,
 	// including collection links. If no path is given, returns a list of all
 	// shared links for the current user, including collection links. If a
@@ -89,52 +130,87 @@ type Client interface {
 	// access to the given path.  Collection links are never returned in this
 	// case. Note that the url field in the response is never the shortened URL.
 	GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error)
+	// GetSharedLinksContext : Like `getSharedLinks`, but the request is bound
+	// to ctx.
+	GetSharedLinksContext(ctx context.Context, arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error)
 	// ListFileMembers : Use to obtain the members who have been invited to a
 	// file, both inherited and uninherited members.
 	ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMembers, err error)
+	// ListFileMembersContext : Like `listFileMembers`, but the request is bound
+	// to ctx.
+	ListFileMembersContext(ctx context.Context, arg *ListFileMembersArg) (res *SharedFileMembers, err error)
 	// ListFileMembersBatch : Get members of multiple files at once. The
 	// arguments to this route are more limited, and the limit on query result
 	// size per file is more strict. To customize the results more, use the
 	// individual file endpoint. Inherited users and groups are not included in
 	// the result, and permissions are not returned for this endpoint.
 	ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error)
+	// ListFileMembersBatchContext : Like `listFileMembersBatch`, but the
+	// request is bound to ctx.
+	ListFileMembersBatchContext(ctx context.Context, arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error)
 	// ListFileMembersContinue : Once a cursor has been retrieved from
 	// `listFileMembers` or `listFileMembersBatch`, use this to paginate through
 	// all shared file members.
 	ListFileMembersContinue(arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error)
+	// ListFileMembersContinueContext : Like `listFileMembersContinue`, but the
+	// request is bound to ctx.
+	ListFileMembersContinueContext(ctx context.Context, arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error)
 	// ListFolderMembers : Returns shared folder membership by its folder ID.
 	// Apps must have full Dropbox access to use this endpoint.
 	ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error)
+	// ListFolderMembersContext : Like `listFolderMembers`, but the request is
+	// bound to ctx.
+	ListFolderMembersContext(ctx context.Context, arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error)
 	// ListFolderMembersContinue : Once a cursor has been retrieved from
 	// `listFolderMembers`, use this to paginate through all shared folder
 	// members. Apps must have full Dropbox access to use this endpoint.
 	ListFolderMembersContinue(arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error)
+	// ListFolderMembersContinueContext : Like `listFolderMembersContinue`, but
+	// the request is bound to ctx.
+	ListFolderMembersContinueContext(ctx context.Context, arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error)
 	// ListFolders : Return the list of all shared folders the current user has
 	// access to. Apps must have full Dropbox access to use this endpoint.
 	ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error)
+	// ListFoldersContext : Like `listFolders`, but the request is bound to ctx.
+	ListFoldersContext(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error)
 	// ListFoldersContinue : Once a cursor has been retrieved from
 	// `listFolders`, use this to paginate through all shared folders. The
 	// cursor must come from a previous call to `listFolders` or
 	// `listFoldersContinue`. Apps must have full Dropbox access to use this
 	// endpoint.
 	ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error)
+	// ListFoldersContinueContext : Like `listFoldersContinue`, but the request
+	// is bound to ctx.
+	ListFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error)
 	// ListMountableFolders : Return the list of all shared folders the current
 	// user can mount or unmount. Apps must have full Dropbox access to use this
 	// endpoint.
 	ListMountableFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error)
+	// ListMountableFoldersContext : Like `listMountableFolders`, but the
+	// request is bound to ctx.
+	ListMountableFoldersContext(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error)
 	// ListMountableFoldersContinue : Once a cursor has been retrieved from
 	// `listMountableFolders`, use this to paginate through all mountable shared
 	// folders. The cursor must come from a previous call to
 	// `listMountableFolders` or `listMountableFoldersContinue`. Apps must have
 	// full Dropbox access to use this endpoint.
 	ListMountableFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error)
+	// ListMountableFoldersContinueContext : Like
+	// `listMountableFoldersContinue`, but the request is bound to ctx.
+	ListMountableFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error)
 	// ListReceivedFiles : Returns a list of all files shared with current user.
 	// Does not include files the user has received via shared folders, and does
 	// not include unclaimed invitations.
 	ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult, err error)
+	// ListReceivedFilesContext : Like `listReceivedFiles`, but the request is
+	// bound to ctx.
+	ListReceivedFilesContext(ctx context.Context, arg *ListFilesArg) (res *ListFilesResult, err error)
 	// ListReceivedFilesContinue : Get more results with a cursor from
 	// `listReceivedFiles`.
 	ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *ListFilesResult, err error)
+	// ListReceivedFilesContinueContext : Like `listReceivedFilesContinue`, but
+	// the request is bound to ctx.
+	ListReceivedFilesContinueContext(ctx context.Context, arg *ListFilesContinueArg) (res *ListFilesResult, err error)
 	// ListSharedLinks : List shared links of this user. If no path is given,
 	// returns a list of all shared links for the current user. If a non-empty
 	// path is given, returns a list of all shared links that allow access to
@@ -142,6 +218,9 @@ type Client interface {
 	// folders of the given path. Links to parent folders can be suppressed by
 	// setting direct_only to true.
 	ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error)
+	// ListSharedLinksContext : Like `listSharedLinks`, but the request is bound
+	// to ctx.
+	ListSharedLinksContext(ctx context.Context, arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error)
 	// ModifySharedLinkSettings : Modify the shared link's settings. If the
 	// requested visibility conflict with the shared links policy of the team or
 	// the shared folder (in case the linked file is part of a shared folder)
@@ -150,16 +229,24 @@ type Client interface {
 	// link and the `LinkPermissions.requested_visibility` will reflect the
 	// requested visibility.
 	ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error)
+	// ModifySharedLinkSettingsContext : Like `modifySharedLinkSettings`, but
+	// the request is bound to ctx.
+	ModifySharedLinkSettingsContext(ctx context.Context, arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error)
 	// MountFolder : The current user mounts the designated folder. Mount a
 	// shared folder for a user after they have been added as a member. Once
 	// mounted, the shared folder will appear in their Dropbox. Apps must have
 	// full Dropbox access to use this endpoint.
 	MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata, err error)
+	// MountFolderContext : Like `mountFolder`, but the request is bound to ctx.
+	MountFolderContext(ctx context.Context, arg *MountFolderArg) (res *SharedFolderMetadata, err error)
 	// RelinquishFileMembership : The current user relinquishes their membership
 	// in the designated file. Note that the current user may still have
 	// inherited access to this file through the parent folder. Apps must have
 	// full Dropbox access to use this endpoint.
 	RelinquishFileMembership(arg *RelinquishFileMembershipArg) (err error)
+	// RelinquishFileMembershipContext : Like `relinquishFileMembership`, but
+	// the request is bound to ctx.
+	RelinquishFileMembershipContext(ctx context.Context, arg *RelinquishFileMembershipArg) (err error)
 	// RelinquishFolderMembership : The current user relinquishes their
 	// membership in the designated shared folder and will no longer have access
 	// to the folder.  A folder owner cannot relinquish membership in their own
@@ -167,21 +254,36 @@ type Client interface {
 	// asynchronously if leave_a_copy is true. Apps must have full Dropbox
 	// access to use this endpoint.
 	RelinquishFolderMembership(arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error)
+	// RelinquishFolderMembershipContext : Like `relinquishFolderMembership`,
+	// but the request is bound to ctx.
+	RelinquishFolderMembershipContext(ctx context.Context, arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error)
 	// RemoveFileMember : Identical to remove_file_member_2 but with less
 	// information returned.
 	RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error)
+	// RemoveFileMemberContext : Like `removeFileMember`, but the request is
+	// bound to ctx.
+	RemoveFileMemberContext(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error)
 	// RemoveFileMember2 : Removes a specified member from the file.
 	RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error)
+	// RemoveFileMember2Context : Like `removeFileMember2`, but the request is
+	// bound to ctx.
+	RemoveFileMember2Context(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error)
 	// RemoveFolderMember : Allows an owner or editor (if the ACL update policy
 	// allows) of a shared folder to remove another member. Apps must have full
 	// Dropbox access to use this endpoint.
 	RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error)
+	// RemoveFolderMemberContext : Like `removeFolderMember`, but the request is
+	// bound to ctx.
+	RemoveFolderMemberContext(ctx context.Context, arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error)
 	// RevokeSharedLink : Revoke a shared link. Note that even after revoking a
 	// shared link to a file, the file may be accessible if there are shared
 	// links leading to any of the file parent folders. To list all shared links
 	// that enable access to a specific file, you can use the `listSharedLinks`
 	// with the file as the `ListSharedLinksArg.path` argument.
 	RevokeSharedLink(arg *RevokeSharedLinkArg) (err error)
+	// RevokeSharedLinkContext : Like `revokeSharedLink`, but the request is
+	// bound to ctx.
+	RevokeSharedLinkContext(ctx context.Context, arg *RevokeSharedLinkArg) (err error)
 	// ShareFolder : Share a folder with collaborators. Most sharing will be
 	// completed synchronously. Large folders will be completed asynchronously.
 	// To make testing the async case repeatable, set
@@ -190,48 +292,80 @@ type Client interface {
 	// completes to get the metadata for the folder. Apps must have full Dropbox
 	// access to use this endpoint.
 	ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, err error)
+	// ShareFolderContext : Like `shareFolder`, but the request is bound to ctx.
+	ShareFolderContext(ctx context.Context, arg *ShareFolderArg) (res *ShareFolderLaunch, err error)
 	// TransferFolder : Transfer ownership of a shared folder to a member of the
 	// shared folder. User must have `AccessLevel.owner` access to the shared
 	// folder to perform a transfer. Apps must have full Dropbox access to use
 	// this endpoint.
 	TransferFolder(arg *TransferFolderArg) (err error)
+	// TransferFolderContext : Like `transferFolder`, but the request is bound
+	// to ctx.
+	TransferFolderContext(ctx context.Context, arg *TransferFolderArg) (err error)
 	// UnmountFolder : The current user unmounts the designated folder. They can
 	// re-mount the folder at a later time using `mountFolder`. Apps must have
 	// full Dropbox access to use this endpoint.
 	UnmountFolder(arg *UnmountFolderArg) (err error)
+	// UnmountFolderContext : Like `unmountFolder`, but the request is bound to
+	// ctx.
+	UnmountFolderContext(ctx context.Context, arg *UnmountFolderArg) (err error)
 	// UnshareFile : Remove all members from this file. Does not remove
 	// inherited members.
 	UnshareFile(arg *UnshareFileArg) (err error)
+	// UnshareFileContext : Like `unshareFile`, but the request is bound to ctx.
+	UnshareFileContext(ctx context.Context, arg *UnshareFileArg) (err error)
 	// UnshareFolder : Allows a shared folder owner to unshare the folder.
 	// You'll need to call `checkJobStatus` to determine if the action has
 	// completed successfully. Apps must have full Dropbox access to use this
 	// endpoint.
 	UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error)
+	// UnshareFolderContext : Like `unshareFolder`, but the request is bound to
+	// ctx.
+	UnshareFolderContext(ctx context.Context, arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error)
 	// UpdateFileMember : Changes a member's access on a shared file.
 	UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error)
+	// UpdateFileMemberContext : Like `updateFileMember`, but the request is
+	// bound to ctx.
+	UpdateFileMemberContext(ctx context.Context, arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error)
 	// UpdateFolderMember : Allows an owner or editor of a shared folder to
 	// update another member's permissions. Apps must have full Dropbox access
 	// to use this endpoint.
 	UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error)
+	// UpdateFolderMemberContext : Like `updateFolderMember`, but the request is
+	// bound to ctx.
+	UpdateFolderMemberContext(ctx context.Context, arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error)
 	// UpdateFolderPolicy : Update the sharing policies for a shared folder.
 	// User must have `AccessLevel.owner` access to the shared folder to update
 	// its policies. Apps must have full Dropbox access to use this endpoint.
 	UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error)
+	// UpdateFolderPolicyContext : Like `updateFolderPolicy`, but the request is
+	// bound to ctx.
+	UpdateFolderPolicyContext(ctx context.Context, arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error)
 }
 
-type apiImpl dropbox.Context
+// ClientImpl implements Client by calling the Dropbox API
+type ClientImpl dropbox.Context
 
-//AddFileMemberAPIError is an error-wrapper for the add_file_member route
+var _ Client = (*ClientImpl)(nil)
+
+// AddFileMemberAPIError is an error-wrapper for the add_file_member route
 type AddFileMemberAPIError struct {
 	dropbox.APIError
 	EndpointError *AddFileMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e AddFileMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error) {
+	return dbx.AddFileMemberContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) AddFileMemberContext(ctx context.Context, arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -243,20 +377,23 @@ func (dbx *apiImpl) AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActi
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "add_file_member", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "add_file_member", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -267,9 +404,6 @@ func (dbx *apiImpl) AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActi
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -287,31 +421,36 @@ func (dbx *apiImpl) AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActi
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//AddFolderMemberAPIError is an error-wrapper for the add_folder_member route
+// AddFolderMemberAPIError is an error-wrapper for the add_folder_member route
 type AddFolderMemberAPIError struct {
 	dropbox.APIError
 	EndpointError *AddFolderMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) AddFolderMember(arg *AddFolderMemberArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e AddFolderMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) AddFolderMember(arg *AddFolderMemberArg) (err error) {
+	return dbx.AddFolderMemberContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) AddFolderMemberContext(ctx context.Context, arg *AddFolderMemberArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -323,20 +462,23 @@ func (dbx *apiImpl) AddFolderMember(arg *AddFolderMemberArg) (err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "add_folder_member", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "add_folder_member", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -347,9 +489,6 @@ func (dbx *apiImpl) AddFolderMember(arg *AddFolderMemberArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -362,31 +501,36 @@ func (dbx *apiImpl) AddFolderMember(arg *AddFolderMemberArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ChangeFileMemberAccessAPIError is an error-wrapper for the change_file_member_access route
+// ChangeFileMemberAccessAPIError is an error-wrapper for the change_file_member_access route
 type ChangeFileMemberAccessAPIError struct {
 	dropbox.APIError
 	EndpointError *FileMemberActionError `json:"error"`
 }
 
-func (dbx *apiImpl) ChangeFileMemberAccess(arg *ChangeFileMemberAccessArgs) (res *FileMemberActionResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ChangeFileMemberAccessAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ChangeFileMemberAccess(arg *ChangeFileMemberAccessArgs) (res *FileMemberActionResult, err error) {
+	return dbx.ChangeFileMemberAccessContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ChangeFileMemberAccessContext(ctx context.Context, arg *ChangeFileMemberAccessArgs) (res *FileMemberActionResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -398,20 +542,23 @@ func (dbx *apiImpl) ChangeFileMemberAccess(arg *ChangeFileMemberAccessArgs) (res
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "change_file_member_access", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "change_file_member_access", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -422,9 +569,6 @@ func (dbx *apiImpl) ChangeFileMemberAccess(arg *ChangeFileMemberAccessArgs) (res
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -442,31 +586,36 @@ func (dbx *apiImpl) ChangeFileMemberAccess(arg *ChangeFileMemberAccessArgs) (res
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CheckJobStatusAPIError is an error-wrapper for the check_job_status route
+// CheckJobStatusAPIError is an error-wrapper for the check_job_status route
 type CheckJobStatusAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CheckJobStatus(arg *async.PollArg) (res *JobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CheckJobStatusAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) CheckJobStatus(arg *async.PollArg) (res *JobStatus, err error) {
+	return dbx.CheckJobStatusContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *JobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -478,20 +627,23 @@ func (dbx *apiImpl) CheckJobStatus(arg *async.PollArg) (res *JobStatus, err erro
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "check_job_status", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "check_job_status", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -502,9 +654,6 @@ func (dbx *apiImpl) CheckJobStatus(arg *async.PollArg) (res *JobStatus, err erro
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -522,31 +671,36 @@ func (dbx *apiImpl) CheckJobStatus(arg *async.PollArg) (res *JobStatus, err erro
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CheckRemoveMemberJobStatusAPIError is an error-wrapper for the check_remove_member_job_status route
+// CheckRemoveMemberJobStatusAPIError is an error-wrapper for the check_remove_member_job_status route
 type CheckRemoveMemberJobStatusAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveMemberJobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CheckRemoveMemberJobStatusAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveMemberJobStatus, err error) {
+	return dbx.CheckRemoveMemberJobStatusContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CheckRemoveMemberJobStatusContext(ctx context.Context, arg *async.PollArg) (res *RemoveMemberJobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -558,20 +712,23 @@ func (dbx *apiImpl) CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveM
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "check_remove_member_job_status", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "check_remove_member_job_status", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -582,9 +739,6 @@ func (dbx *apiImpl) CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveM
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -602,31 +756,36 @@ func (dbx *apiImpl) CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveM
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CheckShareJobStatusAPIError is an error-wrapper for the check_share_job_status route
+// CheckShareJobStatusAPIError is an error-wrapper for the check_share_job_status route
 type CheckShareJobStatusAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CheckShareJobStatusAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJobStatus, err error) {
+	return dbx.CheckShareJobStatusContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CheckShareJobStatusContext(ctx context.Context, arg *async.PollArg) (res *ShareFolderJobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -638,20 +797,23 @@ func (dbx *apiImpl) CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJob
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "check_share_job_status", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "check_share_job_status", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -662,9 +824,6 @@ func (dbx *apiImpl) CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJob
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -682,31 +841,36 @@ func (dbx *apiImpl) CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJob
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CreateSharedLinkAPIError is an error-wrapper for the create_shared_link route
+// CreateSharedLinkAPIError is an error-wrapper for the create_shared_link route
 type CreateSharedLinkAPIError struct {
 	dropbox.APIError
 	EndpointError *CreateSharedLinkError `json:"error"`
 }
 
-func (dbx *apiImpl) CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CreateSharedLinkAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error) {
+	return dbx.CreateSharedLinkContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CreateSharedLinkContext(ctx context.Context, arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -718,20 +882,23 @@ func (dbx *apiImpl) CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMet
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "create_shared_link", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "create_shared_link", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -742,9 +909,6 @@ func (dbx *apiImpl) CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMet
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -762,31 +926,36 @@ func (dbx *apiImpl) CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMet
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//CreateSharedLinkWithSettingsAPIError is an error-wrapper for the create_shared_link_with_settings route
+// CreateSharedLinkWithSettingsAPIError is an error-wrapper for the create_shared_link_with_settings route
 type CreateSharedLinkWithSettingsAPIError struct {
 	dropbox.APIError
 	EndpointError *CreateSharedLinkWithSettingsError `json:"error"`
 }
 
-func (dbx *apiImpl) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e CreateSharedLinkWithSettingsAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error) {
+	return dbx.CreateSharedLinkWithSettingsContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) CreateSharedLinkWithSettingsContext(ctx context.Context, arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -798,20 +967,23 @@ func (dbx *apiImpl) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettin
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "create_shared_link_with_settings", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "create_shared_link_with_settings", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -822,9 +994,6 @@ func (dbx *apiImpl) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettin
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		var tmp sharedLinkMetadataUnion
 		err = json.Unmarshal(body, &tmp)
@@ -850,31 +1019,36 @@ func (dbx *apiImpl) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettin
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetFileMetadataAPIError is an error-wrapper for the get_file_metadata route
+// GetFileMetadataAPIError is an error-wrapper for the get_file_metadata route
 type GetFileMetadataAPIError struct {
 	dropbox.APIError
 	EndpointError *GetFileMetadataError `json:"error"`
 }
 
-func (dbx *apiImpl) GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetFileMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMetadata, err error) {
+	return dbx.GetFileMetadataContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetFileMetadataContext(ctx context.Context, arg *GetFileMetadataArg) (res *SharedFileMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -886,20 +1060,23 @@ func (dbx *apiImpl) GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMet
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "get_file_metadata", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "get_file_metadata", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -910,9 +1087,6 @@ func (dbx *apiImpl) GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMet
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -930,31 +1104,36 @@ func (dbx *apiImpl) GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMet
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetFileMetadataBatchAPIError is an error-wrapper for the get_file_metadata/batch route
+// GetFileMetadataBatchAPIError is an error-wrapper for the get_file_metadata/batch route
 type GetFileMetadataBatchAPIError struct {
 	dropbox.APIError
 	EndpointError *SharingUserError `json:"error"`
 }
 
-func (dbx *apiImpl) GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetFileMetadataBatchAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error) {
+	return dbx.GetFileMetadataBatchContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) GetFileMetadataBatchContext(ctx context.Context, arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -966,20 +1145,23 @@ func (dbx *apiImpl) GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*G
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "get_file_metadata/batch", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "get_file_metadata/batch", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -990,9 +1172,6 @@ func (dbx *apiImpl) GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*G
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1010,31 +1189,36 @@ func (dbx *apiImpl) GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*G
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetFolderMetadataAPIError is an error-wrapper for the get_folder_metadata route
+// GetFolderMetadataAPIError is an error-wrapper for the get_folder_metadata route
 type GetFolderMetadataAPIError struct {
 	dropbox.APIError
 	EndpointError *SharedFolderAccessError `json:"error"`
 }
 
-func (dbx *apiImpl) GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetFolderMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMetadata, err error) {
+	return dbx.GetFolderMetadataContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetFolderMetadataContext(ctx context.Context, arg *GetMetadataArgs) (res *SharedFolderMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1046,20 +1230,23 @@ func (dbx *apiImpl) GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMe
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "get_folder_metadata", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "get_folder_metadata", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1070,9 +1257,6 @@ func (dbx *apiImpl) GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMe
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1090,31 +1274,36 @@ func (dbx *apiImpl) GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMe
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetSharedLinkFileAPIError is an error-wrapper for the get_shared_link_file route
+// GetSharedLinkFileAPIError is an error-wrapper for the get_shared_link_file route
 type GetSharedLinkFileAPIError struct {
 	dropbox.APIError
 	EndpointError *GetSharedLinkFileError `json:"error"`
 }
 
-func (dbx *apiImpl) GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetSharedLinkFileAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
+	return dbx.GetSharedLinkFileContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetSharedLinkFileContext(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1126,30 +1315,30 @@ func (dbx *apiImpl) GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsShar
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("content", "download", true, "sharing", "get_shared_link_file", headers, nil)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "content", "download", "user", "sharing", "get_shared_link_file", headers, nil)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
 
 	body := []byte(resp.Header.Get("Dropbox-API-Result"))
-	content = resp.Body
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
+		content = resp.Body
 		var tmp sharedLinkMetadataUnion
 		err = json.Unmarshal(body, &tmp)
 		if err != nil {
@@ -1165,6 +1354,11 @@ func (dbx *apiImpl) GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsShar
 		}
 		return
 	}
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
 	if resp.StatusCode == http.StatusConflict {
 		var apiError GetSharedLinkFileAPIError
 		err = json.Unmarshal(body, &apiError)
@@ -1174,31 +1368,36 @@ func (dbx *apiImpl) GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsShar
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetSharedLinkMetadataAPIError is an error-wrapper for the get_shared_link_metadata route
+// GetSharedLinkMetadataAPIError is an error-wrapper for the get_shared_link_metadata route
 type GetSharedLinkMetadataAPIError struct {
 	dropbox.APIError
 	EndpointError *SharedLinkError `json:"error"`
 }
 
-func (dbx *apiImpl) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetSharedLinkMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error) {
+	return dbx.GetSharedLinkMetadataContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetSharedLinkMetadataContext(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1210,20 +1409,23 @@ func (dbx *apiImpl) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res Is
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "get_shared_link_metadata", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "get_shared_link_metadata", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1234,9 +1436,6 @@ func (dbx *apiImpl) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res Is
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		var tmp sharedLinkMetadataUnion
 		err = json.Unmarshal(body, &tmp)
@@ -1262,31 +1461,36 @@ func (dbx *apiImpl) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res Is
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetSharedLinksAPIError is an error-wrapper for the get_shared_links route
+// GetSharedLinksAPIError is an error-wrapper for the get_shared_links route
 type GetSharedLinksAPIError struct {
 	dropbox.APIError
 	EndpointError *GetSharedLinksError `json:"error"`
 }
 
-func (dbx *apiImpl) GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetSharedLinksAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error) {
+	return dbx.GetSharedLinksContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetSharedLinksContext(ctx context.Context, arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1298,20 +1502,23 @@ func (dbx *apiImpl) GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksR
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "get_shared_links", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "get_shared_links", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1322,9 +1529,6 @@ func (dbx *apiImpl) GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksR
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1342,31 +1546,36 @@ func (dbx *apiImpl) GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksR
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFileMembersAPIError is an error-wrapper for the list_file_members route
+// ListFileMembersAPIError is an error-wrapper for the list_file_members route
 type ListFileMembersAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFileMembersError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMembers, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFileMembersAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMembers, err error) {
+	return dbx.ListFileMembersContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) ListFileMembersContext(ctx context.Context, arg *ListFileMembersArg) (res *SharedFileMembers, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1378,20 +1587,23 @@ func (dbx *apiImpl) ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMem
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_file_members", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_file_members", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1402,9 +1614,6 @@ func (dbx *apiImpl) ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMem
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1422,31 +1631,36 @@ func (dbx *apiImpl) ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMem
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFileMembersBatchAPIError is an error-wrapper for the list_file_members/batch route
+// ListFileMembersBatchAPIError is an error-wrapper for the list_file_members/batch route
 type ListFileMembersBatchAPIError struct {
 	dropbox.APIError
 	EndpointError *SharingUserError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFileMembersBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error) {
+	return dbx.ListFileMembersBatchContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFileMembersBatchContext(ctx context.Context, arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1458,20 +1672,23 @@ func (dbx *apiImpl) ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*L
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_file_members/batch", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_file_members/batch", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1482,9 +1699,6 @@ func (dbx *apiImpl) ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*L
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1502,31 +1716,36 @@ func (dbx *apiImpl) ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*L
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFileMembersContinueAPIError is an error-wrapper for the list_file_members/continue route
+// ListFileMembersContinueAPIError is an error-wrapper for the list_file_members/continue route
 type ListFileMembersContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFileMembersContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFileMembersContinue(arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFileMembersContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListFileMembersContinue(arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error) {
+	return dbx.ListFileMembersContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFileMembersContinueContext(ctx context.Context, arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1538,20 +1757,23 @@ func (dbx *apiImpl) ListFileMembersContinue(arg *ListFileMembersContinueArg) (re
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_file_members/continue", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_file_members/continue", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1562,9 +1784,6 @@ func (dbx *apiImpl) ListFileMembersContinue(arg *ListFileMembersContinueArg) (re
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1582,31 +1801,36 @@ func (dbx *apiImpl) ListFileMembersContinue(arg *ListFileMembersContinueArg) (re
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFolderMembersAPIError is an error-wrapper for the list_folder_members route
+// ListFolderMembersAPIError is an error-wrapper for the list_folder_members route
 type ListFolderMembersAPIError struct {
 	dropbox.APIError
 	EndpointError *SharedFolderAccessError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFolderMembersAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error) {
+	return dbx.ListFolderMembersContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFolderMembersContext(ctx context.Context, arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1618,20 +1842,23 @@ func (dbx *apiImpl) ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFo
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_folder_members", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_folder_members", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1642,9 +1869,6 @@ func (dbx *apiImpl) ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFo
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1662,31 +1886,36 @@ func (dbx *apiImpl) ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFo
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFolderMembersContinueAPIError is an error-wrapper for the list_folder_members/continue route
+// ListFolderMembersContinueAPIError is an error-wrapper for the list_folder_members/continue route
 type ListFolderMembersContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFolderMembersContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderMembersContinue(arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFolderMembersContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListFolderMembersContinue(arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error) {
+	return dbx.ListFolderMembersContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFolderMembersContinueContext(ctx context.Context, arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1698,20 +1927,23 @@ func (dbx *apiImpl) ListFolderMembersContinue(arg *ListFolderMembersContinueArg)
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_folder_members/continue", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_folder_members/continue", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1722,9 +1954,6 @@ func (dbx *apiImpl) ListFolderMembersContinue(arg *ListFolderMembersContinueArg)
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1742,31 +1971,36 @@ func (dbx *apiImpl) ListFolderMembersContinue(arg *ListFolderMembersContinueArg)
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFoldersAPIError is an error-wrapper for the list_folders route
+// ListFoldersAPIError is an error-wrapper for the list_folders route
 type ListFoldersAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFoldersAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+	return dbx.ListFoldersContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFoldersContext(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1778,20 +2012,23 @@ func (dbx *apiImpl) ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, e
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_folders", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_folders", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1802,9 +2039,6 @@ func (dbx *apiImpl) ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, e
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1822,31 +2056,36 @@ func (dbx *apiImpl) ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, e
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListFoldersContinueAPIError is an error-wrapper for the list_folders/continue route
+// ListFoldersContinueAPIError is an error-wrapper for the list_folders/continue route
 type ListFoldersContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFoldersContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListFoldersContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+	return dbx.ListFoldersContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1858,20 +2097,23 @@ func (dbx *apiImpl) ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListF
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_folders/continue", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_folders/continue", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1882,9 +2124,6 @@ func (dbx *apiImpl) ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListF
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1902,31 +2141,36 @@ func (dbx *apiImpl) ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListF
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListMountableFoldersAPIError is an error-wrapper for the list_mountable_folders route
+// ListMountableFoldersAPIError is an error-wrapper for the list_mountable_folders route
 type ListMountableFoldersAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) ListMountableFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListMountableFoldersAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListMountableFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+	return dbx.ListMountableFoldersContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListMountableFoldersContext(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1938,20 +2182,23 @@ func (dbx *apiImpl) ListMountableFolders(arg *ListFoldersArgs) (res *ListFolders
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_mountable_folders", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_mountable_folders", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1962,9 +2209,6 @@ func (dbx *apiImpl) ListMountableFolders(arg *ListFoldersArgs) (res *ListFolders
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1982,31 +2226,36 @@ func (dbx *apiImpl) ListMountableFolders(arg *ListFoldersArgs) (res *ListFolders
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListMountableFoldersContinueAPIError is an error-wrapper for the list_mountable_folders/continue route
+// ListMountableFoldersContinueAPIError is an error-wrapper for the list_mountable_folders/continue route
 type ListMountableFoldersContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFoldersContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListMountableFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListMountableFoldersContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListMountableFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+	return dbx.ListMountableFoldersContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListMountableFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2018,20 +2267,23 @@ func (dbx *apiImpl) ListMountableFoldersContinue(arg *ListFoldersContinueArg) (r
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_mountable_folders/continue", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_mountable_folders/continue", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2042,9 +2294,6 @@ func (dbx *apiImpl) ListMountableFoldersContinue(arg *ListFoldersContinueArg) (r
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2062,31 +2311,36 @@ func (dbx *apiImpl) ListMountableFoldersContinue(arg *ListFoldersContinueArg) (r
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListReceivedFilesAPIError is an error-wrapper for the list_received_files route
+// ListReceivedFilesAPIError is an error-wrapper for the list_received_files route
 type ListReceivedFilesAPIError struct {
 	dropbox.APIError
 	EndpointError *SharingUserError `json:"error"`
 }
 
-func (dbx *apiImpl) ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListReceivedFilesAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult, err error) {
+	return dbx.ListReceivedFilesContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListReceivedFilesContext(ctx context.Context, arg *ListFilesArg) (res *ListFilesResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2098,20 +2352,23 @@ func (dbx *apiImpl) ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult,
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_received_files", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_received_files", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2122,9 +2379,6 @@ func (dbx *apiImpl) ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult,
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2142,31 +2396,36 @@ func (dbx *apiImpl) ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult,
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListReceivedFilesContinueAPIError is an error-wrapper for the list_received_files/continue route
+// ListReceivedFilesContinueAPIError is an error-wrapper for the list_received_files/continue route
 type ListReceivedFilesContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *ListFilesContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *ListFilesResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListReceivedFilesContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *ListFilesResult, err error) {
+	return dbx.ListReceivedFilesContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListReceivedFilesContinueContext(ctx context.Context, arg *ListFilesContinueArg) (res *ListFilesResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2178,20 +2437,23 @@ func (dbx *apiImpl) ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *L
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_received_files/continue", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_received_files/continue", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2202,9 +2464,6 @@ func (dbx *apiImpl) ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *L
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2222,31 +2481,36 @@ func (dbx *apiImpl) ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *L
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ListSharedLinksAPIError is an error-wrapper for the list_shared_links route
+// ListSharedLinksAPIError is an error-wrapper for the list_shared_links route
 type ListSharedLinksAPIError struct {
 	dropbox.APIError
 	EndpointError *ListSharedLinksError `json:"error"`
 }
 
-func (dbx *apiImpl) ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ListSharedLinksAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error) {
+	return dbx.ListSharedLinksContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ListSharedLinksContext(ctx context.Context, arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2258,20 +2522,23 @@ func (dbx *apiImpl) ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLin
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "list_shared_links", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "list_shared_links", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2282,9 +2549,6 @@ func (dbx *apiImpl) ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLin
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2302,31 +2566,36 @@ func (dbx *apiImpl) ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLin
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ModifySharedLinkSettingsAPIError is an error-wrapper for the modify_shared_link_settings route
+// ModifySharedLinkSettingsAPIError is an error-wrapper for the modify_shared_link_settings route
 type ModifySharedLinkSettingsAPIError struct {
 	dropbox.APIError
 	EndpointError *ModifySharedLinkSettingsError `json:"error"`
 }
 
-func (dbx *apiImpl) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ModifySharedLinkSettingsAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error) {
+	return dbx.ModifySharedLinkSettingsContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ModifySharedLinkSettingsContext(ctx context.Context, arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2338,20 +2607,23 @@ func (dbx *apiImpl) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs)
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "modify_shared_link_settings", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "modify_shared_link_settings", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2362,9 +2634,6 @@ func (dbx *apiImpl) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs)
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		var tmp sharedLinkMetadataUnion
 		err = json.Unmarshal(body, &tmp)
@@ -2390,31 +2659,36 @@ func (dbx *apiImpl) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs)
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MountFolderAPIError is an error-wrapper for the mount_folder route
+// MountFolderAPIError is an error-wrapper for the mount_folder route
 type MountFolderAPIError struct {
 	dropbox.APIError
 	EndpointError *MountFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MountFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata, err error) {
+	return dbx.MountFolderContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MountFolderContext(ctx context.Context, arg *MountFolderArg) (res *SharedFolderMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2426,20 +2700,23 @@ func (dbx *apiImpl) MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata,
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "mount_folder", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "mount_folder", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2450,9 +2727,6 @@ func (dbx *apiImpl) MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata,
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2470,31 +2744,36 @@ func (dbx *apiImpl) MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata,
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//RelinquishFileMembershipAPIError is an error-wrapper for the relinquish_file_membership route
+// RelinquishFileMembershipAPIError is an error-wrapper for the relinquish_file_membership route
 type RelinquishFileMembershipAPIError struct {
 	dropbox.APIError
 	EndpointError *RelinquishFileMembershipError `json:"error"`
 }
 
-func (dbx *apiImpl) RelinquishFileMembership(arg *RelinquishFileMembershipArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e RelinquishFileMembershipAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) RelinquishFileMembership(arg *RelinquishFileMembershipArg) (err error) {
+	return dbx.RelinquishFileMembershipContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) RelinquishFileMembershipContext(ctx context.Context, arg *RelinquishFileMembershipArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2506,20 +2785,23 @@ func (dbx *apiImpl) RelinquishFileMembership(arg *RelinquishFileMembershipArg) (
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "relinquish_file_membership", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "relinquish_file_membership", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2530,9 +2812,6 @@ func (dbx *apiImpl) RelinquishFileMembership(arg *RelinquishFileMembershipArg) (
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2545,31 +2824,36 @@ func (dbx *apiImpl) RelinquishFileMembership(arg *RelinquishFileMembershipArg) (
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//RelinquishFolderMembershipAPIError is an error-wrapper for the relinquish_folder_membership route
+// RelinquishFolderMembershipAPIError is an error-wrapper for the relinquish_folder_membership route
 type RelinquishFolderMembershipAPIError struct {
 	dropbox.APIError
 	EndpointError *RelinquishFolderMembershipError `json:"error"`
 }
 
-func (dbx *apiImpl) RelinquishFolderMembership(arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e RelinquishFolderMembershipAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) RelinquishFolderMembership(arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error) {
+	return dbx.RelinquishFolderMembershipContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) RelinquishFolderMembershipContext(ctx context.Context, arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2581,20 +2865,23 @@ func (dbx *apiImpl) RelinquishFolderMembership(arg *RelinquishFolderMembershipAr
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "relinquish_folder_membership", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "relinquish_folder_membership", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2605,9 +2892,6 @@ func (dbx *apiImpl) RelinquishFolderMembership(arg *RelinquishFolderMembershipAr
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2625,31 +2909,36 @@ func (dbx *apiImpl) RelinquishFolderMembership(arg *RelinquishFolderMembershipAr
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//RemoveFileMemberAPIError is an error-wrapper for the remove_file_member route
+// RemoveFileMemberAPIError is an error-wrapper for the remove_file_member route
 type RemoveFileMemberAPIError struct {
 	dropbox.APIError
 	EndpointError *RemoveFileMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e RemoveFileMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error) {
+	return dbx.RemoveFileMemberContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) RemoveFileMemberContext(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2661,20 +2950,23 @@ func (dbx *apiImpl) RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberA
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "remove_file_member", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "remove_file_member", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2685,9 +2977,6 @@ func (dbx *apiImpl) RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberA
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2705,31 +2994,36 @@ func (dbx *apiImpl) RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberA
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//RemoveFileMember2APIError is an error-wrapper for the remove_file_member_2 route
+// RemoveFileMember2APIError is an error-wrapper for the remove_file_member_2 route
 type RemoveFileMember2APIError struct {
 	dropbox.APIError
 	EndpointError *RemoveFileMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e RemoveFileMember2APIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error) {
+	return dbx.RemoveFileMember2Context(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) RemoveFileMember2Context(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2741,20 +3035,23 @@ func (dbx *apiImpl) RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMember
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "remove_file_member_2", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "remove_file_member_2", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2765,9 +3062,6 @@ func (dbx *apiImpl) RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMember
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2785,31 +3079,36 @@ func (dbx *apiImpl) RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMember
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//RemoveFolderMemberAPIError is an error-wrapper for the remove_folder_member route
+// RemoveFolderMemberAPIError is an error-wrapper for the remove_folder_member route
 type RemoveFolderMemberAPIError struct {
 	dropbox.APIError
 	EndpointError *RemoveFolderMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e RemoveFolderMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error) {
+	return dbx.RemoveFolderMemberContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) RemoveFolderMemberContext(ctx context.Context, arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2821,20 +3120,23 @@ func (dbx *apiImpl) RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.L
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "remove_folder_member", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "remove_folder_member", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2845,9 +3147,6 @@ func (dbx *apiImpl) RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.L
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2865,31 +3164,36 @@ func (dbx *apiImpl) RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.L
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//RevokeSharedLinkAPIError is an error-wrapper for the revoke_shared_link route
+// RevokeSharedLinkAPIError is an error-wrapper for the revoke_shared_link route
 type RevokeSharedLinkAPIError struct {
 	dropbox.APIError
 	EndpointError *RevokeSharedLinkError `json:"error"`
 }
 
-func (dbx *apiImpl) RevokeSharedLink(arg *RevokeSharedLinkArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e RevokeSharedLinkAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) RevokeSharedLink(arg *RevokeSharedLinkArg) (err error) {
+	return dbx.RevokeSharedLinkContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) RevokeSharedLinkContext(ctx context.Context, arg *RevokeSharedLinkArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2901,20 +3205,23 @@ func (dbx *apiImpl) RevokeSharedLink(arg *RevokeSharedLinkArg) (err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "revoke_shared_link", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "revoke_shared_link", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2925,9 +3232,6 @@ func (dbx *apiImpl) RevokeSharedLink(arg *RevokeSharedLinkArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2940,31 +3244,36 @@ func (dbx *apiImpl) RevokeSharedLink(arg *RevokeSharedLinkArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ShareFolderAPIError is an error-wrapper for the share_folder route
+// ShareFolderAPIError is an error-wrapper for the share_folder route
 type ShareFolderAPIError struct {
 	dropbox.APIError
 	EndpointError *ShareFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ShareFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, err error) {
+	return dbx.ShareFolderContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ShareFolderContext(ctx context.Context, arg *ShareFolderArg) (res *ShareFolderLaunch, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2976,20 +3285,23 @@ func (dbx *apiImpl) ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, er
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "share_folder", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "share_folder", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3000,9 +3312,6 @@ func (dbx *apiImpl) ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, er
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3020,31 +3329,36 @@ func (dbx *apiImpl) ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, er
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TransferFolderAPIError is an error-wrapper for the transfer_folder route
+// TransferFolderAPIError is an error-wrapper for the transfer_folder route
 type TransferFolderAPIError struct {
 	dropbox.APIError
 	EndpointError *TransferFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) TransferFolder(arg *TransferFolderArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TransferFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) TransferFolder(arg *TransferFolderArg) (err error) {
+	return dbx.TransferFolderContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TransferFolderContext(ctx context.Context, arg *TransferFolderArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3056,20 +3370,23 @@ func (dbx *apiImpl) TransferFolder(arg *TransferFolderArg) (err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "transfer_folder", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "transfer_folder", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3080,9 +3397,6 @@ func (dbx *apiImpl) TransferFolder(arg *TransferFolderArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -3095,31 +3409,36 @@ func (dbx *apiImpl) TransferFolder(arg *TransferFolderArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UnmountFolderAPIError is an error-wrapper for the unmount_folder route
+// UnmountFolderAPIError is an error-wrapper for the unmount_folder route
 type UnmountFolderAPIError struct {
 	dropbox.APIError
 	EndpointError *UnmountFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) UnmountFolder(arg *UnmountFolderArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UnmountFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UnmountFolder(arg *UnmountFolderArg) (err error) {
+	return dbx.UnmountFolderContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UnmountFolderContext(ctx context.Context, arg *UnmountFolderArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3131,20 +3450,23 @@ func (dbx *apiImpl) UnmountFolder(arg *UnmountFolderArg) (err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "unmount_folder", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "unmount_folder", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3155,9 +3477,6 @@ func (dbx *apiImpl) UnmountFolder(arg *UnmountFolderArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -3170,31 +3489,36 @@ func (dbx *apiImpl) UnmountFolder(arg *UnmountFolderArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UnshareFileAPIError is an error-wrapper for the unshare_file route
+// UnshareFileAPIError is an error-wrapper for the unshare_file route
 type UnshareFileAPIError struct {
 	dropbox.APIError
 	EndpointError *UnshareFileError `json:"error"`
 }
 
-func (dbx *apiImpl) UnshareFile(arg *UnshareFileArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UnshareFileAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UnshareFile(arg *UnshareFileArg) (err error) {
+	return dbx.UnshareFileContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UnshareFileContext(ctx context.Context, arg *UnshareFileArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3206,20 +3530,23 @@ func (dbx *apiImpl) UnshareFile(arg *UnshareFileArg) (err error) {
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "unshare_file", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "unshare_file", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3230,9 +3557,6 @@ func (dbx *apiImpl) UnshareFile(arg *UnshareFileArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -3245,31 +3569,36 @@ func (dbx *apiImpl) UnshareFile(arg *UnshareFileArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UnshareFolderAPIError is an error-wrapper for the unshare_folder route
+// UnshareFolderAPIError is an error-wrapper for the unshare_folder route
 type UnshareFolderAPIError struct {
 	dropbox.APIError
 	EndpointError *UnshareFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UnshareFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error) {
+	return dbx.UnshareFolderContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UnshareFolderContext(ctx context.Context, arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3281,20 +3610,23 @@ func (dbx *apiImpl) UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmpty
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "unshare_folder", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "unshare_folder", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3305,9 +3637,6 @@ func (dbx *apiImpl) UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmpty
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3325,31 +3654,36 @@ func (dbx *apiImpl) UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmpty
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UpdateFileMemberAPIError is an error-wrapper for the update_file_member route
+// UpdateFileMemberAPIError is an error-wrapper for the update_file_member route
 type UpdateFileMemberAPIError struct {
 	dropbox.APIError
 	EndpointError *FileMemberActionError `json:"error"`
 }
 
-func (dbx *apiImpl) UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UpdateFileMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error) {
+	return dbx.UpdateFileMemberContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UpdateFileMemberContext(ctx context.Context, arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3361,20 +3695,23 @@ func (dbx *apiImpl) UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAcce
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "update_file_member", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "update_file_member", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3385,9 +3722,6 @@ func (dbx *apiImpl) UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAcce
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3405,31 +3739,36 @@ func (dbx *apiImpl) UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAcce
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UpdateFolderMemberAPIError is an error-wrapper for the update_folder_member route
+// UpdateFolderMemberAPIError is an error-wrapper for the update_folder_member route
 type UpdateFolderMemberAPIError struct {
 	dropbox.APIError
 	EndpointError *UpdateFolderMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UpdateFolderMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error) {
+	return dbx.UpdateFolderMemberContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UpdateFolderMemberContext(ctx context.Context, arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3441,20 +3780,23 @@ func (dbx *apiImpl) UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberA
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "update_folder_member", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "update_folder_member", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3465,9 +3807,6 @@ func (dbx *apiImpl) UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberA
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3485,31 +3824,36 @@ func (dbx *apiImpl) UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberA
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//UpdateFolderPolicyAPIError is an error-wrapper for the update_folder_policy route
+// UpdateFolderPolicyAPIError is an error-wrapper for the update_folder_policy route
 type UpdateFolderPolicyAPIError struct {
 	dropbox.APIError
 	EndpointError *UpdateFolderPolicyError `json:"error"`
 }
 
-func (dbx *apiImpl) UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e UpdateFolderPolicyAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error) {
+	return dbx.UpdateFolderPolicyContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) UpdateFolderPolicyContext(ctx context.Context, arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3521,20 +3865,23 @@ func (dbx *apiImpl) UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedF
 	}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
+	} else if dbx.Config.AsAdminID != "" {
+		headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "sharing", "update_folder_policy", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "sharing", "update_folder_policy", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3545,9 +3892,6 @@ func (dbx *apiImpl) UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedF
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3565,22 +3909,26 @@ func (dbx *apiImpl) UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedF
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
 // New returns a Client implementation for this namespace
-func New(c dropbox.Config) *apiImpl {
-	ctx := apiImpl(dropbox.NewContext(c))
+func New(c dropbox.Config) *ClientImpl {
+	return NewFromContext(dropbox.NewContext(c))
+}
+
+// NewFromContext returns a Client implementation for this namespace
+// that shares the http.Client and tokens of c
+func NewFromContext(c dropbox.Context) *ClientImpl {
+	ctx := ClientImpl(c)
 	return &ctx
 }
@@ -0,0 +1,57 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sharing
+
+import "github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+
+// Unions whose tag reports that the user lacks permission for a sharing
+// action, and that tag
+var accessDenied = []struct {
+	union interface{}
+	tag   string
+}{
+	{(*AddFolderMemberError)(nil), AddFolderMemberErrorNoPermission},
+	{(*CreateSharedLinkWithSettingsError)(nil), CreateSharedLinkWithSettingsErrorAccessDenied},
+	{(*FileMemberActionError)(nil), FileMemberActionErrorNoPermission},
+	{(*MountFolderError)(nil), MountFolderErrorNoPermission},
+	{(*RelinquishFileMembershipError)(nil), RelinquishFileMembershipErrorNoPermission},
+	{(*RelinquishFolderMembershipError)(nil), RelinquishFolderMembershipErrorNoPermission},
+	{(*RemoveFolderMemberError)(nil), RemoveFolderMemberErrorNoPermission},
+	{(*ShareFolderError)(nil), ShareFolderErrorNoPermission},
+	{(*SharedLinkError)(nil), SharedLinkErrorSharedLinkAccessDenied},
+	{(*SharingFileAccessError)(nil), SharingFileAccessErrorNoPermission},
+	{(*TransferFolderError)(nil), TransferFolderErrorNoPermission},
+	{(*UnmountFolderError)(nil), UnmountFolderErrorNoPermission},
+	{(*UnshareFolderError)(nil), UnshareFolderErrorNoPermission},
+	{(*UpdateFolderMemberError)(nil), UpdateFolderMemberErrorNoPermission},
+	{(*UpdateFolderPolicyError)(nil), UpdateFolderPolicyErrorNoPermission},
+}
+
+// IsAccessDenied reports whether err was caused by the user not having
+// permission to perform the sharing action.
+func IsAccessDenied(err error) bool {
+	for _, a := range accessDenied {
+		if dropbox.IsEndpointError(err, a.union, a.tag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,789 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package sharingtest provides a fake sharing.Client for tests.
+package sharingtest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/async"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/sharing"
+)
+
+// ErrNotStubbed is returned by the routes of a Fake that are not stubbed
+var ErrNotStubbed = errors.New("sharingtest: route not stubbed")
+
+// Call is a call made to a Fake
+type Call struct {
+	// Name of the route, e.g. "add_file_member"
+	Route string
+	// Argument of the call (nil for routes without one)
+	Arg interface{}
+	// Content passed to upload routes
+	Content io.Reader
+}
+
+// Fake is a sharing.Client for tests. Each route calls the function in the
+// matching field, e.g. AddFileMemberFunc, or returns ErrNotStubbed if the field
+// is nil. All calls are recorded. The zero value is ready to use.
+type Fake struct {
+	// Stubs AddFileMember and AddFileMemberContext
+	AddFileMemberFunc func(ctx context.Context, arg *sharing.AddFileMemberArgs) (res []*sharing.FileMemberActionResult, err error)
+	// Stubs AddFolderMember and AddFolderMemberContext
+	AddFolderMemberFunc func(ctx context.Context, arg *sharing.AddFolderMemberArg) (err error)
+	// Stubs ChangeFileMemberAccess and ChangeFileMemberAccessContext
+	ChangeFileMemberAccessFunc func(ctx context.Context, arg *sharing.ChangeFileMemberAccessArgs) (res *sharing.FileMemberActionResult, err error)
+	// Stubs CheckJobStatus and CheckJobStatusContext
+	CheckJobStatusFunc func(ctx context.Context, arg *async.PollArg) (res *sharing.JobStatus, err error)
+	// Stubs CheckRemoveMemberJobStatus and CheckRemoveMemberJobStatusContext
+	CheckRemoveMemberJobStatusFunc func(ctx context.Context, arg *async.PollArg) (res *sharing.RemoveMemberJobStatus, err error)
+	// Stubs CheckShareJobStatus and CheckShareJobStatusContext
+	CheckShareJobStatusFunc func(ctx context.Context, arg *async.PollArg) (res *sharing.ShareFolderJobStatus, err error)
+	// Stubs CreateSharedLink and CreateSharedLinkContext
+	CreateSharedLinkFunc func(ctx context.Context, arg *sharing.CreateSharedLinkArg) (res *sharing.PathLinkMetadata, err error)
+	// Stubs CreateSharedLinkWithSettings and CreateSharedLinkWithSettingsContext
+	CreateSharedLinkWithSettingsFunc func(ctx context.Context, arg *sharing.CreateSharedLinkWithSettingsArg) (res sharing.IsSharedLinkMetadata, err error)
+	// Stubs GetFileMetadata and GetFileMetadataContext
+	GetFileMetadataFunc func(ctx context.Context, arg *sharing.GetFileMetadataArg) (res *sharing.SharedFileMetadata, err error)
+	// Stubs GetFileMetadataBatch and GetFileMetadataBatchContext
+	GetFileMetadataBatchFunc func(ctx context.Context, arg *sharing.GetFileMetadataBatchArg) (res []*sharing.GetFileMetadataBatchResult, err error)
+	// Stubs GetFolderMetadata and GetFolderMetadataContext
+	GetFolderMetadataFunc func(ctx context.Context, arg *sharing.GetMetadataArgs) (res *sharing.SharedFolderMetadata, err error)
+	// Stubs GetSharedLinkFile and GetSharedLinkFileContext
+	GetSharedLinkFileFunc func(ctx context.Context, arg *sharing.GetSharedLinkMetadataArg) (res sharing.IsSharedLinkMetadata, content io.ReadCloser, err error)
+	// Stubs GetSharedLinkMetadata and GetSharedLinkMetadataContext
+	GetSharedLinkMetadataFunc func(ctx context.Context, arg *sharing.GetSharedLinkMetadataArg) (res sharing.IsSharedLinkMetadata, err error)
+	// Stubs GetSharedLinks and GetSharedLinksContext
+	GetSharedLinksFunc func(ctx context.Context, arg *sharing.GetSharedLinksArg) (res *sharing.GetSharedLinksResult, err error)
+	// Stubs ListFileMembers and ListFileMembersContext
+	ListFileMembersFunc func(ctx context.Context, arg *sharing.ListFileMembersArg) (res *sharing.SharedFileMembers, err error)
+	// Stubs ListFileMembersBatch and ListFileMembersBatchContext
+	ListFileMembersBatchFunc func(ctx context.Context, arg *sharing.ListFileMembersBatchArg) (res []*sharing.ListFileMembersBatchResult, err error)
+	// Stubs ListFileMembersContinue and ListFileMembersContinueContext
+	ListFileMembersContinueFunc func(ctx context.Context, arg *sharing.ListFileMembersContinueArg) (res *sharing.SharedFileMembers, err error)
+	// Stubs ListFolderMembers and ListFolderMembersContext
+	ListFolderMembersFunc func(ctx context.Context, arg *sharing.ListFolderMembersArgs) (res *sharing.SharedFolderMembers, err error)
+	// Stubs ListFolderMembersContinue and ListFolderMembersContinueContext
+	ListFolderMembersContinueFunc func(ctx context.Context, arg *sharing.ListFolderMembersContinueArg) (res *sharing.SharedFolderMembers, err error)
+	// Stubs ListFolders and ListFoldersContext
+	ListFoldersFunc func(ctx context.Context, arg *sharing.ListFoldersArgs) (res *sharing.ListFoldersResult, err error)
+	// Stubs ListFoldersContinue and ListFoldersContinueContext
+	ListFoldersContinueFunc func(ctx context.Context, arg *sharing.ListFoldersContinueArg) (res *sharing.ListFoldersResult, err error)
+	// Stubs ListMountableFolders and ListMountableFoldersContext
+	ListMountableFoldersFunc func(ctx context.Context, arg *sharing.ListFoldersArgs) (res *sharing.ListFoldersResult, err error)
+	// Stubs ListMountableFoldersContinue and ListMountableFoldersContinueContext
+	ListMountableFoldersContinueFunc func(ctx context.Context, arg *sharing.ListFoldersContinueArg) (res *sharing.ListFoldersResult, err error)
+	// Stubs ListReceivedFiles and ListReceivedFilesContext
+	ListReceivedFilesFunc func(ctx context.Context, arg *sharing.ListFilesArg) (res *sharing.ListFilesResult, err error)
+	// Stubs ListReceivedFilesContinue and ListReceivedFilesContinueContext
+	ListReceivedFilesContinueFunc func(ctx context.Context, arg *sharing.ListFilesContinueArg) (res *sharing.ListFilesResult, err error)
+	// Stubs ListSharedLinks and ListSharedLinksContext
+	ListSharedLinksFunc func(ctx context.Context, arg *sharing.ListSharedLinksArg) (res *sharing.ListSharedLinksResult, err error)
+	// Stubs ModifySharedLinkSettings and ModifySharedLinkSettingsContext
+	ModifySharedLinkSettingsFunc func(ctx context.Context, arg *sharing.ModifySharedLinkSettingsArgs) (res sharing.IsSharedLinkMetadata, err error)
+	// Stubs MountFolder and MountFolderContext
+	MountFolderFunc func(ctx context.Context, arg *sharing.MountFolderArg) (res *sharing.SharedFolderMetadata, err error)
+	// Stubs RelinquishFileMembership and RelinquishFileMembershipContext
+	RelinquishFileMembershipFunc func(ctx context.Context, arg *sharing.RelinquishFileMembershipArg) (err error)
+	// Stubs RelinquishFolderMembership and RelinquishFolderMembershipContext
+	RelinquishFolderMembershipFunc func(ctx context.Context, arg *sharing.RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error)
+	// Stubs RemoveFileMember and RemoveFileMemberContext
+	RemoveFileMemberFunc func(ctx context.Context, arg *sharing.RemoveFileMemberArg) (res *sharing.FileMemberActionIndividualResult, err error)
+	// Stubs RemoveFileMember2 and RemoveFileMember2Context
+	RemoveFileMember2Func func(ctx context.Context, arg *sharing.RemoveFileMemberArg) (res *sharing.FileMemberRemoveActionResult, err error)
+	// Stubs RemoveFolderMember and RemoveFolderMemberContext
+	RemoveFolderMemberFunc func(ctx context.Context, arg *sharing.RemoveFolderMemberArg) (res *async.LaunchResultBase, err error)
+	// Stubs RevokeSharedLink and RevokeSharedLinkContext
+	RevokeSharedLinkFunc func(ctx context.Context, arg *sharing.RevokeSharedLinkArg) (err error)
+	// Stubs ShareFolder and ShareFolderContext
+	ShareFolderFunc func(ctx context.Context, arg *sharing.ShareFolderArg) (res *sharing.ShareFolderLaunch, err error)
+	// Stubs TransferFolder and TransferFolderContext
+	TransferFolderFunc func(ctx context.Context, arg *sharing.TransferFolderArg) (err error)
+	// Stubs UnmountFolder and UnmountFolderContext
+	UnmountFolderFunc func(ctx context.Context, arg *sharing.UnmountFolderArg) (err error)
+	// Stubs UnshareFile and UnshareFileContext
+	UnshareFileFunc func(ctx context.Context, arg *sharing.UnshareFileArg) (err error)
+	// Stubs UnshareFolder and UnshareFolderContext
+	UnshareFolderFunc func(ctx context.Context, arg *sharing.UnshareFolderArg) (res *async.LaunchEmptyResult, err error)
+	// Stubs UpdateFileMember and UpdateFileMemberContext
+	UpdateFileMemberFunc func(ctx context.Context, arg *sharing.UpdateFileMemberArgs) (res *sharing.MemberAccessLevelResult, err error)
+	// Stubs UpdateFolderMember and UpdateFolderMemberContext
+	UpdateFolderMemberFunc func(ctx context.Context, arg *sharing.UpdateFolderMemberArg) (res *sharing.MemberAccessLevelResult, err error)
+	// Stubs UpdateFolderPolicy and UpdateFolderPolicyContext
+	UpdateFolderPolicyFunc func(ctx context.Context, arg *sharing.UpdateFolderPolicyArg) (res *sharing.SharedFolderMetadata, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ sharing.Client = (*Fake)(nil)
+
+// Calls returns the calls made so far, or only those to the given route
+func (f *Fake) Calls(route ...string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []Call
+	for _, c := range f.calls {
+		if len(route) == 0 || c.Route == route[0] {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (f *Fake) record(route string, arg interface{}, content io.Reader) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, Call{route, arg, content})
+}
+
+// AddFileMember implements sharing.Client
+func (f *Fake) AddFileMember(arg *sharing.AddFileMemberArgs) (res []*sharing.FileMemberActionResult, err error) {
+	return f.AddFileMemberContext(context.Background(), arg)
+}
+
+// AddFileMemberContext implements sharing.Client
+func (f *Fake) AddFileMemberContext(ctx context.Context, arg *sharing.AddFileMemberArgs) (res []*sharing.FileMemberActionResult, err error) {
+	f.record("add_file_member", arg, nil)
+	if f.AddFileMemberFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.AddFileMemberFunc(ctx, arg)
+}
+
+// AddFolderMember implements sharing.Client
+func (f *Fake) AddFolderMember(arg *sharing.AddFolderMemberArg) (err error) {
+	return f.AddFolderMemberContext(context.Background(), arg)
+}
+
+// AddFolderMemberContext implements sharing.Client
+func (f *Fake) AddFolderMemberContext(ctx context.Context, arg *sharing.AddFolderMemberArg) (err error) {
+	f.record("add_folder_member", arg, nil)
+	if f.AddFolderMemberFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.AddFolderMemberFunc(ctx, arg)
+}
+
+// ChangeFileMemberAccess implements sharing.Client
+func (f *Fake) ChangeFileMemberAccess(arg *sharing.ChangeFileMemberAccessArgs) (res *sharing.FileMemberActionResult, err error) {
+	return f.ChangeFileMemberAccessContext(context.Background(), arg)
+}
+
+// ChangeFileMemberAccessContext implements sharing.Client
+func (f *Fake) ChangeFileMemberAccessContext(ctx context.Context, arg *sharing.ChangeFileMemberAccessArgs) (res *sharing.FileMemberActionResult, err error) {
+	f.record("change_file_member_access", arg, nil)
+	if f.ChangeFileMemberAccessFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ChangeFileMemberAccessFunc(ctx, arg)
+}
+
+// CheckJobStatus implements sharing.Client
+func (f *Fake) CheckJobStatus(arg *async.PollArg) (res *sharing.JobStatus, err error) {
+	return f.CheckJobStatusContext(context.Background(), arg)
+}
+
+// CheckJobStatusContext implements sharing.Client
+func (f *Fake) CheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *sharing.JobStatus, err error) {
+	f.record("check_job_status", arg, nil)
+	if f.CheckJobStatusFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CheckJobStatusFunc(ctx, arg)
+}
+
+// CheckRemoveMemberJobStatus implements sharing.Client
+func (f *Fake) CheckRemoveMemberJobStatus(arg *async.PollArg) (res *sharing.RemoveMemberJobStatus, err error) {
+	return f.CheckRemoveMemberJobStatusContext(context.Background(), arg)
+}
+
+// CheckRemoveMemberJobStatusContext implements sharing.Client
+func (f *Fake) CheckRemoveMemberJobStatusContext(ctx context.Context, arg *async.PollArg) (res *sharing.RemoveMemberJobStatus, err error) {
+	f.record("check_remove_member_job_status", arg, nil)
+	if f.CheckRemoveMemberJobStatusFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CheckRemoveMemberJobStatusFunc(ctx, arg)
+}
+
+// CheckShareJobStatus implements sharing.Client
+func (f *Fake) CheckShareJobStatus(arg *async.PollArg) (res *sharing.ShareFolderJobStatus, err error) {
+	return f.CheckShareJobStatusContext(context.Background(), arg)
+}
+
+// CheckShareJobStatusContext implements sharing.Client
+func (f *Fake) CheckShareJobStatusContext(ctx context.Context, arg *async.PollArg) (res *sharing.ShareFolderJobStatus, err error) {
+	f.record("check_share_job_status", arg, nil)
+	if f.CheckShareJobStatusFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CheckShareJobStatusFunc(ctx, arg)
+}
+
+// CreateSharedLink implements sharing.Client
+func (f *Fake) CreateSharedLink(arg *sharing.CreateSharedLinkArg) (res *sharing.PathLinkMetadata, err error) {
+	return f.CreateSharedLinkContext(context.Background(), arg)
+}
+
+// CreateSharedLinkContext implements sharing.Client
+func (f *Fake) CreateSharedLinkContext(ctx context.Context, arg *sharing.CreateSharedLinkArg) (res *sharing.PathLinkMetadata, err error) {
+	f.record("create_shared_link", arg, nil)
+	if f.CreateSharedLinkFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CreateSharedLinkFunc(ctx, arg)
+}
+
+// CreateSharedLinkWithSettings implements sharing.Client
+func (f *Fake) CreateSharedLinkWithSettings(arg *sharing.CreateSharedLinkWithSettingsArg) (res sharing.IsSharedLinkMetadata, err error) {
+	return f.CreateSharedLinkWithSettingsContext(context.Background(), arg)
+}
+
+// CreateSharedLinkWithSettingsContext implements sharing.Client
+func (f *Fake) CreateSharedLinkWithSettingsContext(ctx context.Context, arg *sharing.CreateSharedLinkWithSettingsArg) (res sharing.IsSharedLinkMetadata, err error) {
+	f.record("create_shared_link_with_settings", arg, nil)
+	if f.CreateSharedLinkWithSettingsFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.CreateSharedLinkWithSettingsFunc(ctx, arg)
+}
+
+// GetFileMetadata implements sharing.Client
+func (f *Fake) GetFileMetadata(arg *sharing.GetFileMetadataArg) (res *sharing.SharedFileMetadata, err error) {
+	return f.GetFileMetadataContext(context.Background(), arg)
+}
+
+// GetFileMetadataContext implements sharing.Client
+func (f *Fake) GetFileMetadataContext(ctx context.Context, arg *sharing.GetFileMetadataArg) (res *sharing.SharedFileMetadata, err error) {
+	f.record("get_file_metadata", arg, nil)
+	if f.GetFileMetadataFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetFileMetadataFunc(ctx, arg)
+}
+
+// GetFileMetadataBatch implements sharing.Client
+func (f *Fake) GetFileMetadataBatch(arg *sharing.GetFileMetadataBatchArg) (res []*sharing.GetFileMetadataBatchResult, err error) {
+	return f.GetFileMetadataBatchContext(context.Background(), arg)
+}
+
+// GetFileMetadataBatchContext implements sharing.Client
+func (f *Fake) GetFileMetadataBatchContext(ctx context.Context, arg *sharing.GetFileMetadataBatchArg) (res []*sharing.GetFileMetadataBatchResult, err error) {
+	f.record("get_file_metadata/batch", arg, nil)
+	if f.GetFileMetadataBatchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetFileMetadataBatchFunc(ctx, arg)
+}
+
+// GetFolderMetadata implements sharing.Client
+func (f *Fake) GetFolderMetadata(arg *sharing.GetMetadataArgs) (res *sharing.SharedFolderMetadata, err error) {
+	return f.GetFolderMetadataContext(context.Background(), arg)
+}
+
+// GetFolderMetadataContext implements sharing.Client
+func (f *Fake) GetFolderMetadataContext(ctx context.Context, arg *sharing.GetMetadataArgs) (res *sharing.SharedFolderMetadata, err error) {
+	f.record("get_folder_metadata", arg, nil)
+	if f.GetFolderMetadataFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetFolderMetadataFunc(ctx, arg)
+}
+
+// GetSharedLinkFile implements sharing.Client
+func (f *Fake) GetSharedLinkFile(arg *sharing.GetSharedLinkMetadataArg) (res sharing.IsSharedLinkMetadata, content io.ReadCloser, err error) {
+	return f.GetSharedLinkFileContext(context.Background(), arg)
+}
+
+// GetSharedLinkFileContext implements sharing.Client
+func (f *Fake) GetSharedLinkFileContext(ctx context.Context, arg *sharing.GetSharedLinkMetadataArg) (res sharing.IsSharedLinkMetadata, content io.ReadCloser, err error) {
+	f.record("get_shared_link_file", arg, nil)
+	if f.GetSharedLinkFileFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetSharedLinkFileFunc(ctx, arg)
+}
+
+// GetSharedLinkMetadata implements sharing.Client
+func (f *Fake) GetSharedLinkMetadata(arg *sharing.GetSharedLinkMetadataArg) (res sharing.IsSharedLinkMetadata, err error) {
+	return f.GetSharedLinkMetadataContext(context.Background(), arg)
+}
+
+// GetSharedLinkMetadataContext implements sharing.Client
+func (f *Fake) GetSharedLinkMetadataContext(ctx context.Context, arg *sharing.GetSharedLinkMetadataArg) (res sharing.IsSharedLinkMetadata, err error) {
+	f.record("get_shared_link_metadata", arg, nil)
+	if f.GetSharedLinkMetadataFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetSharedLinkMetadataFunc(ctx, arg)
+}
+
+// GetSharedLinks implements sharing.Client
+func (f *Fake) GetSharedLinks(arg *sharing.GetSharedLinksArg) (res *sharing.GetSharedLinksResult, err error) {
+	return f.GetSharedLinksContext(context.Background(), arg)
+}
+
+// GetSharedLinksContext implements sharing.Client
+func (f *Fake) GetSharedLinksContext(ctx context.Context, arg *sharing.GetSharedLinksArg) (res *sharing.GetSharedLinksResult, err error) {
+	f.record("get_shared_links", arg, nil)
+	if f.GetSharedLinksFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetSharedLinksFunc(ctx, arg)
+}
+
+// ListFileMembers implements sharing.Client
+func (f *Fake) ListFileMembers(arg *sharing.ListFileMembersArg) (res *sharing.SharedFileMembers, err error) {
+	return f.ListFileMembersContext(context.Background(), arg)
+}
+
+// ListFileMembersContext implements sharing.Client
+func (f *Fake) ListFileMembersContext(ctx context.Context, arg *sharing.ListFileMembersArg) (res *sharing.SharedFileMembers, err error) {
+	f.record("list_file_members", arg, nil)
+	if f.ListFileMembersFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFileMembersFunc(ctx, arg)
+}
+
+// ListFileMembersBatch implements sharing.Client
+func (f *Fake) ListFileMembersBatch(arg *sharing.ListFileMembersBatchArg) (res []*sharing.ListFileMembersBatchResult, err error) {
+	return f.ListFileMembersBatchContext(context.Background(), arg)
+}
+
+// ListFileMembersBatchContext implements sharing.Client
+func (f *Fake) ListFileMembersBatchContext(ctx context.Context, arg *sharing.ListFileMembersBatchArg) (res []*sharing.ListFileMembersBatchResult, err error) {
+	f.record("list_file_members/batch", arg, nil)
+	if f.ListFileMembersBatchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFileMembersBatchFunc(ctx, arg)
+}
+
+// ListFileMembersContinue implements sharing.Client
+func (f *Fake) ListFileMembersContinue(arg *sharing.ListFileMembersContinueArg) (res *sharing.SharedFileMembers, err error) {
+	return f.ListFileMembersContinueContext(context.Background(), arg)
+}
+
+// ListFileMembersContinueContext implements sharing.Client
+func (f *Fake) ListFileMembersContinueContext(ctx context.Context, arg *sharing.ListFileMembersContinueArg) (res *sharing.SharedFileMembers, err error) {
+	f.record("list_file_members/continue", arg, nil)
+	if f.ListFileMembersContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFileMembersContinueFunc(ctx, arg)
+}
+
+// ListFolderMembers implements sharing.Client
+func (f *Fake) ListFolderMembers(arg *sharing.ListFolderMembersArgs) (res *sharing.SharedFolderMembers, err error) {
+	return f.ListFolderMembersContext(context.Background(), arg)
+}
+
+// ListFolderMembersContext implements sharing.Client
+func (f *Fake) ListFolderMembersContext(ctx context.Context, arg *sharing.ListFolderMembersArgs) (res *sharing.SharedFolderMembers, err error) {
+	f.record("list_folder_members", arg, nil)
+	if f.ListFolderMembersFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFolderMembersFunc(ctx, arg)
+}
+
+// ListFolderMembersContinue implements sharing.Client
+func (f *Fake) ListFolderMembersContinue(arg *sharing.ListFolderMembersContinueArg) (res *sharing.SharedFolderMembers, err error) {
+	return f.ListFolderMembersContinueContext(context.Background(), arg)
+}
+
+// ListFolderMembersContinueContext implements sharing.Client
+func (f *Fake) ListFolderMembersContinueContext(ctx context.Context, arg *sharing.ListFolderMembersContinueArg) (res *sharing.SharedFolderMembers, err error) {
+	f.record("list_folder_members/continue", arg, nil)
+	if f.ListFolderMembersContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFolderMembersContinueFunc(ctx, arg)
+}
+
+// ListFolders implements sharing.Client
+func (f *Fake) ListFolders(arg *sharing.ListFoldersArgs) (res *sharing.ListFoldersResult, err error) {
+	return f.ListFoldersContext(context.Background(), arg)
+}
+
+// ListFoldersContext implements sharing.Client
+func (f *Fake) ListFoldersContext(ctx context.Context, arg *sharing.ListFoldersArgs) (res *sharing.ListFoldersResult, err error) {
+	f.record("list_folders", arg, nil)
+	if f.ListFoldersFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFoldersFunc(ctx, arg)
+}
+
+// ListFoldersContinue implements sharing.Client
+func (f *Fake) ListFoldersContinue(arg *sharing.ListFoldersContinueArg) (res *sharing.ListFoldersResult, err error) {
+	return f.ListFoldersContinueContext(context.Background(), arg)
+}
+
+// ListFoldersContinueContext implements sharing.Client
+func (f *Fake) ListFoldersContinueContext(ctx context.Context, arg *sharing.ListFoldersContinueArg) (res *sharing.ListFoldersResult, err error) {
+	f.record("list_folders/continue", arg, nil)
+	if f.ListFoldersContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListFoldersContinueFunc(ctx, arg)
+}
+
+// ListMountableFolders implements sharing.Client
+func (f *Fake) ListMountableFolders(arg *sharing.ListFoldersArgs) (res *sharing.ListFoldersResult, err error) {
+	return f.ListMountableFoldersContext(context.Background(), arg)
+}
+
+// ListMountableFoldersContext implements sharing.Client
+func (f *Fake) ListMountableFoldersContext(ctx context.Context, arg *sharing.ListFoldersArgs) (res *sharing.ListFoldersResult, err error) {
+	f.record("list_mountable_folders", arg, nil)
+	if f.ListMountableFoldersFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListMountableFoldersFunc(ctx, arg)
+}
+
+// ListMountableFoldersContinue implements sharing.Client
+func (f *Fake) ListMountableFoldersContinue(arg *sharing.ListFoldersContinueArg) (res *sharing.ListFoldersResult, err error) {
+	return f.ListMountableFoldersContinueContext(context.Background(), arg)
+}
+
+// ListMountableFoldersContinueContext implements sharing.Client
+func (f *Fake) ListMountableFoldersContinueContext(ctx context.Context, arg *sharing.ListFoldersContinueArg) (res *sharing.ListFoldersResult, err error) {
+	f.record("list_mountable_folders/continue", arg, nil)
+	if f.ListMountableFoldersContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListMountableFoldersContinueFunc(ctx, arg)
+}
+
+// ListReceivedFiles implements sharing.Client
+func (f *Fake) ListReceivedFiles(arg *sharing.ListFilesArg) (res *sharing.ListFilesResult, err error) {
+	return f.ListReceivedFilesContext(context.Background(), arg)
+}
+
+// ListReceivedFilesContext implements sharing.Client
+func (f *Fake) ListReceivedFilesContext(ctx context.Context, arg *sharing.ListFilesArg) (res *sharing.ListFilesResult, err error) {
+	f.record("list_received_files", arg, nil)
+	if f.ListReceivedFilesFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListReceivedFilesFunc(ctx, arg)
+}
+
+// ListReceivedFilesContinue implements sharing.Client
+func (f *Fake) ListReceivedFilesContinue(arg *sharing.ListFilesContinueArg) (res *sharing.ListFilesResult, err error) {
+	return f.ListReceivedFilesContinueContext(context.Background(), arg)
+}
+
+// ListReceivedFilesContinueContext implements sharing.Client
+func (f *Fake) ListReceivedFilesContinueContext(ctx context.Context, arg *sharing.ListFilesContinueArg) (res *sharing.ListFilesResult, err error) {
+	f.record("list_received_files/continue", arg, nil)
+	if f.ListReceivedFilesContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListReceivedFilesContinueFunc(ctx, arg)
+}
+
+// ListSharedLinks implements sharing.Client
+func (f *Fake) ListSharedLinks(arg *sharing.ListSharedLinksArg) (res *sharing.ListSharedLinksResult, err error) {
+	return f.ListSharedLinksContext(context.Background(), arg)
+}
+
+// ListSharedLinksContext implements sharing.Client
+func (f *Fake) ListSharedLinksContext(ctx context.Context, arg *sharing.ListSharedLinksArg) (res *sharing.ListSharedLinksResult, err error) {
+	f.record("list_shared_links", arg, nil)
+	if f.ListSharedLinksFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ListSharedLinksFunc(ctx, arg)
+}
+
+// ModifySharedLinkSettings implements sharing.Client
+func (f *Fake) ModifySharedLinkSettings(arg *sharing.ModifySharedLinkSettingsArgs) (res sharing.IsSharedLinkMetadata, err error) {
+	return f.ModifySharedLinkSettingsContext(context.Background(), arg)
+}
+
+// ModifySharedLinkSettingsContext implements sharing.Client
+func (f *Fake) ModifySharedLinkSettingsContext(ctx context.Context, arg *sharing.ModifySharedLinkSettingsArgs) (res sharing.IsSharedLinkMetadata, err error) {
+	f.record("modify_shared_link_settings", arg, nil)
+	if f.ModifySharedLinkSettingsFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ModifySharedLinkSettingsFunc(ctx, arg)
+}
+
+// MountFolder implements sharing.Client
+func (f *Fake) MountFolder(arg *sharing.MountFolderArg) (res *sharing.SharedFolderMetadata, err error) {
+	return f.MountFolderContext(context.Background(), arg)
+}
+
+// MountFolderContext implements sharing.Client
+func (f *Fake) MountFolderContext(ctx context.Context, arg *sharing.MountFolderArg) (res *sharing.SharedFolderMetadata, err error) {
+	f.record("mount_folder", arg, nil)
+	if f.MountFolderFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MountFolderFunc(ctx, arg)
+}
+
+// RelinquishFileMembership implements sharing.Client
+func (f *Fake) RelinquishFileMembership(arg *sharing.RelinquishFileMembershipArg) (err error) {
+	return f.RelinquishFileMembershipContext(context.Background(), arg)
+}
+
+// RelinquishFileMembershipContext implements sharing.Client
+func (f *Fake) RelinquishFileMembershipContext(ctx context.Context, arg *sharing.RelinquishFileMembershipArg) (err error) {
+	f.record("relinquish_file_membership", arg, nil)
+	if f.RelinquishFileMembershipFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.RelinquishFileMembershipFunc(ctx, arg)
+}
+
+// RelinquishFolderMembership implements sharing.Client
+func (f *Fake) RelinquishFolderMembership(arg *sharing.RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error) {
+	return f.RelinquishFolderMembershipContext(context.Background(), arg)
+}
+
+// RelinquishFolderMembershipContext implements sharing.Client
+func (f *Fake) RelinquishFolderMembershipContext(ctx context.Context, arg *sharing.RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error) {
+	f.record("relinquish_folder_membership", arg, nil)
+	if f.RelinquishFolderMembershipFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.RelinquishFolderMembershipFunc(ctx, arg)
+}
+
+// RemoveFileMember implements sharing.Client
+func (f *Fake) RemoveFileMember(arg *sharing.RemoveFileMemberArg) (res *sharing.FileMemberActionIndividualResult, err error) {
+	return f.RemoveFileMemberContext(context.Background(), arg)
+}
+
+// RemoveFileMemberContext implements sharing.Client
+func (f *Fake) RemoveFileMemberContext(ctx context.Context, arg *sharing.RemoveFileMemberArg) (res *sharing.FileMemberActionIndividualResult, err error) {
+	f.record("remove_file_member", arg, nil)
+	if f.RemoveFileMemberFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.RemoveFileMemberFunc(ctx, arg)
+}
+
+// RemoveFileMember2 implements sharing.Client
+func (f *Fake) RemoveFileMember2(arg *sharing.RemoveFileMemberArg) (res *sharing.FileMemberRemoveActionResult, err error) {
+	return f.RemoveFileMember2Context(context.Background(), arg)
+}
+
+// RemoveFileMember2Context implements sharing.Client
+func (f *Fake) RemoveFileMember2Context(ctx context.Context, arg *sharing.RemoveFileMemberArg) (res *sharing.FileMemberRemoveActionResult, err error) {
+	f.record("remove_file_member_2", arg, nil)
+	if f.RemoveFileMember2Func == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.RemoveFileMember2Func(ctx, arg)
+}
+
+// RemoveFolderMember implements sharing.Client
+func (f *Fake) RemoveFolderMember(arg *sharing.RemoveFolderMemberArg) (res *async.LaunchResultBase, err error) {
+	return f.RemoveFolderMemberContext(context.Background(), arg)
+}
+
+// RemoveFolderMemberContext implements sharing.Client
+func (f *Fake) RemoveFolderMemberContext(ctx context.Context, arg *sharing.RemoveFolderMemberArg) (res *async.LaunchResultBase, err error) {
+	f.record("remove_folder_member", arg, nil)
+	if f.RemoveFolderMemberFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.RemoveFolderMemberFunc(ctx, arg)
+}
+
+// RevokeSharedLink implements sharing.Client
+func (f *Fake) RevokeSharedLink(arg *sharing.RevokeSharedLinkArg) (err error) {
+	return f.RevokeSharedLinkContext(context.Background(), arg)
+}
+
+// RevokeSharedLinkContext implements sharing.Client
+func (f *Fake) RevokeSharedLinkContext(ctx context.Context, arg *sharing.RevokeSharedLinkArg) (err error) {
+	f.record("revoke_shared_link", arg, nil)
+	if f.RevokeSharedLinkFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.RevokeSharedLinkFunc(ctx, arg)
+}
+
+// ShareFolder implements sharing.Client
+func (f *Fake) ShareFolder(arg *sharing.ShareFolderArg) (res *sharing.ShareFolderLaunch, err error) {
+	return f.ShareFolderContext(context.Background(), arg)
+}
+
+// ShareFolderContext implements sharing.Client
+func (f *Fake) ShareFolderContext(ctx context.Context, arg *sharing.ShareFolderArg) (res *sharing.ShareFolderLaunch, err error) {
+	f.record("share_folder", arg, nil)
+	if f.ShareFolderFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ShareFolderFunc(ctx, arg)
+}
+
+// TransferFolder implements sharing.Client
+func (f *Fake) TransferFolder(arg *sharing.TransferFolderArg) (err error) {
+	return f.TransferFolderContext(context.Background(), arg)
+}
+
+// TransferFolderContext implements sharing.Client
+func (f *Fake) TransferFolderContext(ctx context.Context, arg *sharing.TransferFolderArg) (err error) {
+	f.record("transfer_folder", arg, nil)
+	if f.TransferFolderFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TransferFolderFunc(ctx, arg)
+}
+
+// UnmountFolder implements sharing.Client
+func (f *Fake) UnmountFolder(arg *sharing.UnmountFolderArg) (err error) {
+	return f.UnmountFolderContext(context.Background(), arg)
+}
+
+// UnmountFolderContext implements sharing.Client
+func (f *Fake) UnmountFolderContext(ctx context.Context, arg *sharing.UnmountFolderArg) (err error) {
+	f.record("unmount_folder", arg, nil)
+	if f.UnmountFolderFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UnmountFolderFunc(ctx, arg)
+}
+
+// UnshareFile implements sharing.Client
+func (f *Fake) UnshareFile(arg *sharing.UnshareFileArg) (err error) {
+	return f.UnshareFileContext(context.Background(), arg)
+}
+
+// UnshareFileContext implements sharing.Client
+func (f *Fake) UnshareFileContext(ctx context.Context, arg *sharing.UnshareFileArg) (err error) {
+	f.record("unshare_file", arg, nil)
+	if f.UnshareFileFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UnshareFileFunc(ctx, arg)
+}
+
+// UnshareFolder implements sharing.Client
+func (f *Fake) UnshareFolder(arg *sharing.UnshareFolderArg) (res *async.LaunchEmptyResult, err error) {
+	return f.UnshareFolderContext(context.Background(), arg)
+}
+
+// UnshareFolderContext implements sharing.Client
+func (f *Fake) UnshareFolderContext(ctx context.Context, arg *sharing.UnshareFolderArg) (res *async.LaunchEmptyResult, err error) {
+	f.record("unshare_folder", arg, nil)
+	if f.UnshareFolderFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UnshareFolderFunc(ctx, arg)
+}
+
+// UpdateFileMember implements sharing.Client
+func (f *Fake) UpdateFileMember(arg *sharing.UpdateFileMemberArgs) (res *sharing.MemberAccessLevelResult, err error) {
+	return f.UpdateFileMemberContext(context.Background(), arg)
+}
+
+// UpdateFileMemberContext implements sharing.Client
+func (f *Fake) UpdateFileMemberContext(ctx context.Context, arg *sharing.UpdateFileMemberArgs) (res *sharing.MemberAccessLevelResult, err error) {
+	f.record("update_file_member", arg, nil)
+	if f.UpdateFileMemberFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UpdateFileMemberFunc(ctx, arg)
+}
+
+// UpdateFolderMember implements sharing.Client
+func (f *Fake) UpdateFolderMember(arg *sharing.UpdateFolderMemberArg) (res *sharing.MemberAccessLevelResult, err error) {
+	return f.UpdateFolderMemberContext(context.Background(), arg)
+}
+
+// UpdateFolderMemberContext implements sharing.Client
+func (f *Fake) UpdateFolderMemberContext(ctx context.Context, arg *sharing.UpdateFolderMemberArg) (res *sharing.MemberAccessLevelResult, err error) {
+	f.record("update_folder_member", arg, nil)
+	if f.UpdateFolderMemberFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UpdateFolderMemberFunc(ctx, arg)
+}
+
+// UpdateFolderPolicy implements sharing.Client
+func (f *Fake) UpdateFolderPolicy(arg *sharing.UpdateFolderPolicyArg) (res *sharing.SharedFolderMetadata, err error) {
+	return f.UpdateFolderPolicyContext(context.Background(), arg)
+}
+
+// UpdateFolderPolicyContext implements sharing.Client
+func (f *Fake) UpdateFolderPolicyContext(ctx context.Context, arg *sharing.UpdateFolderPolicyArg) (res *sharing.SharedFolderMetadata, err error) {
+	f.record("update_folder_policy", arg, nil)
+	if f.UpdateFolderPolicyFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.UpdateFolderPolicyFunc(ctx, arg)
+}
@@ -35,6 +35,17 @@ import (
 // AccessLevel : Defines the access levels for collaborators.
 type AccessLevel struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AccessLevel) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AccessLevel
@@ -46,10 +57,47 @@ const (
 	AccessLevelOther           = "other"
 )
 
+// UnmarshalJSON deserializes into a AccessLevel instance
+func (u *AccessLevel) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "owner", "editor", "viewer", "viewer_no_comment", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a AccessLevel instance
+func (u AccessLevel) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // AclUpdatePolicy : Who can change a shared folder's access control list (ACL).
 // In other words, who can add, remove, or change the privileges of members.
 type AclUpdatePolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AclUpdatePolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AclUpdatePolicy
@@ -59,6 +107,32 @@ const (
 	AclUpdatePolicyOther   = "other"
 )
 
+// UnmarshalJSON deserializes into a AclUpdatePolicy instance
+func (u *AclUpdatePolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "owner", "editors", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a AclUpdatePolicy instance
+func (u AclUpdatePolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // AddFileMemberArgs : Arguments for `addFileMember`.
 type AddFileMemberArgs struct {
 	// File : File to which to add members.
@@ -78,6 +152,8 @@ type AddFileMemberArgs struct {
 	// AddMessageAsComment : If the custom message should be added as a comment
 	// on the file.
 	AddMessageAsComment bool `json:"add_message_as_comment"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAddFileMemberArgs returns a new AddFileMemberArgs instance
@@ -86,11 +162,26 @@ func NewAddFileMemberArgs(File string, Members []*MemberSelector) *AddFileMember
 	s.File = File
 	s.Members = Members
 	s.Quiet = false
-	s.AccessLevel = &AccessLevel{Tagged: dropbox.Tagged{"viewer"}}
+	s.AccessLevel = &AccessLevel{Tagged: dropbox.Tagged{Tag: "viewer"}}
 	s.AddMessageAsComment = false
 	return s
 }
 
+// MarshalJSON serializes a AddFileMemberArgs instance
+func (u AddFileMemberArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AddFileMemberArgs instance
+func (u *AddFileMemberArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *AddFileMemberArgs) Validate() error {
+	return nil
+}
+
 // AddFileMemberError : Errors for `addFileMember`.
 type AddFileMemberError struct {
 	dropbox.Tagged
@@ -98,6 +189,17 @@ type AddFileMemberError struct {
 	UserError *SharingUserError `json:"user_error,omitempty"`
 	// AccessError : has no documentation (yet)
 	AccessError *SharingFileAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AddFileMemberError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AddFileMemberError
@@ -123,7 +225,7 @@ func (u *AddFileMemberError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user_error":
 		err = json.Unmarshal(w.UserError, &u.UserError)
@@ -137,10 +239,41 @@ func (u *AddFileMemberError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "rate_limit", "invalid_comment", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a AddFileMemberError instance
+func (u AddFileMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsAddFileMemberError reports whether err carries a AddFileMemberError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsAddFileMemberError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*AddFileMemberError)(nil), tags...)
+}
+
 // AddFolderMemberArg : has no documentation (yet)
 type AddFolderMemberArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -154,6 +287,8 @@ type AddFolderMemberArg struct {
 	// CustomMessage : Optional message to display to added members in their
 	// invitation.
 	CustomMessage string `json:"custom_message,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAddFolderMemberArg returns a new AddFolderMemberArg instance
@@ -165,6 +300,21 @@ func NewAddFolderMemberArg(SharedFolderId string, Members []*AddMember) *AddFold
 	return s
 }
 
+// MarshalJSON serializes a AddFolderMemberArg instance
+func (u AddFolderMemberArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AddFolderMemberArg instance
+func (u *AddFolderMemberArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *AddFolderMemberArg) Validate() error {
+	return nil
+}
+
 // AddFolderMemberError : has no documentation (yet)
 type AddFolderMemberError struct {
 	dropbox.Tagged
@@ -178,6 +328,17 @@ type AddFolderMemberError struct {
 	// TooManyPendingInvites : The value is the pending invite limit that was
 	// reached.
 	TooManyPendingInvites uint64 `json:"too_many_pending_invites,omitempty"`
+	raw                   string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AddFolderMemberError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AddFolderMemberError
@@ -205,13 +366,18 @@ func (u *AddFolderMemberError) UnmarshalJSON(body []byte) error {
 		// BadMember : `AddFolderMemberArg.members` contains a bad invitation
 		// recipient.
 		BadMember json.RawMessage `json:"bad_member,omitempty"`
+		// TooManyMembers : The value is the member limit that was reached.
+		TooManyMembers json.RawMessage `json:"too_many_members,omitempty"`
+		// TooManyPendingInvites : The value is the pending invite limit that
+		// was reached.
+		TooManyPendingInvites json.RawMessage `json:"too_many_pending_invites,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -226,21 +392,64 @@ func (u *AddFolderMemberError) UnmarshalJSON(body []byte) error {
 			return err
 		}
 	case "too_many_members":
-		err = json.Unmarshal(body, &u.TooManyMembers)
+		err = json.Unmarshal(w.TooManyMembers, &u.TooManyMembers)
 
 		if err != nil {
 			return err
 		}
 	case "too_many_pending_invites":
-		err = json.Unmarshal(body, &u.TooManyPendingInvites)
+		err = json.Unmarshal(w.TooManyPendingInvites, &u.TooManyPendingInvites)
 
 		if err != nil {
 			return err
 		}
+	case "email_unverified", "cant_share_outside_team", "rate_limit", "too_many_invitees", "insufficient_plan", "team_folder", "no_permission", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a AddFolderMemberError instance
+func (u AddFolderMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	case "bad_member":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			BadMember *AddMemberSelectorError `json:"bad_member"`
+		}{u.Tagged, u.BadMember})
+
+	case "too_many_members":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TooManyMembers uint64 `json:"too_many_members"`
+		}{u.Tagged, u.TooManyMembers})
+
+	case "too_many_pending_invites":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TooManyPendingInvites uint64 `json:"too_many_pending_invites"`
+		}{u.Tagged, u.TooManyPendingInvites})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsAddFolderMemberError reports whether err carries a AddFolderMemberError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsAddFolderMemberError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*AddFolderMemberError)(nil), tags...)
+}
+
 // AddMember : The member and type of access the member should have when added
 // to a shared folder.
 type AddMember struct {
@@ -249,16 +458,28 @@ type AddMember struct {
 	// AccessLevel : The access level to grant `member` to the shared folder.
 	// `AccessLevel.owner` is disallowed.
 	AccessLevel *AccessLevel `json:"access_level"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAddMember returns a new AddMember instance
 func NewAddMember(Member *MemberSelector) *AddMember {
 	s := new(AddMember)
 	s.Member = Member
-	s.AccessLevel = &AccessLevel{Tagged: dropbox.Tagged{"viewer"}}
+	s.AccessLevel = &AccessLevel{Tagged: dropbox.Tagged{Tag: "viewer"}}
 	return s
 }
 
+// MarshalJSON serializes a AddMember instance
+func (u AddMember) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AddMember instance
+func (u *AddMember) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // AddMemberSelectorError : has no documentation (yet)
 type AddMemberSelectorError struct {
 	dropbox.Tagged
@@ -270,6 +491,17 @@ type AddMemberSelectorError struct {
 	// unverified e-mail address.  Invite unverified users by e-mail address
 	// instead of by their Dropbox ID.
 	UnverifiedDropboxId string `json:"unverified_dropbox_id,omitempty"`
+	raw                 string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AddMemberSelectorError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AddMemberSelectorError
@@ -287,36 +519,81 @@ const (
 func (u *AddMemberSelectorError) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// InvalidDropboxId : The value is the ID that could not be identified.
+		InvalidDropboxId json.RawMessage `json:"invalid_dropbox_id,omitempty"`
+		// InvalidEmail : The value is the e-email address that is malformed.
+		InvalidEmail json.RawMessage `json:"invalid_email,omitempty"`
+		// UnverifiedDropboxId : The value is the ID of the Dropbox user with an
+		// unverified e-mail address.  Invite unverified users by e-mail address
+		// instead of by their Dropbox ID.
+		UnverifiedDropboxId json.RawMessage `json:"unverified_dropbox_id,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "invalid_dropbox_id":
-		err = json.Unmarshal(body, &u.InvalidDropboxId)
+		err = json.Unmarshal(w.InvalidDropboxId, &u.InvalidDropboxId)
 
 		if err != nil {
 			return err
 		}
 	case "invalid_email":
-		err = json.Unmarshal(body, &u.InvalidEmail)
+		err = json.Unmarshal(w.InvalidEmail, &u.InvalidEmail)
 
 		if err != nil {
 			return err
 		}
 	case "unverified_dropbox_id":
-		err = json.Unmarshal(body, &u.UnverifiedDropboxId)
+		err = json.Unmarshal(w.UnverifiedDropboxId, &u.UnverifiedDropboxId)
 
 		if err != nil {
 			return err
 		}
+	case "automatic_group", "group_deleted", "group_not_on_team", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a AddMemberSelectorError instance
+func (u AddMemberSelectorError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "invalid_dropbox_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidDropboxId string `json:"invalid_dropbox_id"`
+		}{u.Tagged, u.InvalidDropboxId})
+
+	case "invalid_email":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidEmail string `json:"invalid_email"`
+		}{u.Tagged, u.InvalidEmail})
+
+	case "unverified_dropbox_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UnverifiedDropboxId string `json:"unverified_dropbox_id"`
+		}{u.Tagged, u.UnverifiedDropboxId})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsAddMemberSelectorError reports whether err carries a AddMemberSelectorError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsAddMemberSelectorError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*AddMemberSelectorError)(nil), tags...)
+}
+
 // AudienceRestrictingSharedFolder : Information about the shared folder that
 // prevents the link audience for this link from being more restrictive.
 type AudienceRestrictingSharedFolder struct {
@@ -326,6 +603,8 @@ type AudienceRestrictingSharedFolder struct {
 	Name string `json:"name"`
 	// Audience : The link audience of the shared folder.
 	Audience *LinkAudience `json:"audience"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAudienceRestrictingSharedFolder returns a new AudienceRestrictingSharedFolder instance
@@ -337,6 +616,16 @@ func NewAudienceRestrictingSharedFolder(SharedFolderId string, Name string, Audi
 	return s
 }
 
+// MarshalJSON serializes a AudienceRestrictingSharedFolder instance
+func (u AudienceRestrictingSharedFolder) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AudienceRestrictingSharedFolder instance
+func (u *AudienceRestrictingSharedFolder) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ChangeFileMemberAccessArgs : Arguments for `changeFileMemberAccess`.
 type ChangeFileMemberAccessArgs struct {
 	// File : File for which we are changing a member's access.
@@ -345,6 +634,8 @@ type ChangeFileMemberAccessArgs struct {
 	Member *MemberSelector `json:"member"`
 	// AccessLevel : The new access level for the member.
 	AccessLevel *AccessLevel `json:"access_level"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewChangeFileMemberAccessArgs returns a new ChangeFileMemberAccessArgs instance
@@ -356,6 +647,21 @@ func NewChangeFileMemberAccessArgs(File string, Member *MemberSelector, AccessLe
 	return s
 }
 
+// MarshalJSON serializes a ChangeFileMemberAccessArgs instance
+func (u ChangeFileMemberAccessArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ChangeFileMemberAccessArgs instance
+func (u *ChangeFileMemberAccessArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ChangeFileMemberAccessArgs) Validate() error {
+	return nil
+}
+
 // LinkMetadata : Metadata for a shared link. This can be either a
 // `PathLinkMetadata` or `CollectionLinkMetadata`.
 type LinkMetadata struct {
@@ -365,6 +671,8 @@ type LinkMetadata struct {
 	Visibility *Visibility `json:"visibility"`
 	// Expires : Expiration time, if set. By default the link won't expire.
 	Expires time.Time `json:"expires,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewLinkMetadata returns a new LinkMetadata instance
@@ -375,6 +683,16 @@ func NewLinkMetadata(Url string, Visibility *Visibility) *LinkMetadata {
 	return s
 }
 
+// MarshalJSON serializes a LinkMetadata instance
+func (u LinkMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a LinkMetadata instance
+func (u *LinkMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // IsLinkMetadata is the interface type for LinkMetadata and its subtypes
 type IsLinkMetadata interface {
 	IsLinkMetadata()
@@ -389,6 +707,17 @@ type linkMetadataUnion struct {
 	Path *PathLinkMetadata `json:"path,omitempty"`
 	// Collection : has no documentation (yet)
 	Collection *CollectionLinkMetadata `json:"collection,omitempty"`
+	raw        string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u linkMetadataUnion) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for LinkMetadata
@@ -411,7 +740,7 @@ func (u *linkMetadataUnion) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(body, &u.Path)
@@ -425,6 +754,10 @@ func (u *linkMetadataUnion) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
@@ -449,6 +782,8 @@ func IsLinkMetadataFromJSON(data []byte) (IsLinkMetadata, error) {
 // CollectionLinkMetadata : Metadata for a collection-based shared link.
 type CollectionLinkMetadata struct {
 	LinkMetadata
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewCollectionLinkMetadata returns a new CollectionLinkMetadata instance
@@ -459,6 +794,16 @@ func NewCollectionLinkMetadata(Url string, Visibility *Visibility) *CollectionLi
 	return s
 }
 
+// MarshalJSON serializes a CollectionLinkMetadata instance, tagged as a `LinkMetadata`
+func (u CollectionLinkMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("collection", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a CollectionLinkMetadata instance
+func (u *CollectionLinkMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // CreateSharedLinkArg : has no documentation (yet)
 type CreateSharedLinkArg struct {
 	// Path : The path to share.
@@ -469,6 +814,8 @@ type CreateSharedLinkArg struct {
 	// this to either `PendingUploadMode.file` or `PendingUploadMode.folder` to
 	// indicate whether to assume it's a file or folder.
 	PendingUpload *PendingUploadMode `json:"pending_upload,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewCreateSharedLinkArg returns a new CreateSharedLinkArg instance
@@ -479,11 +826,37 @@ func NewCreateSharedLinkArg(Path string) *CreateSharedLinkArg {
 	return s
 }
 
+// MarshalJSON serializes a CreateSharedLinkArg instance
+func (u CreateSharedLinkArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a CreateSharedLinkArg instance
+func (u *CreateSharedLinkArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *CreateSharedLinkArg) Validate() error {
+	return nil
+}
+
 // CreateSharedLinkError : has no documentation (yet)
 type CreateSharedLinkError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *files.LookupError `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u CreateSharedLinkError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for CreateSharedLinkError
@@ -504,7 +877,7 @@ func (u *CreateSharedLinkError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -512,16 +885,43 @@ func (u *CreateSharedLinkError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a CreateSharedLinkError instance
+func (u CreateSharedLinkError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *files.LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsCreateSharedLinkError reports whether err carries a CreateSharedLinkError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsCreateSharedLinkError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*CreateSharedLinkError)(nil), tags...)
+}
+
 // CreateSharedLinkWithSettingsArg : has no documentation (yet)
 type CreateSharedLinkWithSettingsArg struct {
 	// Path : The path to be shared by the shared link
 	Path string `json:"path"`
 	// Settings : The requested settings for the newly created shared link
 	Settings *SharedLinkSettings `json:"settings,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewCreateSharedLinkWithSettingsArg returns a new CreateSharedLinkWithSettingsArg instance
@@ -531,6 +931,21 @@ func NewCreateSharedLinkWithSettingsArg(Path string) *CreateSharedLinkWithSettin
 	return s
 }
 
+// MarshalJSON serializes a CreateSharedLinkWithSettingsArg instance
+func (u CreateSharedLinkWithSettingsArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a CreateSharedLinkWithSettingsArg instance
+func (u *CreateSharedLinkWithSettingsArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *CreateSharedLinkWithSettingsArg) Validate() error {
+	return nil
+}
+
 // CreateSharedLinkWithSettingsError : has no documentation (yet)
 type CreateSharedLinkWithSettingsError struct {
 	dropbox.Tagged
@@ -538,6 +953,17 @@ type CreateSharedLinkWithSettingsError struct {
 	Path *files.LookupError `json:"path,omitempty"`
 	// SettingsError : There is an error with the given settings
 	SettingsError *SharedLinkSettingsError `json:"settings_error,omitempty"`
+	raw           string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u CreateSharedLinkWithSettingsError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for CreateSharedLinkWithSettingsError
@@ -563,7 +989,7 @@ func (u *CreateSharedLinkWithSettingsError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -577,10 +1003,41 @@ func (u *CreateSharedLinkWithSettingsError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "email_not_verified", "shared_link_already_exists", "access_denied":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a CreateSharedLinkWithSettingsError instance
+func (u CreateSharedLinkWithSettingsError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *files.LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	case "settings_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SettingsError *SharedLinkSettingsError `json:"settings_error"`
+		}{u.Tagged, u.SettingsError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsCreateSharedLinkWithSettingsError reports whether err carries a CreateSharedLinkWithSettingsError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsCreateSharedLinkWithSettingsError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*CreateSharedLinkWithSettingsError)(nil), tags...)
+}
+
 // SharedContentLinkMetadataBase : has no documentation (yet)
 type SharedContentLinkMetadataBase struct {
 	// AccessLevel : The access level on the link for this file.
@@ -604,6 +1061,8 @@ type SharedContentLinkMetadataBase struct {
 	LinkPermissions []*LinkPermission `json:"link_permissions"`
 	// PasswordProtected : Whether the link is protected by a password.
 	PasswordProtected bool `json:"password_protected"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentLinkMetadataBase returns a new SharedContentLinkMetadataBase instance
@@ -616,11 +1075,23 @@ func NewSharedContentLinkMetadataBase(AudienceOptions []*LinkAudience, CurrentAu
 	return s
 }
 
+// MarshalJSON serializes a SharedContentLinkMetadataBase instance
+func (u SharedContentLinkMetadataBase) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentLinkMetadataBase instance
+func (u *SharedContentLinkMetadataBase) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ExpectedSharedContentLinkMetadata : The expected metadata of a shared link
 // for a file or folder when a link is first created for  the content. Absent if
 // the link already exists.
 type ExpectedSharedContentLinkMetadata struct {
 	SharedContentLinkMetadataBase
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewExpectedSharedContentLinkMetadata returns a new ExpectedSharedContentLinkMetadata instance
@@ -633,9 +1104,30 @@ func NewExpectedSharedContentLinkMetadata(AudienceOptions []*LinkAudience, Curre
 	return s
 }
 
+// MarshalJSON serializes a ExpectedSharedContentLinkMetadata instance
+func (u ExpectedSharedContentLinkMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ExpectedSharedContentLinkMetadata instance
+func (u *ExpectedSharedContentLinkMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileAction : Sharing actions that may be taken on files.
 type FileAction struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FileAction) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FileAction
@@ -652,6 +1144,32 @@ const (
 	FileActionOther                 = "other"
 )
 
+// UnmarshalJSON deserializes into a FileAction instance
+func (u *FileAction) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "disable_viewer_info", "edit_contents", "enable_viewer_info", "invite_viewer", "invite_viewer_no_comment", "unshare", "relinquish_membership", "share_link", "create_link", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a FileAction instance
+func (u FileAction) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // FileErrorResult : has no documentation (yet)
 type FileErrorResult struct {
 	dropbox.Tagged
@@ -663,6 +1181,17 @@ type FileErrorResult struct {
 	// PermissionDeniedError : User does not have permission to access file
 	// specified by file.Id.
 	PermissionDeniedError string `json:"permission_denied_error,omitempty"`
+	raw                   string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FileErrorResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FileErrorResult
@@ -677,36 +1206,74 @@ const (
 func (u *FileErrorResult) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// FileNotFoundError : File specified by id was not found.
+		FileNotFoundError json.RawMessage `json:"file_not_found_error,omitempty"`
+		// InvalidFileActionError : User does not have permission to take the
+		// specified action on the file.
+		InvalidFileActionError json.RawMessage `json:"invalid_file_action_error,omitempty"`
+		// PermissionDeniedError : User does not have permission to access file
+		// specified by file.Id.
+		PermissionDeniedError json.RawMessage `json:"permission_denied_error,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "file_not_found_error":
-		err = json.Unmarshal(body, &u.FileNotFoundError)
+		err = json.Unmarshal(w.FileNotFoundError, &u.FileNotFoundError)
 
 		if err != nil {
 			return err
 		}
 	case "invalid_file_action_error":
-		err = json.Unmarshal(body, &u.InvalidFileActionError)
+		err = json.Unmarshal(w.InvalidFileActionError, &u.InvalidFileActionError)
 
 		if err != nil {
 			return err
 		}
 	case "permission_denied_error":
-		err = json.Unmarshal(body, &u.PermissionDeniedError)
+		err = json.Unmarshal(w.PermissionDeniedError, &u.PermissionDeniedError)
 
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a FileErrorResult instance
+func (u FileErrorResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "file_not_found_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FileNotFoundError string `json:"file_not_found_error"`
+		}{u.Tagged, u.FileNotFoundError})
+
+	case "invalid_file_action_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidFileActionError string `json:"invalid_file_action_error"`
+		}{u.Tagged, u.InvalidFileActionError})
+
+	case "permission_denied_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PermissionDeniedError string `json:"permission_denied_error"`
+		}{u.Tagged, u.PermissionDeniedError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharedLinkMetadata : The metadata of a shared link
 type SharedLinkMetadata struct {
 	// Url : URL of the shared link.
@@ -731,6 +1298,8 @@ type SharedLinkMetadata struct {
 	// field will only be present if the content's owner is a team member and
 	// the content's owner team is different from the link's owner team.
 	ContentOwnerTeamInfo *users.Team `json:"content_owner_team_info,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedLinkMetadata returns a new SharedLinkMetadata instance
@@ -742,6 +1311,16 @@ func NewSharedLinkMetadata(Url string, Name string, LinkPermissions *LinkPermiss
 	return s
 }
 
+// MarshalJSON serializes a SharedLinkMetadata instance
+func (u SharedLinkMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedLinkMetadata instance
+func (u *SharedLinkMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // IsSharedLinkMetadata is the interface type for SharedLinkMetadata and its subtypes
 type IsSharedLinkMetadata interface {
 	IsSharedLinkMetadata()
@@ -756,6 +1335,17 @@ type sharedLinkMetadataUnion struct {
 	File *FileLinkMetadata `json:"file,omitempty"`
 	// Folder : has no documentation (yet)
 	Folder *FolderLinkMetadata `json:"folder,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u sharedLinkMetadataUnion) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedLinkMetadata
@@ -778,7 +1368,7 @@ func (u *sharedLinkMetadataUnion) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "file":
 		err = json.Unmarshal(body, &u.File)
@@ -792,6 +1382,10 @@ func (u *sharedLinkMetadataUnion) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
@@ -830,6 +1424,8 @@ type FileLinkMetadata struct {
 	Rev string `json:"rev"`
 	// Size : The file size in bytes.
 	Size uint64 `json:"size"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileLinkMetadata returns a new FileLinkMetadata instance
@@ -845,6 +1441,16 @@ func NewFileLinkMetadata(Url string, Name string, LinkPermissions *LinkPermissio
 	return s
 }
 
+// MarshalJSON serializes a FileLinkMetadata instance, tagged as a `SharedLinkMetadata`
+func (u FileLinkMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("file", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileLinkMetadata instance
+func (u *FileLinkMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileMemberActionError : has no documentation (yet)
 type FileMemberActionError struct {
 	dropbox.Tagged
@@ -854,6 +1460,17 @@ type FileMemberActionError struct {
 	// member does not have explicit access to the file. The return value is the
 	// access that the member has to the file from a parent folder.
 	NoExplicitAccess *MemberAccessLevelResult `json:"no_explicit_access,omitempty"`
+	raw              string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FileMemberActionError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FileMemberActionError
@@ -882,7 +1499,7 @@ func (u *FileMemberActionError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -896,10 +1513,38 @@ func (u *FileMemberActionError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "invalid_member", "no_permission", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a FileMemberActionError instance
+func (u FileMemberActionError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	case "no_explicit_access":
+		return dropbox.MarshalTagged(u.Tag, u.NoExplicitAccess)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsFileMemberActionError reports whether err carries a FileMemberActionError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsFileMemberActionError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*FileMemberActionError)(nil), tags...)
+}
+
 // FileMemberActionIndividualResult : has no documentation (yet)
 type FileMemberActionIndividualResult struct {
 	dropbox.Tagged
@@ -908,6 +1553,17 @@ type FileMemberActionIndividualResult struct {
 	Success *AccessLevel `json:"success,omitempty"`
 	// MemberError : User was not able to perform this action.
 	MemberError *FileMemberActionError `json:"member_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FileMemberActionIndividualResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FileMemberActionIndividualResult
@@ -932,10 +1588,10 @@ func (u *FileMemberActionIndividualResult) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "success":
-		err = json.Unmarshal(body, &u.Success)
+		err = json.Unmarshal(w.Success, &u.Success)
 
 		if err != nil {
 			return err
@@ -946,10 +1602,33 @@ func (u *FileMemberActionIndividualResult) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a FileMemberActionIndividualResult instance
+func (u FileMemberActionIndividualResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Success *AccessLevel `json:"success,omitempty"`
+		}{u.Tagged, u.Success})
+
+	case "member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MemberError *FileMemberActionError `json:"member_error"`
+		}{u.Tagged, u.MemberError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // FileMemberActionResult : Per-member result for `addFileMember` or
 // `changeFileMemberAccess`.
 type FileMemberActionResult struct {
@@ -957,6 +1636,8 @@ type FileMemberActionResult struct {
 	Member *MemberSelector `json:"member"`
 	// Result : The outcome of the action on this member.
 	Result *FileMemberActionIndividualResult `json:"result"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileMemberActionResult returns a new FileMemberActionResult instance
@@ -967,6 +1648,16 @@ func NewFileMemberActionResult(Member *MemberSelector, Result *FileMemberActionI
 	return s
 }
 
+// MarshalJSON serializes a FileMemberActionResult instance
+func (u FileMemberActionResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileMemberActionResult instance
+func (u *FileMemberActionResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileMemberRemoveActionResult : has no documentation (yet)
 type FileMemberRemoveActionResult struct {
 	dropbox.Tagged
@@ -974,6 +1665,17 @@ type FileMemberRemoveActionResult struct {
 	Success *MemberAccessLevelResult `json:"success,omitempty"`
 	// MemberError : User was not able to remove this member.
 	MemberError *FileMemberActionError `json:"member_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FileMemberRemoveActionResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FileMemberRemoveActionResult
@@ -997,7 +1699,7 @@ func (u *FileMemberRemoveActionResult) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "success":
 		err = json.Unmarshal(body, &u.Success)
@@ -1011,11 +1713,32 @@ func (u *FileMemberRemoveActionResult) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
-// FilePermission : Whether the user is allowed to take the sharing action on
+// MarshalJSON serializes a FileMemberRemoveActionResult instance
+func (u FileMemberRemoveActionResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return dropbox.MarshalTagged(u.Tag, u.Success)
+
+	case "member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MemberError *FileMemberActionError `json:"member_error"`
+		}{u.Tagged, u.MemberError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// FilePermission : Whether the user is allowed to take the sharing action on
 // the file.
 type FilePermission struct {
 	// Action : The action that the user may wish to take on the file.
@@ -1025,6 +1748,8 @@ type FilePermission struct {
 	// Reason : The reason why the user is denied the permission. Not present if
 	// the action is allowed.
 	Reason *PermissionDeniedReason `json:"reason,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFilePermission returns a new FilePermission instance
@@ -1035,9 +1760,30 @@ func NewFilePermission(Action *FileAction, Allow bool) *FilePermission {
 	return s
 }
 
+// MarshalJSON serializes a FilePermission instance
+func (u FilePermission) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FilePermission instance
+func (u *FilePermission) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FolderAction : Actions that may be taken on shared folders.
 type FolderAction struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FolderAction) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FolderAction
@@ -1058,9 +1804,37 @@ const (
 	FolderActionOther                 = "other"
 )
 
+// UnmarshalJSON deserializes into a FolderAction instance
+func (u *FolderAction) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "change_options", "disable_viewer_info", "edit_contents", "enable_viewer_info", "invite_editor", "invite_viewer", "invite_viewer_no_comment", "relinquish_membership", "unmount", "unshare", "leave_a_copy", "share_link", "create_link", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a FolderAction instance
+func (u FolderAction) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // FolderLinkMetadata : The metadata of a folder shared link
 type FolderLinkMetadata struct {
 	SharedLinkMetadata
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFolderLinkMetadata returns a new FolderLinkMetadata instance
@@ -1072,6 +1846,16 @@ func NewFolderLinkMetadata(Url string, Name string, LinkPermissions *LinkPermiss
 	return s
 }
 
+// MarshalJSON serializes a FolderLinkMetadata instance, tagged as a `SharedLinkMetadata`
+func (u FolderLinkMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("folder", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FolderLinkMetadata instance
+func (u *FolderLinkMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FolderPermission : Whether the user is allowed to take the action on the
 // shared folder.
 type FolderPermission struct {
@@ -1082,6 +1866,8 @@ type FolderPermission struct {
 	// Reason : The reason why the user is denied the permission. Not present if
 	// the action is allowed, or if no reason is available.
 	Reason *PermissionDeniedReason `json:"reason,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFolderPermission returns a new FolderPermission instance
@@ -1092,6 +1878,16 @@ func NewFolderPermission(Action *FolderAction, Allow bool) *FolderPermission {
 	return s
 }
 
+// MarshalJSON serializes a FolderPermission instance
+func (u FolderPermission) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FolderPermission instance
+func (u *FolderPermission) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FolderPolicy : A set of policies governing membership and privileges for a
 // shared folder.
 type FolderPolicy struct {
@@ -1113,6 +1909,8 @@ type FolderPolicy struct {
 	// ViewerInfoPolicy : Who can enable/disable viewer info for this shared
 	// folder.
 	ViewerInfoPolicy *ViewerInfoPolicy `json:"viewer_info_policy,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFolderPolicy returns a new FolderPolicy instance
@@ -1123,6 +1921,16 @@ func NewFolderPolicy(AclUpdatePolicy *AclUpdatePolicy, SharedLinkPolicy *SharedL
 	return s
 }
 
+// MarshalJSON serializes a FolderPolicy instance
+func (u FolderPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FolderPolicy instance
+func (u *FolderPolicy) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetFileMetadataArg : Arguments of `getFileMetadata`.
 type GetFileMetadataArg struct {
 	// File : The file to query.
@@ -1131,6 +1939,8 @@ type GetFileMetadataArg struct {
 	// should appear in the  response's `SharedFileMetadata.permissions` field
 	// describing the actions the  authenticated user can perform on the file.
 	Actions []*FileAction `json:"actions,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetFileMetadataArg returns a new GetFileMetadataArg instance
@@ -1140,6 +1950,21 @@ func NewGetFileMetadataArg(File string) *GetFileMetadataArg {
 	return s
 }
 
+// MarshalJSON serializes a GetFileMetadataArg instance
+func (u GetFileMetadataArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetFileMetadataArg instance
+func (u *GetFileMetadataArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetFileMetadataArg) Validate() error {
+	return nil
+}
+
 // GetFileMetadataBatchArg : Arguments of `getFileMetadataBatch`.
 type GetFileMetadataBatchArg struct {
 	// Files : The files to query.
@@ -1148,6 +1973,8 @@ type GetFileMetadataBatchArg struct {
 	// should appear in the  response's `SharedFileMetadata.permissions` field
 	// describing the actions the  authenticated user can perform on the file.
 	Actions []*FileAction `json:"actions,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetFileMetadataBatchArg returns a new GetFileMetadataBatchArg instance
@@ -1157,6 +1984,21 @@ func NewGetFileMetadataBatchArg(Files []string) *GetFileMetadataBatchArg {
 	return s
 }
 
+// MarshalJSON serializes a GetFileMetadataBatchArg instance
+func (u GetFileMetadataBatchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetFileMetadataBatchArg instance
+func (u *GetFileMetadataBatchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetFileMetadataBatchArg) Validate() error {
+	return nil
+}
+
 // GetFileMetadataBatchResult : Per file results of `getFileMetadataBatch`.
 type GetFileMetadataBatchResult struct {
 	// File : This is the input file identifier corresponding to one of
@@ -1164,6 +2006,8 @@ type GetFileMetadataBatchResult struct {
 	File string `json:"file"`
 	// Result : The result for this particular file.
 	Result *GetFileMetadataIndividualResult `json:"result"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetFileMetadataBatchResult returns a new GetFileMetadataBatchResult instance
@@ -1174,6 +2018,16 @@ func NewGetFileMetadataBatchResult(File string, Result *GetFileMetadataIndividua
 	return s
 }
 
+// MarshalJSON serializes a GetFileMetadataBatchResult instance
+func (u GetFileMetadataBatchResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetFileMetadataBatchResult instance
+func (u *GetFileMetadataBatchResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetFileMetadataError : Error result for `getFileMetadata`.
 type GetFileMetadataError struct {
 	dropbox.Tagged
@@ -1181,6 +2035,17 @@ type GetFileMetadataError struct {
 	UserError *SharingUserError `json:"user_error,omitempty"`
 	// AccessError : has no documentation (yet)
 	AccessError *SharingFileAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetFileMetadataError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetFileMetadataError
@@ -1204,7 +2069,7 @@ func (u *GetFileMetadataError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user_error":
 		err = json.Unmarshal(w.UserError, &u.UserError)
@@ -1218,10 +2083,41 @@ func (u *GetFileMetadataError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GetFileMetadataError instance
+func (u GetFileMetadataError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetFileMetadataError reports whether err carries a GetFileMetadataError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetFileMetadataError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetFileMetadataError)(nil), tags...)
+}
+
 // GetFileMetadataIndividualResult : has no documentation (yet)
 type GetFileMetadataIndividualResult struct {
 	dropbox.Tagged
@@ -1229,6 +2125,17 @@ type GetFileMetadataIndividualResult struct {
 	Metadata *SharedFileMetadata `json:"metadata,omitempty"`
 	// AccessError : The result for this file if it was an error.
 	AccessError *SharingFileAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetFileMetadataIndividualResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetFileMetadataIndividualResult
@@ -1252,7 +2159,7 @@ func (u *GetFileMetadataIndividualResult) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "metadata":
 		err = json.Unmarshal(body, &u.Metadata)
@@ -1266,10 +2173,31 @@ func (u *GetFileMetadataIndividualResult) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GetFileMetadataIndividualResult instance
+func (u GetFileMetadataIndividualResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "metadata":
+		return dropbox.MarshalTagged(u.Tag, u.Metadata)
+
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // GetMetadataArgs : has no documentation (yet)
 type GetMetadataArgs struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -1279,6 +2207,8 @@ type GetMetadataArgs struct {
 	// field describing the actions the  authenticated user can perform on the
 	// folder.
 	Actions []*FolderAction `json:"actions,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetMetadataArgs returns a new GetMetadataArgs instance
@@ -1288,9 +2218,35 @@ func NewGetMetadataArgs(SharedFolderId string) *GetMetadataArgs {
 	return s
 }
 
+// MarshalJSON serializes a GetMetadataArgs instance
+func (u GetMetadataArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetMetadataArgs instance
+func (u *GetMetadataArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetMetadataArgs) Validate() error {
+	return nil
+}
+
 // SharedLinkError : has no documentation (yet)
 type SharedLinkError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedLinkError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedLinkError
@@ -1301,9 +2257,53 @@ const (
 	SharedLinkErrorOther                  = "other"
 )
 
+// UnmarshalJSON deserializes into a SharedLinkError instance
+func (u *SharedLinkError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "shared_link_not_found", "shared_link_access_denied", "unsupported_link_type", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedLinkError instance
+func (u SharedLinkError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSharedLinkError reports whether err carries a SharedLinkError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSharedLinkError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SharedLinkError)(nil), tags...)
+}
+
 // GetSharedLinkFileError : has no documentation (yet)
 type GetSharedLinkFileError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetSharedLinkFileError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetSharedLinkFileError
@@ -1311,6 +2311,39 @@ const (
 	GetSharedLinkFileErrorSharedLinkIsDirectory = "shared_link_is_directory"
 )
 
+// UnmarshalJSON deserializes into a GetSharedLinkFileError instance
+func (u *GetSharedLinkFileError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "shared_link_is_directory":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GetSharedLinkFileError instance
+func (u GetSharedLinkFileError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetSharedLinkFileError reports whether err carries a GetSharedLinkFileError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetSharedLinkFileError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetSharedLinkFileError)(nil), tags...)
+}
+
 // GetSharedLinkMetadataArg : has no documentation (yet)
 type GetSharedLinkMetadataArg struct {
 	// Url : URL of the shared link.
@@ -1322,6 +2355,8 @@ type GetSharedLinkMetadataArg struct {
 	// LinkPassword : If the shared link has a password, this parameter can be
 	// used.
 	LinkPassword string `json:"link_password,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetSharedLinkMetadataArg returns a new GetSharedLinkMetadataArg instance
@@ -1331,10 +2366,27 @@ func NewGetSharedLinkMetadataArg(Url string) *GetSharedLinkMetadataArg {
 	return s
 }
 
+// MarshalJSON serializes a GetSharedLinkMetadataArg instance
+func (u GetSharedLinkMetadataArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetSharedLinkMetadataArg instance
+func (u *GetSharedLinkMetadataArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetSharedLinkMetadataArg) Validate() error {
+	return nil
+}
+
 // GetSharedLinksArg : has no documentation (yet)
 type GetSharedLinksArg struct {
 	// Path : See `getSharedLinks` description.
 	Path string `json:"path,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetSharedLinksArg returns a new GetSharedLinksArg instance
@@ -1343,11 +2395,37 @@ func NewGetSharedLinksArg() *GetSharedLinksArg {
 	return s
 }
 
+// MarshalJSON serializes a GetSharedLinksArg instance
+func (u GetSharedLinksArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetSharedLinksArg instance
+func (u *GetSharedLinksArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetSharedLinksArg) Validate() error {
+	return nil
+}
+
 // GetSharedLinksError : has no documentation (yet)
 type GetSharedLinksError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path string `json:"path,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetSharedLinksError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetSharedLinksError
@@ -1368,18 +2446,43 @@ func (u *GetSharedLinksError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
-		err = json.Unmarshal(body, &u.Path)
+		err = json.Unmarshal(w.Path, &u.Path)
 
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GetSharedLinksError instance
+func (u GetSharedLinksError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path string `json:"path,omitempty"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetSharedLinksError reports whether err carries a GetSharedLinksError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetSharedLinksError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetSharedLinksError)(nil), tags...)
+}
+
 // GetSharedLinksResult : has no documentation (yet)
 type GetSharedLinksResult struct {
 	// Links : Shared links applicable to the path argument.
@@ -1405,6 +2508,8 @@ type GroupInfo struct {
 	IsOwner bool `json:"is_owner"`
 	// SameTeam : If the group is owned by the current user's team.
 	SameTeam bool `json:"same_team"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupInfo returns a new GroupInfo instance
@@ -1420,6 +2525,16 @@ func NewGroupInfo(GroupName string, GroupId string, GroupManagementType *team_co
 	return s
 }
 
+// MarshalJSON serializes a GroupInfo instance
+func (u GroupInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupInfo instance
+func (u *GroupInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MembershipInfo : The information about a member of the shared content.
 type MembershipInfo struct {
 	// AccessType : The access type for this member.
@@ -1431,6 +2546,8 @@ type MembershipInfo struct {
 	Initials string `json:"initials,omitempty"`
 	// IsInherited : True if the member has access from a parent folder.
 	IsInherited bool `json:"is_inherited"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembershipInfo returns a new MembershipInfo instance
@@ -1441,12 +2558,24 @@ func NewMembershipInfo(AccessType *AccessLevel) *MembershipInfo {
 	return s
 }
 
+// MarshalJSON serializes a MembershipInfo instance
+func (u MembershipInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembershipInfo instance
+func (u *MembershipInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupMembershipInfo : The information about a group member of the shared
 // content.
 type GroupMembershipInfo struct {
 	MembershipInfo
 	// Group : The information about the membership group.
 	Group *GroupInfo `json:"group"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupMembershipInfo returns a new GroupMembershipInfo instance
@@ -1458,6 +2587,16 @@ func NewGroupMembershipInfo(AccessType *AccessLevel, Group *GroupInfo) *GroupMem
 	return s
 }
 
+// MarshalJSON serializes a GroupMembershipInfo instance
+func (u GroupMembershipInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupMembershipInfo instance
+func (u *GroupMembershipInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // InsufficientQuotaAmounts : has no documentation (yet)
 type InsufficientQuotaAmounts struct {
 	// SpaceNeeded : The amount of space needed to add the item (the size of the
@@ -1468,6 +2607,8 @@ type InsufficientQuotaAmounts struct {
 	// SpaceLeft : The amount of space left in the user's Dropbox, less than
 	// space_needed.
 	SpaceLeft uint64 `json:"space_left"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewInsufficientQuotaAmounts returns a new InsufficientQuotaAmounts instance
@@ -1479,11 +2620,32 @@ func NewInsufficientQuotaAmounts(SpaceNeeded uint64, SpaceShortage uint64, Space
 	return s
 }
 
+// MarshalJSON serializes a InsufficientQuotaAmounts instance
+func (u InsufficientQuotaAmounts) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a InsufficientQuotaAmounts instance
+func (u *InsufficientQuotaAmounts) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // InviteeInfo : Information about the recipient of a shared content invitation.
 type InviteeInfo struct {
 	dropbox.Tagged
 	// Email : E-mail address of invited user.
 	Email string `json:"email,omitempty"`
+	raw   string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u InviteeInfo) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for InviteeInfo
@@ -1496,24 +2658,44 @@ const (
 func (u *InviteeInfo) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// Email : E-mail address of invited user.
+		Email json.RawMessage `json:"email,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "email":
-		err = json.Unmarshal(body, &u.Email)
+		err = json.Unmarshal(w.Email, &u.Email)
 
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a InviteeInfo instance
+func (u InviteeInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "email":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Email string `json:"email"`
+		}{u.Tagged, u.Email})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // InviteeMembershipInfo : Information about an invited member of a shared
 // content.
 type InviteeMembershipInfo struct {
@@ -1522,6 +2704,8 @@ type InviteeMembershipInfo struct {
 	Invitee *InviteeInfo `json:"invitee"`
 	// User : The user this invitation is tied to, if available.
 	User *UserInfo `json:"user,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewInviteeMembershipInfo returns a new InviteeMembershipInfo instance
@@ -1533,6 +2717,16 @@ func NewInviteeMembershipInfo(AccessType *AccessLevel, Invitee *InviteeInfo) *In
 	return s
 }
 
+// MarshalJSON serializes a InviteeMembershipInfo instance
+func (u InviteeMembershipInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a InviteeMembershipInfo instance
+func (u *InviteeMembershipInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // JobError : Error occurred while performing an asynchronous job from
 // `unshareFolder` or `removeFolderMember`.
 type JobError struct {
@@ -1546,6 +2740,17 @@ type JobError struct {
 	// RelinquishFolderMembershipError : Error occurred while performing
 	// `relinquishFolderMembership` action.
 	RelinquishFolderMembershipError *RelinquishFolderMembershipError `json:"relinquish_folder_membership_error,omitempty"`
+	raw                             string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u JobError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for JobError
@@ -1575,7 +2780,7 @@ func (u *JobError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "unshare_folder_error":
 		err = json.Unmarshal(w.UnshareFolderError, &u.UnshareFolderError)
@@ -1595,21 +2800,70 @@ func (u *JobError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a JobError instance
+func (u JobError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "unshare_folder_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UnshareFolderError *UnshareFolderError `json:"unshare_folder_error"`
+		}{u.Tagged, u.UnshareFolderError})
+
+	case "remove_folder_member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			RemoveFolderMemberError *RemoveFolderMemberError `json:"remove_folder_member_error"`
+		}{u.Tagged, u.RemoveFolderMemberError})
+
+	case "relinquish_folder_membership_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			RelinquishFolderMembershipError *RelinquishFolderMembershipError `json:"relinquish_folder_membership_error"`
+		}{u.Tagged, u.RelinquishFolderMembershipError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsJobError reports whether err carries a JobError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsJobError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*JobError)(nil), tags...)
+}
+
 // JobStatus : has no documentation (yet)
 type JobStatus struct {
 	dropbox.Tagged
 	// Failed : The asynchronous job returned an error.
 	Failed *JobError `json:"failed,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u JobStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for JobStatus
 const (
-	JobStatusComplete = "complete"
-	JobStatusFailed   = "failed"
+	JobStatusInProgress = "in_progress"
+	JobStatusComplete   = "complete"
+	JobStatusFailed     = "failed"
 )
 
 // UnmarshalJSON deserializes into a JobStatus instance
@@ -1624,7 +2878,7 @@ func (u *JobStatus) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "failed":
 		err = json.Unmarshal(w.Failed, &u.Failed)
@@ -1632,13 +2886,42 @@ func (u *JobStatus) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "in_progress", "complete":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a JobStatus instance
+func (u JobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *JobError `json:"failed"`
+		}{u.Tagged, u.Failed})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // LinkAction : Actions that can be performed on a link.
 type LinkAction struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u LinkAction) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for LinkAction
@@ -1652,9 +2935,46 @@ const (
 	LinkActionOther             = "other"
 )
 
+// UnmarshalJSON deserializes into a LinkAction instance
+func (u *LinkAction) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "change_access_level", "change_audience", "remove_expiry", "remove_password", "set_expiry", "set_password", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a LinkAction instance
+func (u LinkAction) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // LinkAudience : has no documentation (yet)
 type LinkAudience struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u LinkAudience) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for LinkAudience
@@ -1665,47 +2985,115 @@ const (
 	LinkAudienceOther   = "other"
 )
 
-// LinkExpiry : has no documentation (yet)
-type LinkExpiry struct {
-	dropbox.Tagged
-	// SetExpiry : Set a new expiry or change an existing expiry.
-	SetExpiry time.Time `json:"set_expiry,omitempty"`
-}
-
-// Valid tag values for LinkExpiry
-const (
-	LinkExpiryRemoveExpiry = "remove_expiry"
-	LinkExpirySetExpiry    = "set_expiry"
-	LinkExpiryOther        = "other"
-)
+// UnmarshalJSON deserializes into a LinkAudience instance
+func (u *LinkAudience) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "public", "team", "members", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a LinkAudience instance
+func (u LinkAudience) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// LinkExpiry : has no documentation (yet)
+type LinkExpiry struct {
+	dropbox.Tagged
+	// SetExpiry : Set a new expiry or change an existing expiry.
+	SetExpiry time.Time `json:"set_expiry,omitempty"`
+	raw       string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u LinkExpiry) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
+}
+
+// Valid tag values for LinkExpiry
+const (
+	LinkExpiryRemoveExpiry = "remove_expiry"
+	LinkExpirySetExpiry    = "set_expiry"
+	LinkExpiryOther        = "other"
+)
 
 // UnmarshalJSON deserializes into a LinkExpiry instance
 func (u *LinkExpiry) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// SetExpiry : Set a new expiry or change an existing expiry.
+		SetExpiry json.RawMessage `json:"set_expiry,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "set_expiry":
-		err = json.Unmarshal(body, &u.SetExpiry)
+		err = json.Unmarshal(w.SetExpiry, &u.SetExpiry)
 
 		if err != nil {
 			return err
 		}
+	case "remove_expiry", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a LinkExpiry instance
+func (u LinkExpiry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "set_expiry":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SetExpiry time.Time `json:"set_expiry"`
+		}{u.Tagged, u.SetExpiry})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // LinkPassword : has no documentation (yet)
 type LinkPassword struct {
 	dropbox.Tagged
 	// SetPassword : Set a new password or change an existing password.
 	SetPassword string `json:"set_password,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u LinkPassword) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for LinkPassword
@@ -1719,24 +3107,44 @@ const (
 func (u *LinkPassword) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// SetPassword : Set a new password or change an existing password.
+		SetPassword json.RawMessage `json:"set_password,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "set_password":
-		err = json.Unmarshal(body, &u.SetPassword)
+		err = json.Unmarshal(w.SetPassword, &u.SetPassword)
 
 		if err != nil {
 			return err
 		}
+	case "remove_password", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a LinkPassword instance
+func (u LinkPassword) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "set_password":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SetPassword string `json:"set_password"`
+		}{u.Tagged, u.SetPassword})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // LinkPermission : Permissions for actions that can be performed on a link.
 type LinkPermission struct {
 	// Action : has no documentation (yet)
@@ -1745,6 +3153,8 @@ type LinkPermission struct {
 	Allow bool `json:"allow"`
 	// Reason : has no documentation (yet)
 	Reason *PermissionDeniedReason `json:"reason,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewLinkPermission returns a new LinkPermission instance
@@ -1755,6 +3165,16 @@ func NewLinkPermission(Action *LinkAction, Allow bool) *LinkPermission {
 	return s
 }
 
+// MarshalJSON serializes a LinkPermission instance
+func (u LinkPermission) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a LinkPermission instance
+func (u *LinkPermission) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // LinkPermissions : has no documentation (yet)
 type LinkPermissions struct {
 	// ResolvedVisibility : The current visibility of the link after considering
@@ -1773,6 +3193,8 @@ type LinkPermissions struct {
 	// RevokeFailureReason : The failure reason for revoking the link. This
 	// field will only be present if the `can_revoke` is false.
 	RevokeFailureReason *SharedLinkAccessFailureReason `json:"revoke_failure_reason,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewLinkPermissions returns a new LinkPermissions instance
@@ -1782,6 +3204,16 @@ func NewLinkPermissions(CanRevoke bool) *LinkPermissions {
 	return s
 }
 
+// MarshalJSON serializes a LinkPermissions instance
+func (u LinkPermissions) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a LinkPermissions instance
+func (u *LinkPermissions) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // LinkSettings : Settings that apply to a link.
 type LinkSettings struct {
 	// AccessLevel : The access level on the link for this file. Currently, it
@@ -1793,6 +3225,8 @@ type LinkSettings struct {
 	Expiry *LinkExpiry `json:"expiry,omitempty"`
 	// Password : The password for the link.
 	Password *LinkPassword `json:"password,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewLinkSettings returns a new LinkSettings instance
@@ -1801,6 +3235,16 @@ func NewLinkSettings() *LinkSettings {
 	return s
 }
 
+// MarshalJSON serializes a LinkSettings instance
+func (u LinkSettings) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a LinkSettings instance
+func (u *LinkSettings) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListFileMembersArg : Arguments for `listFileMembers`.
 type ListFileMembersArg struct {
 	// File : The file for which you want to see members.
@@ -1813,6 +3257,8 @@ type ListFileMembersArg struct {
 	// Limit : Number of members to return max per query. Defaults to 100 if no
 	// limit is specified.
 	Limit uint32 `json:"limit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFileMembersArg returns a new ListFileMembersArg instance
@@ -1824,6 +3270,21 @@ func NewListFileMembersArg(File string) *ListFileMembersArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFileMembersArg instance
+func (u ListFileMembersArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFileMembersArg instance
+func (u *ListFileMembersArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFileMembersArg) Validate() error {
+	return nil
+}
+
 // ListFileMembersBatchArg : Arguments for `listFileMembersBatch`.
 type ListFileMembersBatchArg struct {
 	// Files : Files for which to return members.
@@ -1831,6 +3292,8 @@ type ListFileMembersBatchArg struct {
 	// Limit : Number of members to return max per query. Defaults to 10 if no
 	// limit is specified.
 	Limit uint32 `json:"limit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFileMembersBatchArg returns a new ListFileMembersBatchArg instance
@@ -1841,12 +3304,29 @@ func NewListFileMembersBatchArg(Files []string) *ListFileMembersBatchArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFileMembersBatchArg instance
+func (u ListFileMembersBatchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFileMembersBatchArg instance
+func (u *ListFileMembersBatchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFileMembersBatchArg) Validate() error {
+	return nil
+}
+
 // ListFileMembersBatchResult : Per-file result for `listFileMembersBatch`.
 type ListFileMembersBatchResult struct {
 	// File : This is the input file identifier, whether an ID or a path.
 	File string `json:"file"`
 	// Result : The result for this particular file.
 	Result *ListFileMembersIndividualResult `json:"result"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFileMembersBatchResult returns a new ListFileMembersBatchResult instance
@@ -1857,11 +3337,23 @@ func NewListFileMembersBatchResult(File string, Result *ListFileMembersIndividua
 	return s
 }
 
+// MarshalJSON serializes a ListFileMembersBatchResult instance
+func (u ListFileMembersBatchResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFileMembersBatchResult instance
+func (u *ListFileMembersBatchResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListFileMembersContinueArg : Arguments for `listFileMembersContinue`.
 type ListFileMembersContinueArg struct {
 	// Cursor : The cursor returned by your last call to `listFileMembers`,
 	// `listFileMembersContinue`, or `listFileMembersBatch`.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFileMembersContinueArg returns a new ListFileMembersContinueArg instance
@@ -1871,6 +3363,21 @@ func NewListFileMembersContinueArg(Cursor string) *ListFileMembersContinueArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFileMembersContinueArg instance
+func (u ListFileMembersContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFileMembersContinueArg instance
+func (u *ListFileMembersContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFileMembersContinueArg) Validate() error {
+	return nil
+}
+
 // ListFileMembersContinueError : Error for `listFileMembersContinue`.
 type ListFileMembersContinueError struct {
 	dropbox.Tagged
@@ -1878,6 +3385,17 @@ type ListFileMembersContinueError struct {
 	UserError *SharingUserError `json:"user_error,omitempty"`
 	// AccessError : has no documentation (yet)
 	AccessError *SharingFileAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListFileMembersContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListFileMembersContinueError
@@ -1902,7 +3420,7 @@ func (u *ListFileMembersContinueError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user_error":
 		err = json.Unmarshal(w.UserError, &u.UserError)
@@ -1916,10 +3434,41 @@ func (u *ListFileMembersContinueError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "invalid_cursor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListFileMembersContinueError instance
+func (u ListFileMembersContinueError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListFileMembersContinueError reports whether err carries a ListFileMembersContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListFileMembersContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListFileMembersContinueError)(nil), tags...)
+}
+
 // ListFileMembersCountResult : has no documentation (yet)
 type ListFileMembersCountResult struct {
 	// Members : A list of members on this file.
@@ -1927,6 +3476,8 @@ type ListFileMembersCountResult struct {
 	// MemberCount : The number of members on this file. This does not include
 	// inherited members.
 	MemberCount uint32 `json:"member_count"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFileMembersCountResult returns a new ListFileMembersCountResult instance
@@ -1937,6 +3488,16 @@ func NewListFileMembersCountResult(Members *SharedFileMembers, MemberCount uint3
 	return s
 }
 
+// MarshalJSON serializes a ListFileMembersCountResult instance
+func (u ListFileMembersCountResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFileMembersCountResult instance
+func (u *ListFileMembersCountResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListFileMembersError : Error for `listFileMembers`.
 type ListFileMembersError struct {
 	dropbox.Tagged
@@ -1944,6 +3505,17 @@ type ListFileMembersError struct {
 	UserError *SharingUserError `json:"user_error,omitempty"`
 	// AccessError : has no documentation (yet)
 	AccessError *SharingFileAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListFileMembersError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListFileMembersError
@@ -1967,7 +3539,7 @@ func (u *ListFileMembersError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user_error":
 		err = json.Unmarshal(w.UserError, &u.UserError)
@@ -1981,10 +3553,41 @@ func (u *ListFileMembersError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListFileMembersError instance
+func (u ListFileMembersError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListFileMembersError reports whether err carries a ListFileMembersError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListFileMembersError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListFileMembersError)(nil), tags...)
+}
+
 // ListFileMembersIndividualResult : has no documentation (yet)
 type ListFileMembersIndividualResult struct {
 	dropbox.Tagged
@@ -1992,6 +3595,17 @@ type ListFileMembersIndividualResult struct {
 	Result *ListFileMembersCountResult `json:"result,omitempty"`
 	// AccessError : The result of the query for this file if it was an error.
 	AccessError *SharingFileAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListFileMembersIndividualResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListFileMembersIndividualResult
@@ -2016,7 +3630,7 @@ func (u *ListFileMembersIndividualResult) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "result":
 		err = json.Unmarshal(body, &u.Result)
@@ -2030,10 +3644,31 @@ func (u *ListFileMembersIndividualResult) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListFileMembersIndividualResult instance
+func (u ListFileMembersIndividualResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "result":
+		return dropbox.MarshalTagged(u.Tag, u.Result)
+
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ListFilesArg : Arguments for `listReceivedFiles`.
 type ListFilesArg struct {
 	// Limit : Number of files to return max per query. Defaults to 100 if no
@@ -2043,6 +3678,8 @@ type ListFilesArg struct {
 	// should appear in the  response's `SharedFileMetadata.permissions` field
 	// describing the actions the  authenticated user can perform on the file.
 	Actions []*FileAction `json:"actions,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFilesArg returns a new ListFilesArg instance
@@ -2052,10 +3689,27 @@ func NewListFilesArg() *ListFilesArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFilesArg instance
+func (u ListFilesArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFilesArg instance
+func (u *ListFilesArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFilesArg) Validate() error {
+	return nil
+}
+
 // ListFilesContinueArg : Arguments for `listReceivedFilesContinue`.
 type ListFilesContinueArg struct {
 	// Cursor : Cursor in `ListFilesResult.cursor`.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFilesContinueArg returns a new ListFilesContinueArg instance
@@ -2065,11 +3719,37 @@ func NewListFilesContinueArg(Cursor string) *ListFilesContinueArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFilesContinueArg instance
+func (u ListFilesContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFilesContinueArg instance
+func (u *ListFilesContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFilesContinueArg) Validate() error {
+	return nil
+}
+
 // ListFilesContinueError : Error results for `listReceivedFilesContinue`.
 type ListFilesContinueError struct {
 	dropbox.Tagged
 	// UserError : User account had a problem.
 	UserError *SharingUserError `json:"user_error,omitempty"`
+	raw       string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListFilesContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListFilesContinueError
@@ -2091,7 +3771,7 @@ func (u *ListFilesContinueError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user_error":
 		err = json.Unmarshal(w.UserError, &u.UserError)
@@ -2099,16 +3779,43 @@ func (u *ListFilesContinueError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "invalid_cursor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListFilesContinueError instance
+func (u ListFilesContinueError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListFilesContinueError reports whether err carries a ListFilesContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListFilesContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListFilesContinueError)(nil), tags...)
+}
+
 // ListFilesResult : Success results for `listReceivedFiles`.
 type ListFilesResult struct {
 	// Entries : Information about the files shared with current user.
 	Entries []*SharedFileMetadata `json:"entries"`
 	// Cursor : Cursor used to obtain additional shared files.
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFilesResult returns a new ListFilesResult instance
@@ -2118,6 +3825,16 @@ func NewListFilesResult(Entries []*SharedFileMetadata) *ListFilesResult {
 	return s
 }
 
+// MarshalJSON serializes a ListFilesResult instance
+func (u ListFilesResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFilesResult instance
+func (u *ListFilesResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListFolderMembersCursorArg : has no documentation (yet)
 type ListFolderMembersCursorArg struct {
 	// Actions : This is a list indicating whether each returned member will
@@ -2127,6 +3844,8 @@ type ListFolderMembersCursorArg struct {
 	// Limit : The maximum number of results that include members, groups and
 	// invitees to return per request.
 	Limit uint32 `json:"limit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFolderMembersCursorArg returns a new ListFolderMembersCursorArg instance
@@ -2136,11 +3855,43 @@ func NewListFolderMembersCursorArg() *ListFolderMembersCursorArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFolderMembersCursorArg instance
+func (u ListFolderMembersCursorArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFolderMembersCursorArg instance
+func (u *ListFolderMembersCursorArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFolderMembersCursorArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Limit < 1 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at least 1",
+		}
+	}
+	if s.Limit > 1000 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at most 1000",
+		}
+	}
+	return nil
+}
+
 // ListFolderMembersArgs : has no documentation (yet)
 type ListFolderMembersArgs struct {
 	ListFolderMembersCursorArg
 	// SharedFolderId : The ID for the shared folder.
 	SharedFolderId string `json:"shared_folder_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFolderMembersArgs returns a new ListFolderMembersArgs instance
@@ -2151,11 +3902,43 @@ func NewListFolderMembersArgs(SharedFolderId string) *ListFolderMembersArgs {
 	return s
 }
 
+// MarshalJSON serializes a ListFolderMembersArgs instance
+func (u ListFolderMembersArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFolderMembersArgs instance
+func (u *ListFolderMembersArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFolderMembersArgs) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Limit < 1 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at least 1",
+		}
+	}
+	if s.Limit > 1000 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at most 1000",
+		}
+	}
+	return nil
+}
+
 // ListFolderMembersContinueArg : has no documentation (yet)
 type ListFolderMembersContinueArg struct {
 	// Cursor : The cursor returned by your last call to `listFolderMembers` or
 	// `listFolderMembersContinue`.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFolderMembersContinueArg returns a new ListFolderMembersContinueArg instance
@@ -2165,11 +3948,37 @@ func NewListFolderMembersContinueArg(Cursor string) *ListFolderMembersContinueAr
 	return s
 }
 
+// MarshalJSON serializes a ListFolderMembersContinueArg instance
+func (u ListFolderMembersContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFolderMembersContinueArg instance
+func (u *ListFolderMembersContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFolderMembersContinueArg) Validate() error {
+	return nil
+}
+
 // ListFolderMembersContinueError : has no documentation (yet)
 type ListFolderMembersContinueError struct {
 	dropbox.Tagged
 	// AccessError : has no documentation (yet)
 	AccessError *SharedFolderAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListFolderMembersContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListFolderMembersContinueError
@@ -2191,7 +4000,7 @@ func (u *ListFolderMembersContinueError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -2199,10 +4008,35 @@ func (u *ListFolderMembersContinueError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "invalid_cursor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListFolderMembersContinueError instance
+func (u ListFolderMembersContinueError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListFolderMembersContinueError reports whether err carries a ListFolderMembersContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListFolderMembersContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListFolderMembersContinueError)(nil), tags...)
+}
+
 // ListFoldersArgs : has no documentation (yet)
 type ListFoldersArgs struct {
 	// Limit : The maximum number of results to return per request.
@@ -2212,6 +4046,8 @@ type ListFoldersArgs struct {
 	// field describing the actions the  authenticated user can perform on the
 	// folder.
 	Actions []*FolderAction `json:"actions,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFoldersArgs returns a new ListFoldersArgs instance
@@ -2221,11 +4057,43 @@ func NewListFoldersArgs() *ListFoldersArgs {
 	return s
 }
 
+// MarshalJSON serializes a ListFoldersArgs instance
+func (u ListFoldersArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFoldersArgs instance
+func (u *ListFoldersArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFoldersArgs) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Limit < 1 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at least 1",
+		}
+	}
+	if s.Limit > 1000 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at most 1000",
+		}
+	}
+	return nil
+}
+
 // ListFoldersContinueArg : has no documentation (yet)
 type ListFoldersContinueArg struct {
 	// Cursor : The cursor returned by the previous API call specified in the
 	// endpoint description.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFoldersContinueArg returns a new ListFoldersContinueArg instance
@@ -2235,9 +4103,35 @@ func NewListFoldersContinueArg(Cursor string) *ListFoldersContinueArg {
 	return s
 }
 
+// MarshalJSON serializes a ListFoldersContinueArg instance
+func (u ListFoldersContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFoldersContinueArg instance
+func (u *ListFoldersContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListFoldersContinueArg) Validate() error {
+	return nil
+}
+
 // ListFoldersContinueError : has no documentation (yet)
 type ListFoldersContinueError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListFoldersContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListFoldersContinueError
@@ -2246,6 +4140,39 @@ const (
 	ListFoldersContinueErrorOther         = "other"
 )
 
+// UnmarshalJSON deserializes into a ListFoldersContinueError instance
+func (u *ListFoldersContinueError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_cursor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListFoldersContinueError instance
+func (u ListFoldersContinueError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListFoldersContinueError reports whether err carries a ListFoldersContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListFoldersContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListFoldersContinueError)(nil), tags...)
+}
+
 // ListFoldersResult : Result for `listFolders` or `listMountableFolders`,
 // depending on which endpoint was requested. Unmounted shared folders can be
 // identified by the absence of `SharedFolderMetadata.path_lower`.
@@ -2258,6 +4185,8 @@ type ListFoldersResult struct {
 	// endpoint (either `listFoldersContinue` or `listMountableFoldersContinue`)
 	// to list additional folders.
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListFoldersResult returns a new ListFoldersResult instance
@@ -2267,6 +4196,16 @@ func NewListFoldersResult(Entries []*SharedFolderMetadata) *ListFoldersResult {
 	return s
 }
 
+// MarshalJSON serializes a ListFoldersResult instance
+func (u ListFoldersResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListFoldersResult instance
+func (u *ListFoldersResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListSharedLinksArg : has no documentation (yet)
 type ListSharedLinksArg struct {
 	// Path : See `listSharedLinks` description.
@@ -2275,6 +4214,8 @@ type ListSharedLinksArg struct {
 	Cursor string `json:"cursor,omitempty"`
 	// DirectOnly : See `listSharedLinks` description.
 	DirectOnly bool `json:"direct_only,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListSharedLinksArg returns a new ListSharedLinksArg instance
@@ -2283,15 +4224,41 @@ func NewListSharedLinksArg() *ListSharedLinksArg {
 	return s
 }
 
+// MarshalJSON serializes a ListSharedLinksArg instance
+func (u ListSharedLinksArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListSharedLinksArg instance
+func (u *ListSharedLinksArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListSharedLinksArg) Validate() error {
+	return nil
+}
+
 // ListSharedLinksError : has no documentation (yet)
 type ListSharedLinksError struct {
 	dropbox.Tagged
 	// Path : has no documentation (yet)
 	Path *files.LookupError `json:"path,omitempty"`
+	raw  string
 }
 
-// Valid tag values for ListSharedLinksError
-const (
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListSharedLinksError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
+}
+
+// Valid tag values for ListSharedLinksError
+const (
 	ListSharedLinksErrorPath  = "path"
 	ListSharedLinksErrorReset = "reset"
 	ListSharedLinksErrorOther = "other"
@@ -2309,7 +4276,7 @@ func (u *ListSharedLinksError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "path":
 		err = json.Unmarshal(w.Path, &u.Path)
@@ -2317,10 +4284,35 @@ func (u *ListSharedLinksError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "reset", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ListSharedLinksError instance
+func (u ListSharedLinksError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *files.LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListSharedLinksError reports whether err carries a ListSharedLinksError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListSharedLinksError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListSharedLinksError)(nil), tags...)
+}
+
 // ListSharedLinksResult : has no documentation (yet)
 type ListSharedLinksResult struct {
 	// Links : Shared links applicable to the path argument.
@@ -2354,6 +4346,8 @@ type MemberAccessLevelResult struct {
 	// is present if the user has access to the first parent folder where the
 	// member gains access.
 	AccessDetails []*ParentFolderAccessInfo `json:"access_details,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberAccessLevelResult returns a new MemberAccessLevelResult instance
@@ -2362,9 +4356,30 @@ func NewMemberAccessLevelResult() *MemberAccessLevelResult {
 	return s
 }
 
+// MarshalJSON serializes a MemberAccessLevelResult instance
+func (u MemberAccessLevelResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberAccessLevelResult instance
+func (u *MemberAccessLevelResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberAction : Actions that may be taken on members of a shared folder.
 type MemberAction struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MemberAction) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MemberAction
@@ -2378,6 +4393,32 @@ const (
 	MemberActionOther               = "other"
 )
 
+// UnmarshalJSON deserializes into a MemberAction instance
+func (u *MemberAction) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "leave_a_copy", "make_editor", "make_owner", "make_viewer", "make_viewer_no_comment", "remove", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MemberAction instance
+func (u MemberAction) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // MemberPermission : Whether the user is allowed to take the action on the
 // associated member.
 type MemberPermission struct {
@@ -2388,6 +4429,8 @@ type MemberPermission struct {
 	// Reason : The reason why the user is denied the permission. Not present if
 	// the action is allowed.
 	Reason *PermissionDeniedReason `json:"reason,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberPermission returns a new MemberPermission instance
@@ -2398,10 +4441,31 @@ func NewMemberPermission(Action *MemberAction, Allow bool) *MemberPermission {
 	return s
 }
 
+// MarshalJSON serializes a MemberPermission instance
+func (u MemberPermission) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberPermission instance
+func (u *MemberPermission) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberPolicy : Policy governing who can be a member of a shared folder. Only
 // applicable to folders owned by a user on a team.
 type MemberPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MemberPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MemberPolicy
@@ -2411,6 +4475,32 @@ const (
 	MemberPolicyOther  = "other"
 )
 
+// UnmarshalJSON deserializes into a MemberPolicy instance
+func (u *MemberPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "team", "anyone", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MemberPolicy instance
+func (u MemberPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // MemberSelector : Includes different ways to identify a member of a shared
 // folder.
 type MemberSelector struct {
@@ -2419,6 +4509,17 @@ type MemberSelector struct {
 	DropboxId string `json:"dropbox_id,omitempty"`
 	// Email : E-mail address of member.
 	Email string `json:"email,omitempty"`
+	raw   string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MemberSelector) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MemberSelector
@@ -2432,30 +4533,58 @@ const (
 func (u *MemberSelector) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// DropboxId : Dropbox account, team member, or group ID of member.
+		DropboxId json.RawMessage `json:"dropbox_id,omitempty"`
+		// Email : E-mail address of member.
+		Email json.RawMessage `json:"email,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "dropbox_id":
-		err = json.Unmarshal(body, &u.DropboxId)
+		err = json.Unmarshal(w.DropboxId, &u.DropboxId)
 
 		if err != nil {
 			return err
 		}
 	case "email":
-		err = json.Unmarshal(body, &u.Email)
+		err = json.Unmarshal(w.Email, &u.Email)
 
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a MemberSelector instance
+func (u MemberSelector) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "dropbox_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DropboxId string `json:"dropbox_id"`
+		}{u.Tagged, u.DropboxId})
+
+	case "email":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Email string `json:"email"`
+		}{u.Tagged, u.Email})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ModifySharedLinkSettingsArgs : has no documentation (yet)
 type ModifySharedLinkSettingsArgs struct {
 	// Url : URL of the shared link to change its settings
@@ -2465,6 +4594,8 @@ type ModifySharedLinkSettingsArgs struct {
 	// RemoveExpiration : If set to true, removes the expiration of the shared
 	// link.
 	RemoveExpiration bool `json:"remove_expiration"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewModifySharedLinkSettingsArgs returns a new ModifySharedLinkSettingsArgs instance
@@ -2476,11 +4607,37 @@ func NewModifySharedLinkSettingsArgs(Url string, Settings *SharedLinkSettings) *
 	return s
 }
 
+// MarshalJSON serializes a ModifySharedLinkSettingsArgs instance
+func (u ModifySharedLinkSettingsArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ModifySharedLinkSettingsArgs instance
+func (u *ModifySharedLinkSettingsArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ModifySharedLinkSettingsArgs) Validate() error {
+	return nil
+}
+
 // ModifySharedLinkSettingsError : has no documentation (yet)
 type ModifySharedLinkSettingsError struct {
 	dropbox.Tagged
 	// SettingsError : There is an error with the given settings
 	SettingsError *SharedLinkSettingsError `json:"settings_error,omitempty"`
+	raw           string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ModifySharedLinkSettingsError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ModifySharedLinkSettingsError
@@ -2501,7 +4658,7 @@ func (u *ModifySharedLinkSettingsError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "settings_error":
 		err = json.Unmarshal(w.SettingsError, &u.SettingsError)
@@ -2509,14 +4666,41 @@ func (u *ModifySharedLinkSettingsError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "email_not_verified":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ModifySharedLinkSettingsError instance
+func (u ModifySharedLinkSettingsError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "settings_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SettingsError *SharedLinkSettingsError `json:"settings_error"`
+		}{u.Tagged, u.SettingsError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsModifySharedLinkSettingsError reports whether err carries a ModifySharedLinkSettingsError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsModifySharedLinkSettingsError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ModifySharedLinkSettingsError)(nil), tags...)
+}
+
 // MountFolderArg : has no documentation (yet)
 type MountFolderArg struct {
 	// SharedFolderId : The ID of the shared folder to mount.
 	SharedFolderId string `json:"shared_folder_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMountFolderArg returns a new MountFolderArg instance
@@ -2526,6 +4710,21 @@ func NewMountFolderArg(SharedFolderId string) *MountFolderArg {
 	return s
 }
 
+// MarshalJSON serializes a MountFolderArg instance
+func (u MountFolderArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MountFolderArg instance
+func (u *MountFolderArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MountFolderArg) Validate() error {
+	return nil
+}
+
 // MountFolderError : has no documentation (yet)
 type MountFolderError struct {
 	dropbox.Tagged
@@ -2534,6 +4733,17 @@ type MountFolderError struct {
 	// InsufficientQuota : The current user does not have enough space to mount
 	// the shared folder.
 	InsufficientQuota *InsufficientQuotaAmounts `json:"insufficient_quota,omitempty"`
+	raw               string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MountFolderError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MountFolderError
@@ -2562,7 +4772,7 @@ func (u *MountFolderError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -2576,10 +4786,38 @@ func (u *MountFolderError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "inside_shared_folder", "already_mounted", "no_permission", "not_mountable", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a MountFolderError instance
+func (u MountFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	case "insufficient_quota":
+		return dropbox.MarshalTagged(u.Tag, u.InsufficientQuota)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMountFolderError reports whether err carries a MountFolderError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMountFolderError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MountFolderError)(nil), tags...)
+}
+
 // ParentFolderAccessInfo : Contains information about a parent folder that a
 // member has access to.
 type ParentFolderAccessInfo struct {
@@ -2592,6 +4830,8 @@ type ParentFolderAccessInfo struct {
 	// Path : The full path to the parent shared folder relative to the acting
 	// user's root.
 	Path string `json:"path"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewParentFolderAccessInfo returns a new ParentFolderAccessInfo instance
@@ -2604,11 +4844,23 @@ func NewParentFolderAccessInfo(FolderName string, SharedFolderId string, Permiss
 	return s
 }
 
+// MarshalJSON serializes a ParentFolderAccessInfo instance
+func (u ParentFolderAccessInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ParentFolderAccessInfo instance
+func (u *ParentFolderAccessInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PathLinkMetadata : Metadata for a path-based shared link.
 type PathLinkMetadata struct {
 	LinkMetadata
 	// Path : Path in user's Dropbox.
 	Path string `json:"path"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPathLinkMetadata returns a new PathLinkMetadata instance
@@ -2620,10 +4872,31 @@ func NewPathLinkMetadata(Url string, Visibility *Visibility, Path string) *PathL
 	return s
 }
 
+// MarshalJSON serializes a PathLinkMetadata instance, tagged as a `LinkMetadata`
+func (u PathLinkMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("path", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PathLinkMetadata instance
+func (u *PathLinkMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PendingUploadMode : Flag to indicate pending upload default (for linking to
 // not-yet-existing paths).
 type PendingUploadMode struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PendingUploadMode) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PendingUploadMode
@@ -2632,9 +4905,46 @@ const (
 	PendingUploadModeFolder = "folder"
 )
 
+// UnmarshalJSON deserializes into a PendingUploadMode instance
+func (u *PendingUploadMode) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "file", "folder":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PendingUploadMode instance
+func (u PendingUploadMode) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PermissionDeniedReason : Possible reasons the user is denied a permission.
 type PermissionDeniedReason struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PermissionDeniedReason) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PermissionDeniedReason
@@ -2655,10 +4965,38 @@ const (
 	PermissionDeniedReasonOther                      = "other"
 )
 
+// UnmarshalJSON deserializes into a PermissionDeniedReason instance
+func (u *PermissionDeniedReason) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "user_not_same_team_as_owner", "user_not_allowed_by_owner", "target_is_indirect_member", "target_is_owner", "target_is_self", "target_not_active", "folder_is_limited_team_folder", "owner_not_on_team", "permission_denied", "restricted_by_team", "user_account_type", "user_not_on_team", "folder_is_inside_shared_folder", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PermissionDeniedReason instance
+func (u PermissionDeniedReason) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // RelinquishFileMembershipArg : has no documentation (yet)
 type RelinquishFileMembershipArg struct {
 	// File : The path or id for the file.
 	File string `json:"file"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRelinquishFileMembershipArg returns a new RelinquishFileMembershipArg instance
@@ -2668,11 +5006,37 @@ func NewRelinquishFileMembershipArg(File string) *RelinquishFileMembershipArg {
 	return s
 }
 
+// MarshalJSON serializes a RelinquishFileMembershipArg instance
+func (u RelinquishFileMembershipArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RelinquishFileMembershipArg instance
+func (u *RelinquishFileMembershipArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RelinquishFileMembershipArg) Validate() error {
+	return nil
+}
+
 // RelinquishFileMembershipError : has no documentation (yet)
 type RelinquishFileMembershipError struct {
 	dropbox.Tagged
 	// AccessError : has no documentation (yet)
 	AccessError *SharingFileAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RelinquishFileMembershipError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RelinquishFileMembershipError
@@ -2695,7 +5059,7 @@ func (u *RelinquishFileMembershipError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -2703,10 +5067,35 @@ func (u *RelinquishFileMembershipError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "group_access", "no_permission", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RelinquishFileMembershipError instance
+func (u RelinquishFileMembershipError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRelinquishFileMembershipError reports whether err carries a RelinquishFileMembershipError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRelinquishFileMembershipError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RelinquishFileMembershipError)(nil), tags...)
+}
+
 // RelinquishFolderMembershipArg : has no documentation (yet)
 type RelinquishFolderMembershipArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -2714,6 +5103,8 @@ type RelinquishFolderMembershipArg struct {
 	// LeaveACopy : Keep a copy of the folder's contents upon relinquishing
 	// membership.
 	LeaveACopy bool `json:"leave_a_copy"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRelinquishFolderMembershipArg returns a new RelinquishFolderMembershipArg instance
@@ -2724,11 +5115,37 @@ func NewRelinquishFolderMembershipArg(SharedFolderId string) *RelinquishFolderMe
 	return s
 }
 
+// MarshalJSON serializes a RelinquishFolderMembershipArg instance
+func (u RelinquishFolderMembershipArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RelinquishFolderMembershipArg instance
+func (u *RelinquishFolderMembershipArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RelinquishFolderMembershipArg) Validate() error {
+	return nil
+}
+
 // RelinquishFolderMembershipError : has no documentation (yet)
 type RelinquishFolderMembershipError struct {
 	dropbox.Tagged
 	// AccessError : has no documentation (yet)
 	AccessError *SharedFolderAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RelinquishFolderMembershipError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RelinquishFolderMembershipError
@@ -2755,7 +5172,7 @@ func (u *RelinquishFolderMembershipError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -2763,10 +5180,35 @@ func (u *RelinquishFolderMembershipError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "folder_owner", "mounted", "group_access", "team_folder", "no_permission", "no_explicit_access", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RelinquishFolderMembershipError instance
+func (u RelinquishFolderMembershipError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRelinquishFolderMembershipError reports whether err carries a RelinquishFolderMembershipError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRelinquishFolderMembershipError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RelinquishFolderMembershipError)(nil), tags...)
+}
+
 // RemoveFileMemberArg : Arguments for `removeFileMember2`.
 type RemoveFileMemberArg struct {
 	// File : File from which to remove members.
@@ -2775,6 +5217,8 @@ type RemoveFileMemberArg struct {
 	// specified, it may result in the removal of a user (not an invitee) if the
 	// user's main account corresponds to that email address.
 	Member *MemberSelector `json:"member"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRemoveFileMemberArg returns a new RemoveFileMemberArg instance
@@ -2785,6 +5229,21 @@ func NewRemoveFileMemberArg(File string, Member *MemberSelector) *RemoveFileMemb
 	return s
 }
 
+// MarshalJSON serializes a RemoveFileMemberArg instance
+func (u RemoveFileMemberArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RemoveFileMemberArg instance
+func (u *RemoveFileMemberArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RemoveFileMemberArg) Validate() error {
+	return nil
+}
+
 // RemoveFileMemberError : Errors for `removeFileMember2`.
 type RemoveFileMemberError struct {
 	dropbox.Tagged
@@ -2796,6 +5255,17 @@ type RemoveFileMemberError struct {
 	// and therefore cannot be removed. The return value is the access that a
 	// user might have to the file from a parent folder.
 	NoExplicitAccess *MemberAccessLevelResult `json:"no_explicit_access,omitempty"`
+	raw              string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RemoveFileMemberError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RemoveFileMemberError
@@ -2824,7 +5294,7 @@ func (u *RemoveFileMemberError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user_error":
 		err = json.Unmarshal(w.UserError, &u.UserError)
@@ -2844,10 +5314,44 @@ func (u *RemoveFileMemberError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RemoveFileMemberError instance
+func (u RemoveFileMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	case "no_explicit_access":
+		return dropbox.MarshalTagged(u.Tag, u.NoExplicitAccess)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRemoveFileMemberError reports whether err carries a RemoveFileMemberError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRemoveFileMemberError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RemoveFileMemberError)(nil), tags...)
+}
+
 // RemoveFolderMemberArg : has no documentation (yet)
 type RemoveFolderMemberArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -2859,6 +5363,8 @@ type RemoveFolderMemberArg struct {
 	// removed from their Dropbox. Also, this must be set to false when kicking
 	// a group.
 	LeaveACopy bool `json:"leave_a_copy"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRemoveFolderMemberArg returns a new RemoveFolderMemberArg instance
@@ -2870,6 +5376,21 @@ func NewRemoveFolderMemberArg(SharedFolderId string, Member *MemberSelector, Lea
 	return s
 }
 
+// MarshalJSON serializes a RemoveFolderMemberArg instance
+func (u RemoveFolderMemberArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RemoveFolderMemberArg instance
+func (u *RemoveFolderMemberArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RemoveFolderMemberArg) Validate() error {
+	return nil
+}
+
 // RemoveFolderMemberError : has no documentation (yet)
 type RemoveFolderMemberError struct {
 	dropbox.Tagged
@@ -2877,6 +5398,17 @@ type RemoveFolderMemberError struct {
 	AccessError *SharedFolderAccessError `json:"access_error,omitempty"`
 	// MemberError : has no documentation (yet)
 	MemberError *SharedFolderMemberError `json:"member_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RemoveFolderMemberError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RemoveFolderMemberError
@@ -2904,7 +5436,7 @@ func (u *RemoveFolderMemberError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -2918,10 +5450,41 @@ func (u *RemoveFolderMemberError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "folder_owner", "group_access", "team_folder", "no_permission", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RemoveFolderMemberError instance
+func (u RemoveFolderMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	case "member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MemberError *SharedFolderMemberError `json:"member_error"`
+		}{u.Tagged, u.MemberError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRemoveFolderMemberError reports whether err carries a RemoveFolderMemberError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRemoveFolderMemberError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RemoveFolderMemberError)(nil), tags...)
+}
+
 // RemoveMemberJobStatus : has no documentation (yet)
 type RemoveMemberJobStatus struct {
 	dropbox.Tagged
@@ -2930,12 +5493,24 @@ type RemoveMemberJobStatus struct {
 	Complete *MemberAccessLevelResult `json:"complete,omitempty"`
 	// Failed : has no documentation (yet)
 	Failed *RemoveFolderMemberError `json:"failed,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RemoveMemberJobStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RemoveMemberJobStatus
 const (
-	RemoveMemberJobStatusComplete = "complete"
-	RemoveMemberJobStatusFailed   = "failed"
+	RemoveMemberJobStatusInProgress = "in_progress"
+	RemoveMemberJobStatusComplete   = "complete"
+	RemoveMemberJobStatusFailed     = "failed"
 )
 
 // UnmarshalJSON deserializes into a RemoveMemberJobStatus instance
@@ -2953,7 +5528,7 @@ func (u *RemoveMemberJobStatus) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
@@ -2967,10 +5542,31 @@ func (u *RemoveMemberJobStatus) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "in_progress":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RemoveMemberJobStatus instance
+func (u RemoveMemberJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *RemoveFolderMemberError `json:"failed"`
+		}{u.Tagged, u.Failed})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // RequestedVisibility : The access permission that can be requested by the
 // caller for the shared link. Note that the final resolved visibility of the
 // shared link takes into account other aspects, such as team and shared folder
@@ -2978,6 +5574,17 @@ func (u *RemoveMemberJobStatus) UnmarshalJSON(body []byte) error {
 // resolved visibility values of shared links.
 type RequestedVisibility struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RequestedVisibility) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RequestedVisibility
@@ -2987,12 +5594,49 @@ const (
 	RequestedVisibilityPassword = "password"
 )
 
-// ResolvedVisibility : The actual access permissions values of shared links
+// UnmarshalJSON deserializes into a RequestedVisibility instance
+func (u *RequestedVisibility) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "public", "team_only", "password":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a RequestedVisibility instance
+func (u RequestedVisibility) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// ResolvedVisibility : The actual access permissions values of shared links
 // after taking into account user preferences and the team and shared folder
 // settings. Check the `RequestedVisibility` for more info on the possible
 // visibility values that can be set by the shared link's owner.
 type ResolvedVisibility struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ResolvedVisibility) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ResolvedVisibility
@@ -3002,10 +5646,38 @@ const (
 	ResolvedVisibilityOther            = "other"
 )
 
+// UnmarshalJSON deserializes into a ResolvedVisibility instance
+func (u *ResolvedVisibility) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "team_and_password", "shared_folder_only", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ResolvedVisibility instance
+func (u ResolvedVisibility) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // RevokeSharedLinkArg : has no documentation (yet)
 type RevokeSharedLinkArg struct {
 	// Url : URL of the shared link.
 	Url string `json:"url"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRevokeSharedLinkArg returns a new RevokeSharedLinkArg instance
@@ -3015,9 +5687,35 @@ func NewRevokeSharedLinkArg(Url string) *RevokeSharedLinkArg {
 	return s
 }
 
+// MarshalJSON serializes a RevokeSharedLinkArg instance
+func (u RevokeSharedLinkArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RevokeSharedLinkArg instance
+func (u *RevokeSharedLinkArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RevokeSharedLinkArg) Validate() error {
+	return nil
+}
+
 // RevokeSharedLinkError : has no documentation (yet)
 type RevokeSharedLinkError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RevokeSharedLinkError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RevokeSharedLinkError
@@ -3025,6 +5723,39 @@ const (
 	RevokeSharedLinkErrorSharedLinkMalformed = "shared_link_malformed"
 )
 
+// UnmarshalJSON deserializes into a RevokeSharedLinkError instance
+func (u *RevokeSharedLinkError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "shared_link_malformed":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a RevokeSharedLinkError instance
+func (u RevokeSharedLinkError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRevokeSharedLinkError reports whether err carries a RevokeSharedLinkError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRevokeSharedLinkError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RevokeSharedLinkError)(nil), tags...)
+}
+
 // ShareFolderArg : has no documentation (yet)
 type ShareFolderArg struct {
 	// Path : The path to the folder to share. If it does not exist, then a new
@@ -3051,6 +5782,8 @@ type ShareFolderArg struct {
 	// ViewerInfoPolicy : Who can enable/disable viewer info for this shared
 	// folder.
 	ViewerInfoPolicy *ViewerInfoPolicy `json:"viewer_info_policy,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShareFolderArg returns a new ShareFolderArg instance
@@ -3061,11 +5794,37 @@ func NewShareFolderArg(Path string) *ShareFolderArg {
 	return s
 }
 
+// MarshalJSON serializes a ShareFolderArg instance
+func (u ShareFolderArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShareFolderArg instance
+func (u *ShareFolderArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ShareFolderArg) Validate() error {
+	return nil
+}
+
 // ShareFolderErrorBase : has no documentation (yet)
 type ShareFolderErrorBase struct {
 	dropbox.Tagged
 	// BadPath : `ShareFolderArg.path` is invalid.
 	BadPath *SharePathError `json:"bad_path,omitempty"`
+	raw     string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ShareFolderErrorBase) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ShareFolderErrorBase
@@ -3089,7 +5848,7 @@ func (u *ShareFolderErrorBase) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "bad_path":
 		err = json.Unmarshal(w.BadPath, &u.BadPath)
@@ -3097,13 +5856,42 @@ func (u *ShareFolderErrorBase) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "email_unverified", "team_policy_disallows_member_policy", "disallowed_shared_link_policy", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ShareFolderErrorBase instance
+func (u ShareFolderErrorBase) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "bad_path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			BadPath *SharePathError `json:"bad_path"`
+		}{u.Tagged, u.BadPath})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ShareFolderError : has no documentation (yet)
 type ShareFolderError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ShareFolderError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ShareFolderError
@@ -3111,6 +5899,39 @@ const (
 	ShareFolderErrorNoPermission = "no_permission"
 )
 
+// UnmarshalJSON deserializes into a ShareFolderError instance
+func (u *ShareFolderError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "no_permission":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ShareFolderError instance
+func (u ShareFolderError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsShareFolderError reports whether err carries a ShareFolderError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsShareFolderError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ShareFolderError)(nil), tags...)
+}
+
 // ShareFolderJobStatus : has no documentation (yet)
 type ShareFolderJobStatus struct {
 	dropbox.Tagged
@@ -3119,12 +5940,24 @@ type ShareFolderJobStatus struct {
 	Complete *SharedFolderMetadata `json:"complete,omitempty"`
 	// Failed : has no documentation (yet)
 	Failed *ShareFolderError `json:"failed,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ShareFolderJobStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ShareFolderJobStatus
 const (
-	ShareFolderJobStatusComplete = "complete"
-	ShareFolderJobStatusFailed   = "failed"
+	ShareFolderJobStatusInProgress = "in_progress"
+	ShareFolderJobStatusComplete   = "complete"
+	ShareFolderJobStatusFailed     = "failed"
 )
 
 // UnmarshalJSON deserializes into a ShareFolderJobStatus instance
@@ -3142,7 +5975,7 @@ func (u *ShareFolderJobStatus) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
@@ -3156,26 +5989,67 @@ func (u *ShareFolderJobStatus) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "in_progress":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ShareFolderJobStatus instance
+func (u ShareFolderJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *ShareFolderError `json:"failed"`
+		}{u.Tagged, u.Failed})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ShareFolderLaunch : has no documentation (yet)
 type ShareFolderLaunch struct {
 	dropbox.Tagged
+	// AsyncJobId : This response indicates that the processing is asynchronous.
+	// The string is an id that can be used to obtain the status of the
+	// asynchronous job.
+	AsyncJobId string `json:"async_job_id,omitempty"`
 	// Complete : has no documentation (yet)
 	Complete *SharedFolderMetadata `json:"complete,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ShareFolderLaunch) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ShareFolderLaunch
 const (
-	ShareFolderLaunchComplete = "complete"
+	ShareFolderLaunchAsyncJobId = "async_job_id"
+	ShareFolderLaunchComplete   = "complete"
 )
 
 // UnmarshalJSON deserializes into a ShareFolderLaunch instance
 func (u *ShareFolderLaunch) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// AsyncJobId : This response indicates that the processing is
+		// asynchronous. The string is an id that can be used to obtain the
+		// status of the asynchronous job.
+		AsyncJobId json.RawMessage `json:"async_job_id,omitempty"`
 		// Complete : has no documentation (yet)
 		Complete json.RawMessage `json:"complete,omitempty"`
 	}
@@ -3184,24 +6058,61 @@ func (u *ShareFolderLaunch) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
+	case "async_job_id":
+		err = json.Unmarshal(w.AsyncJobId, &u.AsyncJobId)
+
+		if err != nil {
+			return err
+		}
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ShareFolderLaunch instance
+func (u ShareFolderLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharePathError : has no documentation (yet)
 type SharePathError struct {
 	dropbox.Tagged
 	// AlreadyShared : Folder is already shared. Contains metadata about the
 	// existing shared folder.
 	AlreadyShared *SharedFolderMetadata `json:"already_shared,omitempty"`
+	raw           string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharePathError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharePathError
@@ -3235,7 +6146,7 @@ func (u *SharePathError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "already_shared":
 		err = json.Unmarshal(body, &u.AlreadyShared)
@@ -3243,15 +6154,39 @@ func (u *SharePathError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "is_file", "inside_shared_folder", "contains_shared_folder", "contains_app_folder", "contains_team_folder", "is_app_folder", "inside_app_folder", "is_public_folder", "inside_public_folder", "invalid_path", "is_osx_package", "inside_osx_package", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a SharePathError instance
+func (u SharePathError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "already_shared":
+		return dropbox.MarshalTagged(u.Tag, u.AlreadyShared)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSharePathError reports whether err carries a SharePathError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSharePathError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SharePathError)(nil), tags...)
+}
+
 // SharedContentLinkMetadata : Metadata of a shared link for a file or folder.
 type SharedContentLinkMetadata struct {
 	SharedContentLinkMetadataBase
 	// Url : The URL of the link.
 	Url string `json:"url"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentLinkMetadata returns a new SharedContentLinkMetadata instance
@@ -3265,6 +6200,16 @@ func NewSharedContentLinkMetadata(AudienceOptions []*LinkAudience, CurrentAudien
 	return s
 }
 
+// MarshalJSON serializes a SharedContentLinkMetadata instance
+func (u SharedContentLinkMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentLinkMetadata instance
+func (u *SharedContentLinkMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFileMembers : Shared file user, group, and invitee membership. Used for
 // the results of `listFileMembers` and `listFileMembersContinue`, and used as
 // part of the results for `listFileMembersBatch`.
@@ -3280,6 +6225,8 @@ type SharedFileMembers struct {
 	// not been returned yet. Pass the cursor into `listFileMembersContinue` to
 	// list additional members.
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFileMembers returns a new SharedFileMembers instance
@@ -3291,6 +6238,16 @@ func NewSharedFileMembers(Users []*UserMembershipInfo, Groups []*GroupMembership
 	return s
 }
 
+// MarshalJSON serializes a SharedFileMembers instance
+func (u SharedFileMembers) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFileMembers instance
+func (u *SharedFileMembers) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFileMetadata : Properties of the shared file.
 type SharedFileMetadata struct {
 	// AccessType : The current user's access level for this shared file.
@@ -3333,6 +6290,8 @@ type SharedFileMetadata struct {
 	// timestamp will indicate when the user was invited to the parent shared
 	// folder. This value may be absent.
 	TimeInvited time.Time `json:"time_invited,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFileMetadata returns a new SharedFileMetadata instance
@@ -3345,9 +6304,30 @@ func NewSharedFileMetadata(Id string, Name string, Policy *FolderPolicy, Preview
 	return s
 }
 
+// MarshalJSON serializes a SharedFileMetadata instance
+func (u SharedFileMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFileMetadata instance
+func (u *SharedFileMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderAccessError : There is an error accessing the shared folder.
 type SharedFolderAccessError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedFolderAccessError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedFolderAccessError
@@ -3359,12 +6339,56 @@ const (
 	SharedFolderAccessErrorOther           = "other"
 )
 
+// UnmarshalJSON deserializes into a SharedFolderAccessError instance
+func (u *SharedFolderAccessError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_id", "not_a_member", "email_unverified", "unmounted", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedFolderAccessError instance
+func (u SharedFolderAccessError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSharedFolderAccessError reports whether err carries a SharedFolderAccessError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSharedFolderAccessError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SharedFolderAccessError)(nil), tags...)
+}
+
 // SharedFolderMemberError : has no documentation (yet)
 type SharedFolderMemberError struct {
 	dropbox.Tagged
 	// NoExplicitAccess : The target member only has inherited access to the
 	// shared folder.
 	NoExplicitAccess *MemberAccessLevelResult `json:"no_explicit_access,omitempty"`
+	raw              string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedFolderMemberError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedFolderMemberError
@@ -3388,7 +6412,7 @@ func (u *SharedFolderMemberError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "no_explicit_access":
 		err = json.Unmarshal(body, &u.NoExplicitAccess)
@@ -3396,10 +6420,32 @@ func (u *SharedFolderMemberError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "invalid_dropbox_id", "not_a_member", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a SharedFolderMemberError instance
+func (u SharedFolderMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "no_explicit_access":
+		return dropbox.MarshalTagged(u.Tag, u.NoExplicitAccess)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSharedFolderMemberError reports whether err carries a SharedFolderMemberError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSharedFolderMemberError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SharedFolderMemberError)(nil), tags...)
+}
+
 // SharedFolderMembers : Shared folder user and group membership.
 type SharedFolderMembers struct {
 	// Users : The list of user members of the shared folder.
@@ -3412,6 +6458,8 @@ type SharedFolderMembers struct {
 	// not been returned yet. Pass the cursor into `listFolderMembersContinue`
 	// to list additional members.
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderMembers returns a new SharedFolderMembers instance
@@ -3423,6 +6471,16 @@ func NewSharedFolderMembers(Users []*UserMembershipInfo, Groups []*GroupMembersh
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderMembers instance
+func (u SharedFolderMembers) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderMembers instance
+func (u *SharedFolderMembers) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderMetadataBase : Properties of the shared folder.
 type SharedFolderMetadataBase struct {
 	// AccessType : The current user's access level for this shared folder.
@@ -3441,6 +6499,8 @@ type SharedFolderMetadataBase struct {
 	// PathLower : The lower-cased full path of this shared folder. Absent for
 	// unmounted folders.
 	PathLower string `json:"path_lower,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderMetadataBase returns a new SharedFolderMetadataBase instance
@@ -3452,6 +6512,16 @@ func NewSharedFolderMetadataBase(AccessType *AccessLevel, IsInsideTeamFolder boo
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderMetadataBase instance
+func (u SharedFolderMetadataBase) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderMetadataBase instance
+func (u *SharedFolderMetadataBase) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderMetadata : The metadata which includes basic information about
 // the shared folder.
 type SharedFolderMetadata struct {
@@ -3475,6 +6545,8 @@ type SharedFolderMetadata struct {
 	// TimeInvited : Timestamp indicating when the current user was invited to
 	// this shared folder.
 	TimeInvited time.Time `json:"time_invited"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderMetadata returns a new SharedFolderMetadata instance
@@ -3491,9 +6563,30 @@ func NewSharedFolderMetadata(AccessType *AccessLevel, IsInsideTeamFolder bool, I
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderMetadata instance
+func (u SharedFolderMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderMetadata instance
+func (u *SharedFolderMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedLinkAccessFailureReason : has no documentation (yet)
 type SharedLinkAccessFailureReason struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedLinkAccessFailureReason) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedLinkAccessFailureReason
@@ -3506,9 +6599,46 @@ const (
 	SharedLinkAccessFailureReasonOther               = "other"
 )
 
+// UnmarshalJSON deserializes into a SharedLinkAccessFailureReason instance
+func (u *SharedLinkAccessFailureReason) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "login_required", "email_verify_required", "password_required", "team_only", "owner_only", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedLinkAccessFailureReason instance
+func (u SharedLinkAccessFailureReason) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharedLinkPolicy : Who can view shared links in this folder.
 type SharedLinkPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedLinkPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedLinkPolicy
@@ -3519,6 +6649,32 @@ const (
 	SharedLinkPolicyOther   = "other"
 )
 
+// UnmarshalJSON deserializes into a SharedLinkPolicy instance
+func (u *SharedLinkPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "anyone", "team", "members", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedLinkPolicy instance
+func (u SharedLinkPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharedLinkSettings : has no documentation (yet)
 type SharedLinkSettings struct {
 	// RequestedVisibility : The requested access for this shared link.
@@ -3530,6 +6686,8 @@ type SharedLinkSettings struct {
 	// Expires : Expiration time of the shared link. By default the link won't
 	// expire.
 	Expires time.Time `json:"expires,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedLinkSettings returns a new SharedLinkSettings instance
@@ -3538,9 +6696,30 @@ func NewSharedLinkSettings() *SharedLinkSettings {
 	return s
 }
 
+// MarshalJSON serializes a SharedLinkSettings instance
+func (u SharedLinkSettings) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedLinkSettings instance
+func (u *SharedLinkSettings) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedLinkSettingsError : has no documentation (yet)
 type SharedLinkSettingsError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedLinkSettingsError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedLinkSettingsError
@@ -3549,9 +6728,53 @@ const (
 	SharedLinkSettingsErrorNotAuthorized   = "not_authorized"
 )
 
+// UnmarshalJSON deserializes into a SharedLinkSettingsError instance
+func (u *SharedLinkSettingsError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_settings", "not_authorized":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedLinkSettingsError instance
+func (u SharedLinkSettingsError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSharedLinkSettingsError reports whether err carries a SharedLinkSettingsError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSharedLinkSettingsError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SharedLinkSettingsError)(nil), tags...)
+}
+
 // SharingFileAccessError : User could not access this file.
 type SharingFileAccessError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharingFileAccessError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharingFileAccessError
@@ -3564,9 +6787,53 @@ const (
 	SharingFileAccessErrorOther              = "other"
 )
 
+// UnmarshalJSON deserializes into a SharingFileAccessError instance
+func (u *SharingFileAccessError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "no_permission", "invalid_file", "is_folder", "inside_public_folder", "inside_osx_package", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharingFileAccessError instance
+func (u SharingFileAccessError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSharingFileAccessError reports whether err carries a SharingFileAccessError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSharingFileAccessError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SharingFileAccessError)(nil), tags...)
+}
+
 // SharingUserError : User account had a problem preventing this action.
 type SharingUserError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharingUserError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharingUserError
@@ -3575,6 +6842,39 @@ const (
 	SharingUserErrorOther           = "other"
 )
 
+// UnmarshalJSON deserializes into a SharingUserError instance
+func (u *SharingUserError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "email_unverified", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharingUserError instance
+func (u SharingUserError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsSharingUserError reports whether err carries a SharingUserError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsSharingUserError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*SharingUserError)(nil), tags...)
+}
+
 // TeamMemberInfo : Information about a team member.
 type TeamMemberInfo struct {
 	// TeamInfo : Information about the member's team
@@ -3584,6 +6884,8 @@ type TeamMemberInfo struct {
 	// MemberId : ID of user as a member of a team. This field will only be
 	// present if the member is in the same team as current user.
 	MemberId string `json:"member_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamMemberInfo returns a new TeamMemberInfo instance
@@ -3594,12 +6896,24 @@ func NewTeamMemberInfo(TeamInfo *users.Team, DisplayName string) *TeamMemberInfo
 	return s
 }
 
+// MarshalJSON serializes a TeamMemberInfo instance
+func (u TeamMemberInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamMemberInfo instance
+func (u *TeamMemberInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TransferFolderArg : has no documentation (yet)
 type TransferFolderArg struct {
 	// SharedFolderId : The ID for the shared folder.
 	SharedFolderId string `json:"shared_folder_id"`
 	// ToDropboxId : A account or team member ID to transfer ownership to.
 	ToDropboxId string `json:"to_dropbox_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTransferFolderArg returns a new TransferFolderArg instance
@@ -3610,11 +6924,37 @@ func NewTransferFolderArg(SharedFolderId string, ToDropboxId string) *TransferFo
 	return s
 }
 
+// MarshalJSON serializes a TransferFolderArg instance
+func (u TransferFolderArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TransferFolderArg instance
+func (u *TransferFolderArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *TransferFolderArg) Validate() error {
+	return nil
+}
+
 // TransferFolderError : has no documentation (yet)
 type TransferFolderError struct {
 	dropbox.Tagged
 	// AccessError : has no documentation (yet)
 	AccessError *SharedFolderAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TransferFolderError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TransferFolderError
@@ -3641,7 +6981,7 @@ func (u *TransferFolderError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -3649,14 +6989,41 @@ func (u *TransferFolderError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "invalid_dropbox_id", "new_owner_not_a_member", "new_owner_unmounted", "new_owner_email_unverified", "team_folder", "no_permission", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a TransferFolderError instance
+func (u TransferFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTransferFolderError reports whether err carries a TransferFolderError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTransferFolderError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TransferFolderError)(nil), tags...)
+}
+
 // UnmountFolderArg : has no documentation (yet)
 type UnmountFolderArg struct {
 	// SharedFolderId : The ID for the shared folder.
 	SharedFolderId string `json:"shared_folder_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUnmountFolderArg returns a new UnmountFolderArg instance
@@ -3666,11 +7033,37 @@ func NewUnmountFolderArg(SharedFolderId string) *UnmountFolderArg {
 	return s
 }
 
+// MarshalJSON serializes a UnmountFolderArg instance
+func (u UnmountFolderArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UnmountFolderArg instance
+func (u *UnmountFolderArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UnmountFolderArg) Validate() error {
+	return nil
+}
+
 // UnmountFolderError : has no documentation (yet)
 type UnmountFolderError struct {
 	dropbox.Tagged
 	// AccessError : has no documentation (yet)
 	AccessError *SharedFolderAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UnmountFolderError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UnmountFolderError
@@ -3693,7 +7086,7 @@ func (u *UnmountFolderError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -3701,14 +7094,41 @@ func (u *UnmountFolderError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "no_permission", "not_unmountable", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UnmountFolderError instance
+func (u UnmountFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUnmountFolderError reports whether err carries a UnmountFolderError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUnmountFolderError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UnmountFolderError)(nil), tags...)
+}
+
 // UnshareFileArg : Arguments for `unshareFile`.
 type UnshareFileArg struct {
 	// File : The file to unshare.
 	File string `json:"file"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUnshareFileArg returns a new UnshareFileArg instance
@@ -3718,6 +7138,21 @@ func NewUnshareFileArg(File string) *UnshareFileArg {
 	return s
 }
 
+// MarshalJSON serializes a UnshareFileArg instance
+func (u UnshareFileArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UnshareFileArg instance
+func (u *UnshareFileArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UnshareFileArg) Validate() error {
+	return nil
+}
+
 // UnshareFileError : Error result for `unshareFile`.
 type UnshareFileError struct {
 	dropbox.Tagged
@@ -3725,6 +7160,17 @@ type UnshareFileError struct {
 	UserError *SharingUserError `json:"user_error,omitempty"`
 	// AccessError : has no documentation (yet)
 	AccessError *SharingFileAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UnshareFileError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UnshareFileError
@@ -3748,7 +7194,7 @@ func (u *UnshareFileError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user_error":
 		err = json.Unmarshal(w.UserError, &u.UserError)
@@ -3762,10 +7208,41 @@ func (u *UnshareFileError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UnshareFileError instance
+func (u UnshareFileError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUnshareFileError reports whether err carries a UnshareFileError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUnshareFileError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UnshareFileError)(nil), tags...)
+}
+
 // UnshareFolderArg : has no documentation (yet)
 type UnshareFolderArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -3775,6 +7252,8 @@ type UnshareFolderArg struct {
 	// Dropbox. The current user, who is an owner, will always retain their
 	// copy.
 	LeaveACopy bool `json:"leave_a_copy"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUnshareFolderArg returns a new UnshareFolderArg instance
@@ -3785,11 +7264,37 @@ func NewUnshareFolderArg(SharedFolderId string) *UnshareFolderArg {
 	return s
 }
 
+// MarshalJSON serializes a UnshareFolderArg instance
+func (u UnshareFolderArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UnshareFolderArg instance
+func (u *UnshareFolderArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UnshareFolderArg) Validate() error {
+	return nil
+}
+
 // UnshareFolderError : has no documentation (yet)
 type UnshareFolderError struct {
 	dropbox.Tagged
 	// AccessError : has no documentation (yet)
 	AccessError *SharedFolderAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UnshareFolderError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UnshareFolderError
@@ -3813,7 +7318,7 @@ func (u *UnshareFolderError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -3821,13 +7326,40 @@ func (u *UnshareFolderError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "team_folder", "no_permission", "too_many_files", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UnshareFolderError instance
+func (u UnshareFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUnshareFolderError reports whether err carries a UnshareFolderError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUnshareFolderError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UnshareFolderError)(nil), tags...)
+}
+
 // UpdateFileMemberArgs : Arguments for `updateFileMember`.
 type UpdateFileMemberArgs struct {
 	ChangeFileMemberAccessArgs
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUpdateFileMemberArgs returns a new UpdateFileMemberArgs instance
@@ -3839,6 +7371,21 @@ func NewUpdateFileMemberArgs(File string, Member *MemberSelector, AccessLevel *A
 	return s
 }
 
+// MarshalJSON serializes a UpdateFileMemberArgs instance
+func (u UpdateFileMemberArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UpdateFileMemberArgs instance
+func (u *UpdateFileMemberArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UpdateFileMemberArgs) Validate() error {
+	return nil
+}
+
 // UpdateFolderMemberArg : has no documentation (yet)
 type UpdateFolderMemberArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -3849,6 +7396,8 @@ type UpdateFolderMemberArg struct {
 	// AccessLevel : The new access level for `member`. `AccessLevel.owner` is
 	// disallowed.
 	AccessLevel *AccessLevel `json:"access_level"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUpdateFolderMemberArg returns a new UpdateFolderMemberArg instance
@@ -3860,6 +7409,21 @@ func NewUpdateFolderMemberArg(SharedFolderId string, Member *MemberSelector, Acc
 	return s
 }
 
+// MarshalJSON serializes a UpdateFolderMemberArg instance
+func (u UpdateFolderMemberArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UpdateFolderMemberArg instance
+func (u *UpdateFolderMemberArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UpdateFolderMemberArg) Validate() error {
+	return nil
+}
+
 // UpdateFolderMemberError : has no documentation (yet)
 type UpdateFolderMemberError struct {
 	dropbox.Tagged
@@ -3870,6 +7434,17 @@ type UpdateFolderMemberError struct {
 	// NoExplicitAccess : If updating the access type required the member to be
 	// added to the shared folder and there was an error when adding the member.
 	NoExplicitAccess *AddFolderMemberError `json:"no_explicit_access,omitempty"`
+	raw              string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UpdateFolderMemberError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UpdateFolderMemberError
@@ -3900,7 +7475,7 @@ func (u *UpdateFolderMemberError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -3920,10 +7495,47 @@ func (u *UpdateFolderMemberError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "insufficient_plan", "no_permission", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UpdateFolderMemberError instance
+func (u UpdateFolderMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	case "member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MemberError *SharedFolderMemberError `json:"member_error"`
+		}{u.Tagged, u.MemberError})
+
+	case "no_explicit_access":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			NoExplicitAccess *AddFolderMemberError `json:"no_explicit_access"`
+		}{u.Tagged, u.NoExplicitAccess})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUpdateFolderMemberError reports whether err carries a UpdateFolderMemberError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUpdateFolderMemberError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UpdateFolderMemberError)(nil), tags...)
+}
+
 // UpdateFolderPolicyArg : If any of the policies are unset, then they retain
 // their current setting.
 type UpdateFolderPolicyArg struct {
@@ -3948,6 +7560,8 @@ type UpdateFolderPolicyArg struct {
 	// field describing the actions the  authenticated user can perform on the
 	// folder.
 	Actions []*FolderAction `json:"actions,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUpdateFolderPolicyArg returns a new UpdateFolderPolicyArg instance
@@ -3957,11 +7571,37 @@ func NewUpdateFolderPolicyArg(SharedFolderId string) *UpdateFolderPolicyArg {
 	return s
 }
 
+// MarshalJSON serializes a UpdateFolderPolicyArg instance
+func (u UpdateFolderPolicyArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UpdateFolderPolicyArg instance
+func (u *UpdateFolderPolicyArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UpdateFolderPolicyArg) Validate() error {
+	return nil
+}
+
 // UpdateFolderPolicyError : has no documentation (yet)
 type UpdateFolderPolicyError struct {
 	dropbox.Tagged
 	// AccessError : has no documentation (yet)
 	AccessError *SharedFolderAccessError `json:"access_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UpdateFolderPolicyError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UpdateFolderPolicyError
@@ -3987,7 +7627,7 @@ func (u *UpdateFolderPolicyError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -3995,10 +7635,35 @@ func (u *UpdateFolderPolicyError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "not_on_team", "team_policy_disallows_member_policy", "disallowed_shared_link_policy", "no_permission", "team_folder", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UpdateFolderPolicyError instance
+func (u UpdateFolderPolicyError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUpdateFolderPolicyError reports whether err carries a UpdateFolderPolicyError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUpdateFolderPolicyError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UpdateFolderPolicyError)(nil), tags...)
+}
+
 // UserInfo : Basic information about a user. Use `usersAccount` and
 // `usersAccountBatch` to obtain more detailed information.
 type UserInfo struct {
@@ -4009,6 +7674,8 @@ type UserInfo struct {
 	// TeamMemberId : The team member ID of the shared folder member. Only
 	// present if `same_team` is true.
 	TeamMemberId string `json:"team_member_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUserInfo returns a new UserInfo instance
@@ -4019,12 +7686,24 @@ func NewUserInfo(AccountId string, SameTeam bool) *UserInfo {
 	return s
 }
 
+// MarshalJSON serializes a UserInfo instance
+func (u UserInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UserInfo instance
+func (u *UserInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UserMembershipInfo : The information about a user member of the shared
 // content.
 type UserMembershipInfo struct {
 	MembershipInfo
 	// User : The account information for the membership user.
 	User *UserInfo `json:"user"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUserMembershipInfo returns a new UserMembershipInfo instance
@@ -4036,9 +7715,30 @@ func NewUserMembershipInfo(AccessType *AccessLevel, User *UserInfo) *UserMembers
 	return s
 }
 
+// MarshalJSON serializes a UserMembershipInfo instance
+func (u UserMembershipInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UserMembershipInfo instance
+func (u *UserMembershipInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ViewerInfoPolicy : has no documentation (yet)
 type ViewerInfoPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ViewerInfoPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ViewerInfoPolicy
@@ -4048,11 +7748,48 @@ const (
 	ViewerInfoPolicyOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a ViewerInfoPolicy instance
+func (u *ViewerInfoPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "enabled", "disabled", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ViewerInfoPolicy instance
+func (u ViewerInfoPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // Visibility : Who can access a shared link. The most open visibility is
 // `public`. The default depends on many aspects, such as team and user
 // preferences and shared folder settings.
 type Visibility struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u Visibility) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for Visibility
@@ -4064,3 +7801,29 @@ const (
 	VisibilitySharedFolderOnly = "shared_folder_only"
 	VisibilityOther            = "other"
 )
+
+// UnmarshalJSON deserializes into a Visibility instance
+func (u *Visibility) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "public", "team_only", "password", "team_and_password", "shared_folder_only", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a Visibility instance
+func (u Visibility) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
@@ -22,13 +22,14 @@ package team
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
-	"log"
 	"net/http"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/async"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/auth"
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/properties"
 )
 
@@ -36,56 +37,102 @@ import (
 type Client interface {
 	// DevicesListMemberDevices : List all device sessions of a team's member.
 	DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error)
+	// DevicesListMemberDevicesContext : Like `devicesListMemberDevices`, but
+	// the request is bound to ctx.
+	DevicesListMemberDevicesContext(ctx context.Context, arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error)
 	// DevicesListMembersDevices : List all device sessions of a team.
 	DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error)
+	// DevicesListMembersDevicesContext : Like `devicesListMembersDevices`, but
+	// the request is bound to ctx.
+	DevicesListMembersDevicesContext(ctx context.Context, arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error)
 	// DevicesListTeamDevices : List all device sessions of a team.
 	DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error)
+	// DevicesListTeamDevicesContext : Like `devicesListTeamDevices`, but the
+	// request is bound to ctx.
+	DevicesListTeamDevicesContext(ctx context.Context, arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error)
 	// DevicesRevokeDeviceSession : Revoke a device session of a team's member
 	DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err error)
+	// DevicesRevokeDeviceSessionContext : Like `devicesRevokeDeviceSession`,
+	// but the request is bound to ctx.
+	DevicesRevokeDeviceSessionContext(ctx context.Context, arg *RevokeDeviceSessionArg) (err error)
 	// DevicesRevokeDeviceSessionBatch : Revoke a list of device sessions of
 	// team members
 	DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error)
+	// DevicesRevokeDeviceSessionBatchContext : Like
+	// `devicesRevokeDeviceSessionBatch`, but the request is bound to ctx.
+	DevicesRevokeDeviceSessionBatchContext(ctx context.Context, arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error)
 	// FeaturesGetValues : Get the values for one or more featues. This route
 	// allows you to check your account's capability for what feature you can
 	// access or what value you have for certain features. Permission : Team
 	// information.
 	FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error)
+	// FeaturesGetValuesContext : Like `featuresGetValues`, but the request is
+	// bound to ctx.
+	FeaturesGetValuesContext(ctx context.Context, arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error)
 	// GetInfo : Retrieves information about a team.
 	GetInfo() (res *TeamGetInfoResult, err error)
+	// GetInfoContext : Like `getInfo`, but the request is bound to ctx.
+	GetInfoContext(ctx context.Context) (res *TeamGetInfoResult, err error)
 	// GroupsCreate : Creates a new, empty group, with a requested name.
 	// Permission : Team member management.
 	GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err error)
+	// GroupsCreateContext : Like `groupsCreate`, but the request is bound to
+	// ctx.
+	GroupsCreateContext(ctx context.Context, arg *GroupCreateArg) (res *GroupFullInfo, err error)
 	// GroupsDelete : Deletes a group. The group is deleted immediately. However
 	// the revoking of group-owned resources may take additional time. Use the
 	// `groupsJobStatusGet` to determine whether this process has completed.
 	// Permission : Team member management.
 	GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResult, err error)
+	// GroupsDeleteContext : Like `groupsDelete`, but the request is bound to
+	// ctx.
+	GroupsDeleteContext(ctx context.Context, arg *GroupSelector) (res *async.LaunchEmptyResult, err error)
 	// GroupsGetInfo : Retrieves information about one or more groups. Note that
 	// the optional field  `GroupFullInfo.members` is not returned for
 	// system-managed groups. Permission : Team Information.
 	GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem, err error)
+	// GroupsGetInfoContext : Like `groupsGetInfo`, but the request is bound to
+	// ctx.
+	GroupsGetInfoContext(ctx context.Context, arg *GroupsSelector) (res []*GroupsGetInfoItem, err error)
 	// GroupsJobStatusGet : Once an async_job_id is returned from
 	// `groupsDelete`, `groupsMembersAdd` , or `groupsMembersRemove` use this
 	// method to poll the status of granting/revoking group members' access to
 	// group-owned resources. Permission : Team member management.
 	GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	// GroupsJobStatusGetContext : Like `groupsJobStatusGet`, but the request is
+	// bound to ctx.
+	GroupsJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error)
 	// GroupsList : Lists groups on a team. Permission : Team Information.
 	GroupsList(arg *GroupsListArg) (res *GroupsListResult, err error)
+	// GroupsListContext : Like `groupsList`, but the request is bound to ctx.
+	GroupsListContext(ctx context.Context, arg *GroupsListArg) (res *GroupsListResult, err error)
 	// GroupsListContinue : Once a cursor has been retrieved from `groupsList`,
 	// use this to paginate through all groups. Permission : Team Information.
 	GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsListResult, err error)
+	// GroupsListContinueContext : Like `groupsListContinue`, but the request is
+	// bound to ctx.
+	GroupsListContinueContext(ctx context.Context, arg *GroupsListContinueArg) (res *GroupsListResult, err error)
 	// GroupsMembersAdd : Adds members to a group. The members are added
 	// immediately. However the granting of group-owned resources may take
 	// additional time. Use the `groupsJobStatusGet` to determine whether this
 	// process has completed. Permission : Team member management.
 	GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error)
+	// GroupsMembersAddContext : Like `groupsMembersAdd`, but the request is
+	// bound to ctx.
+	GroupsMembersAddContext(ctx context.Context, arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error)
 	// GroupsMembersList : Lists members of a group. Permission : Team
 	// Information.
 	GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error)
+	// GroupsMembersListContext : Like `groupsMembersList`, but the request is
+	// bound to ctx.
+	GroupsMembersListContext(ctx context.Context, arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error)
 	// GroupsMembersListContinue : Once a cursor has been retrieved from
 	// `groupsMembersList`, use this to paginate through all members of the
 	// group. Permission : Team information.
 	GroupsMembersListContinue(arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error)
+	// GroupsMembersListContinueContext : Like `groupsMembersListContinue`, but
+	// the request is bound to ctx.
+	GroupsMembersListContinueContext(ctx context.Context, arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error)
 	// GroupsMembersRemove : Removes members from a group. The members are
 	// removed immediately. However the revoking of group-owned resources may
 	// take additional time. Use the `groupsJobStatusGet` to determine whether
@@ -93,29 +140,53 @@ type Client interface {
 	// of a group, even in cases where this is not possible via the web client.
 	// Permission : Team member management.
 	GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error)
+	// GroupsMembersRemoveContext : Like `groupsMembersRemove`, but the request
+	// is bound to ctx.
+	GroupsMembersRemoveContext(ctx context.Context, arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error)
 	// GroupsMembersSetAccessType : Sets a member's access type in a group.
 	// Permission : Team member management.
 	GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error)
+	// GroupsMembersSetAccessTypeContext : Like `groupsMembersSetAccessType`,
+	// but the request is bound to ctx.
+	GroupsMembersSetAccessTypeContext(ctx context.Context, arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error)
 	// GroupsUpdate : Updates a group's name and/or external ID. Permission :
 	// Team member management.
 	GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err error)
+	// GroupsUpdateContext : Like `groupsUpdate`, but the request is bound to
+	// ctx.
+	GroupsUpdateContext(ctx context.Context, arg *GroupUpdateArgs) (res *GroupFullInfo, err error)
 	// LinkedAppsListMemberLinkedApps : List all linked applications of the team
 	// member. Note, this endpoint does not list any team-linked applications.
 	LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error)
+	// LinkedAppsListMemberLinkedAppsContext : Like
+	// `linkedAppsListMemberLinkedApps`, but the request is bound to ctx.
+	LinkedAppsListMemberLinkedAppsContext(ctx context.Context, arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error)
 	// LinkedAppsListMembersLinkedApps : List all applications linked to the
 	// team members' accounts. Note, this endpoint does not list any team-linked
 	// applications.
 	LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error)
+	// LinkedAppsListMembersLinkedAppsContext : Like
+	// `linkedAppsListMembersLinkedApps`, but the request is bound to ctx.
+	LinkedAppsListMembersLinkedAppsContext(ctx context.Context, arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error)
 	// LinkedAppsListTeamLinkedApps : List all applications linked to the team
 	// members' accounts. Note, this endpoint doesn't list any team-linked
 	// applications.
 	LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error)
+	// LinkedAppsListTeamLinkedAppsContext : Like
+	// `linkedAppsListTeamLinkedApps`, but the request is bound to ctx.
+	LinkedAppsListTeamLinkedAppsContext(ctx context.Context, arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error)
 	// LinkedAppsRevokeLinkedApp : Revoke a linked application of the team
 	// member
 	LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err error)
+	// LinkedAppsRevokeLinkedAppContext : Like `linkedAppsRevokeLinkedApp`, but
+	// the request is bound to ctx.
+	LinkedAppsRevokeLinkedAppContext(ctx context.Context, arg *RevokeLinkedApiAppArg) (err error)
 	// LinkedAppsRevokeLinkedAppBatch : Revoke a list of linked applications of
 	// the team members
 	LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error)
+	// LinkedAppsRevokeLinkedAppBatchContext : Like
+	// `linkedAppsRevokeLinkedAppBatch`, but the request is bound to ctx.
+	LinkedAppsRevokeLinkedAppBatchContext(ctx context.Context, arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error)
 	// MembersAdd : Adds members to a team. Permission : Team member management
 	// A maximum of 20 members can be specified in a single call. If no Dropbox
 	// account exists with the email address specified, a new Dropbox account
@@ -128,25 +199,41 @@ type Client interface {
 	// surname for a user to use in the team invitation and for 'Perform as team
 	// member' actions taken on the user before they become 'active'.
 	MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err error)
+	// MembersAddContext : Like `membersAdd`, but the request is bound to ctx.
+	MembersAddContext(ctx context.Context, arg *MembersAddArg) (res *MembersAddLaunch, err error)
 	// MembersAddJobStatusGet : Once an async_job_id is returned from
 	// `membersAdd` , use this to poll the status of the asynchronous request.
 	// Permission : Team member management
 	MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJobStatus, err error)
+	// MembersAddJobStatusGetContext : Like `membersAddJobStatusGet`, but the
+	// request is bound to ctx.
+	MembersAddJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *MembersAddJobStatus, err error)
 	// MembersGetInfo : Returns information about multiple team members.
 	// Permission : Team information This endpoint will return
 	// `MembersGetInfoItem.id_not_found`, for IDs (or emails) that cannot be
 	// matched to a valid team member.
 	MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error)
+	// MembersGetInfoContext : Like `membersGetInfo`, but the request is bound
+	// to ctx.
+	MembersGetInfoContext(ctx context.Context, arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error)
 	// MembersList : Lists members of a team. Permission : Team information
 	MembersList(arg *MembersListArg) (res *MembersListResult, err error)
+	// MembersListContext : Like `membersList`, but the request is bound to ctx.
+	MembersListContext(ctx context.Context, arg *MembersListArg) (res *MembersListResult, err error)
 	// MembersListContinue : Once a cursor has been retrieved from
 	// `membersList`, use this to paginate through all team members. Permission
 	// : Team information
 	MembersListContinue(arg *MembersListContinueArg) (res *MembersListResult, err error)
+	// MembersListContinueContext : Like `membersListContinue`, but the request
+	// is bound to ctx.
+	MembersListContinueContext(ctx context.Context, arg *MembersListContinueArg) (res *MembersListResult, err error)
 	// MembersRecover : Recover a deleted member. Permission : Team member
 	// management Exactly one of team_member_id, email, or external_id must be
 	// provided to identify the user account.
 	MembersRecover(arg *MembersRecoverArg) (err error)
+	// MembersRecoverContext : Like `membersRecover`, but the request is bound
+	// to ctx.
+	MembersRecoverContext(ctx context.Context, arg *MembersRecoverArg) (err error)
 	// MembersRemove : Removes a member from a team. Permission : Team member
 	// management Exactly one of team_member_id, email, or external_id must be
 	// provided to identify the user account. Accounts can be recovered via
@@ -157,100 +244,185 @@ type Client interface {
 	// endpoint may initiate an asynchronous job. To obtain the final result of
 	// the job, the client should periodically poll `membersRemoveJobStatusGet`.
 	MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error)
+	// MembersRemoveContext : Like `membersRemove`, but the request is bound to
+	// ctx.
+	MembersRemoveContext(ctx context.Context, arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error)
 	// MembersRemoveJobStatusGet : Once an async_job_id is returned from
 	// `membersRemove` , use this to poll the status of the asynchronous
 	// request. Permission : Team member management
 	MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	// MembersRemoveJobStatusGetContext : Like `membersRemoveJobStatusGet`, but
+	// the request is bound to ctx.
+	MembersRemoveJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error)
 	// MembersSendWelcomeEmail : Sends welcome email to pending team member.
 	// Permission : Team member management Exactly one of team_member_id, email,
 	// or external_id must be provided to identify the user account. No-op if
 	// team member is not pending.
 	MembersSendWelcomeEmail(arg *UserSelectorArg) (err error)
+	// MembersSendWelcomeEmailContext : Like `membersSendWelcomeEmail`, but the
+	// request is bound to ctx.
+	MembersSendWelcomeEmailContext(ctx context.Context, arg *UserSelectorArg) (err error)
 	// MembersSetAdminPermissions : Updates a team member's permissions.
 	// Permission : Team member management
 	MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error)
+	// MembersSetAdminPermissionsContext : Like `membersSetAdminPermissions`,
+	// but the request is bound to ctx.
+	MembersSetAdminPermissionsContext(ctx context.Context, arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error)
 	// MembersSetProfile : Updates a team member's profile. Permission : Team
 	// member management
 	MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMemberInfo, err error)
+	// MembersSetProfileContext : Like `membersSetProfile`, but the request is
+	// bound to ctx.
+	MembersSetProfileContext(ctx context.Context, arg *MembersSetProfileArg) (res *TeamMemberInfo, err error)
 	// MembersSuspend : Suspend a member from a team. Permission : Team member
 	// management Exactly one of team_member_id, email, or external_id must be
 	// provided to identify the user account.
 	MembersSuspend(arg *MembersDeactivateArg) (err error)
+	// MembersSuspendContext : Like `membersSuspend`, but the request is bound
+	// to ctx.
+	MembersSuspendContext(ctx context.Context, arg *MembersDeactivateArg) (err error)
 	// MembersUnsuspend : Unsuspend a member from a team. Permission : Team
 	// member management Exactly one of team_member_id, email, or external_id
 	// must be provided to identify the user account.
 	MembersUnsuspend(arg *MembersUnsuspendArg) (err error)
+	// MembersUnsuspendContext : Like `membersUnsuspend`, but the request is
+	// bound to ctx.
+	MembersUnsuspendContext(ctx context.Context, arg *MembersUnsuspendArg) (err error)
 	// PropertiesTemplateAdd : Add a property template. See route
 	// files/properties/add to add properties to a file.
 	PropertiesTemplateAdd(arg *AddPropertyTemplateArg) (res *AddPropertyTemplateResult, err error)
+	// PropertiesTemplateAddContext : Like `propertiesTemplateAdd`, but the
+	// request is bound to ctx.
+	PropertiesTemplateAddContext(ctx context.Context, arg *AddPropertyTemplateArg) (res *AddPropertyTemplateResult, err error)
 	// PropertiesTemplateGet : Get the schema for a specified template.
 	PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error)
+	// PropertiesTemplateGetContext : Like `propertiesTemplateGet`, but the
+	// request is bound to ctx.
+	PropertiesTemplateGetContext(ctx context.Context, arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error)
 	// PropertiesTemplateList : Get the property template identifiers for a
 	// team. To get the schema of each template use `propertiesTemplateGet`.
 	PropertiesTemplateList() (res *properties.ListPropertyTemplateIds, err error)
+	// PropertiesTemplateListContext : Like `propertiesTemplateList`, but the
+	// request is bound to ctx.
+	PropertiesTemplateListContext(ctx context.Context) (res *properties.ListPropertyTemplateIds, err error)
 	// PropertiesTemplateUpdate : Update a property template. This route can
 	// update the template name, the template description and add optional
 	// properties to templates.
 	PropertiesTemplateUpdate(arg *UpdatePropertyTemplateArg) (res *UpdatePropertyTemplateResult, err error)
+	// PropertiesTemplateUpdateContext : Like `propertiesTemplateUpdate`, but
+	// the request is bound to ctx.
+	PropertiesTemplateUpdateContext(ctx context.Context, arg *UpdatePropertyTemplateArg) (res *UpdatePropertyTemplateResult, err error)
 	// ReportsGetActivity : Retrieves reporting data about a team's user
 	// activity.
 	ReportsGetActivity(arg *DateRange) (res *GetActivityReport, err error)
+	// ReportsGetActivityContext : Like `reportsGetActivity`, but the request is
+	// bound to ctx.
+	ReportsGetActivityContext(ctx context.Context, arg *DateRange) (res *GetActivityReport, err error)
 	// ReportsGetDevices : Retrieves reporting data about a team's linked
 	// devices.
 	ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, err error)
+	// ReportsGetDevicesContext : Like `reportsGetDevices`, but the request is
+	// bound to ctx.
+	ReportsGetDevicesContext(ctx context.Context, arg *DateRange) (res *GetDevicesReport, err error)
 	// ReportsGetMembership : Retrieves reporting data about a team's
 	// membership.
 	ReportsGetMembership(arg *DateRange) (res *GetMembershipReport, err error)
+	// ReportsGetMembershipContext : Like `reportsGetMembership`, but the
+	// request is bound to ctx.
+	ReportsGetMembershipContext(ctx context.Context, arg *DateRange) (res *GetMembershipReport, err error)
 	// ReportsGetStorage : Retrieves reporting data about a team's storage
 	// usage.
 	ReportsGetStorage(arg *DateRange) (res *GetStorageReport, err error)
+	// ReportsGetStorageContext : Like `reportsGetStorage`, but the request is
+	// bound to ctx.
+	ReportsGetStorageContext(ctx context.Context, arg *DateRange) (res *GetStorageReport, err error)
 	// TeamFolderActivate : Sets an archived team folder's status to active.
 	// Permission : Team member file access.
 	TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error)
+	// TeamFolderActivateContext : Like `teamFolderActivate`, but the request is
+	// bound to ctx.
+	TeamFolderActivateContext(ctx context.Context, arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error)
 	// TeamFolderArchive : Sets an active team folder's status to archived and
 	// removes all folder and file members. Permission : Team member file
 	// access.
 	TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error)
+	// TeamFolderArchiveContext : Like `teamFolderArchive`, but the request is
+	// bound to ctx.
+	TeamFolderArchiveContext(ctx context.Context, arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error)
 	// TeamFolderArchiveCheck : Returns the status of an asynchronous job for
 	// archiving a team folder. Permission : Team member file access.
 	TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error)
+	// TeamFolderArchiveCheckContext : Like `teamFolderArchiveCheck`, but the
+	// request is bound to ctx.
+	TeamFolderArchiveCheckContext(ctx context.Context, arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error)
 	// TeamFolderCreate : Creates a new, active, team folder. Permission : Team
 	// member file access.
 	TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error)
+	// TeamFolderCreateContext : Like `teamFolderCreate`, but the request is
+	// bound to ctx.
+	TeamFolderCreateContext(ctx context.Context, arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error)
 	// TeamFolderGetInfo : Retrieves metadata for team folders. Permission :
 	// Team member file access.
 	TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error)
+	// TeamFolderGetInfoContext : Like `teamFolderGetInfo`, but the request is
+	// bound to ctx.
+	TeamFolderGetInfoContext(ctx context.Context, arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error)
 	// TeamFolderList : Lists all team folders. Permission : Team member file
 	// access.
 	TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListResult, err error)
+	// TeamFolderListContext : Like `teamFolderList`, but the request is bound
+	// to ctx.
+	TeamFolderListContext(ctx context.Context, arg *TeamFolderListArg) (res *TeamFolderListResult, err error)
 	// TeamFolderListContinue : Once a cursor has been retrieved from
 	// `teamFolderList`, use this to paginate through all team folders.
 	// Permission : Team member file access.
 	TeamFolderListContinue(arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error)
+	// TeamFolderListContinueContext : Like `teamFolderListContinue`, but the
+	// request is bound to ctx.
+	TeamFolderListContinueContext(ctx context.Context, arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error)
 	// TeamFolderPermanentlyDelete : Permanently deletes an archived team
 	// folder. Permission : Team member file access.
 	TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error)
+	// TeamFolderPermanentlyDeleteContext : Like `teamFolderPermanentlyDelete`,
+	// but the request is bound to ctx.
+	TeamFolderPermanentlyDeleteContext(ctx context.Context, arg *TeamFolderIdArg) (err error)
 	// TeamFolderRename : Changes an active team folder's name. Permission :
 	// Team member file access.
 	TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error)
+	// TeamFolderRenameContext : Like `teamFolderRename`, but the request is
+	// bound to ctx.
+	TeamFolderRenameContext(ctx context.Context, arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error)
 	// TokenGetAuthenticatedAdmin : Returns the member profile of the admin who
 	// generated the team access token used to make the call.
 	TokenGetAuthenticatedAdmin() (res *TokenGetAuthenticatedAdminResult, err error)
+	// TokenGetAuthenticatedAdminContext : Like `tokenGetAuthenticatedAdmin`,
+	// but the request is bound to ctx.
+	TokenGetAuthenticatedAdminContext(ctx context.Context) (res *TokenGetAuthenticatedAdminResult, err error)
 }
 
-type apiImpl dropbox.Context
+// ClientImpl implements Client by calling the Dropbox API
+type ClientImpl dropbox.Context
 
-//DevicesListMemberDevicesAPIError is an error-wrapper for the devices/list_member_devices route
+var _ Client = (*ClientImpl)(nil)
+
+// DevicesListMemberDevicesAPIError is an error-wrapper for the devices/list_member_devices route
 type DevicesListMemberDevicesAPIError struct {
 	dropbox.APIError
 	EndpointError *ListMemberDevicesError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DevicesListMemberDevicesAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error) {
+	return dbx.DevicesListMemberDevicesContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DevicesListMemberDevicesContext(ctx context.Context, arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -261,18 +433,12 @@ func (dbx *apiImpl) DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *Li
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "devices/list_member_devices", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "devices/list_member_devices", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -283,9 +449,6 @@ func (dbx *apiImpl) DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *Li
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -303,31 +466,32 @@ func (dbx *apiImpl) DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *Li
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DevicesListMembersDevicesAPIError is an error-wrapper for the devices/list_members_devices route
+// DevicesListMembersDevicesAPIError is an error-wrapper for the devices/list_members_devices route
 type DevicesListMembersDevicesAPIError struct {
 	dropbox.APIError
 	EndpointError *ListMembersDevicesError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DevicesListMembersDevicesAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error) {
+	return dbx.DevicesListMembersDevicesContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DevicesListMembersDevicesContext(ctx context.Context, arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -338,18 +502,12 @@ func (dbx *apiImpl) DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "devices/list_members_devices", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "devices/list_members_devices", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -360,9 +518,6 @@ func (dbx *apiImpl) DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -380,31 +535,32 @@ func (dbx *apiImpl) DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DevicesListTeamDevicesAPIError is an error-wrapper for the devices/list_team_devices route
+// DevicesListTeamDevicesAPIError is an error-wrapper for the devices/list_team_devices route
 type DevicesListTeamDevicesAPIError struct {
 	dropbox.APIError
 	EndpointError *ListTeamDevicesError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DevicesListTeamDevicesAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error) {
+	return dbx.DevicesListTeamDevicesContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DevicesListTeamDevicesContext(ctx context.Context, arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -415,18 +571,12 @@ func (dbx *apiImpl) DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTe
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "devices/list_team_devices", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "devices/list_team_devices", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -437,9 +587,6 @@ func (dbx *apiImpl) DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTe
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -457,31 +604,32 @@ func (dbx *apiImpl) DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTe
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DevicesRevokeDeviceSessionAPIError is an error-wrapper for the devices/revoke_device_session route
+// DevicesRevokeDeviceSessionAPIError is an error-wrapper for the devices/revoke_device_session route
 type DevicesRevokeDeviceSessionAPIError struct {
 	dropbox.APIError
 	EndpointError *RevokeDeviceSessionError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DevicesRevokeDeviceSessionAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err error) {
+	return dbx.DevicesRevokeDeviceSessionContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DevicesRevokeDeviceSessionContext(ctx context.Context, arg *RevokeDeviceSessionArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -492,18 +640,12 @@ func (dbx *apiImpl) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "devices/revoke_device_session", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "devices/revoke_device_session", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -514,9 +656,6 @@ func (dbx *apiImpl) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -529,31 +668,32 @@ func (dbx *apiImpl) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//DevicesRevokeDeviceSessionBatchAPIError is an error-wrapper for the devices/revoke_device_session_batch route
+// DevicesRevokeDeviceSessionBatchAPIError is an error-wrapper for the devices/revoke_device_session_batch route
 type DevicesRevokeDeviceSessionBatchAPIError struct {
 	dropbox.APIError
 	EndpointError *RevokeDeviceSessionBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e DevicesRevokeDeviceSessionBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error) {
+	return dbx.DevicesRevokeDeviceSessionBatchContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) DevicesRevokeDeviceSessionBatchContext(ctx context.Context, arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -564,18 +704,12 @@ func (dbx *apiImpl) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatc
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "devices/revoke_device_session_batch", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "devices/revoke_device_session_batch", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -586,9 +720,6 @@ func (dbx *apiImpl) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatc
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -606,31 +737,32 @@ func (dbx *apiImpl) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatc
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//FeaturesGetValuesAPIError is an error-wrapper for the features/get_values route
+// FeaturesGetValuesAPIError is an error-wrapper for the features/get_values route
 type FeaturesGetValuesAPIError struct {
 	dropbox.APIError
 	EndpointError *FeaturesGetValuesBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e FeaturesGetValuesAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error) {
+	return dbx.FeaturesGetValuesContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) FeaturesGetValuesContext(ctx context.Context, arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -641,18 +773,12 @@ func (dbx *apiImpl) FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *Feat
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "features/get_values", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "features/get_values", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -663,9 +789,6 @@ func (dbx *apiImpl) FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *Feat
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -683,43 +806,38 @@ func (dbx *apiImpl) FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *Feat
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetInfoAPIError is an error-wrapper for the get_info route
+// GetInfoAPIError is an error-wrapper for the get_info route
 type GetInfoAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) GetInfo() (res *TeamGetInfoResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetInfo() (res *TeamGetInfoResult, err error) {
+	return dbx.GetInfoContext(context.Background())
+}
 
+func (dbx *ClientImpl) GetInfoContext(ctx context.Context) (res *TeamGetInfoResult, err error) {
 	headers := map[string]string{}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "get_info", headers, nil)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "get_info", headers, nil)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -730,9 +848,6 @@ func (dbx *apiImpl) GetInfo() (res *TeamGetInfoResult, err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -750,31 +865,32 @@ func (dbx *apiImpl) GetInfo() (res *TeamGetInfoResult, err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsCreateAPIError is an error-wrapper for the groups/create route
+// GroupsCreateAPIError is an error-wrapper for the groups/create route
 type GroupsCreateAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupCreateError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsCreateAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err error) {
+	return dbx.GroupsCreateContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsCreateContext(ctx context.Context, arg *GroupCreateArg) (res *GroupFullInfo, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -785,18 +901,12 @@ func (dbx *apiImpl) GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err e
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/create", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/create", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -807,9 +917,6 @@ func (dbx *apiImpl) GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err e
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -827,31 +934,32 @@ func (dbx *apiImpl) GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err e
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsDeleteAPIError is an error-wrapper for the groups/delete route
+// GroupsDeleteAPIError is an error-wrapper for the groups/delete route
 type GroupsDeleteAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupDeleteError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsDeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResult, err error) {
+	return dbx.GroupsDeleteContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsDeleteContext(ctx context.Context, arg *GroupSelector) (res *async.LaunchEmptyResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -862,18 +970,12 @@ func (dbx *apiImpl) GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResu
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/delete", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/delete", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -884,9 +986,6 @@ func (dbx *apiImpl) GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResu
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -904,31 +1003,32 @@ func (dbx *apiImpl) GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResu
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsGetInfoAPIError is an error-wrapper for the groups/get_info route
+// GroupsGetInfoAPIError is an error-wrapper for the groups/get_info route
 type GroupsGetInfoAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupsGetInfoError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsGetInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem, err error) {
+	return dbx.GroupsGetInfoContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsGetInfoContext(ctx context.Context, arg *GroupsSelector) (res []*GroupsGetInfoItem, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -939,18 +1039,12 @@ func (dbx *apiImpl) GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/get_info", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/get_info", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -961,9 +1055,6 @@ func (dbx *apiImpl) GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -981,31 +1072,32 @@ func (dbx *apiImpl) GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsJobStatusGetAPIError is an error-wrapper for the groups/job_status/get route
+// GroupsJobStatusGetAPIError is an error-wrapper for the groups/job_status/get route
 type GroupsJobStatusGetAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupsPollError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsJobStatusGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	return dbx.GroupsJobStatusGetContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1016,18 +1108,12 @@ func (dbx *apiImpl) GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmpty
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/job_status/get", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/job_status/get", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1038,9 +1124,6 @@ func (dbx *apiImpl) GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmpty
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1058,31 +1141,32 @@ func (dbx *apiImpl) GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmpty
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsListAPIError is an error-wrapper for the groups/list route
+// GroupsListAPIError is an error-wrapper for the groups/list route
 type GroupsListAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsList(arg *GroupsListArg) (res *GroupsListResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsListAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsList(arg *GroupsListArg) (res *GroupsListResult, err error) {
+	return dbx.GroupsListContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsListContext(ctx context.Context, arg *GroupsListArg) (res *GroupsListResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1093,18 +1177,12 @@ func (dbx *apiImpl) GroupsList(arg *GroupsListArg) (res *GroupsListResult, err e
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/list", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/list", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1115,9 +1193,6 @@ func (dbx *apiImpl) GroupsList(arg *GroupsListArg) (res *GroupsListResult, err e
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1135,31 +1210,32 @@ func (dbx *apiImpl) GroupsList(arg *GroupsListArg) (res *GroupsListResult, err e
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsListContinueAPIError is an error-wrapper for the groups/list/continue route
+// GroupsListContinueAPIError is an error-wrapper for the groups/list/continue route
 type GroupsListContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupsListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsListResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsListResult, err error) {
+	return dbx.GroupsListContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsListContinueContext(ctx context.Context, arg *GroupsListContinueArg) (res *GroupsListResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1170,18 +1246,12 @@ func (dbx *apiImpl) GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsL
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/list/continue", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/list/continue", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1192,9 +1262,6 @@ func (dbx *apiImpl) GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsL
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1212,31 +1279,32 @@ func (dbx *apiImpl) GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsL
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsMembersAddAPIError is an error-wrapper for the groups/members/add route
+// GroupsMembersAddAPIError is an error-wrapper for the groups/members/add route
 type GroupsMembersAddAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupMembersAddError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsMembersAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error) {
+	return dbx.GroupsMembersAddContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsMembersAddContext(ctx context.Context, arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1247,18 +1315,12 @@ func (dbx *apiImpl) GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembers
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/members/add", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/members/add", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1269,9 +1331,6 @@ func (dbx *apiImpl) GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembers
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1289,31 +1348,32 @@ func (dbx *apiImpl) GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembers
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsMembersListAPIError is an error-wrapper for the groups/members/list route
+// GroupsMembersListAPIError is an error-wrapper for the groups/members/list route
 type GroupsMembersListAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupSelectorError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsMembersListAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error) {
+	return dbx.GroupsMembersListContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsMembersListContext(ctx context.Context, arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1324,18 +1384,12 @@ func (dbx *apiImpl) GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMem
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/members/list", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/members/list", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1346,9 +1400,6 @@ func (dbx *apiImpl) GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMem
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1366,31 +1417,32 @@ func (dbx *apiImpl) GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMem
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsMembersListContinueAPIError is an error-wrapper for the groups/members/list/continue route
+// GroupsMembersListContinueAPIError is an error-wrapper for the groups/members/list/continue route
 type GroupsMembersListContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupsMembersListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersListContinue(arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsMembersListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsMembersListContinue(arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error) {
+	return dbx.GroupsMembersListContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsMembersListContinueContext(ctx context.Context, arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1401,18 +1453,12 @@ func (dbx *apiImpl) GroupsMembersListContinue(arg *GroupsMembersListContinueArg)
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/members/list/continue", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/members/list/continue", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1423,9 +1469,6 @@ func (dbx *apiImpl) GroupsMembersListContinue(arg *GroupsMembersListContinueArg)
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1443,31 +1486,32 @@ func (dbx *apiImpl) GroupsMembersListContinue(arg *GroupsMembersListContinueArg)
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsMembersRemoveAPIError is an error-wrapper for the groups/members/remove route
+// GroupsMembersRemoveAPIError is an error-wrapper for the groups/members/remove route
 type GroupsMembersRemoveAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupMembersRemoveError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsMembersRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error) {
+	return dbx.GroupsMembersRemoveContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsMembersRemoveContext(ctx context.Context, arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1478,18 +1522,12 @@ func (dbx *apiImpl) GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupM
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/members/remove", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/members/remove", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1500,9 +1538,6 @@ func (dbx *apiImpl) GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupM
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1520,31 +1555,32 @@ func (dbx *apiImpl) GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupM
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsMembersSetAccessTypeAPIError is an error-wrapper for the groups/members/set_access_type route
+// GroupsMembersSetAccessTypeAPIError is an error-wrapper for the groups/members/set_access_type route
 type GroupsMembersSetAccessTypeAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupMemberSetAccessTypeError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsMembersSetAccessTypeAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error) {
+	return dbx.GroupsMembersSetAccessTypeContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsMembersSetAccessTypeContext(ctx context.Context, arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1555,18 +1591,12 @@ func (dbx *apiImpl) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/members/set_access_type", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/members/set_access_type", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1577,9 +1607,6 @@ func (dbx *apiImpl) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1597,31 +1624,32 @@ func (dbx *apiImpl) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GroupsUpdateAPIError is an error-wrapper for the groups/update route
+// GroupsUpdateAPIError is an error-wrapper for the groups/update route
 type GroupsUpdateAPIError struct {
 	dropbox.APIError
 	EndpointError *GroupUpdateError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GroupsUpdateAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err error) {
+	return dbx.GroupsUpdateContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GroupsUpdateContext(ctx context.Context, arg *GroupUpdateArgs) (res *GroupFullInfo, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1632,18 +1660,12 @@ func (dbx *apiImpl) GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "groups/update", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "groups/update", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1654,9 +1676,6 @@ func (dbx *apiImpl) GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1674,31 +1693,32 @@ func (dbx *apiImpl) GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//LinkedAppsListMemberLinkedAppsAPIError is an error-wrapper for the linked_apps/list_member_linked_apps route
+// LinkedAppsListMemberLinkedAppsAPIError is an error-wrapper for the linked_apps/list_member_linked_apps route
 type LinkedAppsListMemberLinkedAppsAPIError struct {
 	dropbox.APIError
 	EndpointError *ListMemberAppsError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e LinkedAppsListMemberLinkedAppsAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error) {
+	return dbx.LinkedAppsListMemberLinkedAppsContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) LinkedAppsListMemberLinkedAppsContext(ctx context.Context, arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1709,18 +1729,12 @@ func (dbx *apiImpl) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "linked_apps/list_member_linked_apps", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "linked_apps/list_member_linked_apps", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1731,9 +1745,6 @@ func (dbx *apiImpl) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1751,31 +1762,32 @@ func (dbx *apiImpl) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//LinkedAppsListMembersLinkedAppsAPIError is an error-wrapper for the linked_apps/list_members_linked_apps route
+// LinkedAppsListMembersLinkedAppsAPIError is an error-wrapper for the linked_apps/list_members_linked_apps route
 type LinkedAppsListMembersLinkedAppsAPIError struct {
 	dropbox.APIError
 	EndpointError *ListMembersAppsError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e LinkedAppsListMembersLinkedAppsAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error) {
+	return dbx.LinkedAppsListMembersLinkedAppsContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) LinkedAppsListMembersLinkedAppsContext(ctx context.Context, arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1786,18 +1798,12 @@ func (dbx *apiImpl) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (re
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "linked_apps/list_members_linked_apps", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "linked_apps/list_members_linked_apps", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1808,9 +1814,6 @@ func (dbx *apiImpl) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (re
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1828,31 +1831,32 @@ func (dbx *apiImpl) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (re
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//LinkedAppsListTeamLinkedAppsAPIError is an error-wrapper for the linked_apps/list_team_linked_apps route
+// LinkedAppsListTeamLinkedAppsAPIError is an error-wrapper for the linked_apps/list_team_linked_apps route
 type LinkedAppsListTeamLinkedAppsAPIError struct {
 	dropbox.APIError
 	EndpointError *ListTeamAppsError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e LinkedAppsListTeamLinkedAppsAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error) {
+	return dbx.LinkedAppsListTeamLinkedAppsContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) LinkedAppsListTeamLinkedAppsContext(ctx context.Context, arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1863,18 +1867,12 @@ func (dbx *apiImpl) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *Lis
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "linked_apps/list_team_linked_apps", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "linked_apps/list_team_linked_apps", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1885,9 +1883,6 @@ func (dbx *apiImpl) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *Lis
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -1905,31 +1900,32 @@ func (dbx *apiImpl) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *Lis
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//LinkedAppsRevokeLinkedAppAPIError is an error-wrapper for the linked_apps/revoke_linked_app route
+// LinkedAppsRevokeLinkedAppAPIError is an error-wrapper for the linked_apps/revoke_linked_app route
 type LinkedAppsRevokeLinkedAppAPIError struct {
 	dropbox.APIError
 	EndpointError *RevokeLinkedAppError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e LinkedAppsRevokeLinkedAppAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err error) {
+	return dbx.LinkedAppsRevokeLinkedAppContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) LinkedAppsRevokeLinkedAppContext(ctx context.Context, arg *RevokeLinkedApiAppArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -1940,18 +1936,12 @@ func (dbx *apiImpl) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err e
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "linked_apps/revoke_linked_app", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "linked_apps/revoke_linked_app", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -1962,9 +1952,6 @@ func (dbx *apiImpl) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err e
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -1977,31 +1964,32 @@ func (dbx *apiImpl) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err e
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//LinkedAppsRevokeLinkedAppBatchAPIError is an error-wrapper for the linked_apps/revoke_linked_app_batch route
+// LinkedAppsRevokeLinkedAppBatchAPIError is an error-wrapper for the linked_apps/revoke_linked_app_batch route
 type LinkedAppsRevokeLinkedAppBatchAPIError struct {
 	dropbox.APIError
 	EndpointError *RevokeLinkedAppBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e LinkedAppsRevokeLinkedAppBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error) {
+	return dbx.LinkedAppsRevokeLinkedAppBatchContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) LinkedAppsRevokeLinkedAppBatchContext(ctx context.Context, arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2012,18 +2000,12 @@ func (dbx *apiImpl) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchA
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "linked_apps/revoke_linked_app_batch", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "linked_apps/revoke_linked_app_batch", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2034,9 +2016,6 @@ func (dbx *apiImpl) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchA
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2054,31 +2033,32 @@ func (dbx *apiImpl) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchA
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersAddAPIError is an error-wrapper for the members/add route
+// MembersAddAPIError is an error-wrapper for the members/add route
 type MembersAddAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err error) {
+	return dbx.MembersAddContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersAddContext(ctx context.Context, arg *MembersAddArg) (res *MembersAddLaunch, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2089,18 +2069,12 @@ func (dbx *apiImpl) MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err e
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/add", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/add", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2111,9 +2085,6 @@ func (dbx *apiImpl) MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err e
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2131,31 +2102,32 @@ func (dbx *apiImpl) MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err e
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersAddJobStatusGetAPIError is an error-wrapper for the members/add/job_status/get route
+// MembersAddJobStatusGetAPIError is an error-wrapper for the members/add/job_status/get route
 type MembersAddJobStatusGetAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersAddJobStatusGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJobStatus, err error) {
+	return dbx.MembersAddJobStatusGetContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersAddJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *MembersAddJobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2166,18 +2138,12 @@ func (dbx *apiImpl) MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJ
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/add/job_status/get", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/add/job_status/get", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2188,9 +2154,6 @@ func (dbx *apiImpl) MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJ
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2208,31 +2171,32 @@ func (dbx *apiImpl) MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJ
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersGetInfoAPIError is an error-wrapper for the members/get_info route
+// MembersGetInfoAPIError is an error-wrapper for the members/get_info route
 type MembersGetInfoAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersGetInfoError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersGetInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error) {
+	return dbx.MembersGetInfoContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersGetInfoContext(ctx context.Context, arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2243,18 +2207,12 @@ func (dbx *apiImpl) MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetIn
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/get_info", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/get_info", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2265,9 +2223,6 @@ func (dbx *apiImpl) MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetIn
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2285,31 +2240,32 @@ func (dbx *apiImpl) MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetIn
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersListAPIError is an error-wrapper for the members/list route
+// MembersListAPIError is an error-wrapper for the members/list route
 type MembersListAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersListError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersList(arg *MembersListArg) (res *MembersListResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersListAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersList(arg *MembersListArg) (res *MembersListResult, err error) {
+	return dbx.MembersListContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) MembersListContext(ctx context.Context, arg *MembersListArg) (res *MembersListResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2320,18 +2276,12 @@ func (dbx *apiImpl) MembersList(arg *MembersListArg) (res *MembersListResult, er
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/list", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/list", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2342,9 +2292,6 @@ func (dbx *apiImpl) MembersList(arg *MembersListArg) (res *MembersListResult, er
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2362,31 +2309,32 @@ func (dbx *apiImpl) MembersList(arg *MembersListArg) (res *MembersListResult, er
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersListContinueAPIError is an error-wrapper for the members/list/continue route
+// MembersListContinueAPIError is an error-wrapper for the members/list/continue route
 type MembersListContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersListContinue(arg *MembersListContinueArg) (res *MembersListResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersListContinue(arg *MembersListContinueArg) (res *MembersListResult, err error) {
+	return dbx.MembersListContinueContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersListContinueContext(ctx context.Context, arg *MembersListContinueArg) (res *MembersListResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2397,18 +2345,12 @@ func (dbx *apiImpl) MembersListContinue(arg *MembersListContinueArg) (res *Membe
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/list/continue", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/list/continue", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2419,9 +2361,6 @@ func (dbx *apiImpl) MembersListContinue(arg *MembersListContinueArg) (res *Membe
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2439,31 +2378,32 @@ func (dbx *apiImpl) MembersListContinue(arg *MembersListContinueArg) (res *Membe
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersRecoverAPIError is an error-wrapper for the members/recover route
+// MembersRecoverAPIError is an error-wrapper for the members/recover route
 type MembersRecoverAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersRecoverError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersRecover(arg *MembersRecoverArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersRecoverAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersRecover(arg *MembersRecoverArg) (err error) {
+	return dbx.MembersRecoverContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersRecoverContext(ctx context.Context, arg *MembersRecoverArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2474,18 +2414,12 @@ func (dbx *apiImpl) MembersRecover(arg *MembersRecoverArg) (err error) {
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/recover", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/recover", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2496,9 +2430,6 @@ func (dbx *apiImpl) MembersRecover(arg *MembersRecoverArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2511,31 +2442,32 @@ func (dbx *apiImpl) MembersRecover(arg *MembersRecoverArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersRemoveAPIError is an error-wrapper for the members/remove route
+// MembersRemoveAPIError is an error-wrapper for the members/remove route
 type MembersRemoveAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersRemoveError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error) {
+	return dbx.MembersRemoveContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersRemoveContext(ctx context.Context, arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2546,18 +2478,12 @@ func (dbx *apiImpl) MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmpty
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/remove", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/remove", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2568,9 +2494,6 @@ func (dbx *apiImpl) MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmpty
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2588,31 +2511,32 @@ func (dbx *apiImpl) MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmpty
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersRemoveJobStatusGetAPIError is an error-wrapper for the members/remove/job_status/get route
+// MembersRemoveJobStatusGetAPIError is an error-wrapper for the members/remove/job_status/get route
 type MembersRemoveJobStatusGetAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersRemoveJobStatusGetAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	return dbx.MembersRemoveJobStatusGetContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) MembersRemoveJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2623,18 +2547,12 @@ func (dbx *apiImpl) MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.Po
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/remove/job_status/get", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/remove/job_status/get", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2645,9 +2563,6 @@ func (dbx *apiImpl) MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.Po
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2665,31 +2580,32 @@ func (dbx *apiImpl) MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.Po
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersSendWelcomeEmailAPIError is an error-wrapper for the members/send_welcome_email route
+// MembersSendWelcomeEmailAPIError is an error-wrapper for the members/send_welcome_email route
 type MembersSendWelcomeEmailAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersSendWelcomeError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSendWelcomeEmail(arg *UserSelectorArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersSendWelcomeEmailAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersSendWelcomeEmail(arg *UserSelectorArg) (err error) {
+	return dbx.MembersSendWelcomeEmailContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersSendWelcomeEmailContext(ctx context.Context, arg *UserSelectorArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2700,18 +2616,12 @@ func (dbx *apiImpl) MembersSendWelcomeEmail(arg *UserSelectorArg) (err error) {
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/send_welcome_email", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/send_welcome_email", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2722,9 +2632,6 @@ func (dbx *apiImpl) MembersSendWelcomeEmail(arg *UserSelectorArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2737,31 +2644,32 @@ func (dbx *apiImpl) MembersSendWelcomeEmail(arg *UserSelectorArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersSetAdminPermissionsAPIError is an error-wrapper for the members/set_admin_permissions route
+// MembersSetAdminPermissionsAPIError is an error-wrapper for the members/set_admin_permissions route
 type MembersSetAdminPermissionsAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersSetPermissionsError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersSetAdminPermissionsAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error) {
+	return dbx.MembersSetAdminPermissionsContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersSetAdminPermissionsContext(ctx context.Context, arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2772,18 +2680,12 @@ func (dbx *apiImpl) MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (r
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/set_admin_permissions", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/set_admin_permissions", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2794,9 +2696,6 @@ func (dbx *apiImpl) MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (r
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2814,31 +2713,32 @@ func (dbx *apiImpl) MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (r
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersSetProfileAPIError is an error-wrapper for the members/set_profile route
+// MembersSetProfileAPIError is an error-wrapper for the members/set_profile route
 type MembersSetProfileAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersSetProfileError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMemberInfo, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersSetProfileAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMemberInfo, err error) {
+	return dbx.MembersSetProfileContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersSetProfileContext(ctx context.Context, arg *MembersSetProfileArg) (res *TeamMemberInfo, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2849,18 +2749,12 @@ func (dbx *apiImpl) MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMembe
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/set_profile", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/set_profile", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2871,9 +2765,6 @@ func (dbx *apiImpl) MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMembe
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -2891,31 +2782,32 @@ func (dbx *apiImpl) MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMembe
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersSuspendAPIError is an error-wrapper for the members/suspend route
+// MembersSuspendAPIError is an error-wrapper for the members/suspend route
 type MembersSuspendAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersSuspendError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSuspend(arg *MembersDeactivateArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersSuspendAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersSuspend(arg *MembersDeactivateArg) (err error) {
+	return dbx.MembersSuspendContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) MembersSuspendContext(ctx context.Context, arg *MembersDeactivateArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2926,18 +2818,12 @@ func (dbx *apiImpl) MembersSuspend(arg *MembersDeactivateArg) (err error) {
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/suspend", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/suspend", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -2948,9 +2834,6 @@ func (dbx *apiImpl) MembersSuspend(arg *MembersDeactivateArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -2963,31 +2846,32 @@ func (dbx *apiImpl) MembersSuspend(arg *MembersDeactivateArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//MembersUnsuspendAPIError is an error-wrapper for the members/unsuspend route
+// MembersUnsuspendAPIError is an error-wrapper for the members/unsuspend route
 type MembersUnsuspendAPIError struct {
 	dropbox.APIError
 	EndpointError *MembersUnsuspendError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersUnsuspend(arg *MembersUnsuspendArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e MembersUnsuspendAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) MembersUnsuspend(arg *MembersUnsuspendArg) (err error) {
+	return dbx.MembersUnsuspendContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) MembersUnsuspendContext(ctx context.Context, arg *MembersUnsuspendArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -2998,18 +2882,12 @@ func (dbx *apiImpl) MembersUnsuspend(arg *MembersUnsuspendArg) (err error) {
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "members/unsuspend", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "members/unsuspend", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3020,9 +2898,6 @@ func (dbx *apiImpl) MembersUnsuspend(arg *MembersUnsuspendArg) (err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -3035,31 +2910,32 @@ func (dbx *apiImpl) MembersUnsuspend(arg *MembersUnsuspendArg) (err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesTemplateAddAPIError is an error-wrapper for the properties/template/add route
+// PropertiesTemplateAddAPIError is an error-wrapper for the properties/template/add route
 type PropertiesTemplateAddAPIError struct {
 	dropbox.APIError
 	EndpointError *properties.ModifyPropertyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateAdd(arg *AddPropertyTemplateArg) (res *AddPropertyTemplateResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesTemplateAddAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) PropertiesTemplateAdd(arg *AddPropertyTemplateArg) (res *AddPropertyTemplateResult, err error) {
+	return dbx.PropertiesTemplateAddContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) PropertiesTemplateAddContext(ctx context.Context, arg *AddPropertyTemplateArg) (res *AddPropertyTemplateResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3070,18 +2946,12 @@ func (dbx *apiImpl) PropertiesTemplateAdd(arg *AddPropertyTemplateArg) (res *Add
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "properties/template/add", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "properties/template/add", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3092,9 +2962,6 @@ func (dbx *apiImpl) PropertiesTemplateAdd(arg *AddPropertyTemplateArg) (res *Add
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3112,31 +2979,32 @@ func (dbx *apiImpl) PropertiesTemplateAdd(arg *AddPropertyTemplateArg) (res *Add
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesTemplateGetAPIError is an error-wrapper for the properties/template/get route
+// PropertiesTemplateGetAPIError is an error-wrapper for the properties/template/get route
 type PropertiesTemplateGetAPIError struct {
 	dropbox.APIError
 	EndpointError *properties.PropertyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesTemplateGetAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
+	return dbx.PropertiesTemplateGetContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) PropertiesTemplateGetContext(ctx context.Context, arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3147,18 +3015,12 @@ func (dbx *apiImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "properties/template/get", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "properties/template/get", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3169,9 +3031,6 @@ func (dbx *apiImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3189,43 +3048,38 @@ func (dbx *apiImpl) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesTemplateListAPIError is an error-wrapper for the properties/template/list route
+// PropertiesTemplateListAPIError is an error-wrapper for the properties/template/list route
 type PropertiesTemplateListAPIError struct {
 	dropbox.APIError
 	EndpointError *properties.PropertyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateList() (res *properties.ListPropertyTemplateIds, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesTemplateListAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) PropertiesTemplateList() (res *properties.ListPropertyTemplateIds, err error) {
+	return dbx.PropertiesTemplateListContext(context.Background())
+}
 
+func (dbx *ClientImpl) PropertiesTemplateListContext(ctx context.Context) (res *properties.ListPropertyTemplateIds, err error) {
 	headers := map[string]string{}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "properties/template/list", headers, nil)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "properties/template/list", headers, nil)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3236,9 +3090,6 @@ func (dbx *apiImpl) PropertiesTemplateList() (res *properties.ListPropertyTempla
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3256,31 +3107,32 @@ func (dbx *apiImpl) PropertiesTemplateList() (res *properties.ListPropertyTempla
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//PropertiesTemplateUpdateAPIError is an error-wrapper for the properties/template/update route
+// PropertiesTemplateUpdateAPIError is an error-wrapper for the properties/template/update route
 type PropertiesTemplateUpdateAPIError struct {
 	dropbox.APIError
 	EndpointError *properties.ModifyPropertyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateUpdate(arg *UpdatePropertyTemplateArg) (res *UpdatePropertyTemplateResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e PropertiesTemplateUpdateAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) PropertiesTemplateUpdate(arg *UpdatePropertyTemplateArg) (res *UpdatePropertyTemplateResult, err error) {
+	return dbx.PropertiesTemplateUpdateContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) PropertiesTemplateUpdateContext(ctx context.Context, arg *UpdatePropertyTemplateArg) (res *UpdatePropertyTemplateResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3291,18 +3143,12 @@ func (dbx *apiImpl) PropertiesTemplateUpdate(arg *UpdatePropertyTemplateArg) (re
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "properties/template/update", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "properties/template/update", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3313,9 +3159,6 @@ func (dbx *apiImpl) PropertiesTemplateUpdate(arg *UpdatePropertyTemplateArg) (re
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3333,31 +3176,32 @@ func (dbx *apiImpl) PropertiesTemplateUpdate(arg *UpdatePropertyTemplateArg) (re
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ReportsGetActivityAPIError is an error-wrapper for the reports/get_activity route
+// ReportsGetActivityAPIError is an error-wrapper for the reports/get_activity route
 type ReportsGetActivityAPIError struct {
 	dropbox.APIError
 	EndpointError *DateRangeError `json:"error"`
 }
 
-func (dbx *apiImpl) ReportsGetActivity(arg *DateRange) (res *GetActivityReport, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ReportsGetActivityAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ReportsGetActivity(arg *DateRange) (res *GetActivityReport, err error) {
+	return dbx.ReportsGetActivityContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) ReportsGetActivityContext(ctx context.Context, arg *DateRange) (res *GetActivityReport, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3368,18 +3212,12 @@ func (dbx *apiImpl) ReportsGetActivity(arg *DateRange) (res *GetActivityReport,
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "reports/get_activity", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "reports/get_activity", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3390,9 +3228,6 @@ func (dbx *apiImpl) ReportsGetActivity(arg *DateRange) (res *GetActivityReport,
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3410,31 +3245,32 @@ func (dbx *apiImpl) ReportsGetActivity(arg *DateRange) (res *GetActivityReport,
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ReportsGetDevicesAPIError is an error-wrapper for the reports/get_devices route
+// ReportsGetDevicesAPIError is an error-wrapper for the reports/get_devices route
 type ReportsGetDevicesAPIError struct {
 	dropbox.APIError
 	EndpointError *DateRangeError `json:"error"`
 }
 
-func (dbx *apiImpl) ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ReportsGetDevicesAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, err error) {
+	return dbx.ReportsGetDevicesContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ReportsGetDevicesContext(ctx context.Context, arg *DateRange) (res *GetDevicesReport, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3445,18 +3281,12 @@ func (dbx *apiImpl) ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, er
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "reports/get_devices", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "reports/get_devices", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3467,9 +3297,6 @@ func (dbx *apiImpl) ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, er
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3487,31 +3314,32 @@ func (dbx *apiImpl) ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, er
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ReportsGetMembershipAPIError is an error-wrapper for the reports/get_membership route
+// ReportsGetMembershipAPIError is an error-wrapper for the reports/get_membership route
 type ReportsGetMembershipAPIError struct {
 	dropbox.APIError
 	EndpointError *DateRangeError `json:"error"`
 }
 
-func (dbx *apiImpl) ReportsGetMembership(arg *DateRange) (res *GetMembershipReport, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ReportsGetMembershipAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ReportsGetMembership(arg *DateRange) (res *GetMembershipReport, err error) {
+	return dbx.ReportsGetMembershipContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ReportsGetMembershipContext(ctx context.Context, arg *DateRange) (res *GetMembershipReport, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3522,18 +3350,12 @@ func (dbx *apiImpl) ReportsGetMembership(arg *DateRange) (res *GetMembershipRepo
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "reports/get_membership", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "reports/get_membership", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3544,9 +3366,6 @@ func (dbx *apiImpl) ReportsGetMembership(arg *DateRange) (res *GetMembershipRepo
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3564,31 +3383,32 @@ func (dbx *apiImpl) ReportsGetMembership(arg *DateRange) (res *GetMembershipRepo
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//ReportsGetStorageAPIError is an error-wrapper for the reports/get_storage route
+// ReportsGetStorageAPIError is an error-wrapper for the reports/get_storage route
 type ReportsGetStorageAPIError struct {
 	dropbox.APIError
 	EndpointError *DateRangeError `json:"error"`
 }
 
-func (dbx *apiImpl) ReportsGetStorage(arg *DateRange) (res *GetStorageReport, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e ReportsGetStorageAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) ReportsGetStorage(arg *DateRange) (res *GetStorageReport, err error) {
+	return dbx.ReportsGetStorageContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) ReportsGetStorageContext(ctx context.Context, arg *DateRange) (res *GetStorageReport, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3599,18 +3419,12 @@ func (dbx *apiImpl) ReportsGetStorage(arg *DateRange) (res *GetStorageReport, er
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "reports/get_storage", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "reports/get_storage", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3621,9 +3435,6 @@ func (dbx *apiImpl) ReportsGetStorage(arg *DateRange) (res *GetStorageReport, er
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3641,31 +3452,32 @@ func (dbx *apiImpl) ReportsGetStorage(arg *DateRange) (res *GetStorageReport, er
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TeamFolderActivateAPIError is an error-wrapper for the team_folder/activate route
+// TeamFolderActivateAPIError is an error-wrapper for the team_folder/activate route
 type TeamFolderActivateAPIError struct {
 	dropbox.APIError
 	EndpointError *TeamFolderActivateError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TeamFolderActivateAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error) {
+	return dbx.TeamFolderActivateContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) TeamFolderActivateContext(ctx context.Context, arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3676,18 +3488,12 @@ func (dbx *apiImpl) TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMet
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "team_folder/activate", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "team_folder/activate", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3698,9 +3504,6 @@ func (dbx *apiImpl) TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMet
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3718,31 +3521,32 @@ func (dbx *apiImpl) TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMet
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TeamFolderArchiveAPIError is an error-wrapper for the team_folder/archive route
+// TeamFolderArchiveAPIError is an error-wrapper for the team_folder/archive route
 type TeamFolderArchiveAPIError struct {
 	dropbox.APIError
 	EndpointError *TeamFolderArchiveError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TeamFolderArchiveAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error) {
+	return dbx.TeamFolderArchiveContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TeamFolderArchiveContext(ctx context.Context, arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3753,18 +3557,12 @@ func (dbx *apiImpl) TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolde
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "team_folder/archive", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "team_folder/archive", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3775,9 +3573,6 @@ func (dbx *apiImpl) TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolde
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3795,31 +3590,32 @@ func (dbx *apiImpl) TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolde
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TeamFolderArchiveCheckAPIError is an error-wrapper for the team_folder/archive/check route
+// TeamFolderArchiveCheckAPIError is an error-wrapper for the team_folder/archive/check route
 type TeamFolderArchiveCheckAPIError struct {
 	dropbox.APIError
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TeamFolderArchiveCheckAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error) {
+	return dbx.TeamFolderArchiveCheckContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) TeamFolderArchiveCheckContext(ctx context.Context, arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3830,18 +3626,12 @@ func (dbx *apiImpl) TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderA
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "team_folder/archive/check", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "team_folder/archive/check", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3852,9 +3642,6 @@ func (dbx *apiImpl) TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderA
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3872,31 +3659,32 @@ func (dbx *apiImpl) TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderA
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TeamFolderCreateAPIError is an error-wrapper for the team_folder/create route
+// TeamFolderCreateAPIError is an error-wrapper for the team_folder/create route
 type TeamFolderCreateAPIError struct {
 	dropbox.APIError
 	EndpointError *TeamFolderCreateError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TeamFolderCreateAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error) {
+	return dbx.TeamFolderCreateContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TeamFolderCreateContext(ctx context.Context, arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3907,18 +3695,12 @@ func (dbx *apiImpl) TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderM
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "team_folder/create", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "team_folder/create", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -3929,9 +3711,6 @@ func (dbx *apiImpl) TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderM
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -3949,31 +3728,32 @@ func (dbx *apiImpl) TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderM
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TeamFolderGetInfoAPIError is an error-wrapper for the team_folder/get_info route
+// TeamFolderGetInfoAPIError is an error-wrapper for the team_folder/get_info route
 type TeamFolderGetInfoAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TeamFolderGetInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error) {
+	return dbx.TeamFolderGetInfoContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TeamFolderGetInfoContext(ctx context.Context, arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -3984,18 +3764,12 @@ func (dbx *apiImpl) TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFold
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "team_folder/get_info", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "team_folder/get_info", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -4006,9 +3780,6 @@ func (dbx *apiImpl) TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFold
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -4026,31 +3797,32 @@ func (dbx *apiImpl) TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFold
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TeamFolderListAPIError is an error-wrapper for the team_folder/list route
+// TeamFolderListAPIError is an error-wrapper for the team_folder/list route
 type TeamFolderListAPIError struct {
 	dropbox.APIError
 	EndpointError *TeamFolderListError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TeamFolderListAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListResult, err error) {
+	return dbx.TeamFolderListContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) TeamFolderListContext(ctx context.Context, arg *TeamFolderListArg) (res *TeamFolderListResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -4061,18 +3833,12 @@ func (dbx *apiImpl) TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListR
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "team_folder/list", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "team_folder/list", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -4083,9 +3849,6 @@ func (dbx *apiImpl) TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListR
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -4103,31 +3866,32 @@ func (dbx *apiImpl) TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListR
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TeamFolderListContinueAPIError is an error-wrapper for the team_folder/list/continue route
+// TeamFolderListContinueAPIError is an error-wrapper for the team_folder/list/continue route
 type TeamFolderListContinueAPIError struct {
 	dropbox.APIError
 	EndpointError *TeamFolderListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderListContinue(arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TeamFolderListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TeamFolderListContinue(arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error) {
+	return dbx.TeamFolderListContinueContext(context.Background(), arg)
+}
+
+func (dbx *ClientImpl) TeamFolderListContinueContext(ctx context.Context, arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -4138,18 +3902,12 @@ func (dbx *apiImpl) TeamFolderListContinue(arg *TeamFolderListContinueArg) (res
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "team_folder/list/continue", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "team_folder/list/continue", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -4160,9 +3918,6 @@ func (dbx *apiImpl) TeamFolderListContinue(arg *TeamFolderListContinueArg) (res
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -4180,31 +3935,32 @@ func (dbx *apiImpl) TeamFolderListContinue(arg *TeamFolderListContinueArg) (res
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TeamFolderPermanentlyDeleteAPIError is an error-wrapper for the team_folder/permanently_delete route
+// TeamFolderPermanentlyDeleteAPIError is an error-wrapper for the team_folder/permanently_delete route
 type TeamFolderPermanentlyDeleteAPIError struct {
 	dropbox.APIError
 	EndpointError *TeamFolderPermanentlyDeleteError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TeamFolderPermanentlyDeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error) {
+	return dbx.TeamFolderPermanentlyDeleteContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TeamFolderPermanentlyDeleteContext(ctx context.Context, arg *TeamFolderIdArg) (err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -4215,18 +3971,12 @@ func (dbx *apiImpl) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "team_folder/permanently_delete", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "team_folder/permanently_delete", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -4237,9 +3987,6 @@ func (dbx *apiImpl) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		return
 	}
@@ -4252,31 +3999,32 @@ func (dbx *apiImpl) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TeamFolderRenameAPIError is an error-wrapper for the team_folder/rename route
+// TeamFolderRenameAPIError is an error-wrapper for the team_folder/rename route
 type TeamFolderRenameAPIError struct {
 	dropbox.APIError
 	EndpointError *TeamFolderRenameError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TeamFolderRenameAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error) {
+	return dbx.TeamFolderRenameContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) TeamFolderRenameContext(ctx context.Context, arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -4287,18 +4035,12 @@ func (dbx *apiImpl) TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderM
 		"Content-Type": "application/json",
 	}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "team_folder/rename", headers, bytes.NewReader(b))
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "team_folder/rename", headers, bytes.NewReader(b))
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -4309,9 +4051,6 @@ func (dbx *apiImpl) TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderM
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -4329,43 +4068,38 @@ func (dbx *apiImpl) TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderM
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//TokenGetAuthenticatedAdminAPIError is an error-wrapper for the token/get_authenticated_admin route
+// TokenGetAuthenticatedAdminAPIError is an error-wrapper for the token/get_authenticated_admin route
 type TokenGetAuthenticatedAdminAPIError struct {
 	dropbox.APIError
 	EndpointError *TokenGetAuthenticatedAdminError `json:"error"`
 }
 
-func (dbx *apiImpl) TokenGetAuthenticatedAdmin() (res *TokenGetAuthenticatedAdminResult, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e TokenGetAuthenticatedAdminAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) TokenGetAuthenticatedAdmin() (res *TokenGetAuthenticatedAdminResult, err error) {
+	return dbx.TokenGetAuthenticatedAdminContext(context.Background())
+}
 
+func (dbx *ClientImpl) TokenGetAuthenticatedAdminContext(ctx context.Context) (res *TokenGetAuthenticatedAdminResult, err error) {
 	headers := map[string]string{}
 
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "team", "token/get_authenticated_admin", headers, nil)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "team", "team", "token/get_authenticated_admin", headers, nil)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
-	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
-	}
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -4376,9 +4110,6 @@ func (dbx *apiImpl) TokenGetAuthenticatedAdmin() (res *TokenGetAuthenticatedAdmi
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -4396,22 +4127,22 @@ func (dbx *apiImpl) TokenGetAuthenticatedAdmin() (res *TokenGetAuthenticatedAdmi
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
-		return
-	}
-	err = json.Unmarshal(body, &apiError)
+	err = auth.HandleCommonAuthErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
 // New returns a Client implementation for this namespace
-func New(c dropbox.Config) *apiImpl {
-	ctx := apiImpl(dropbox.NewContext(c))
+func New(c dropbox.Config) *ClientImpl {
+	return NewFromContext(dropbox.NewContext(c))
+}
+
+// NewFromContext returns a Client implementation for this namespace
+// that shares the http.Client and tokens of c
+func NewFromContext(c dropbox.Context) *ClientImpl {
+	ctx := ClientImpl(c)
 	return &ctx
 }
@@ -0,0 +1,1011 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package teamtest provides a fake team.Client for tests.
+package teamtest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/async"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/properties"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/team"
+)
+
+// ErrNotStubbed is returned by the routes of a Fake that are not stubbed
+var ErrNotStubbed = errors.New("teamtest: route not stubbed")
+
+// Call is a call made to a Fake
+type Call struct {
+	// Name of the route, e.g. "devices/list_member_devices"
+	Route string
+	// Argument of the call (nil for routes without one)
+	Arg interface{}
+	// Content passed to upload routes
+	Content io.Reader
+}
+
+// Fake is a team.Client for tests. Each route calls the function in the
+// matching field, e.g. DevicesListMemberDevicesFunc, or returns ErrNotStubbed
+// if the field is nil. All calls are recorded. The zero value is ready to use.
+type Fake struct {
+	// Stubs DevicesListMemberDevices and DevicesListMemberDevicesContext
+	DevicesListMemberDevicesFunc func(ctx context.Context, arg *team.ListMemberDevicesArg) (res *team.ListMemberDevicesResult, err error)
+	// Stubs DevicesListMembersDevices and DevicesListMembersDevicesContext
+	DevicesListMembersDevicesFunc func(ctx context.Context, arg *team.ListMembersDevicesArg) (res *team.ListMembersDevicesResult, err error)
+	// Stubs DevicesListTeamDevices and DevicesListTeamDevicesContext
+	DevicesListTeamDevicesFunc func(ctx context.Context, arg *team.ListTeamDevicesArg) (res *team.ListTeamDevicesResult, err error)
+	// Stubs DevicesRevokeDeviceSession and DevicesRevokeDeviceSessionContext
+	DevicesRevokeDeviceSessionFunc func(ctx context.Context, arg *team.RevokeDeviceSessionArg) (err error)
+	// Stubs DevicesRevokeDeviceSessionBatch and DevicesRevokeDeviceSessionBatchContext
+	DevicesRevokeDeviceSessionBatchFunc func(ctx context.Context, arg *team.RevokeDeviceSessionBatchArg) (res *team.RevokeDeviceSessionBatchResult, err error)
+	// Stubs FeaturesGetValues and FeaturesGetValuesContext
+	FeaturesGetValuesFunc func(ctx context.Context, arg *team.FeaturesGetValuesBatchArg) (res *team.FeaturesGetValuesBatchResult, err error)
+	// Stubs GetInfo and GetInfoContext
+	GetInfoFunc func(ctx context.Context) (res *team.TeamGetInfoResult, err error)
+	// Stubs GroupsCreate and GroupsCreateContext
+	GroupsCreateFunc func(ctx context.Context, arg *team.GroupCreateArg) (res *team.GroupFullInfo, err error)
+	// Stubs GroupsDelete and GroupsDeleteContext
+	GroupsDeleteFunc func(ctx context.Context, arg *team.GroupSelector) (res *async.LaunchEmptyResult, err error)
+	// Stubs GroupsGetInfo and GroupsGetInfoContext
+	GroupsGetInfoFunc func(ctx context.Context, arg *team.GroupsSelector) (res []*team.GroupsGetInfoItem, err error)
+	// Stubs GroupsJobStatusGet and GroupsJobStatusGetContext
+	GroupsJobStatusGetFunc func(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	// Stubs GroupsList and GroupsListContext
+	GroupsListFunc func(ctx context.Context, arg *team.GroupsListArg) (res *team.GroupsListResult, err error)
+	// Stubs GroupsListContinue and GroupsListContinueContext
+	GroupsListContinueFunc func(ctx context.Context, arg *team.GroupsListContinueArg) (res *team.GroupsListResult, err error)
+	// Stubs GroupsMembersAdd and GroupsMembersAddContext
+	GroupsMembersAddFunc func(ctx context.Context, arg *team.GroupMembersAddArg) (res *team.GroupMembersChangeResult, err error)
+	// Stubs GroupsMembersList and GroupsMembersListContext
+	GroupsMembersListFunc func(ctx context.Context, arg *team.GroupsMembersListArg) (res *team.GroupsMembersListResult, err error)
+	// Stubs GroupsMembersListContinue and GroupsMembersListContinueContext
+	GroupsMembersListContinueFunc func(ctx context.Context, arg *team.GroupsMembersListContinueArg) (res *team.GroupsMembersListResult, err error)
+	// Stubs GroupsMembersRemove and GroupsMembersRemoveContext
+	GroupsMembersRemoveFunc func(ctx context.Context, arg *team.GroupMembersRemoveArg) (res *team.GroupMembersChangeResult, err error)
+	// Stubs GroupsMembersSetAccessType and GroupsMembersSetAccessTypeContext
+	GroupsMembersSetAccessTypeFunc func(ctx context.Context, arg *team.GroupMembersSetAccessTypeArg) (res []*team.GroupsGetInfoItem, err error)
+	// Stubs GroupsUpdate and GroupsUpdateContext
+	GroupsUpdateFunc func(ctx context.Context, arg *team.GroupUpdateArgs) (res *team.GroupFullInfo, err error)
+	// Stubs LinkedAppsListMemberLinkedApps and LinkedAppsListMemberLinkedAppsContext
+	LinkedAppsListMemberLinkedAppsFunc func(ctx context.Context, arg *team.ListMemberAppsArg) (res *team.ListMemberAppsResult, err error)
+	// Stubs LinkedAppsListMembersLinkedApps and LinkedAppsListMembersLinkedAppsContext
+	LinkedAppsListMembersLinkedAppsFunc func(ctx context.Context, arg *team.ListMembersAppsArg) (res *team.ListMembersAppsResult, err error)
+	// Stubs LinkedAppsListTeamLinkedApps and LinkedAppsListTeamLinkedAppsContext
+	LinkedAppsListTeamLinkedAppsFunc func(ctx context.Context, arg *team.ListTeamAppsArg) (res *team.ListTeamAppsResult, err error)
+	// Stubs LinkedAppsRevokeLinkedApp and LinkedAppsRevokeLinkedAppContext
+	LinkedAppsRevokeLinkedAppFunc func(ctx context.Context, arg *team.RevokeLinkedApiAppArg) (err error)
+	// Stubs LinkedAppsRevokeLinkedAppBatch and LinkedAppsRevokeLinkedAppBatchContext
+	LinkedAppsRevokeLinkedAppBatchFunc func(ctx context.Context, arg *team.RevokeLinkedApiAppBatchArg) (res *team.RevokeLinkedAppBatchResult, err error)
+	// Stubs MembersAdd and MembersAddContext
+	MembersAddFunc func(ctx context.Context, arg *team.MembersAddArg) (res *team.MembersAddLaunch, err error)
+	// Stubs MembersAddJobStatusGet and MembersAddJobStatusGetContext
+	MembersAddJobStatusGetFunc func(ctx context.Context, arg *async.PollArg) (res *team.MembersAddJobStatus, err error)
+	// Stubs MembersGetInfo and MembersGetInfoContext
+	MembersGetInfoFunc func(ctx context.Context, arg *team.MembersGetInfoArgs) (res []*team.MembersGetInfoItem, err error)
+	// Stubs MembersList and MembersListContext
+	MembersListFunc func(ctx context.Context, arg *team.MembersListArg) (res *team.MembersListResult, err error)
+	// Stubs MembersListContinue and MembersListContinueContext
+	MembersListContinueFunc func(ctx context.Context, arg *team.MembersListContinueArg) (res *team.MembersListResult, err error)
+	// Stubs MembersRecover and MembersRecoverContext
+	MembersRecoverFunc func(ctx context.Context, arg *team.MembersRecoverArg) (err error)
+	// Stubs MembersRemove and MembersRemoveContext
+	MembersRemoveFunc func(ctx context.Context, arg *team.MembersRemoveArg) (res *async.LaunchEmptyResult, err error)
+	// Stubs MembersRemoveJobStatusGet and MembersRemoveJobStatusGetContext
+	MembersRemoveJobStatusGetFunc func(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	// Stubs MembersSendWelcomeEmail and MembersSendWelcomeEmailContext
+	MembersSendWelcomeEmailFunc func(ctx context.Context, arg *team.UserSelectorArg) (err error)
+	// Stubs MembersSetAdminPermissions and MembersSetAdminPermissionsContext
+	MembersSetAdminPermissionsFunc func(ctx context.Context, arg *team.MembersSetPermissionsArg) (res *team.MembersSetPermissionsResult, err error)
+	// Stubs MembersSetProfile and MembersSetProfileContext
+	MembersSetProfileFunc func(ctx context.Context, arg *team.MembersSetProfileArg) (res *team.TeamMemberInfo, err error)
+	// Stubs MembersSuspend and MembersSuspendContext
+	MembersSuspendFunc func(ctx context.Context, arg *team.MembersDeactivateArg) (err error)
+	// Stubs MembersUnsuspend and MembersUnsuspendContext
+	MembersUnsuspendFunc func(ctx context.Context, arg *team.MembersUnsuspendArg) (err error)
+	// Stubs PropertiesTemplateAdd and PropertiesTemplateAddContext
+	PropertiesTemplateAddFunc func(ctx context.Context, arg *team.AddPropertyTemplateArg) (res *team.AddPropertyTemplateResult, err error)
+	// Stubs PropertiesTemplateGet and PropertiesTemplateGetContext
+	PropertiesTemplateGetFunc func(ctx context.Context, arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error)
+	// Stubs PropertiesTemplateList and PropertiesTemplateListContext
+	PropertiesTemplateListFunc func(ctx context.Context) (res *properties.ListPropertyTemplateIds, err error)
+	// Stubs PropertiesTemplateUpdate and PropertiesTemplateUpdateContext
+	PropertiesTemplateUpdateFunc func(ctx context.Context, arg *team.UpdatePropertyTemplateArg) (res *team.UpdatePropertyTemplateResult, err error)
+	// Stubs ReportsGetActivity and ReportsGetActivityContext
+	ReportsGetActivityFunc func(ctx context.Context, arg *team.DateRange) (res *team.GetActivityReport, err error)
+	// Stubs ReportsGetDevices and ReportsGetDevicesContext
+	ReportsGetDevicesFunc func(ctx context.Context, arg *team.DateRange) (res *team.GetDevicesReport, err error)
+	// Stubs ReportsGetMembership and ReportsGetMembershipContext
+	ReportsGetMembershipFunc func(ctx context.Context, arg *team.DateRange) (res *team.GetMembershipReport, err error)
+	// Stubs ReportsGetStorage and ReportsGetStorageContext
+	ReportsGetStorageFunc func(ctx context.Context, arg *team.DateRange) (res *team.GetStorageReport, err error)
+	// Stubs TeamFolderActivate and TeamFolderActivateContext
+	TeamFolderActivateFunc func(ctx context.Context, arg *team.TeamFolderIdArg) (res *team.TeamFolderMetadata, err error)
+	// Stubs TeamFolderArchive and TeamFolderArchiveContext
+	TeamFolderArchiveFunc func(ctx context.Context, arg *team.TeamFolderArchiveArg) (res *team.TeamFolderArchiveLaunch, err error)
+	// Stubs TeamFolderArchiveCheck and TeamFolderArchiveCheckContext
+	TeamFolderArchiveCheckFunc func(ctx context.Context, arg *async.PollArg) (res *team.TeamFolderArchiveJobStatus, err error)
+	// Stubs TeamFolderCreate and TeamFolderCreateContext
+	TeamFolderCreateFunc func(ctx context.Context, arg *team.TeamFolderCreateArg) (res *team.TeamFolderMetadata, err error)
+	// Stubs TeamFolderGetInfo and TeamFolderGetInfoContext
+	TeamFolderGetInfoFunc func(ctx context.Context, arg *team.TeamFolderIdListArg) (res []*team.TeamFolderGetInfoItem, err error)
+	// Stubs TeamFolderList and TeamFolderListContext
+	TeamFolderListFunc func(ctx context.Context, arg *team.TeamFolderListArg) (res *team.TeamFolderListResult, err error)
+	// Stubs TeamFolderListContinue and TeamFolderListContinueContext
+	TeamFolderListContinueFunc func(ctx context.Context, arg *team.TeamFolderListContinueArg) (res *team.TeamFolderListResult, err error)
+	// Stubs TeamFolderPermanentlyDelete and TeamFolderPermanentlyDeleteContext
+	TeamFolderPermanentlyDeleteFunc func(ctx context.Context, arg *team.TeamFolderIdArg) (err error)
+	// Stubs TeamFolderRename and TeamFolderRenameContext
+	TeamFolderRenameFunc func(ctx context.Context, arg *team.TeamFolderRenameArg) (res *team.TeamFolderMetadata, err error)
+	// Stubs TokenGetAuthenticatedAdmin and TokenGetAuthenticatedAdminContext
+	TokenGetAuthenticatedAdminFunc func(ctx context.Context) (res *team.TokenGetAuthenticatedAdminResult, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ team.Client = (*Fake)(nil)
+
+// Calls returns the calls made so far, or only those to the given route
+func (f *Fake) Calls(route ...string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []Call
+	for _, c := range f.calls {
+		if len(route) == 0 || c.Route == route[0] {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (f *Fake) record(route string, arg interface{}, content io.Reader) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, Call{route, arg, content})
+}
+
+// DevicesListMemberDevices implements team.Client
+func (f *Fake) DevicesListMemberDevices(arg *team.ListMemberDevicesArg) (res *team.ListMemberDevicesResult, err error) {
+	return f.DevicesListMemberDevicesContext(context.Background(), arg)
+}
+
+// DevicesListMemberDevicesContext implements team.Client
+func (f *Fake) DevicesListMemberDevicesContext(ctx context.Context, arg *team.ListMemberDevicesArg) (res *team.ListMemberDevicesResult, err error) {
+	f.record("devices/list_member_devices", arg, nil)
+	if f.DevicesListMemberDevicesFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DevicesListMemberDevicesFunc(ctx, arg)
+}
+
+// DevicesListMembersDevices implements team.Client
+func (f *Fake) DevicesListMembersDevices(arg *team.ListMembersDevicesArg) (res *team.ListMembersDevicesResult, err error) {
+	return f.DevicesListMembersDevicesContext(context.Background(), arg)
+}
+
+// DevicesListMembersDevicesContext implements team.Client
+func (f *Fake) DevicesListMembersDevicesContext(ctx context.Context, arg *team.ListMembersDevicesArg) (res *team.ListMembersDevicesResult, err error) {
+	f.record("devices/list_members_devices", arg, nil)
+	if f.DevicesListMembersDevicesFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DevicesListMembersDevicesFunc(ctx, arg)
+}
+
+// DevicesListTeamDevices implements team.Client
+func (f *Fake) DevicesListTeamDevices(arg *team.ListTeamDevicesArg) (res *team.ListTeamDevicesResult, err error) {
+	return f.DevicesListTeamDevicesContext(context.Background(), arg)
+}
+
+// DevicesListTeamDevicesContext implements team.Client
+func (f *Fake) DevicesListTeamDevicesContext(ctx context.Context, arg *team.ListTeamDevicesArg) (res *team.ListTeamDevicesResult, err error) {
+	f.record("devices/list_team_devices", arg, nil)
+	if f.DevicesListTeamDevicesFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DevicesListTeamDevicesFunc(ctx, arg)
+}
+
+// DevicesRevokeDeviceSession implements team.Client
+func (f *Fake) DevicesRevokeDeviceSession(arg *team.RevokeDeviceSessionArg) (err error) {
+	return f.DevicesRevokeDeviceSessionContext(context.Background(), arg)
+}
+
+// DevicesRevokeDeviceSessionContext implements team.Client
+func (f *Fake) DevicesRevokeDeviceSessionContext(ctx context.Context, arg *team.RevokeDeviceSessionArg) (err error) {
+	f.record("devices/revoke_device_session", arg, nil)
+	if f.DevicesRevokeDeviceSessionFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DevicesRevokeDeviceSessionFunc(ctx, arg)
+}
+
+// DevicesRevokeDeviceSessionBatch implements team.Client
+func (f *Fake) DevicesRevokeDeviceSessionBatch(arg *team.RevokeDeviceSessionBatchArg) (res *team.RevokeDeviceSessionBatchResult, err error) {
+	return f.DevicesRevokeDeviceSessionBatchContext(context.Background(), arg)
+}
+
+// DevicesRevokeDeviceSessionBatchContext implements team.Client
+func (f *Fake) DevicesRevokeDeviceSessionBatchContext(ctx context.Context, arg *team.RevokeDeviceSessionBatchArg) (res *team.RevokeDeviceSessionBatchResult, err error) {
+	f.record("devices/revoke_device_session_batch", arg, nil)
+	if f.DevicesRevokeDeviceSessionBatchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.DevicesRevokeDeviceSessionBatchFunc(ctx, arg)
+}
+
+// FeaturesGetValues implements team.Client
+func (f *Fake) FeaturesGetValues(arg *team.FeaturesGetValuesBatchArg) (res *team.FeaturesGetValuesBatchResult, err error) {
+	return f.FeaturesGetValuesContext(context.Background(), arg)
+}
+
+// FeaturesGetValuesContext implements team.Client
+func (f *Fake) FeaturesGetValuesContext(ctx context.Context, arg *team.FeaturesGetValuesBatchArg) (res *team.FeaturesGetValuesBatchResult, err error) {
+	f.record("features/get_values", arg, nil)
+	if f.FeaturesGetValuesFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.FeaturesGetValuesFunc(ctx, arg)
+}
+
+// GetInfo implements team.Client
+func (f *Fake) GetInfo() (res *team.TeamGetInfoResult, err error) {
+	return f.GetInfoContext(context.Background())
+}
+
+// GetInfoContext implements team.Client
+func (f *Fake) GetInfoContext(ctx context.Context) (res *team.TeamGetInfoResult, err error) {
+	f.record("get_info", nil, nil)
+	if f.GetInfoFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetInfoFunc(ctx)
+}
+
+// GroupsCreate implements team.Client
+func (f *Fake) GroupsCreate(arg *team.GroupCreateArg) (res *team.GroupFullInfo, err error) {
+	return f.GroupsCreateContext(context.Background(), arg)
+}
+
+// GroupsCreateContext implements team.Client
+func (f *Fake) GroupsCreateContext(ctx context.Context, arg *team.GroupCreateArg) (res *team.GroupFullInfo, err error) {
+	f.record("groups/create", arg, nil)
+	if f.GroupsCreateFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsCreateFunc(ctx, arg)
+}
+
+// GroupsDelete implements team.Client
+func (f *Fake) GroupsDelete(arg *team.GroupSelector) (res *async.LaunchEmptyResult, err error) {
+	return f.GroupsDeleteContext(context.Background(), arg)
+}
+
+// GroupsDeleteContext implements team.Client
+func (f *Fake) GroupsDeleteContext(ctx context.Context, arg *team.GroupSelector) (res *async.LaunchEmptyResult, err error) {
+	f.record("groups/delete", arg, nil)
+	if f.GroupsDeleteFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsDeleteFunc(ctx, arg)
+}
+
+// GroupsGetInfo implements team.Client
+func (f *Fake) GroupsGetInfo(arg *team.GroupsSelector) (res []*team.GroupsGetInfoItem, err error) {
+	return f.GroupsGetInfoContext(context.Background(), arg)
+}
+
+// GroupsGetInfoContext implements team.Client
+func (f *Fake) GroupsGetInfoContext(ctx context.Context, arg *team.GroupsSelector) (res []*team.GroupsGetInfoItem, err error) {
+	f.record("groups/get_info", arg, nil)
+	if f.GroupsGetInfoFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsGetInfoFunc(ctx, arg)
+}
+
+// GroupsJobStatusGet implements team.Client
+func (f *Fake) GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	return f.GroupsJobStatusGetContext(context.Background(), arg)
+}
+
+// GroupsJobStatusGetContext implements team.Client
+func (f *Fake) GroupsJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	f.record("groups/job_status/get", arg, nil)
+	if f.GroupsJobStatusGetFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsJobStatusGetFunc(ctx, arg)
+}
+
+// GroupsList implements team.Client
+func (f *Fake) GroupsList(arg *team.GroupsListArg) (res *team.GroupsListResult, err error) {
+	return f.GroupsListContext(context.Background(), arg)
+}
+
+// GroupsListContext implements team.Client
+func (f *Fake) GroupsListContext(ctx context.Context, arg *team.GroupsListArg) (res *team.GroupsListResult, err error) {
+	f.record("groups/list", arg, nil)
+	if f.GroupsListFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsListFunc(ctx, arg)
+}
+
+// GroupsListContinue implements team.Client
+func (f *Fake) GroupsListContinue(arg *team.GroupsListContinueArg) (res *team.GroupsListResult, err error) {
+	return f.GroupsListContinueContext(context.Background(), arg)
+}
+
+// GroupsListContinueContext implements team.Client
+func (f *Fake) GroupsListContinueContext(ctx context.Context, arg *team.GroupsListContinueArg) (res *team.GroupsListResult, err error) {
+	f.record("groups/list/continue", arg, nil)
+	if f.GroupsListContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsListContinueFunc(ctx, arg)
+}
+
+// GroupsMembersAdd implements team.Client
+func (f *Fake) GroupsMembersAdd(arg *team.GroupMembersAddArg) (res *team.GroupMembersChangeResult, err error) {
+	return f.GroupsMembersAddContext(context.Background(), arg)
+}
+
+// GroupsMembersAddContext implements team.Client
+func (f *Fake) GroupsMembersAddContext(ctx context.Context, arg *team.GroupMembersAddArg) (res *team.GroupMembersChangeResult, err error) {
+	f.record("groups/members/add", arg, nil)
+	if f.GroupsMembersAddFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsMembersAddFunc(ctx, arg)
+}
+
+// GroupsMembersList implements team.Client
+func (f *Fake) GroupsMembersList(arg *team.GroupsMembersListArg) (res *team.GroupsMembersListResult, err error) {
+	return f.GroupsMembersListContext(context.Background(), arg)
+}
+
+// GroupsMembersListContext implements team.Client
+func (f *Fake) GroupsMembersListContext(ctx context.Context, arg *team.GroupsMembersListArg) (res *team.GroupsMembersListResult, err error) {
+	f.record("groups/members/list", arg, nil)
+	if f.GroupsMembersListFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsMembersListFunc(ctx, arg)
+}
+
+// GroupsMembersListContinue implements team.Client
+func (f *Fake) GroupsMembersListContinue(arg *team.GroupsMembersListContinueArg) (res *team.GroupsMembersListResult, err error) {
+	return f.GroupsMembersListContinueContext(context.Background(), arg)
+}
+
+// GroupsMembersListContinueContext implements team.Client
+func (f *Fake) GroupsMembersListContinueContext(ctx context.Context, arg *team.GroupsMembersListContinueArg) (res *team.GroupsMembersListResult, err error) {
+	f.record("groups/members/list/continue", arg, nil)
+	if f.GroupsMembersListContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsMembersListContinueFunc(ctx, arg)
+}
+
+// GroupsMembersRemove implements team.Client
+func (f *Fake) GroupsMembersRemove(arg *team.GroupMembersRemoveArg) (res *team.GroupMembersChangeResult, err error) {
+	return f.GroupsMembersRemoveContext(context.Background(), arg)
+}
+
+// GroupsMembersRemoveContext implements team.Client
+func (f *Fake) GroupsMembersRemoveContext(ctx context.Context, arg *team.GroupMembersRemoveArg) (res *team.GroupMembersChangeResult, err error) {
+	f.record("groups/members/remove", arg, nil)
+	if f.GroupsMembersRemoveFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsMembersRemoveFunc(ctx, arg)
+}
+
+// GroupsMembersSetAccessType implements team.Client
+func (f *Fake) GroupsMembersSetAccessType(arg *team.GroupMembersSetAccessTypeArg) (res []*team.GroupsGetInfoItem, err error) {
+	return f.GroupsMembersSetAccessTypeContext(context.Background(), arg)
+}
+
+// GroupsMembersSetAccessTypeContext implements team.Client
+func (f *Fake) GroupsMembersSetAccessTypeContext(ctx context.Context, arg *team.GroupMembersSetAccessTypeArg) (res []*team.GroupsGetInfoItem, err error) {
+	f.record("groups/members/set_access_type", arg, nil)
+	if f.GroupsMembersSetAccessTypeFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsMembersSetAccessTypeFunc(ctx, arg)
+}
+
+// GroupsUpdate implements team.Client
+func (f *Fake) GroupsUpdate(arg *team.GroupUpdateArgs) (res *team.GroupFullInfo, err error) {
+	return f.GroupsUpdateContext(context.Background(), arg)
+}
+
+// GroupsUpdateContext implements team.Client
+func (f *Fake) GroupsUpdateContext(ctx context.Context, arg *team.GroupUpdateArgs) (res *team.GroupFullInfo, err error) {
+	f.record("groups/update", arg, nil)
+	if f.GroupsUpdateFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GroupsUpdateFunc(ctx, arg)
+}
+
+// LinkedAppsListMemberLinkedApps implements team.Client
+func (f *Fake) LinkedAppsListMemberLinkedApps(arg *team.ListMemberAppsArg) (res *team.ListMemberAppsResult, err error) {
+	return f.LinkedAppsListMemberLinkedAppsContext(context.Background(), arg)
+}
+
+// LinkedAppsListMemberLinkedAppsContext implements team.Client
+func (f *Fake) LinkedAppsListMemberLinkedAppsContext(ctx context.Context, arg *team.ListMemberAppsArg) (res *team.ListMemberAppsResult, err error) {
+	f.record("linked_apps/list_member_linked_apps", arg, nil)
+	if f.LinkedAppsListMemberLinkedAppsFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.LinkedAppsListMemberLinkedAppsFunc(ctx, arg)
+}
+
+// LinkedAppsListMembersLinkedApps implements team.Client
+func (f *Fake) LinkedAppsListMembersLinkedApps(arg *team.ListMembersAppsArg) (res *team.ListMembersAppsResult, err error) {
+	return f.LinkedAppsListMembersLinkedAppsContext(context.Background(), arg)
+}
+
+// LinkedAppsListMembersLinkedAppsContext implements team.Client
+func (f *Fake) LinkedAppsListMembersLinkedAppsContext(ctx context.Context, arg *team.ListMembersAppsArg) (res *team.ListMembersAppsResult, err error) {
+	f.record("linked_apps/list_members_linked_apps", arg, nil)
+	if f.LinkedAppsListMembersLinkedAppsFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.LinkedAppsListMembersLinkedAppsFunc(ctx, arg)
+}
+
+// LinkedAppsListTeamLinkedApps implements team.Client
+func (f *Fake) LinkedAppsListTeamLinkedApps(arg *team.ListTeamAppsArg) (res *team.ListTeamAppsResult, err error) {
+	return f.LinkedAppsListTeamLinkedAppsContext(context.Background(), arg)
+}
+
+// LinkedAppsListTeamLinkedAppsContext implements team.Client
+func (f *Fake) LinkedAppsListTeamLinkedAppsContext(ctx context.Context, arg *team.ListTeamAppsArg) (res *team.ListTeamAppsResult, err error) {
+	f.record("linked_apps/list_team_linked_apps", arg, nil)
+	if f.LinkedAppsListTeamLinkedAppsFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.LinkedAppsListTeamLinkedAppsFunc(ctx, arg)
+}
+
+// LinkedAppsRevokeLinkedApp implements team.Client
+func (f *Fake) LinkedAppsRevokeLinkedApp(arg *team.RevokeLinkedApiAppArg) (err error) {
+	return f.LinkedAppsRevokeLinkedAppContext(context.Background(), arg)
+}
+
+// LinkedAppsRevokeLinkedAppContext implements team.Client
+func (f *Fake) LinkedAppsRevokeLinkedAppContext(ctx context.Context, arg *team.RevokeLinkedApiAppArg) (err error) {
+	f.record("linked_apps/revoke_linked_app", arg, nil)
+	if f.LinkedAppsRevokeLinkedAppFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.LinkedAppsRevokeLinkedAppFunc(ctx, arg)
+}
+
+// LinkedAppsRevokeLinkedAppBatch implements team.Client
+func (f *Fake) LinkedAppsRevokeLinkedAppBatch(arg *team.RevokeLinkedApiAppBatchArg) (res *team.RevokeLinkedAppBatchResult, err error) {
+	return f.LinkedAppsRevokeLinkedAppBatchContext(context.Background(), arg)
+}
+
+// LinkedAppsRevokeLinkedAppBatchContext implements team.Client
+func (f *Fake) LinkedAppsRevokeLinkedAppBatchContext(ctx context.Context, arg *team.RevokeLinkedApiAppBatchArg) (res *team.RevokeLinkedAppBatchResult, err error) {
+	f.record("linked_apps/revoke_linked_app_batch", arg, nil)
+	if f.LinkedAppsRevokeLinkedAppBatchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.LinkedAppsRevokeLinkedAppBatchFunc(ctx, arg)
+}
+
+// MembersAdd implements team.Client
+func (f *Fake) MembersAdd(arg *team.MembersAddArg) (res *team.MembersAddLaunch, err error) {
+	return f.MembersAddContext(context.Background(), arg)
+}
+
+// MembersAddContext implements team.Client
+func (f *Fake) MembersAddContext(ctx context.Context, arg *team.MembersAddArg) (res *team.MembersAddLaunch, err error) {
+	f.record("members/add", arg, nil)
+	if f.MembersAddFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersAddFunc(ctx, arg)
+}
+
+// MembersAddJobStatusGet implements team.Client
+func (f *Fake) MembersAddJobStatusGet(arg *async.PollArg) (res *team.MembersAddJobStatus, err error) {
+	return f.MembersAddJobStatusGetContext(context.Background(), arg)
+}
+
+// MembersAddJobStatusGetContext implements team.Client
+func (f *Fake) MembersAddJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *team.MembersAddJobStatus, err error) {
+	f.record("members/add/job_status/get", arg, nil)
+	if f.MembersAddJobStatusGetFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersAddJobStatusGetFunc(ctx, arg)
+}
+
+// MembersGetInfo implements team.Client
+func (f *Fake) MembersGetInfo(arg *team.MembersGetInfoArgs) (res []*team.MembersGetInfoItem, err error) {
+	return f.MembersGetInfoContext(context.Background(), arg)
+}
+
+// MembersGetInfoContext implements team.Client
+func (f *Fake) MembersGetInfoContext(ctx context.Context, arg *team.MembersGetInfoArgs) (res []*team.MembersGetInfoItem, err error) {
+	f.record("members/get_info", arg, nil)
+	if f.MembersGetInfoFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersGetInfoFunc(ctx, arg)
+}
+
+// MembersList implements team.Client
+func (f *Fake) MembersList(arg *team.MembersListArg) (res *team.MembersListResult, err error) {
+	return f.MembersListContext(context.Background(), arg)
+}
+
+// MembersListContext implements team.Client
+func (f *Fake) MembersListContext(ctx context.Context, arg *team.MembersListArg) (res *team.MembersListResult, err error) {
+	f.record("members/list", arg, nil)
+	if f.MembersListFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersListFunc(ctx, arg)
+}
+
+// MembersListContinue implements team.Client
+func (f *Fake) MembersListContinue(arg *team.MembersListContinueArg) (res *team.MembersListResult, err error) {
+	return f.MembersListContinueContext(context.Background(), arg)
+}
+
+// MembersListContinueContext implements team.Client
+func (f *Fake) MembersListContinueContext(ctx context.Context, arg *team.MembersListContinueArg) (res *team.MembersListResult, err error) {
+	f.record("members/list/continue", arg, nil)
+	if f.MembersListContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersListContinueFunc(ctx, arg)
+}
+
+// MembersRecover implements team.Client
+func (f *Fake) MembersRecover(arg *team.MembersRecoverArg) (err error) {
+	return f.MembersRecoverContext(context.Background(), arg)
+}
+
+// MembersRecoverContext implements team.Client
+func (f *Fake) MembersRecoverContext(ctx context.Context, arg *team.MembersRecoverArg) (err error) {
+	f.record("members/recover", arg, nil)
+	if f.MembersRecoverFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersRecoverFunc(ctx, arg)
+}
+
+// MembersRemove implements team.Client
+func (f *Fake) MembersRemove(arg *team.MembersRemoveArg) (res *async.LaunchEmptyResult, err error) {
+	return f.MembersRemoveContext(context.Background(), arg)
+}
+
+// MembersRemoveContext implements team.Client
+func (f *Fake) MembersRemoveContext(ctx context.Context, arg *team.MembersRemoveArg) (res *async.LaunchEmptyResult, err error) {
+	f.record("members/remove", arg, nil)
+	if f.MembersRemoveFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersRemoveFunc(ctx, arg)
+}
+
+// MembersRemoveJobStatusGet implements team.Client
+func (f *Fake) MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	return f.MembersRemoveJobStatusGetContext(context.Background(), arg)
+}
+
+// MembersRemoveJobStatusGetContext implements team.Client
+func (f *Fake) MembersRemoveJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	f.record("members/remove/job_status/get", arg, nil)
+	if f.MembersRemoveJobStatusGetFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersRemoveJobStatusGetFunc(ctx, arg)
+}
+
+// MembersSendWelcomeEmail implements team.Client
+func (f *Fake) MembersSendWelcomeEmail(arg *team.UserSelectorArg) (err error) {
+	return f.MembersSendWelcomeEmailContext(context.Background(), arg)
+}
+
+// MembersSendWelcomeEmailContext implements team.Client
+func (f *Fake) MembersSendWelcomeEmailContext(ctx context.Context, arg *team.UserSelectorArg) (err error) {
+	f.record("members/send_welcome_email", arg, nil)
+	if f.MembersSendWelcomeEmailFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersSendWelcomeEmailFunc(ctx, arg)
+}
+
+// MembersSetAdminPermissions implements team.Client
+func (f *Fake) MembersSetAdminPermissions(arg *team.MembersSetPermissionsArg) (res *team.MembersSetPermissionsResult, err error) {
+	return f.MembersSetAdminPermissionsContext(context.Background(), arg)
+}
+
+// MembersSetAdminPermissionsContext implements team.Client
+func (f *Fake) MembersSetAdminPermissionsContext(ctx context.Context, arg *team.MembersSetPermissionsArg) (res *team.MembersSetPermissionsResult, err error) {
+	f.record("members/set_admin_permissions", arg, nil)
+	if f.MembersSetAdminPermissionsFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersSetAdminPermissionsFunc(ctx, arg)
+}
+
+// MembersSetProfile implements team.Client
+func (f *Fake) MembersSetProfile(arg *team.MembersSetProfileArg) (res *team.TeamMemberInfo, err error) {
+	return f.MembersSetProfileContext(context.Background(), arg)
+}
+
+// MembersSetProfileContext implements team.Client
+func (f *Fake) MembersSetProfileContext(ctx context.Context, arg *team.MembersSetProfileArg) (res *team.TeamMemberInfo, err error) {
+	f.record("members/set_profile", arg, nil)
+	if f.MembersSetProfileFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersSetProfileFunc(ctx, arg)
+}
+
+// MembersSuspend implements team.Client
+func (f *Fake) MembersSuspend(arg *team.MembersDeactivateArg) (err error) {
+	return f.MembersSuspendContext(context.Background(), arg)
+}
+
+// MembersSuspendContext implements team.Client
+func (f *Fake) MembersSuspendContext(ctx context.Context, arg *team.MembersDeactivateArg) (err error) {
+	f.record("members/suspend", arg, nil)
+	if f.MembersSuspendFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersSuspendFunc(ctx, arg)
+}
+
+// MembersUnsuspend implements team.Client
+func (f *Fake) MembersUnsuspend(arg *team.MembersUnsuspendArg) (err error) {
+	return f.MembersUnsuspendContext(context.Background(), arg)
+}
+
+// MembersUnsuspendContext implements team.Client
+func (f *Fake) MembersUnsuspendContext(ctx context.Context, arg *team.MembersUnsuspendArg) (err error) {
+	f.record("members/unsuspend", arg, nil)
+	if f.MembersUnsuspendFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.MembersUnsuspendFunc(ctx, arg)
+}
+
+// PropertiesTemplateAdd implements team.Client
+func (f *Fake) PropertiesTemplateAdd(arg *team.AddPropertyTemplateArg) (res *team.AddPropertyTemplateResult, err error) {
+	return f.PropertiesTemplateAddContext(context.Background(), arg)
+}
+
+// PropertiesTemplateAddContext implements team.Client
+func (f *Fake) PropertiesTemplateAddContext(ctx context.Context, arg *team.AddPropertyTemplateArg) (res *team.AddPropertyTemplateResult, err error) {
+	f.record("properties/template/add", arg, nil)
+	if f.PropertiesTemplateAddFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesTemplateAddFunc(ctx, arg)
+}
+
+// PropertiesTemplateGet implements team.Client
+func (f *Fake) PropertiesTemplateGet(arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
+	return f.PropertiesTemplateGetContext(context.Background(), arg)
+}
+
+// PropertiesTemplateGetContext implements team.Client
+func (f *Fake) PropertiesTemplateGetContext(ctx context.Context, arg *properties.GetPropertyTemplateArg) (res *properties.GetPropertyTemplateResult, err error) {
+	f.record("properties/template/get", arg, nil)
+	if f.PropertiesTemplateGetFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesTemplateGetFunc(ctx, arg)
+}
+
+// PropertiesTemplateList implements team.Client
+func (f *Fake) PropertiesTemplateList() (res *properties.ListPropertyTemplateIds, err error) {
+	return f.PropertiesTemplateListContext(context.Background())
+}
+
+// PropertiesTemplateListContext implements team.Client
+func (f *Fake) PropertiesTemplateListContext(ctx context.Context) (res *properties.ListPropertyTemplateIds, err error) {
+	f.record("properties/template/list", nil, nil)
+	if f.PropertiesTemplateListFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesTemplateListFunc(ctx)
+}
+
+// PropertiesTemplateUpdate implements team.Client
+func (f *Fake) PropertiesTemplateUpdate(arg *team.UpdatePropertyTemplateArg) (res *team.UpdatePropertyTemplateResult, err error) {
+	return f.PropertiesTemplateUpdateContext(context.Background(), arg)
+}
+
+// PropertiesTemplateUpdateContext implements team.Client
+func (f *Fake) PropertiesTemplateUpdateContext(ctx context.Context, arg *team.UpdatePropertyTemplateArg) (res *team.UpdatePropertyTemplateResult, err error) {
+	f.record("properties/template/update", arg, nil)
+	if f.PropertiesTemplateUpdateFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.PropertiesTemplateUpdateFunc(ctx, arg)
+}
+
+// ReportsGetActivity implements team.Client
+func (f *Fake) ReportsGetActivity(arg *team.DateRange) (res *team.GetActivityReport, err error) {
+	return f.ReportsGetActivityContext(context.Background(), arg)
+}
+
+// ReportsGetActivityContext implements team.Client
+func (f *Fake) ReportsGetActivityContext(ctx context.Context, arg *team.DateRange) (res *team.GetActivityReport, err error) {
+	f.record("reports/get_activity", arg, nil)
+	if f.ReportsGetActivityFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ReportsGetActivityFunc(ctx, arg)
+}
+
+// ReportsGetDevices implements team.Client
+func (f *Fake) ReportsGetDevices(arg *team.DateRange) (res *team.GetDevicesReport, err error) {
+	return f.ReportsGetDevicesContext(context.Background(), arg)
+}
+
+// ReportsGetDevicesContext implements team.Client
+func (f *Fake) ReportsGetDevicesContext(ctx context.Context, arg *team.DateRange) (res *team.GetDevicesReport, err error) {
+	f.record("reports/get_devices", arg, nil)
+	if f.ReportsGetDevicesFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ReportsGetDevicesFunc(ctx, arg)
+}
+
+// ReportsGetMembership implements team.Client
+func (f *Fake) ReportsGetMembership(arg *team.DateRange) (res *team.GetMembershipReport, err error) {
+	return f.ReportsGetMembershipContext(context.Background(), arg)
+}
+
+// ReportsGetMembershipContext implements team.Client
+func (f *Fake) ReportsGetMembershipContext(ctx context.Context, arg *team.DateRange) (res *team.GetMembershipReport, err error) {
+	f.record("reports/get_membership", arg, nil)
+	if f.ReportsGetMembershipFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ReportsGetMembershipFunc(ctx, arg)
+}
+
+// ReportsGetStorage implements team.Client
+func (f *Fake) ReportsGetStorage(arg *team.DateRange) (res *team.GetStorageReport, err error) {
+	return f.ReportsGetStorageContext(context.Background(), arg)
+}
+
+// ReportsGetStorageContext implements team.Client
+func (f *Fake) ReportsGetStorageContext(ctx context.Context, arg *team.DateRange) (res *team.GetStorageReport, err error) {
+	f.record("reports/get_storage", arg, nil)
+	if f.ReportsGetStorageFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.ReportsGetStorageFunc(ctx, arg)
+}
+
+// TeamFolderActivate implements team.Client
+func (f *Fake) TeamFolderActivate(arg *team.TeamFolderIdArg) (res *team.TeamFolderMetadata, err error) {
+	return f.TeamFolderActivateContext(context.Background(), arg)
+}
+
+// TeamFolderActivateContext implements team.Client
+func (f *Fake) TeamFolderActivateContext(ctx context.Context, arg *team.TeamFolderIdArg) (res *team.TeamFolderMetadata, err error) {
+	f.record("team_folder/activate", arg, nil)
+	if f.TeamFolderActivateFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TeamFolderActivateFunc(ctx, arg)
+}
+
+// TeamFolderArchive implements team.Client
+func (f *Fake) TeamFolderArchive(arg *team.TeamFolderArchiveArg) (res *team.TeamFolderArchiveLaunch, err error) {
+	return f.TeamFolderArchiveContext(context.Background(), arg)
+}
+
+// TeamFolderArchiveContext implements team.Client
+func (f *Fake) TeamFolderArchiveContext(ctx context.Context, arg *team.TeamFolderArchiveArg) (res *team.TeamFolderArchiveLaunch, err error) {
+	f.record("team_folder/archive", arg, nil)
+	if f.TeamFolderArchiveFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TeamFolderArchiveFunc(ctx, arg)
+}
+
+// TeamFolderArchiveCheck implements team.Client
+func (f *Fake) TeamFolderArchiveCheck(arg *async.PollArg) (res *team.TeamFolderArchiveJobStatus, err error) {
+	return f.TeamFolderArchiveCheckContext(context.Background(), arg)
+}
+
+// TeamFolderArchiveCheckContext implements team.Client
+func (f *Fake) TeamFolderArchiveCheckContext(ctx context.Context, arg *async.PollArg) (res *team.TeamFolderArchiveJobStatus, err error) {
+	f.record("team_folder/archive/check", arg, nil)
+	if f.TeamFolderArchiveCheckFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TeamFolderArchiveCheckFunc(ctx, arg)
+}
+
+// TeamFolderCreate implements team.Client
+func (f *Fake) TeamFolderCreate(arg *team.TeamFolderCreateArg) (res *team.TeamFolderMetadata, err error) {
+	return f.TeamFolderCreateContext(context.Background(), arg)
+}
+
+// TeamFolderCreateContext implements team.Client
+func (f *Fake) TeamFolderCreateContext(ctx context.Context, arg *team.TeamFolderCreateArg) (res *team.TeamFolderMetadata, err error) {
+	f.record("team_folder/create", arg, nil)
+	if f.TeamFolderCreateFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TeamFolderCreateFunc(ctx, arg)
+}
+
+// TeamFolderGetInfo implements team.Client
+func (f *Fake) TeamFolderGetInfo(arg *team.TeamFolderIdListArg) (res []*team.TeamFolderGetInfoItem, err error) {
+	return f.TeamFolderGetInfoContext(context.Background(), arg)
+}
+
+// TeamFolderGetInfoContext implements team.Client
+func (f *Fake) TeamFolderGetInfoContext(ctx context.Context, arg *team.TeamFolderIdListArg) (res []*team.TeamFolderGetInfoItem, err error) {
+	f.record("team_folder/get_info", arg, nil)
+	if f.TeamFolderGetInfoFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TeamFolderGetInfoFunc(ctx, arg)
+}
+
+// TeamFolderList implements team.Client
+func (f *Fake) TeamFolderList(arg *team.TeamFolderListArg) (res *team.TeamFolderListResult, err error) {
+	return f.TeamFolderListContext(context.Background(), arg)
+}
+
+// TeamFolderListContext implements team.Client
+func (f *Fake) TeamFolderListContext(ctx context.Context, arg *team.TeamFolderListArg) (res *team.TeamFolderListResult, err error) {
+	f.record("team_folder/list", arg, nil)
+	if f.TeamFolderListFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TeamFolderListFunc(ctx, arg)
+}
+
+// TeamFolderListContinue implements team.Client
+func (f *Fake) TeamFolderListContinue(arg *team.TeamFolderListContinueArg) (res *team.TeamFolderListResult, err error) {
+	return f.TeamFolderListContinueContext(context.Background(), arg)
+}
+
+// TeamFolderListContinueContext implements team.Client
+func (f *Fake) TeamFolderListContinueContext(ctx context.Context, arg *team.TeamFolderListContinueArg) (res *team.TeamFolderListResult, err error) {
+	f.record("team_folder/list/continue", arg, nil)
+	if f.TeamFolderListContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TeamFolderListContinueFunc(ctx, arg)
+}
+
+// TeamFolderPermanentlyDelete implements team.Client
+func (f *Fake) TeamFolderPermanentlyDelete(arg *team.TeamFolderIdArg) (err error) {
+	return f.TeamFolderPermanentlyDeleteContext(context.Background(), arg)
+}
+
+// TeamFolderPermanentlyDeleteContext implements team.Client
+func (f *Fake) TeamFolderPermanentlyDeleteContext(ctx context.Context, arg *team.TeamFolderIdArg) (err error) {
+	f.record("team_folder/permanently_delete", arg, nil)
+	if f.TeamFolderPermanentlyDeleteFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TeamFolderPermanentlyDeleteFunc(ctx, arg)
+}
+
+// TeamFolderRename implements team.Client
+func (f *Fake) TeamFolderRename(arg *team.TeamFolderRenameArg) (res *team.TeamFolderMetadata, err error) {
+	return f.TeamFolderRenameContext(context.Background(), arg)
+}
+
+// TeamFolderRenameContext implements team.Client
+func (f *Fake) TeamFolderRenameContext(ctx context.Context, arg *team.TeamFolderRenameArg) (res *team.TeamFolderMetadata, err error) {
+	f.record("team_folder/rename", arg, nil)
+	if f.TeamFolderRenameFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TeamFolderRenameFunc(ctx, arg)
+}
+
+// TokenGetAuthenticatedAdmin implements team.Client
+func (f *Fake) TokenGetAuthenticatedAdmin() (res *team.TokenGetAuthenticatedAdminResult, err error) {
+	return f.TokenGetAuthenticatedAdminContext(context.Background())
+}
+
+// TokenGetAuthenticatedAdminContext implements team.Client
+func (f *Fake) TokenGetAuthenticatedAdminContext(ctx context.Context) (res *team.TokenGetAuthenticatedAdminResult, err error) {
+	f.record("token/get_authenticated_admin", nil, nil)
+	if f.TokenGetAuthenticatedAdminFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.TokenGetAuthenticatedAdminFunc(ctx)
+}
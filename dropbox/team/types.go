@@ -23,6 +23,7 @@ package team
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
@@ -45,6 +46,8 @@ type DeviceSession struct {
 	Created time.Time `json:"created,omitempty"`
 	// Updated : The time of the last activity from this session
 	Updated time.Time `json:"updated,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceSession returns a new DeviceSession instance
@@ -54,6 +57,16 @@ func NewDeviceSession(SessionId string) *DeviceSession {
 	return s
 }
 
+// MarshalJSON serializes a DeviceSession instance
+func (u DeviceSession) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceSession instance
+func (u *DeviceSession) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ActiveWebSession : Information on active web sessions
 type ActiveWebSession struct {
 	DeviceSession
@@ -65,6 +78,8 @@ type ActiveWebSession struct {
 	Browser string `json:"browser"`
 	// Expires : The time this session expires
 	Expires time.Time `json:"expires,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewActiveWebSession returns a new ActiveWebSession instance
@@ -77,9 +92,21 @@ func NewActiveWebSession(SessionId string, UserAgent string, Os string, Browser
 	return s
 }
 
+// MarshalJSON serializes a ActiveWebSession instance
+func (u ActiveWebSession) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ActiveWebSession instance
+func (u *ActiveWebSession) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // AddPropertyTemplateArg : Arguments for adding property templates.
 type AddPropertyTemplateArg struct {
 	properties.PropertyGroupTemplate
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAddPropertyTemplateArg returns a new AddPropertyTemplateArg instance
@@ -91,11 +118,28 @@ func NewAddPropertyTemplateArg(Name string, Description string, Fields []*proper
 	return s
 }
 
+// MarshalJSON serializes a AddPropertyTemplateArg instance
+func (u AddPropertyTemplateArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AddPropertyTemplateArg instance
+func (u *AddPropertyTemplateArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *AddPropertyTemplateArg) Validate() error {
+	return nil
+}
+
 // AddPropertyTemplateResult : has no documentation (yet)
 type AddPropertyTemplateResult struct {
 	// TemplateId : An identifier for property template added by
 	// `propertiesTemplateAdd`.
 	TemplateId string `json:"template_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAddPropertyTemplateResult returns a new AddPropertyTemplateResult instance
@@ -105,9 +149,30 @@ func NewAddPropertyTemplateResult(TemplateId string) *AddPropertyTemplateResult
 	return s
 }
 
+// MarshalJSON serializes a AddPropertyTemplateResult instance
+func (u AddPropertyTemplateResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AddPropertyTemplateResult instance
+func (u *AddPropertyTemplateResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // AdminTier : Describes which team-related admin permissions a user has.
 type AdminTier struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AdminTier) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AdminTier
@@ -118,6 +183,32 @@ const (
 	AdminTierMemberOnly          = "member_only"
 )
 
+// UnmarshalJSON deserializes into a AdminTier instance
+func (u *AdminTier) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "team_admin", "user_management_admin", "support_admin", "member_only":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a AdminTier instance
+func (u AdminTier) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ApiApp : Information on linked third party applications
 type ApiApp struct {
 	// AppId : The application unique id
@@ -132,6 +223,8 @@ type ApiApp struct {
 	Linked time.Time `json:"linked,omitempty"`
 	// IsAppFolder : Whether the linked application uses a dedicated folder
 	IsAppFolder bool `json:"is_app_folder"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewApiApp returns a new ApiApp instance
@@ -143,10 +236,22 @@ func NewApiApp(AppId string, AppName string, IsAppFolder bool) *ApiApp {
 	return s
 }
 
+// MarshalJSON serializes a ApiApp instance
+func (u ApiApp) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ApiApp instance
+func (u *ApiApp) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // BaseDfbReport : Base report structure.
 type BaseDfbReport struct {
 	// StartDate : First date present in the results as 'YYYY-MM-DD' or None.
 	StartDate string `json:"start_date"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewBaseDfbReport returns a new BaseDfbReport instance
@@ -156,6 +261,16 @@ func NewBaseDfbReport(StartDate string) *BaseDfbReport {
 	return s
 }
 
+// MarshalJSON serializes a BaseDfbReport instance
+func (u BaseDfbReport) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a BaseDfbReport instance
+func (u *BaseDfbReport) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // BaseTeamFolderError : Base error that all errors for existing team folders
 // should extend.
 type BaseTeamFolderError struct {
@@ -164,6 +279,17 @@ type BaseTeamFolderError struct {
 	AccessError *TeamFolderAccessError `json:"access_error,omitempty"`
 	// StatusError : has no documentation (yet)
 	StatusError *TeamFolderInvalidStatusError `json:"status_error,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u BaseTeamFolderError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for BaseTeamFolderError
@@ -187,7 +313,7 @@ func (u *BaseTeamFolderError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "access_error":
 		err = json.Unmarshal(w.AccessError, &u.AccessError)
@@ -201,16 +327,49 @@ func (u *BaseTeamFolderError) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a BaseTeamFolderError instance
+func (u BaseTeamFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *TeamFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+
+	case "status_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			StatusError *TeamFolderInvalidStatusError `json:"status_error"`
+		}{u.Tagged, u.StatusError})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsBaseTeamFolderError reports whether err carries a BaseTeamFolderError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsBaseTeamFolderError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*BaseTeamFolderError)(nil), tags...)
+}
+
 // DateRange : Input arguments that can be provided for most reports.
 type DateRange struct {
 	// StartDate : Optional starting date (inclusive)
 	StartDate time.Time `json:"start_date,omitempty"`
 	// EndDate : Optional ending date (exclusive)
 	EndDate time.Time `json:"end_date,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDateRange returns a new DateRange instance
@@ -219,10 +378,36 @@ func NewDateRange() *DateRange {
 	return s
 }
 
+// MarshalJSON serializes a DateRange instance
+func (u DateRange) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DateRange instance
+func (u *DateRange) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *DateRange) Validate() error {
+	return nil
+}
+
 // DateRangeError : Errors that can originate from problems in input arguments
 // to reports.
 type DateRangeError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DateRangeError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DateRangeError
@@ -230,6 +415,39 @@ const (
 	DateRangeErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a DateRangeError instance
+func (u *DateRangeError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a DateRangeError instance
+func (u DateRangeError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsDateRangeError reports whether err carries a DateRangeError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsDateRangeError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*DateRangeError)(nil), tags...)
+}
+
 // DesktopClientSession : Information about linked Dropbox desktop client
 // sessions
 type DesktopClientSession struct {
@@ -245,6 +463,8 @@ type DesktopClientSession struct {
 	// IsDeleteOnUnlinkSupported : Whether it's possible to delete all of the
 	// account files upon unlinking
 	IsDeleteOnUnlinkSupported bool `json:"is_delete_on_unlink_supported"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDesktopClientSession returns a new DesktopClientSession instance
@@ -259,9 +479,30 @@ func NewDesktopClientSession(SessionId string, HostName string, ClientType *Desk
 	return s
 }
 
+// MarshalJSON serializes a DesktopClientSession instance
+func (u DesktopClientSession) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DesktopClientSession instance
+func (u *DesktopClientSession) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DesktopPlatform : has no documentation (yet)
 type DesktopPlatform struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DesktopPlatform) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DesktopPlatform
@@ -272,12 +513,40 @@ const (
 	DesktopPlatformOther   = "other"
 )
 
+// UnmarshalJSON deserializes into a DesktopPlatform instance
+func (u *DesktopPlatform) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "windows", "mac", "linux", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a DesktopPlatform instance
+func (u DesktopPlatform) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // DeviceSessionArg : has no documentation (yet)
 type DeviceSessionArg struct {
 	// SessionId : The session id
 	SessionId string `json:"session_id"`
 	// TeamMemberId : The unique id of the member owning the device
 	TeamMemberId string `json:"team_member_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceSessionArg returns a new DeviceSessionArg instance
@@ -288,6 +557,16 @@ func NewDeviceSessionArg(SessionId string, TeamMemberId string) *DeviceSessionAr
 	return s
 }
 
+// MarshalJSON serializes a DeviceSessionArg instance
+func (u DeviceSessionArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceSessionArg instance
+func (u *DeviceSessionArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DevicesActive : Each of the items is an array of values, one value per day.
 // The value is the number of devices active within a time window, ending with
 // that day. If there is no data for a day, then the value will be None.
@@ -308,6 +587,8 @@ type DevicesActive struct {
 	Other []uint64 `json:"other"`
 	// Total : Array of total number of linked clients with activity.
 	Total []uint64 `json:"total"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDevicesActive returns a new DevicesActive instance
@@ -323,9 +604,30 @@ func NewDevicesActive(Windows []uint64, Macos []uint64, Linux []uint64, Ios []ui
 	return s
 }
 
+// MarshalJSON serializes a DevicesActive instance
+func (u DevicesActive) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DevicesActive instance
+func (u *DevicesActive) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // Feature : A set of features that Dropbox for Business account support.
 type Feature struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u Feature) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for Feature
@@ -334,12 +636,49 @@ const (
 	FeatureOther              = "other"
 )
 
+// UnmarshalJSON deserializes into a Feature instance
+func (u *Feature) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "upload_api_rate_limit", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a Feature instance
+func (u Feature) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // FeatureValue : The values correspond to entries in `Feature`. You may get
 // different value according to your Dropbox for Business plan.
 type FeatureValue struct {
 	dropbox.Tagged
 	// UploadApiRateLimit : has no documentation (yet)
 	UploadApiRateLimit *UploadApiRateLimitValue `json:"upload_api_rate_limit,omitempty"`
+	raw                string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FeatureValue) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FeatureValue
@@ -360,7 +699,7 @@ func (u *FeatureValue) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "upload_api_rate_limit":
 		err = json.Unmarshal(w.UploadApiRateLimit, &u.UploadApiRateLimit)
@@ -368,15 +707,35 @@ func (u *FeatureValue) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a FeatureValue instance
+func (u FeatureValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "upload_api_rate_limit":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UploadApiRateLimit *UploadApiRateLimitValue `json:"upload_api_rate_limit"`
+		}{u.Tagged, u.UploadApiRateLimit})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // FeaturesGetValuesBatchArg : has no documentation (yet)
 type FeaturesGetValuesBatchArg struct {
 	// Features : A list of features in `Feature`. If the list is empty, this
 	// route will return `FeaturesGetValuesBatchError`.
 	Features []*Feature `json:"features"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFeaturesGetValuesBatchArg returns a new FeaturesGetValuesBatchArg instance
@@ -386,9 +745,35 @@ func NewFeaturesGetValuesBatchArg(Features []*Feature) *FeaturesGetValuesBatchAr
 	return s
 }
 
+// MarshalJSON serializes a FeaturesGetValuesBatchArg instance
+func (u FeaturesGetValuesBatchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FeaturesGetValuesBatchArg instance
+func (u *FeaturesGetValuesBatchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *FeaturesGetValuesBatchArg) Validate() error {
+	return nil
+}
+
 // FeaturesGetValuesBatchError : has no documentation (yet)
 type FeaturesGetValuesBatchError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u FeaturesGetValuesBatchError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for FeaturesGetValuesBatchError
@@ -397,10 +782,45 @@ const (
 	FeaturesGetValuesBatchErrorOther             = "other"
 )
 
+// UnmarshalJSON deserializes into a FeaturesGetValuesBatchError instance
+func (u *FeaturesGetValuesBatchError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "empty_features_list", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a FeaturesGetValuesBatchError instance
+func (u FeaturesGetValuesBatchError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsFeaturesGetValuesBatchError reports whether err carries a FeaturesGetValuesBatchError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsFeaturesGetValuesBatchError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*FeaturesGetValuesBatchError)(nil), tags...)
+}
+
 // FeaturesGetValuesBatchResult : has no documentation (yet)
 type FeaturesGetValuesBatchResult struct {
 	// Values : has no documentation (yet)
 	Values []*FeatureValue `json:"values"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFeaturesGetValuesBatchResult returns a new FeaturesGetValuesBatchResult instance
@@ -410,6 +830,16 @@ func NewFeaturesGetValuesBatchResult(Values []*FeatureValue) *FeaturesGetValuesB
 	return s
 }
 
+// MarshalJSON serializes a FeaturesGetValuesBatchResult instance
+func (u FeaturesGetValuesBatchResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FeaturesGetValuesBatchResult instance
+func (u *FeaturesGetValuesBatchResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetActivityReport : Activity Report Result. Each of the items in the storage
 // report is an array of values, one value per day. If there is no data for a
 // day, then the value will be None.
@@ -454,6 +884,8 @@ type GetActivityReport struct {
 	// SharedLinksViewedTotal : Array of the total number of views to shared
 	// links created by the team.
 	SharedLinksViewedTotal []uint64 `json:"shared_links_viewed_total"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetActivityReport returns a new GetActivityReport instance
@@ -477,6 +909,16 @@ func NewGetActivityReport(StartDate string, Adds []uint64, Edits []uint64, Delet
 	return s
 }
 
+// MarshalJSON serializes a GetActivityReport instance
+func (u GetActivityReport) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetActivityReport instance
+func (u *GetActivityReport) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetDevicesReport : Devices Report Result. Contains subsections for different
 // time ranges of activity. Each of the items in each subsection of the storage
 // report is an array of values, one value per day. If there is no data for a
@@ -489,6 +931,8 @@ type GetDevicesReport struct {
 	Active7Day *DevicesActive `json:"active_7_day"`
 	// Active28Day : Report of the number of devices active in the last 28 days.
 	Active28Day *DevicesActive `json:"active_28_day"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetDevicesReport returns a new GetDevicesReport instance
@@ -501,6 +945,16 @@ func NewGetDevicesReport(StartDate string, Active1Day *DevicesActive, Active7Day
 	return s
 }
 
+// MarshalJSON serializes a GetDevicesReport instance
+func (u GetDevicesReport) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetDevicesReport instance
+func (u *GetDevicesReport) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetMembershipReport : Membership Report Result. Each of the items in the
 // storage report is an array of values, one value per day. If there is no data
 // for a day, then the value will be None.
@@ -516,6 +970,8 @@ type GetMembershipReport struct {
 	SuspendedMembers []uint64 `json:"suspended_members"`
 	// Licenses : The total number of licenses the team has, for each day.
 	Licenses []uint64 `json:"licenses"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetMembershipReport returns a new GetMembershipReport instance
@@ -530,6 +986,16 @@ func NewGetMembershipReport(StartDate string, TeamSize []uint64, PendingInvites
 	return s
 }
 
+// MarshalJSON serializes a GetMembershipReport instance
+func (u GetMembershipReport) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetMembershipReport instance
+func (u *GetMembershipReport) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetStorageReport : Storage Report Result. Each of the items in the storage
 // report is an array of values, one value per day. If there is no data for a
 // day, then the value will be None.
@@ -554,6 +1020,8 @@ type GetStorageReport struct {
 	// such summary per day. If there is no data for a day, the storage summary
 	// will be empty.
 	MemberStorageMap [][]*StorageBucket `json:"member_storage_map"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetStorageReport returns a new GetStorageReport instance
@@ -568,9 +1036,30 @@ func NewGetStorageReport(StartDate string, TotalUsage []uint64, SharedUsage []ui
 	return s
 }
 
+// MarshalJSON serializes a GetStorageReport instance
+func (u GetStorageReport) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetStorageReport instance
+func (u *GetStorageReport) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupAccessType : Role of a user in group.
 type GroupAccessType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupAccessType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupAccessType
@@ -579,6 +1068,32 @@ const (
 	GroupAccessTypeOwner  = "owner"
 )
 
+// UnmarshalJSON deserializes into a GroupAccessType instance
+func (u *GroupAccessType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "member", "owner":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupAccessType instance
+func (u GroupAccessType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // GroupCreateArg : has no documentation (yet)
 type GroupCreateArg struct {
 	// GroupName : Group name.
@@ -589,6 +1104,8 @@ type GroupCreateArg struct {
 	// GroupManagementType : Whether the team can be managed by selected users,
 	// or only by team admins.
 	GroupManagementType *team_common.GroupManagementType `json:"group_management_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupCreateArg returns a new GroupCreateArg instance
@@ -598,9 +1115,35 @@ func NewGroupCreateArg(GroupName string) *GroupCreateArg {
 	return s
 }
 
+// MarshalJSON serializes a GroupCreateArg instance
+func (u GroupCreateArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupCreateArg instance
+func (u *GroupCreateArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupCreateArg) Validate() error {
+	return nil
+}
+
 // GroupCreateError : has no documentation (yet)
 type GroupCreateError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupCreateError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupCreateError
@@ -612,9 +1155,53 @@ const (
 	GroupCreateErrorOther                        = "other"
 )
 
+// UnmarshalJSON deserializes into a GroupCreateError instance
+func (u *GroupCreateError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "group_name_already_used", "group_name_invalid", "external_id_already_in_use", "system_managed_group_disallowed", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupCreateError instance
+func (u GroupCreateError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupCreateError reports whether err carries a GroupCreateError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupCreateError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupCreateError)(nil), tags...)
+}
+
 // GroupSelectorError : Error that can be raised when `GroupSelector` is used.
 type GroupSelectorError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupSelectorError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupSelectorError
@@ -623,20 +1210,108 @@ const (
 	GroupSelectorErrorOther         = "other"
 )
 
-// GroupSelectorWithTeamGroupError : Error that can be raised when
-// `GroupSelector` is used and team groups are disallowed from being used.
-type GroupSelectorWithTeamGroupError struct {
-	dropbox.Tagged
-}
-
-// Valid tag values for GroupSelectorWithTeamGroupError
-const (
-	GroupSelectorWithTeamGroupErrorSystemManagedGroupDisallowed = "system_managed_group_disallowed"
-)
+// UnmarshalJSON deserializes into a GroupSelectorError instance
+func (u *GroupSelectorError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "group_not_found", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupSelectorError instance
+func (u GroupSelectorError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupSelectorError reports whether err carries a GroupSelectorError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupSelectorError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupSelectorError)(nil), tags...)
+}
+
+// GroupSelectorWithTeamGroupError : Error that can be raised when
+// `GroupSelector` is used and team groups are disallowed from being used.
+type GroupSelectorWithTeamGroupError struct {
+	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupSelectorWithTeamGroupError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
+}
+
+// Valid tag values for GroupSelectorWithTeamGroupError
+const (
+	GroupSelectorWithTeamGroupErrorSystemManagedGroupDisallowed = "system_managed_group_disallowed"
+)
+
+// UnmarshalJSON deserializes into a GroupSelectorWithTeamGroupError instance
+func (u *GroupSelectorWithTeamGroupError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "system_managed_group_disallowed":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupSelectorWithTeamGroupError instance
+func (u GroupSelectorWithTeamGroupError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupSelectorWithTeamGroupError reports whether err carries a GroupSelectorWithTeamGroupError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupSelectorWithTeamGroupError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupSelectorWithTeamGroupError)(nil), tags...)
+}
 
 // GroupDeleteError : has no documentation (yet)
 type GroupDeleteError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupDeleteError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupDeleteError
@@ -644,6 +1319,39 @@ const (
 	GroupDeleteErrorGroupAlreadyDeleted = "group_already_deleted"
 )
 
+// UnmarshalJSON deserializes into a GroupDeleteError instance
+func (u *GroupDeleteError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "group_already_deleted":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupDeleteError instance
+func (u GroupDeleteError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupDeleteError reports whether err carries a GroupDeleteError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupDeleteError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupDeleteError)(nil), tags...)
+}
+
 // GroupFullInfo : Full description of a group.
 type GroupFullInfo struct {
 	team_common.GroupSummary
@@ -652,6 +1360,8 @@ type GroupFullInfo struct {
 	// Created : The group creation time as a UTC timestamp in milliseconds
 	// since the Unix epoch.
 	Created uint64 `json:"created"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupFullInfo returns a new GroupFullInfo instance
@@ -664,12 +1374,24 @@ func NewGroupFullInfo(GroupName string, GroupId string, GroupManagementType *tea
 	return s
 }
 
+// MarshalJSON serializes a GroupFullInfo instance
+func (u GroupFullInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupFullInfo instance
+func (u *GroupFullInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupMemberInfo : Profile of group member, and role in group.
 type GroupMemberInfo struct {
 	// Profile : Profile of group member.
 	Profile *MemberProfile `json:"profile"`
 	// AccessType : The role that the user has in the group.
 	AccessType *GroupAccessType `json:"access_type"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupMemberInfo returns a new GroupMemberInfo instance
@@ -680,12 +1402,24 @@ func NewGroupMemberInfo(Profile *MemberProfile, AccessType *GroupAccessType) *Gr
 	return s
 }
 
+// MarshalJSON serializes a GroupMemberInfo instance
+func (u GroupMemberInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupMemberInfo instance
+func (u *GroupMemberInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupMemberSelector : Argument for selecting a group and a single user.
 type GroupMemberSelector struct {
 	// Group : Specify a group.
 	Group *GroupSelector `json:"group"`
 	// User : Identity of a user that is a member of `group`.
 	User *UserSelectorArg `json:"user"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupMemberSelector returns a new GroupMemberSelector instance
@@ -696,11 +1430,50 @@ func NewGroupMemberSelector(Group *GroupSelector, User *UserSelectorArg) *GroupM
 	return s
 }
 
+// MarshalJSON serializes a GroupMemberSelector instance
+func (u GroupMemberSelector) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupMemberSelector instance
+func (u *GroupMemberSelector) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupMemberSelector) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Group != nil {
+		if err := s.Group.Validate(); err != nil {
+			return dropbox.NestValidationError("group", err)
+		}
+	}
+	if s.User != nil {
+		if err := s.User.Validate(); err != nil {
+			return dropbox.NestValidationError("user", err)
+		}
+	}
+	return nil
+}
+
 // GroupMemberSelectorError : Error that can be raised when
 // `GroupMemberSelector` is used, and the user is required to be a member of the
 // specified group.
 type GroupMemberSelectorError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupMemberSelectorError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupMemberSelectorError
@@ -708,9 +1481,53 @@ const (
 	GroupMemberSelectorErrorMemberNotInGroup = "member_not_in_group"
 )
 
+// UnmarshalJSON deserializes into a GroupMemberSelectorError instance
+func (u *GroupMemberSelectorError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "member_not_in_group":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupMemberSelectorError instance
+func (u GroupMemberSelectorError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupMemberSelectorError reports whether err carries a GroupMemberSelectorError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupMemberSelectorError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupMemberSelectorError)(nil), tags...)
+}
+
 // GroupMemberSetAccessTypeError : has no documentation (yet)
 type GroupMemberSetAccessTypeError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupMemberSetAccessTypeError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupMemberSetAccessTypeError
@@ -718,12 +1535,47 @@ const (
 	GroupMemberSetAccessTypeErrorUserCannotBeManagerOfCompanyManagedGroup = "user_cannot_be_manager_of_company_managed_group"
 )
 
+// UnmarshalJSON deserializes into a GroupMemberSetAccessTypeError instance
+func (u *GroupMemberSetAccessTypeError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "user_cannot_be_manager_of_company_managed_group":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupMemberSetAccessTypeError instance
+func (u GroupMemberSetAccessTypeError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupMemberSetAccessTypeError reports whether err carries a GroupMemberSetAccessTypeError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupMemberSetAccessTypeError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupMemberSetAccessTypeError)(nil), tags...)
+}
+
 // IncludeMembersArg : has no documentation (yet)
 type IncludeMembersArg struct {
 	// ReturnMembers : Whether to return the list of members in the group.  Note
 	// that the default value will cause all the group members  to be returned
 	// in the response. This may take a long time for large groups.
 	ReturnMembers bool `json:"return_members"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewIncludeMembersArg returns a new IncludeMembersArg instance
@@ -733,6 +1585,16 @@ func NewIncludeMembersArg() *IncludeMembersArg {
 	return s
 }
 
+// MarshalJSON serializes a IncludeMembersArg instance
+func (u IncludeMembersArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a IncludeMembersArg instance
+func (u *IncludeMembersArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupMembersAddArg : has no documentation (yet)
 type GroupMembersAddArg struct {
 	IncludeMembersArg
@@ -740,6 +1602,8 @@ type GroupMembersAddArg struct {
 	Group *GroupSelector `json:"group"`
 	// Members : List of users to be added to the group.
 	Members []*MemberAccess `json:"members"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupMembersAddArg returns a new GroupMembersAddArg instance
@@ -751,6 +1615,36 @@ func NewGroupMembersAddArg(Group *GroupSelector, Members []*MemberAccess) *Group
 	return s
 }
 
+// MarshalJSON serializes a GroupMembersAddArg instance
+func (u GroupMembersAddArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupMembersAddArg instance
+func (u *GroupMembersAddArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupMembersAddArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Group != nil {
+		if err := s.Group.Validate(); err != nil {
+			return dropbox.NestValidationError("group", err)
+		}
+	}
+	for i, v := range s.Members {
+		if v != nil {
+			if err := v.Validate(); err != nil {
+				return dropbox.NestValidationError(fmt.Sprintf("members[%d]", i), err)
+			}
+		}
+	}
+	return nil
+}
+
 // GroupMembersAddError : has no documentation (yet)
 type GroupMembersAddError struct {
 	dropbox.Tagged
@@ -764,6 +1658,17 @@ type GroupMembersAddError struct {
 	// UserCannotBeManagerOfCompanyManagedGroup : A company-managed group cannot
 	// be managed by a user.
 	UserCannotBeManagerOfCompanyManagedGroup []string `json:"user_cannot_be_manager_of_company_managed_group,omitempty"`
+	raw                                      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupMembersAddError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupMembersAddError
@@ -796,30 +1701,67 @@ func (u *GroupMembersAddError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "members_not_in_team":
-		err = json.Unmarshal(body, &u.MembersNotInTeam)
+		err = json.Unmarshal(w.MembersNotInTeam, &u.MembersNotInTeam)
 
 		if err != nil {
 			return err
 		}
 	case "users_not_found":
-		err = json.Unmarshal(body, &u.UsersNotFound)
+		err = json.Unmarshal(w.UsersNotFound, &u.UsersNotFound)
 
 		if err != nil {
 			return err
 		}
 	case "user_cannot_be_manager_of_company_managed_group":
-		err = json.Unmarshal(body, &u.UserCannotBeManagerOfCompanyManagedGroup)
+		err = json.Unmarshal(w.UserCannotBeManagerOfCompanyManagedGroup, &u.UserCannotBeManagerOfCompanyManagedGroup)
 
 		if err != nil {
 			return err
 		}
+	case "duplicate_user", "group_not_in_team", "user_must_be_active_to_be_owner":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GroupMembersAddError instance
+func (u GroupMembersAddError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "members_not_in_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MembersNotInTeam []string `json:"members_not_in_team"`
+		}{u.Tagged, u.MembersNotInTeam})
+
+	case "users_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UsersNotFound []string `json:"users_not_found"`
+		}{u.Tagged, u.UsersNotFound})
+
+	case "user_cannot_be_manager_of_company_managed_group":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserCannotBeManagerOfCompanyManagedGroup []string `json:"user_cannot_be_manager_of_company_managed_group"`
+		}{u.Tagged, u.UserCannotBeManagerOfCompanyManagedGroup})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupMembersAddError reports whether err carries a GroupMembersAddError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupMembersAddError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupMembersAddError)(nil), tags...)
+}
+
 // GroupMembersChangeResult : Result returned by `groupsMembersAdd` and
 // `groupsMembersRemove`.
 type GroupMembersChangeResult struct {
@@ -829,6 +1771,8 @@ type GroupMembersChangeResult struct {
 	// AsyncJobId : An ID that can be used to obtain the status of
 	// granting/revoking group-owned resources.
 	AsyncJobId string `json:"async_job_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupMembersChangeResult returns a new GroupMembersChangeResult instance
@@ -839,6 +1783,16 @@ func NewGroupMembersChangeResult(GroupInfo *GroupFullInfo, AsyncJobId string) *G
 	return s
 }
 
+// MarshalJSON serializes a GroupMembersChangeResult instance
+func (u GroupMembersChangeResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupMembersChangeResult instance
+func (u *GroupMembersChangeResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupMembersRemoveArg : has no documentation (yet)
 type GroupMembersRemoveArg struct {
 	IncludeMembersArg
@@ -846,6 +1800,8 @@ type GroupMembersRemoveArg struct {
 	Group *GroupSelector `json:"group"`
 	// Users : List of users to be removed from the group.
 	Users []*UserSelectorArg `json:"users"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupMembersRemoveArg returns a new GroupMembersRemoveArg instance
@@ -857,11 +1813,52 @@ func NewGroupMembersRemoveArg(Group *GroupSelector, Users []*UserSelectorArg) *G
 	return s
 }
 
+// MarshalJSON serializes a GroupMembersRemoveArg instance
+func (u GroupMembersRemoveArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupMembersRemoveArg instance
+func (u *GroupMembersRemoveArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupMembersRemoveArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Group != nil {
+		if err := s.Group.Validate(); err != nil {
+			return dropbox.NestValidationError("group", err)
+		}
+	}
+	for i, v := range s.Users {
+		if v != nil {
+			if err := v.Validate(); err != nil {
+				return dropbox.NestValidationError(fmt.Sprintf("users[%d]", i), err)
+			}
+		}
+	}
+	return nil
+}
+
 // GroupMembersSelectorError : Error that can be raised when
 // `GroupMembersSelector` is used, and the users are required to be members of
 // the specified group.
 type GroupMembersSelectorError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupMembersSelectorError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupMembersSelectorError
@@ -869,6 +1866,39 @@ const (
 	GroupMembersSelectorErrorMemberNotInGroup = "member_not_in_group"
 )
 
+// UnmarshalJSON deserializes into a GroupMembersSelectorError instance
+func (u *GroupMembersSelectorError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "member_not_in_group":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupMembersSelectorError instance
+func (u GroupMembersSelectorError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupMembersSelectorError reports whether err carries a GroupMembersSelectorError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupMembersSelectorError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupMembersSelectorError)(nil), tags...)
+}
+
 // GroupMembersRemoveError : has no documentation (yet)
 type GroupMembersRemoveError struct {
 	dropbox.Tagged
@@ -876,6 +1906,17 @@ type GroupMembersRemoveError struct {
 	MembersNotInTeam []string `json:"members_not_in_team,omitempty"`
 	// UsersNotFound : These users were not found in Dropbox.
 	UsersNotFound []string `json:"users_not_found,omitempty"`
+	raw           string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupMembersRemoveError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupMembersRemoveError
@@ -899,30 +1940,63 @@ func (u *GroupMembersRemoveError) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "members_not_in_team":
-		err = json.Unmarshal(body, &u.MembersNotInTeam)
+		err = json.Unmarshal(w.MembersNotInTeam, &u.MembersNotInTeam)
 
 		if err != nil {
 			return err
 		}
 	case "users_not_found":
-		err = json.Unmarshal(body, &u.UsersNotFound)
+		err = json.Unmarshal(w.UsersNotFound, &u.UsersNotFound)
 
 		if err != nil {
 			return err
 		}
+	case "group_not_in_team":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GroupMembersRemoveError instance
+func (u GroupMembersRemoveError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "members_not_in_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MembersNotInTeam []string `json:"members_not_in_team"`
+		}{u.Tagged, u.MembersNotInTeam})
+
+	case "users_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UsersNotFound []string `json:"users_not_found"`
+		}{u.Tagged, u.UsersNotFound})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupMembersRemoveError reports whether err carries a GroupMembersRemoveError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupMembersRemoveError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupMembersRemoveError)(nil), tags...)
+}
+
 // GroupMembersSelector : Argument for selecting a group and a list of users.
 type GroupMembersSelector struct {
 	// Group : Specify a group.
 	Group *GroupSelector `json:"group"`
 	// Users : A list of users that are members of `group`.
 	Users *UsersSelectorArg `json:"users"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupMembersSelector returns a new GroupMembersSelector instance
@@ -933,6 +2007,29 @@ func NewGroupMembersSelector(Group *GroupSelector, Users *UsersSelectorArg) *Gro
 	return s
 }
 
+// MarshalJSON serializes a GroupMembersSelector instance
+func (u GroupMembersSelector) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupMembersSelector instance
+func (u *GroupMembersSelector) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupMembersSelector) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Group != nil {
+		if err := s.Group.Validate(); err != nil {
+			return dropbox.NestValidationError("group", err)
+		}
+	}
+	return nil
+}
+
 // GroupMembersSetAccessTypeArg : has no documentation (yet)
 type GroupMembersSetAccessTypeArg struct {
 	GroupMemberSelector
@@ -942,6 +2039,8 @@ type GroupMembersSetAccessTypeArg struct {
 	// that the default value will cause all the group members  to be returned
 	// in the response. This may take a long time for large groups.
 	ReturnMembers bool `json:"return_members"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupMembersSetAccessTypeArg returns a new GroupMembersSetAccessTypeArg instance
@@ -954,6 +2053,34 @@ func NewGroupMembersSetAccessTypeArg(Group *GroupSelector, User *UserSelectorArg
 	return s
 }
 
+// MarshalJSON serializes a GroupMembersSetAccessTypeArg instance
+func (u GroupMembersSetAccessTypeArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupMembersSetAccessTypeArg instance
+func (u *GroupMembersSetAccessTypeArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupMembersSetAccessTypeArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Group != nil {
+		if err := s.Group.Validate(); err != nil {
+			return dropbox.NestValidationError("group", err)
+		}
+	}
+	if s.User != nil {
+		if err := s.User.Validate(); err != nil {
+			return dropbox.NestValidationError("user", err)
+		}
+	}
+	return nil
+}
+
 // GroupSelector : Argument for selecting a single group, either by group_id or
 // by external group ID.
 type GroupSelector struct {
@@ -962,6 +2089,17 @@ type GroupSelector struct {
 	GroupId string `json:"group_id,omitempty"`
 	// GroupExternalId : External ID of the group.
 	GroupExternalId string `json:"group_external_id,omitempty"`
+	raw             string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupSelector) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupSelector
@@ -974,27 +2112,59 @@ const (
 func (u *GroupSelector) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// GroupId : Group ID.
+		GroupId json.RawMessage `json:"group_id,omitempty"`
+		// GroupExternalId : External ID of the group.
+		GroupExternalId json.RawMessage `json:"group_external_id,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "group_id":
-		err = json.Unmarshal(body, &u.GroupId)
+		err = json.Unmarshal(w.GroupId, &u.GroupId)
 
 		if err != nil {
 			return err
 		}
 	case "group_external_id":
-		err = json.Unmarshal(body, &u.GroupExternalId)
+		err = json.Unmarshal(w.GroupExternalId, &u.GroupExternalId)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupSelector instance
+func (u GroupSelector) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "group_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			GroupId string `json:"group_id"`
+		}{u.Tagged, u.GroupId})
+
+	case "group_external_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			GroupExternalId string `json:"group_external_id"`
+		}{u.Tagged, u.GroupExternalId})
+
 	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// Validate checks that u satisfies the constraints of the API spec
+func (u *GroupSelector) Validate() error {
 	return nil
 }
 
@@ -1011,6 +2181,8 @@ type GroupUpdateArgs struct {
 	NewGroupExternalId string `json:"new_group_external_id,omitempty"`
 	// NewGroupManagementType : Set new group management type, if provided.
 	NewGroupManagementType *team_common.GroupManagementType `json:"new_group_management_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupUpdateArgs returns a new GroupUpdateArgs instance
@@ -1021,9 +2193,43 @@ func NewGroupUpdateArgs(Group *GroupSelector) *GroupUpdateArgs {
 	return s
 }
 
-// GroupUpdateError : has no documentation (yet)
-type GroupUpdateError struct {
-	dropbox.Tagged
+// MarshalJSON serializes a GroupUpdateArgs instance
+func (u GroupUpdateArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupUpdateArgs instance
+func (u *GroupUpdateArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupUpdateArgs) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Group != nil {
+		if err := s.Group.Validate(); err != nil {
+			return dropbox.NestValidationError("group", err)
+		}
+	}
+	return nil
+}
+
+// GroupUpdateError : has no documentation (yet)
+type GroupUpdateError struct {
+	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupUpdateError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupUpdateError
@@ -1033,9 +2239,53 @@ const (
 	GroupUpdateErrorExternalIdAlreadyInUse = "external_id_already_in_use"
 )
 
+// UnmarshalJSON deserializes into a GroupUpdateError instance
+func (u *GroupUpdateError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "group_name_already_used", "group_name_invalid", "external_id_already_in_use":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupUpdateError instance
+func (u GroupUpdateError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupUpdateError reports whether err carries a GroupUpdateError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupUpdateError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupUpdateError)(nil), tags...)
+}
+
 // GroupsGetInfoError : has no documentation (yet)
 type GroupsGetInfoError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupsGetInfoError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupsGetInfoError
@@ -1044,6 +2294,39 @@ const (
 	GroupsGetInfoErrorOther          = "other"
 )
 
+// UnmarshalJSON deserializes into a GroupsGetInfoError instance
+func (u *GroupsGetInfoError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "group_not_on_team", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupsGetInfoError instance
+func (u GroupsGetInfoError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupsGetInfoError reports whether err carries a GroupsGetInfoError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupsGetInfoError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupsGetInfoError)(nil), tags...)
+}
+
 // GroupsGetInfoItem : has no documentation (yet)
 type GroupsGetInfoItem struct {
 	dropbox.Tagged
@@ -1053,6 +2336,17 @@ type GroupsGetInfoItem struct {
 	IdNotFound string `json:"id_not_found,omitempty"`
 	// GroupInfo : Info about a group.
 	GroupInfo *GroupFullInfo `json:"group_info,omitempty"`
+	raw       string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupsGetInfoItem) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupsGetInfoItem
@@ -1065,6 +2359,11 @@ const (
 func (u *GroupsGetInfoItem) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// IdNotFound : An ID that was provided as a parameter to
+		// `groupsGetInfo`, and did not match a corresponding group. The ID can
+		// be a group ID, or an external ID, depending on how the method was
+		// called.
+		IdNotFound json.RawMessage `json:"id_not_found,omitempty"`
 		// GroupInfo : Info about a group.
 		GroupInfo json.RawMessage `json:"group_info,omitempty"`
 	}
@@ -1073,10 +2372,10 @@ func (u *GroupsGetInfoItem) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "id_not_found":
-		err = json.Unmarshal(body, &u.IdNotFound)
+		err = json.Unmarshal(w.IdNotFound, &u.IdNotFound)
 
 		if err != nil {
 			return err
@@ -1087,14 +2386,36 @@ func (u *GroupsGetInfoItem) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GroupsGetInfoItem instance
+func (u GroupsGetInfoItem) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "id_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			IdNotFound string `json:"id_not_found"`
+		}{u.Tagged, u.IdNotFound})
+
+	case "group_info":
+		return dropbox.MarshalTagged(u.Tag, u.GroupInfo)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // GroupsListArg : has no documentation (yet)
 type GroupsListArg struct {
 	// Limit : Number of results to return per call.
 	Limit uint32 `json:"limit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupsListArg returns a new GroupsListArg instance
@@ -1104,10 +2425,27 @@ func NewGroupsListArg() *GroupsListArg {
 	return s
 }
 
+// MarshalJSON serializes a GroupsListArg instance
+func (u GroupsListArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupsListArg instance
+func (u *GroupsListArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupsListArg) Validate() error {
+	return nil
+}
+
 // GroupsListContinueArg : has no documentation (yet)
 type GroupsListContinueArg struct {
 	// Cursor : Indicates from what point to get the next set of groups.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupsListContinueArg returns a new GroupsListContinueArg instance
@@ -1117,9 +2455,35 @@ func NewGroupsListContinueArg(Cursor string) *GroupsListContinueArg {
 	return s
 }
 
+// MarshalJSON serializes a GroupsListContinueArg instance
+func (u GroupsListContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupsListContinueArg instance
+func (u *GroupsListContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupsListContinueArg) Validate() error {
+	return nil
+}
+
 // GroupsListContinueError : has no documentation (yet)
 type GroupsListContinueError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupsListContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupsListContinueError
@@ -1128,6 +2492,39 @@ const (
 	GroupsListContinueErrorOther         = "other"
 )
 
+// UnmarshalJSON deserializes into a GroupsListContinueError instance
+func (u *GroupsListContinueError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_cursor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupsListContinueError instance
+func (u GroupsListContinueError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupsListContinueError reports whether err carries a GroupsListContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupsListContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupsListContinueError)(nil), tags...)
+}
+
 // GroupsListResult : has no documentation (yet)
 type GroupsListResult struct {
 	// Groups : has no documentation (yet)
@@ -1139,6 +2536,8 @@ type GroupsListResult struct {
 	// returned yet. An additional call to `groupsListContinue` can retrieve
 	// them.
 	HasMore bool `json:"has_more"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupsListResult returns a new GroupsListResult instance
@@ -1150,12 +2549,24 @@ func NewGroupsListResult(Groups []*team_common.GroupSummary, Cursor string, HasM
 	return s
 }
 
+// MarshalJSON serializes a GroupsListResult instance
+func (u GroupsListResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupsListResult instance
+func (u *GroupsListResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupsMembersListArg : has no documentation (yet)
 type GroupsMembersListArg struct {
 	// Group : The group whose members are to be listed.
 	Group *GroupSelector `json:"group"`
 	// Limit : Number of results to return per call.
 	Limit uint32 `json:"limit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupsMembersListArg returns a new GroupsMembersListArg instance
@@ -1166,10 +2577,35 @@ func NewGroupsMembersListArg(Group *GroupSelector) *GroupsMembersListArg {
 	return s
 }
 
+// MarshalJSON serializes a GroupsMembersListArg instance
+func (u GroupsMembersListArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupsMembersListArg instance
+func (u *GroupsMembersListArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupsMembersListArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Group != nil {
+		if err := s.Group.Validate(); err != nil {
+			return dropbox.NestValidationError("group", err)
+		}
+	}
+	return nil
+}
+
 // GroupsMembersListContinueArg : has no documentation (yet)
 type GroupsMembersListContinueArg struct {
 	// Cursor : Indicates from what point to get the next set of groups.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupsMembersListContinueArg returns a new GroupsMembersListContinueArg instance
@@ -1179,9 +2615,35 @@ func NewGroupsMembersListContinueArg(Cursor string) *GroupsMembersListContinueAr
 	return s
 }
 
+// MarshalJSON serializes a GroupsMembersListContinueArg instance
+func (u GroupsMembersListContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupsMembersListContinueArg instance
+func (u *GroupsMembersListContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GroupsMembersListContinueArg) Validate() error {
+	return nil
+}
+
 // GroupsMembersListContinueError : has no documentation (yet)
 type GroupsMembersListContinueError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupsMembersListContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupsMembersListContinueError
@@ -1190,6 +2652,39 @@ const (
 	GroupsMembersListContinueErrorOther         = "other"
 )
 
+// UnmarshalJSON deserializes into a GroupsMembersListContinueError instance
+func (u *GroupsMembersListContinueError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_cursor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupsMembersListContinueError instance
+func (u GroupsMembersListContinueError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupsMembersListContinueError reports whether err carries a GroupsMembersListContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupsMembersListContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupsMembersListContinueError)(nil), tags...)
+}
+
 // GroupsMembersListResult : has no documentation (yet)
 type GroupsMembersListResult struct {
 	// Members : has no documentation (yet)
@@ -1201,6 +2696,8 @@ type GroupsMembersListResult struct {
 	// been returned yet. An additional call to `groupsMembersListContinue` can
 	// retrieve them.
 	HasMore bool `json:"has_more"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupsMembersListResult returns a new GroupsMembersListResult instance
@@ -1212,9 +2709,30 @@ func NewGroupsMembersListResult(Members []*GroupMemberInfo, Cursor string, HasMo
 	return s
 }
 
+// MarshalJSON serializes a GroupsMembersListResult instance
+func (u GroupsMembersListResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupsMembersListResult instance
+func (u *GroupsMembersListResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupsPollError : has no documentation (yet)
 type GroupsPollError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupsPollError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupsPollError
@@ -1222,6 +2740,39 @@ const (
 	GroupsPollErrorAccessDenied = "access_denied"
 )
 
+// UnmarshalJSON deserializes into a GroupsPollError instance
+func (u *GroupsPollError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "access_denied":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupsPollError instance
+func (u GroupsPollError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGroupsPollError reports whether err carries a GroupsPollError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGroupsPollError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GroupsPollError)(nil), tags...)
+}
+
 // GroupsSelector : Argument for selecting a list of groups, either by
 // group_ids, or external group IDs.
 type GroupsSelector struct {
@@ -1230,6 +2781,17 @@ type GroupsSelector struct {
 	GroupIds []string `json:"group_ids,omitempty"`
 	// GroupExternalIds : List of external IDs of groups.
 	GroupExternalIds []string `json:"group_external_ids,omitempty"`
+	raw              string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupsSelector) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupsSelector
@@ -1252,21 +2814,49 @@ func (u *GroupsSelector) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "group_ids":
-		err = json.Unmarshal(body, &u.GroupIds)
+		err = json.Unmarshal(w.GroupIds, &u.GroupIds)
 
 		if err != nil {
 			return err
 		}
 	case "group_external_ids":
-		err = json.Unmarshal(body, &u.GroupExternalIds)
+		err = json.Unmarshal(w.GroupExternalIds, &u.GroupExternalIds)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupsSelector instance
+func (u GroupsSelector) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "group_ids":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			GroupIds []string `json:"group_ids"`
+		}{u.Tagged, u.GroupIds})
+
+	case "group_external_ids":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			GroupExternalIds []string `json:"group_external_ids"`
+		}{u.Tagged, u.GroupExternalIds})
+
 	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// Validate checks that u satisfies the constraints of the API spec
+func (u *GroupsSelector) Validate() error {
 	return nil
 }
 
@@ -1274,6 +2864,8 @@ func (u *GroupsSelector) UnmarshalJSON(body []byte) error {
 type ListMemberAppsArg struct {
 	// TeamMemberId : The team member id
 	TeamMemberId string `json:"team_member_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListMemberAppsArg returns a new ListMemberAppsArg instance
@@ -1283,9 +2875,35 @@ func NewListMemberAppsArg(TeamMemberId string) *ListMemberAppsArg {
 	return s
 }
 
+// MarshalJSON serializes a ListMemberAppsArg instance
+func (u ListMemberAppsArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListMemberAppsArg instance
+func (u *ListMemberAppsArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListMemberAppsArg) Validate() error {
+	return nil
+}
+
 // ListMemberAppsError : Error returned by `linkedAppsListMemberLinkedApps`.
 type ListMemberAppsError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListMemberAppsError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListMemberAppsError
@@ -1294,11 +2912,46 @@ const (
 	ListMemberAppsErrorOther          = "other"
 )
 
+// UnmarshalJSON deserializes into a ListMemberAppsError instance
+func (u *ListMemberAppsError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "member_not_found", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListMemberAppsError instance
+func (u ListMemberAppsError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListMemberAppsError reports whether err carries a ListMemberAppsError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListMemberAppsError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListMemberAppsError)(nil), tags...)
+}
+
 // ListMemberAppsResult : has no documentation (yet)
 type ListMemberAppsResult struct {
 	// LinkedApiApps : List of third party applications linked by this team
 	// member
 	LinkedApiApps []*ApiApp `json:"linked_api_apps"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListMemberAppsResult returns a new ListMemberAppsResult instance
@@ -1308,6 +2961,16 @@ func NewListMemberAppsResult(LinkedApiApps []*ApiApp) *ListMemberAppsResult {
 	return s
 }
 
+// MarshalJSON serializes a ListMemberAppsResult instance
+func (u ListMemberAppsResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListMemberAppsResult instance
+func (u *ListMemberAppsResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListMemberDevicesArg : has no documentation (yet)
 type ListMemberDevicesArg struct {
 	// TeamMemberId : The team's member id
@@ -1320,6 +2983,8 @@ type ListMemberDevicesArg struct {
 	// IncludeMobileClients : Whether to list linked mobile devices of the
 	// team's member
 	IncludeMobileClients bool `json:"include_mobile_clients"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListMemberDevicesArg returns a new ListMemberDevicesArg instance
@@ -1332,9 +2997,35 @@ func NewListMemberDevicesArg(TeamMemberId string) *ListMemberDevicesArg {
 	return s
 }
 
+// MarshalJSON serializes a ListMemberDevicesArg instance
+func (u ListMemberDevicesArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListMemberDevicesArg instance
+func (u *ListMemberDevicesArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListMemberDevicesArg) Validate() error {
+	return nil
+}
+
 // ListMemberDevicesError : has no documentation (yet)
 type ListMemberDevicesError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListMemberDevicesError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListMemberDevicesError
@@ -1343,6 +3034,39 @@ const (
 	ListMemberDevicesErrorOther          = "other"
 )
 
+// UnmarshalJSON deserializes into a ListMemberDevicesError instance
+func (u *ListMemberDevicesError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "member_not_found", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListMemberDevicesError instance
+func (u ListMemberDevicesError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListMemberDevicesError reports whether err carries a ListMemberDevicesError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListMemberDevicesError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListMemberDevicesError)(nil), tags...)
+}
+
 // ListMemberDevicesResult : has no documentation (yet)
 type ListMemberDevicesResult struct {
 	// ActiveWebSessions : List of web sessions made by this team member
@@ -1351,6 +3075,8 @@ type ListMemberDevicesResult struct {
 	DesktopClientSessions []*DesktopClientSession `json:"desktop_client_sessions,omitempty"`
 	// MobileClientSessions : List of mobile client used by this team member
 	MobileClientSessions []*MobileClientSession `json:"mobile_client_sessions,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListMemberDevicesResult returns a new ListMemberDevicesResult instance
@@ -1359,6 +3085,16 @@ func NewListMemberDevicesResult() *ListMemberDevicesResult {
 	return s
 }
 
+// MarshalJSON serializes a ListMemberDevicesResult instance
+func (u ListMemberDevicesResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListMemberDevicesResult instance
+func (u *ListMemberDevicesResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListMembersAppsArg : Arguments for `linkedAppsListMembersLinkedApps`.
 type ListMembersAppsArg struct {
 	// Cursor : At the first call to the `linkedAppsListMembersLinkedApps` the
@@ -1366,6 +3102,8 @@ type ListMembersAppsArg struct {
 	// cursor, the following requests should include the received cursors in
 	// order to receive the next sub list of the team applications
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListMembersAppsArg returns a new ListMembersAppsArg instance
@@ -1374,17 +3112,76 @@ func NewListMembersAppsArg() *ListMembersAppsArg {
 	return s
 }
 
+// MarshalJSON serializes a ListMembersAppsArg instance
+func (u ListMembersAppsArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListMembersAppsArg instance
+func (u *ListMembersAppsArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListMembersAppsArg) Validate() error {
+	return nil
+}
+
 // ListMembersAppsError : Error returned by `linkedAppsListMembersLinkedApps`
 type ListMembersAppsError struct {
 	dropbox.Tagged
+	raw string
 }
 
-// Valid tag values for ListMembersAppsError
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListMembersAppsError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
+}
+
+// Valid tag values for ListMembersAppsError
 const (
 	ListMembersAppsErrorReset = "reset"
 	ListMembersAppsErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a ListMembersAppsError instance
+func (u *ListMembersAppsError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "reset", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListMembersAppsError instance
+func (u ListMembersAppsError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListMembersAppsError reports whether err carries a ListMembersAppsError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListMembersAppsError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListMembersAppsError)(nil), tags...)
+}
+
 // ListMembersAppsResult : Information returned by
 // `linkedAppsListMembersLinkedApps`.
 type ListMembersAppsResult struct {
@@ -1396,6 +3193,8 @@ type ListMembersAppsResult struct {
 	// Cursor : Pass the cursor into `linkedAppsListMembersLinkedApps` to
 	// receive the next sub list of team's applications.
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListMembersAppsResult returns a new ListMembersAppsResult instance
@@ -1406,6 +3205,16 @@ func NewListMembersAppsResult(Apps []*MemberLinkedApps, HasMore bool) *ListMembe
 	return s
 }
 
+// MarshalJSON serializes a ListMembersAppsResult instance
+func (u ListMembersAppsResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListMembersAppsResult instance
+func (u *ListMembersAppsResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListMembersDevicesArg : has no documentation (yet)
 type ListMembersDevicesArg struct {
 	// Cursor : At the first call to the `devicesListMembersDevices` the cursor
@@ -1420,6 +3229,8 @@ type ListMembersDevicesArg struct {
 	IncludeDesktopClients bool `json:"include_desktop_clients"`
 	// IncludeMobileClients : Whether to list mobile clients of the team members
 	IncludeMobileClients bool `json:"include_mobile_clients"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListMembersDevicesArg returns a new ListMembersDevicesArg instance
@@ -1431,9 +3242,35 @@ func NewListMembersDevicesArg() *ListMembersDevicesArg {
 	return s
 }
 
+// MarshalJSON serializes a ListMembersDevicesArg instance
+func (u ListMembersDevicesArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListMembersDevicesArg instance
+func (u *ListMembersDevicesArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListMembersDevicesArg) Validate() error {
+	return nil
+}
+
 // ListMembersDevicesError : has no documentation (yet)
 type ListMembersDevicesError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListMembersDevicesError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListMembersDevicesError
@@ -1442,6 +3279,39 @@ const (
 	ListMembersDevicesErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a ListMembersDevicesError instance
+func (u *ListMembersDevicesError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "reset", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListMembersDevicesError instance
+func (u ListMembersDevicesError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListMembersDevicesError reports whether err carries a ListMembersDevicesError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListMembersDevicesError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListMembersDevicesError)(nil), tags...)
+}
+
 // ListMembersDevicesResult : has no documentation (yet)
 type ListMembersDevicesResult struct {
 	// Devices : The devices of each member of the team
@@ -1452,6 +3322,8 @@ type ListMembersDevicesResult struct {
 	// Cursor : Pass the cursor into `devicesListMembersDevices` to receive the
 	// next sub list of team's devices.
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListMembersDevicesResult returns a new ListMembersDevicesResult instance
@@ -1462,6 +3334,16 @@ func NewListMembersDevicesResult(Devices []*MemberDevices, HasMore bool) *ListMe
 	return s
 }
 
+// MarshalJSON serializes a ListMembersDevicesResult instance
+func (u ListMembersDevicesResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListMembersDevicesResult instance
+func (u *ListMembersDevicesResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListTeamAppsArg : Arguments for `linkedAppsListTeamLinkedApps`.
 type ListTeamAppsArg struct {
 	// Cursor : At the first call to the `linkedAppsListTeamLinkedApps` the
@@ -1469,6 +3351,8 @@ type ListTeamAppsArg struct {
 	// cursor, the following requests should include the received cursors in
 	// order to receive the next sub list of the team applications
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListTeamAppsArg returns a new ListTeamAppsArg instance
@@ -1477,9 +3361,35 @@ func NewListTeamAppsArg() *ListTeamAppsArg {
 	return s
 }
 
+// MarshalJSON serializes a ListTeamAppsArg instance
+func (u ListTeamAppsArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListTeamAppsArg instance
+func (u *ListTeamAppsArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListTeamAppsArg) Validate() error {
+	return nil
+}
+
 // ListTeamAppsError : Error returned by `linkedAppsListTeamLinkedApps`
 type ListTeamAppsError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListTeamAppsError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListTeamAppsError
@@ -1488,6 +3398,39 @@ const (
 	ListTeamAppsErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a ListTeamAppsError instance
+func (u *ListTeamAppsError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "reset", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListTeamAppsError instance
+func (u ListTeamAppsError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListTeamAppsError reports whether err carries a ListTeamAppsError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListTeamAppsError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListTeamAppsError)(nil), tags...)
+}
+
 // ListTeamAppsResult : Information returned by `linkedAppsListTeamLinkedApps`.
 type ListTeamAppsResult struct {
 	// Apps : The linked applications of each member of the team
@@ -1498,6 +3441,8 @@ type ListTeamAppsResult struct {
 	// Cursor : Pass the cursor into `linkedAppsListTeamLinkedApps` to receive
 	// the next sub list of team's applications.
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListTeamAppsResult returns a new ListTeamAppsResult instance
@@ -1508,6 +3453,16 @@ func NewListTeamAppsResult(Apps []*MemberLinkedApps, HasMore bool) *ListTeamApps
 	return s
 }
 
+// MarshalJSON serializes a ListTeamAppsResult instance
+func (u ListTeamAppsResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListTeamAppsResult instance
+func (u *ListTeamAppsResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ListTeamDevicesArg : has no documentation (yet)
 type ListTeamDevicesArg struct {
 	// Cursor : At the first call to the `devicesListTeamDevices` the cursor
@@ -1522,6 +3477,8 @@ type ListTeamDevicesArg struct {
 	IncludeDesktopClients bool `json:"include_desktop_clients"`
 	// IncludeMobileClients : Whether to list mobile clients of the team members
 	IncludeMobileClients bool `json:"include_mobile_clients"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListTeamDevicesArg returns a new ListTeamDevicesArg instance
@@ -1533,9 +3490,35 @@ func NewListTeamDevicesArg() *ListTeamDevicesArg {
 	return s
 }
 
+// MarshalJSON serializes a ListTeamDevicesArg instance
+func (u ListTeamDevicesArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListTeamDevicesArg instance
+func (u *ListTeamDevicesArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *ListTeamDevicesArg) Validate() error {
+	return nil
+}
+
 // ListTeamDevicesError : has no documentation (yet)
 type ListTeamDevicesError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ListTeamDevicesError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ListTeamDevicesError
@@ -1544,6 +3527,39 @@ const (
 	ListTeamDevicesErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a ListTeamDevicesError instance
+func (u *ListTeamDevicesError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "reset", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ListTeamDevicesError instance
+func (u ListTeamDevicesError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsListTeamDevicesError reports whether err carries a ListTeamDevicesError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsListTeamDevicesError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*ListTeamDevicesError)(nil), tags...)
+}
+
 // ListTeamDevicesResult : has no documentation (yet)
 type ListTeamDevicesResult struct {
 	// Devices : The devices of each member of the team
@@ -1554,6 +3570,8 @@ type ListTeamDevicesResult struct {
 	// Cursor : Pass the cursor into `devicesListTeamDevices` to receive the
 	// next sub list of team's devices.
 	Cursor string `json:"cursor,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewListTeamDevicesResult returns a new ListTeamDevicesResult instance
@@ -1564,6 +3582,16 @@ func NewListTeamDevicesResult(Devices []*MemberDevices, HasMore bool) *ListTeamD
 	return s
 }
 
+// MarshalJSON serializes a ListTeamDevicesResult instance
+func (u ListTeamDevicesResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ListTeamDevicesResult instance
+func (u *ListTeamDevicesResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberAccess : Specify access type a member should have when joined to a
 // group.
 type MemberAccess struct {
@@ -1571,6 +3599,8 @@ type MemberAccess struct {
 	User *UserSelectorArg `json:"user"`
 	// AccessType : Access type.
 	AccessType *GroupAccessType `json:"access_type"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberAccess returns a new MemberAccess instance
@@ -1581,6 +3611,29 @@ func NewMemberAccess(User *UserSelectorArg, AccessType *GroupAccessType) *Member
 	return s
 }
 
+// MarshalJSON serializes a MemberAccess instance
+func (u MemberAccess) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberAccess instance
+func (u *MemberAccess) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MemberAccess) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.User != nil {
+		if err := s.User.Validate(); err != nil {
+			return dropbox.NestValidationError("user", err)
+		}
+	}
+	return nil
+}
+
 // MemberAddArg : has no documentation (yet)
 type MemberAddArg struct {
 	// MemberEmail : has no documentation (yet)
@@ -1601,6 +3654,8 @@ type MemberAddArg struct {
 	SendWelcomeEmail bool `json:"send_welcome_email"`
 	// Role : has no documentation (yet)
 	Role *AdminTier `json:"role"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberAddArg returns a new MemberAddArg instance
@@ -1608,10 +3663,20 @@ func NewMemberAddArg(MemberEmail string) *MemberAddArg {
 	s := new(MemberAddArg)
 	s.MemberEmail = MemberEmail
 	s.SendWelcomeEmail = true
-	s.Role = &AdminTier{Tagged: dropbox.Tagged{"member_only"}}
+	s.Role = &AdminTier{Tagged: dropbox.Tagged{Tag: "member_only"}}
 	return s
 }
 
+// MarshalJSON serializes a MemberAddArg instance
+func (u MemberAddArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberAddArg instance
+func (u *MemberAddArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberAddResult : Describes the result of attempting to add a single user to
 // the team. 'success' is the only value indicating that a user was indeed added
 // to the team - the other values explain the type of failure that occurred, and
@@ -1650,6 +3715,17 @@ type MemberAddResult struct {
 	PersistentIdDisabled string `json:"persistent_id_disabled,omitempty"`
 	// UserCreationFailed : User creation has failed.
 	UserCreationFailed string `json:"user_creation_failed,omitempty"`
+	raw                string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MemberAddResult) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MemberAddResult
@@ -1673,13 +3749,43 @@ func (u *MemberAddResult) UnmarshalJSON(body []byte) error {
 		dropbox.Tagged
 		// Success : Describes a user that was successfully added to the team.
 		Success json.RawMessage `json:"success,omitempty"`
+		// TeamLicenseLimit : Team is already full. The organization has no
+		// available licenses.
+		TeamLicenseLimit json.RawMessage `json:"team_license_limit,omitempty"`
+		// FreeTeamMemberLimitReached : Team is already full. The free team
+		// member limit has been reached.
+		FreeTeamMemberLimitReached json.RawMessage `json:"free_team_member_limit_reached,omitempty"`
+		// UserAlreadyOnTeam : User is already on this team. The provided email
+		// address is associated with a user who is already a member of
+		// (including in recoverable state) or invited to the team.
+		UserAlreadyOnTeam json.RawMessage `json:"user_already_on_team,omitempty"`
+		// UserOnAnotherTeam : User is already on another team. The provided
+		// email address is associated with a user that is already a member or
+		// invited to another team.
+		UserOnAnotherTeam json.RawMessage `json:"user_on_another_team,omitempty"`
+		// UserAlreadyPaired : User is already paired.
+		UserAlreadyPaired json.RawMessage `json:"user_already_paired,omitempty"`
+		// UserMigrationFailed : User migration has failed.
+		UserMigrationFailed json.RawMessage `json:"user_migration_failed,omitempty"`
+		// DuplicateExternalMemberId : A user with the given external member ID
+		// already exists on the team (including in recoverable state).
+		DuplicateExternalMemberId json.RawMessage `json:"duplicate_external_member_id,omitempty"`
+		// DuplicateMemberPersistentId : A user with the given persistent ID
+		// already exists on the team (including in recoverable state).
+		DuplicateMemberPersistentId json.RawMessage `json:"duplicate_member_persistent_id,omitempty"`
+		// PersistentIdDisabled : Persistent ID is only available to teams with
+		// persistent ID SAML configuration. Please contact Dropbox for more
+		// information.
+		PersistentIdDisabled json.RawMessage `json:"persistent_id_disabled,omitempty"`
+		// UserCreationFailed : User creation has failed.
+		UserCreationFailed json.RawMessage `json:"user_creation_failed,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "success":
 		err = json.Unmarshal(body, &u.Success)
@@ -1688,69 +3794,143 @@ func (u *MemberAddResult) UnmarshalJSON(body []byte) error {
 			return err
 		}
 	case "team_license_limit":
-		err = json.Unmarshal(body, &u.TeamLicenseLimit)
+		err = json.Unmarshal(w.TeamLicenseLimit, &u.TeamLicenseLimit)
 
 		if err != nil {
 			return err
 		}
 	case "free_team_member_limit_reached":
-		err = json.Unmarshal(body, &u.FreeTeamMemberLimitReached)
+		err = json.Unmarshal(w.FreeTeamMemberLimitReached, &u.FreeTeamMemberLimitReached)
 
 		if err != nil {
 			return err
 		}
 	case "user_already_on_team":
-		err = json.Unmarshal(body, &u.UserAlreadyOnTeam)
+		err = json.Unmarshal(w.UserAlreadyOnTeam, &u.UserAlreadyOnTeam)
 
 		if err != nil {
 			return err
 		}
 	case "user_on_another_team":
-		err = json.Unmarshal(body, &u.UserOnAnotherTeam)
+		err = json.Unmarshal(w.UserOnAnotherTeam, &u.UserOnAnotherTeam)
 
 		if err != nil {
 			return err
 		}
 	case "user_already_paired":
-		err = json.Unmarshal(body, &u.UserAlreadyPaired)
+		err = json.Unmarshal(w.UserAlreadyPaired, &u.UserAlreadyPaired)
 
 		if err != nil {
 			return err
 		}
 	case "user_migration_failed":
-		err = json.Unmarshal(body, &u.UserMigrationFailed)
+		err = json.Unmarshal(w.UserMigrationFailed, &u.UserMigrationFailed)
 
 		if err != nil {
 			return err
 		}
 	case "duplicate_external_member_id":
-		err = json.Unmarshal(body, &u.DuplicateExternalMemberId)
+		err = json.Unmarshal(w.DuplicateExternalMemberId, &u.DuplicateExternalMemberId)
 
 		if err != nil {
 			return err
 		}
 	case "duplicate_member_persistent_id":
-		err = json.Unmarshal(body, &u.DuplicateMemberPersistentId)
+		err = json.Unmarshal(w.DuplicateMemberPersistentId, &u.DuplicateMemberPersistentId)
 
 		if err != nil {
 			return err
 		}
 	case "persistent_id_disabled":
-		err = json.Unmarshal(body, &u.PersistentIdDisabled)
+		err = json.Unmarshal(w.PersistentIdDisabled, &u.PersistentIdDisabled)
 
 		if err != nil {
 			return err
 		}
 	case "user_creation_failed":
-		err = json.Unmarshal(body, &u.UserCreationFailed)
+		err = json.Unmarshal(w.UserCreationFailed, &u.UserCreationFailed)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a MemberAddResult instance
+func (u MemberAddResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return dropbox.MarshalTagged(u.Tag, u.Success)
+
+	case "team_license_limit":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamLicenseLimit string `json:"team_license_limit"`
+		}{u.Tagged, u.TeamLicenseLimit})
+
+	case "free_team_member_limit_reached":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FreeTeamMemberLimitReached string `json:"free_team_member_limit_reached"`
+		}{u.Tagged, u.FreeTeamMemberLimitReached})
+
+	case "user_already_on_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserAlreadyOnTeam string `json:"user_already_on_team"`
+		}{u.Tagged, u.UserAlreadyOnTeam})
+
+	case "user_on_another_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserOnAnotherTeam string `json:"user_on_another_team"`
+		}{u.Tagged, u.UserOnAnotherTeam})
+
+	case "user_already_paired":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserAlreadyPaired string `json:"user_already_paired"`
+		}{u.Tagged, u.UserAlreadyPaired})
+
+	case "user_migration_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserMigrationFailed string `json:"user_migration_failed"`
+		}{u.Tagged, u.UserMigrationFailed})
+
+	case "duplicate_external_member_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DuplicateExternalMemberId string `json:"duplicate_external_member_id"`
+		}{u.Tagged, u.DuplicateExternalMemberId})
+
+	case "duplicate_member_persistent_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DuplicateMemberPersistentId string `json:"duplicate_member_persistent_id"`
+		}{u.Tagged, u.DuplicateMemberPersistentId})
+
+	case "persistent_id_disabled":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PersistentIdDisabled string `json:"persistent_id_disabled"`
+		}{u.Tagged, u.PersistentIdDisabled})
+
+	case "user_creation_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserCreationFailed string `json:"user_creation_failed"`
+		}{u.Tagged, u.UserCreationFailed})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // MemberDevices : Information on devices of a team's member.
 type MemberDevices struct {
 	// TeamMemberId : The member unique Id
@@ -1761,6 +3941,8 @@ type MemberDevices struct {
 	DesktopClients []*DesktopClientSession `json:"desktop_clients,omitempty"`
 	// MobileClients : List of mobile clients by this team member
 	MobileClients []*MobileClientSession `json:"mobile_clients,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberDevices returns a new MemberDevices instance
@@ -1770,6 +3952,16 @@ func NewMemberDevices(TeamMemberId string) *MemberDevices {
 	return s
 }
 
+// MarshalJSON serializes a MemberDevices instance
+func (u MemberDevices) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberDevices instance
+func (u *MemberDevices) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberLinkedApps : Information on linked applications of a team member.
 type MemberLinkedApps struct {
 	// TeamMemberId : The member unique Id
@@ -1777,6 +3969,8 @@ type MemberLinkedApps struct {
 	// LinkedApiApps : List of third party applications linked by this team
 	// member
 	LinkedApiApps []*ApiApp `json:"linked_api_apps"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberLinkedApps returns a new MemberLinkedApps instance
@@ -1787,6 +3981,16 @@ func NewMemberLinkedApps(TeamMemberId string, LinkedApiApps []*ApiApp) *MemberLi
 	return s
 }
 
+// MarshalJSON serializes a MemberLinkedApps instance
+func (u MemberLinkedApps) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberLinkedApps instance
+func (u *MemberLinkedApps) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberProfile : Basic member profile.
 type MemberProfile struct {
 	// TeamMemberId : ID of user as a member of a team.
@@ -1815,6 +4019,8 @@ type MemberProfile struct {
 	// PersistentId : Persistent ID that a team can attach to the user. The
 	// persistent ID is unique ID to be used for SAML authentication.
 	PersistentId string `json:"persistent_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberProfile returns a new MemberProfile instance
@@ -1829,10 +4035,31 @@ func NewMemberProfile(TeamMemberId string, Email string, EmailVerified bool, Sta
 	return s
 }
 
+// MarshalJSON serializes a MemberProfile instance
+func (u MemberProfile) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberProfile instance
+func (u *MemberProfile) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UserSelectorError : Error that can be returned whenever a struct derived from
 // `UserSelectorArg` is used.
 type UserSelectorError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UserSelectorError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UserSelectorError
@@ -1840,9 +4067,53 @@ const (
 	UserSelectorErrorUserNotFound = "user_not_found"
 )
 
+// UnmarshalJSON deserializes into a UserSelectorError instance
+func (u *UserSelectorError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "user_not_found":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a UserSelectorError instance
+func (u UserSelectorError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsUserSelectorError reports whether err carries a UserSelectorError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsUserSelectorError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*UserSelectorError)(nil), tags...)
+}
+
 // MemberSelectorError : has no documentation (yet)
 type MemberSelectorError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MemberSelectorError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MemberSelectorError
@@ -1850,12 +4121,47 @@ const (
 	MemberSelectorErrorUserNotInTeam = "user_not_in_team"
 )
 
+// UnmarshalJSON deserializes into a MemberSelectorError instance
+func (u *MemberSelectorError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "user_not_in_team":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MemberSelectorError instance
+func (u MemberSelectorError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMemberSelectorError reports whether err carries a MemberSelectorError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMemberSelectorError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MemberSelectorError)(nil), tags...)
+}
+
 // MembersAddArg : has no documentation (yet)
 type MembersAddArg struct {
 	// NewMembers : Details of new members to be added to the team.
 	NewMembers []*MemberAddArg `json:"new_members"`
 	// ForceAsync : Whether to force the add to happen asynchronously.
 	ForceAsync bool `json:"force_async"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersAddArg returns a new MembersAddArg instance
@@ -1866,6 +4172,21 @@ func NewMembersAddArg(NewMembers []*MemberAddArg) *MembersAddArg {
 	return s
 }
 
+// MarshalJSON serializes a MembersAddArg instance
+func (u MembersAddArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersAddArg instance
+func (u *MembersAddArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersAddArg) Validate() error {
+	return nil
+}
+
 // MembersAddJobStatus : has no documentation (yet)
 type MembersAddJobStatus struct {
 	dropbox.Tagged
@@ -1876,12 +4197,24 @@ type MembersAddJobStatus struct {
 	// Failed : The asynchronous job returned an error. The string contains an
 	// error message.
 	Failed string `json:"failed,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersAddJobStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersAddJobStatus
 const (
-	MembersAddJobStatusComplete = "complete"
-	MembersAddJobStatusFailed   = "failed"
+	MembersAddJobStatusInProgress = "in_progress"
+	MembersAddJobStatusComplete   = "complete"
+	MembersAddJobStatusFailed     = "failed"
 )
 
 // UnmarshalJSON deserializes into a MembersAddJobStatus instance
@@ -1892,46 +4225,93 @@ func (u *MembersAddJobStatus) UnmarshalJSON(body []byte) error {
 		// was specified in the parameter `MembersAddArg` that was provided to
 		// `membersAdd`, a corresponding item is returned in this list.
 		Complete json.RawMessage `json:"complete,omitempty"`
+		// Failed : The asynchronous job returned an error. The string contains
+		// an error message.
+		Failed json.RawMessage `json:"failed,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "complete":
-		err = json.Unmarshal(body, &u.Complete)
+		err = json.Unmarshal(w.Complete, &u.Complete)
 
 		if err != nil {
 			return err
 		}
 	case "failed":
-		err = json.Unmarshal(body, &u.Failed)
+		err = json.Unmarshal(w.Failed, &u.Failed)
 
 		if err != nil {
 			return err
 		}
+	case "in_progress":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a MembersAddJobStatus instance
+func (u MembersAddJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Complete []*MemberAddResult `json:"complete"`
+		}{u.Tagged, u.Complete})
+
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed string `json:"failed"`
+		}{u.Tagged, u.Failed})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // MembersAddLaunch : has no documentation (yet)
 type MembersAddLaunch struct {
 	dropbox.Tagged
+	// AsyncJobId : This response indicates that the processing is asynchronous.
+	// The string is an id that can be used to obtain the status of the
+	// asynchronous job.
+	AsyncJobId string `json:"async_job_id,omitempty"`
 	// Complete : has no documentation (yet)
 	Complete []*MemberAddResult `json:"complete,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersAddLaunch) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersAddLaunch
 const (
-	MembersAddLaunchComplete = "complete"
+	MembersAddLaunchAsyncJobId = "async_job_id"
+	MembersAddLaunchComplete   = "complete"
 )
 
 // UnmarshalJSON deserializes into a MembersAddLaunch instance
 func (u *MembersAddLaunch) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// AsyncJobId : This response indicates that the processing is
+		// asynchronous. The string is an id that can be used to obtain the
+		// status of the asynchronous job.
+		AsyncJobId json.RawMessage `json:"async_job_id,omitempty"`
 		// Complete : has no documentation (yet)
 		Complete json.RawMessage `json:"complete,omitempty"`
 	}
@@ -1940,26 +4320,57 @@ func (u *MembersAddLaunch) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
+	case "async_job_id":
+		err = json.Unmarshal(w.AsyncJobId, &u.AsyncJobId)
+
+		if err != nil {
+			return err
+		}
 	case "complete":
-		err = json.Unmarshal(body, &u.Complete)
+		err = json.Unmarshal(w.Complete, &u.Complete)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
-// MembersDeactivateArg : Exactly one of team_member_id, email, or external_id
-// must be provided to identify the user account.
-type MembersDeactivateArg struct {
-	// User : Identity of user to remove/suspend.
-	User *UserSelectorArg `json:"user"`
+// MarshalJSON serializes a MembersAddLaunch instance
+func (u MembersAddLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+
+	case "complete":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Complete []*MemberAddResult `json:"complete"`
+		}{u.Tagged, u.Complete})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// MembersDeactivateArg : Exactly one of team_member_id, email, or external_id
+// must be provided to identify the user account.
+type MembersDeactivateArg struct {
+	// User : Identity of user to remove/suspend.
+	User *UserSelectorArg `json:"user"`
 	// WipeData : If provided, controls if the user's data will be deleted on
 	// their linked devices.
 	WipeData bool `json:"wipe_data"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersDeactivateArg returns a new MembersDeactivateArg instance
@@ -1970,9 +4381,43 @@ func NewMembersDeactivateArg(User *UserSelectorArg) *MembersDeactivateArg {
 	return s
 }
 
+// MarshalJSON serializes a MembersDeactivateArg instance
+func (u MembersDeactivateArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersDeactivateArg instance
+func (u *MembersDeactivateArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersDeactivateArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.User != nil {
+		if err := s.User.Validate(); err != nil {
+			return dropbox.NestValidationError("user", err)
+		}
+	}
+	return nil
+}
+
 // MembersDeactivateError : has no documentation (yet)
 type MembersDeactivateError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersDeactivateError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersDeactivateError
@@ -1981,10 +4426,45 @@ const (
 	MembersDeactivateErrorOther         = "other"
 )
 
+// UnmarshalJSON deserializes into a MembersDeactivateError instance
+func (u *MembersDeactivateError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "user_not_in_team", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersDeactivateError instance
+func (u MembersDeactivateError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersDeactivateError reports whether err carries a MembersDeactivateError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersDeactivateError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersDeactivateError)(nil), tags...)
+}
+
 // MembersGetInfoArgs : has no documentation (yet)
 type MembersGetInfoArgs struct {
 	// Members : List of team members.
 	Members []*UserSelectorArg `json:"members"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersGetInfoArgs returns a new MembersGetInfoArgs instance
@@ -1994,9 +4474,45 @@ func NewMembersGetInfoArgs(Members []*UserSelectorArg) *MembersGetInfoArgs {
 	return s
 }
 
+// MarshalJSON serializes a MembersGetInfoArgs instance
+func (u MembersGetInfoArgs) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersGetInfoArgs instance
+func (u *MembersGetInfoArgs) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersGetInfoArgs) Validate() error {
+	if s == nil {
+		return nil
+	}
+	for i, v := range s.Members {
+		if v != nil {
+			if err := v.Validate(); err != nil {
+				return dropbox.NestValidationError(fmt.Sprintf("members[%d]", i), err)
+			}
+		}
+	}
+	return nil
+}
+
 // MembersGetInfoError :
 type MembersGetInfoError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersGetInfoError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersGetInfoError
@@ -2004,6 +4520,39 @@ const (
 	MembersGetInfoErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a MembersGetInfoError instance
+func (u *MembersGetInfoError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersGetInfoError instance
+func (u MembersGetInfoError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersGetInfoError reports whether err carries a MembersGetInfoError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersGetInfoError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersGetInfoError)(nil), tags...)
+}
+
 // MembersGetInfoItem : Describes a result obtained for a single user whose id
 // was specified in the parameter of `membersGetInfo`.
 type MembersGetInfoItem struct {
@@ -2014,6 +4563,17 @@ type MembersGetInfoItem struct {
 	IdNotFound string `json:"id_not_found,omitempty"`
 	// MemberInfo : Info about a team member.
 	MemberInfo *TeamMemberInfo `json:"member_info,omitempty"`
+	raw        string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersGetInfoItem) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersGetInfoItem
@@ -2026,6 +4586,11 @@ const (
 func (u *MembersGetInfoItem) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// IdNotFound : An ID that was provided as a parameter to
+		// `membersGetInfo`, and did not match a corresponding user. This might
+		// be a team_member_id, an email, or an external ID, depending on how
+		// the method was called.
+		IdNotFound json.RawMessage `json:"id_not_found,omitempty"`
 		// MemberInfo : Info about a team member.
 		MemberInfo json.RawMessage `json:"member_info,omitempty"`
 	}
@@ -2034,10 +4599,10 @@ func (u *MembersGetInfoItem) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "id_not_found":
-		err = json.Unmarshal(body, &u.IdNotFound)
+		err = json.Unmarshal(w.IdNotFound, &u.IdNotFound)
 
 		if err != nil {
 			return err
@@ -2048,16 +4613,38 @@ func (u *MembersGetInfoItem) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a MembersGetInfoItem instance
+func (u MembersGetInfoItem) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "id_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			IdNotFound string `json:"id_not_found"`
+		}{u.Tagged, u.IdNotFound})
+
+	case "member_info":
+		return dropbox.MarshalTagged(u.Tag, u.MemberInfo)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // MembersListArg : has no documentation (yet)
 type MembersListArg struct {
 	// Limit : Number of results to return per call.
 	Limit uint32 `json:"limit"`
 	// IncludeRemoved : Whether to return removed members.
 	IncludeRemoved bool `json:"include_removed"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersListArg returns a new MembersListArg instance
@@ -2068,10 +4655,42 @@ func NewMembersListArg() *MembersListArg {
 	return s
 }
 
+// MarshalJSON serializes a MembersListArg instance
+func (u MembersListArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersListArg instance
+func (u *MembersListArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersListArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Limit < 1 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at least 1",
+		}
+	}
+	if s.Limit > 1000 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at most 1000",
+		}
+	}
+	return nil
+}
+
 // MembersListContinueArg : has no documentation (yet)
 type MembersListContinueArg struct {
 	// Cursor : Indicates from what point to get the next set of members.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersListContinueArg returns a new MembersListContinueArg instance
@@ -2081,9 +4700,35 @@ func NewMembersListContinueArg(Cursor string) *MembersListContinueArg {
 	return s
 }
 
+// MarshalJSON serializes a MembersListContinueArg instance
+func (u MembersListContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersListContinueArg instance
+func (u *MembersListContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersListContinueArg) Validate() error {
+	return nil
+}
+
 // MembersListContinueError : has no documentation (yet)
 type MembersListContinueError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersListContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersListContinueError
@@ -2092,9 +4737,53 @@ const (
 	MembersListContinueErrorOther         = "other"
 )
 
+// UnmarshalJSON deserializes into a MembersListContinueError instance
+func (u *MembersListContinueError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_cursor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersListContinueError instance
+func (u MembersListContinueError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersListContinueError reports whether err carries a MembersListContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersListContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersListContinueError)(nil), tags...)
+}
+
 // MembersListError :
 type MembersListError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersListError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersListError
@@ -2102,6 +4791,39 @@ const (
 	MembersListErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a MembersListError instance
+func (u *MembersListError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersListError instance
+func (u MembersListError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersListError reports whether err carries a MembersListError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersListError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersListError)(nil), tags...)
+}
+
 // MembersListResult : has no documentation (yet)
 type MembersListResult struct {
 	// Members : List of team members.
@@ -2113,6 +4835,8 @@ type MembersListResult struct {
 	// returned yet. An additional call to `membersListContinue` can retrieve
 	// them.
 	HasMore bool `json:"has_more"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersListResult returns a new MembersListResult instance
@@ -2124,11 +4848,23 @@ func NewMembersListResult(Members []*TeamMemberInfo, Cursor string, HasMore bool
 	return s
 }
 
+// MarshalJSON serializes a MembersListResult instance
+func (u MembersListResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersListResult instance
+func (u *MembersListResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MembersRecoverArg : Exactly one of team_member_id, email, or external_id must
 // be provided to identify the user account.
 type MembersRecoverArg struct {
 	// User : Identity of user to recover.
 	User *UserSelectorArg `json:"user"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersRecoverArg returns a new MembersRecoverArg instance
@@ -2138,9 +4874,43 @@ func NewMembersRecoverArg(User *UserSelectorArg) *MembersRecoverArg {
 	return s
 }
 
+// MarshalJSON serializes a MembersRecoverArg instance
+func (u MembersRecoverArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersRecoverArg instance
+func (u *MembersRecoverArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersRecoverArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.User != nil {
+		if err := s.User.Validate(); err != nil {
+			return dropbox.NestValidationError("user", err)
+		}
+	}
+	return nil
+}
+
 // MembersRecoverError : has no documentation (yet)
 type MembersRecoverError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersRecoverError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersRecoverError
@@ -2151,6 +4921,39 @@ const (
 	MembersRecoverErrorOther             = "other"
 )
 
+// UnmarshalJSON deserializes into a MembersRecoverError instance
+func (u *MembersRecoverError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "user_unrecoverable", "user_not_in_team", "team_license_limit", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersRecoverError instance
+func (u MembersRecoverError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersRecoverError reports whether err carries a MembersRecoverError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersRecoverError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersRecoverError)(nil), tags...)
+}
+
 // MembersRemoveArg : has no documentation (yet)
 type MembersRemoveArg struct {
 	MembersDeactivateArg
@@ -2166,6 +4969,8 @@ type MembersRemoveArg struct {
 	// in their account that is not restricted to team members. In order to keep
 	// the account the argument wipe_data should be set to False.
 	KeepAccount bool `json:"keep_account"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersRemoveArg returns a new MembersRemoveArg instance
@@ -2177,9 +4982,53 @@ func NewMembersRemoveArg(User *UserSelectorArg) *MembersRemoveArg {
 	return s
 }
 
+// MarshalJSON serializes a MembersRemoveArg instance
+func (u MembersRemoveArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersRemoveArg instance
+func (u *MembersRemoveArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersRemoveArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.User != nil {
+		if err := s.User.Validate(); err != nil {
+			return dropbox.NestValidationError("user", err)
+		}
+	}
+	if s.TransferDestId != nil {
+		if err := s.TransferDestId.Validate(); err != nil {
+			return dropbox.NestValidationError("transfer_dest_id", err)
+		}
+	}
+	if s.TransferAdminId != nil {
+		if err := s.TransferAdminId.Validate(); err != nil {
+			return dropbox.NestValidationError("transfer_admin_id", err)
+		}
+	}
+	return nil
+}
+
 // MembersRemoveError : has no documentation (yet)
 type MembersRemoveError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersRemoveError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersRemoveError
@@ -2198,9 +5047,53 @@ const (
 	MembersRemoveErrorEmailAddressTooLongToBeDisabled     = "email_address_too_long_to_be_disabled"
 )
 
+// UnmarshalJSON deserializes into a MembersRemoveError instance
+func (u *MembersRemoveError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "remove_last_admin", "removed_and_transfer_dest_should_differ", "removed_and_transfer_admin_should_differ", "transfer_dest_user_not_found", "transfer_dest_user_not_in_team", "transfer_admin_user_not_found", "transfer_admin_user_not_in_team", "unspecified_transfer_admin_id", "transfer_admin_is_not_admin", "cannot_keep_account_and_transfer", "cannot_keep_account_and_delete_data", "email_address_too_long_to_be_disabled":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersRemoveError instance
+func (u MembersRemoveError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersRemoveError reports whether err carries a MembersRemoveError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersRemoveError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersRemoveError)(nil), tags...)
+}
+
 // MembersSendWelcomeError :
 type MembersSendWelcomeError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersSendWelcomeError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersSendWelcomeError
@@ -2208,6 +5101,39 @@ const (
 	MembersSendWelcomeErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a MembersSendWelcomeError instance
+func (u *MembersSendWelcomeError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersSendWelcomeError instance
+func (u MembersSendWelcomeError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersSendWelcomeError reports whether err carries a MembersSendWelcomeError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersSendWelcomeError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersSendWelcomeError)(nil), tags...)
+}
+
 // MembersSetPermissionsArg : Exactly one of team_member_id, email, or
 // external_id must be provided to identify the user account.
 type MembersSetPermissionsArg struct {
@@ -2215,6 +5141,8 @@ type MembersSetPermissionsArg struct {
 	User *UserSelectorArg `json:"user"`
 	// NewRole : The new role of the member.
 	NewRole *AdminTier `json:"new_role"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersSetPermissionsArg returns a new MembersSetPermissionsArg instance
@@ -2225,9 +5153,43 @@ func NewMembersSetPermissionsArg(User *UserSelectorArg, NewRole *AdminTier) *Mem
 	return s
 }
 
+// MarshalJSON serializes a MembersSetPermissionsArg instance
+func (u MembersSetPermissionsArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersSetPermissionsArg instance
+func (u *MembersSetPermissionsArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersSetPermissionsArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.User != nil {
+		if err := s.User.Validate(); err != nil {
+			return dropbox.NestValidationError("user", err)
+		}
+	}
+	return nil
+}
+
 // MembersSetPermissionsError : has no documentation (yet)
 type MembersSetPermissionsError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersSetPermissionsError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersSetPermissionsError
@@ -2239,12 +5201,47 @@ const (
 	MembersSetPermissionsErrorOther                = "other"
 )
 
+// UnmarshalJSON deserializes into a MembersSetPermissionsError instance
+func (u *MembersSetPermissionsError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "last_admin", "user_not_in_team", "cannot_set_permissions", "team_license_limit", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersSetPermissionsError instance
+func (u MembersSetPermissionsError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersSetPermissionsError reports whether err carries a MembersSetPermissionsError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersSetPermissionsError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersSetPermissionsError)(nil), tags...)
+}
+
 // MembersSetPermissionsResult : has no documentation (yet)
 type MembersSetPermissionsResult struct {
 	// TeamMemberId : The member ID of the user to which the change was applied.
 	TeamMemberId string `json:"team_member_id"`
 	// Role : The role after the change.
 	Role *AdminTier `json:"role"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersSetPermissionsResult returns a new MembersSetPermissionsResult instance
@@ -2255,6 +5252,16 @@ func NewMembersSetPermissionsResult(TeamMemberId string, Role *AdminTier) *Membe
 	return s
 }
 
+// MarshalJSON serializes a MembersSetPermissionsResult instance
+func (u MembersSetPermissionsResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersSetPermissionsResult instance
+func (u *MembersSetPermissionsResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MembersSetProfileArg : Exactly one of team_member_id, email, or external_id
 // must be provided to identify the user account. At least one of new_email,
 // new_external_id, new_given_name, and/or new_surname must be provided.
@@ -2272,6 +5279,8 @@ type MembersSetProfileArg struct {
 	// NewPersistentId : New persistent ID. This field only available to teams
 	// using persistent ID SAML configuration.
 	NewPersistentId string `json:"new_persistent_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersSetProfileArg returns a new MembersSetProfileArg instance
@@ -2281,9 +5290,43 @@ func NewMembersSetProfileArg(User *UserSelectorArg) *MembersSetProfileArg {
 	return s
 }
 
+// MarshalJSON serializes a MembersSetProfileArg instance
+func (u MembersSetProfileArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersSetProfileArg instance
+func (u *MembersSetProfileArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersSetProfileArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.User != nil {
+		if err := s.User.Validate(); err != nil {
+			return dropbox.NestValidationError("user", err)
+		}
+	}
+	return nil
+}
+
 // MembersSetProfileError : has no documentation (yet)
 type MembersSetProfileError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersSetProfileError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersSetProfileError
@@ -2299,9 +5342,53 @@ const (
 	MembersSetProfileErrorOther                            = "other"
 )
 
+// UnmarshalJSON deserializes into a MembersSetProfileError instance
+func (u *MembersSetProfileError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "external_id_and_new_external_id_unsafe", "no_new_data_specified", "email_reserved_for_other_user", "external_id_used_by_other_user", "set_profile_disallowed", "param_cannot_be_empty", "persistent_id_disabled", "persistent_id_used_by_other_user", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersSetProfileError instance
+func (u MembersSetProfileError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersSetProfileError reports whether err carries a MembersSetProfileError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersSetProfileError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersSetProfileError)(nil), tags...)
+}
+
 // MembersSuspendError : has no documentation (yet)
 type MembersSuspendError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersSuspendError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersSuspendError
@@ -2311,11 +5398,46 @@ const (
 	MembersSuspendErrorTeamLicenseLimit    = "team_license_limit"
 )
 
+// UnmarshalJSON deserializes into a MembersSuspendError instance
+func (u *MembersSuspendError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "suspend_inactive_user", "suspend_last_admin", "team_license_limit":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersSuspendError instance
+func (u MembersSuspendError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersSuspendError reports whether err carries a MembersSuspendError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersSuspendError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersSuspendError)(nil), tags...)
+}
+
 // MembersUnsuspendArg : Exactly one of team_member_id, email, or external_id
 // must be provided to identify the user account.
 type MembersUnsuspendArg struct {
 	// User : Identity of user to unsuspend.
 	User *UserSelectorArg `json:"user"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMembersUnsuspendArg returns a new MembersUnsuspendArg instance
@@ -2325,9 +5447,43 @@ func NewMembersUnsuspendArg(User *UserSelectorArg) *MembersUnsuspendArg {
 	return s
 }
 
+// MarshalJSON serializes a MembersUnsuspendArg instance
+func (u MembersUnsuspendArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MembersUnsuspendArg instance
+func (u *MembersUnsuspendArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *MembersUnsuspendArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.User != nil {
+		if err := s.User.Validate(); err != nil {
+			return dropbox.NestValidationError("user", err)
+		}
+	}
+	return nil
+}
+
 // MembersUnsuspendError : has no documentation (yet)
 type MembersUnsuspendError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MembersUnsuspendError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MembersUnsuspendError
@@ -2336,9 +5492,53 @@ const (
 	MembersUnsuspendErrorTeamLicenseLimit            = "team_license_limit"
 )
 
+// UnmarshalJSON deserializes into a MembersUnsuspendError instance
+func (u *MembersUnsuspendError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "unsuspend_non_suspended_member", "team_license_limit":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MembersUnsuspendError instance
+func (u MembersUnsuspendError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsMembersUnsuspendError reports whether err carries a MembersUnsuspendError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsMembersUnsuspendError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*MembersUnsuspendError)(nil), tags...)
+}
+
 // MobileClientPlatform : has no documentation (yet)
 type MobileClientPlatform struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MobileClientPlatform) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MobileClientPlatform
@@ -2351,6 +5551,32 @@ const (
 	MobileClientPlatformOther        = "other"
 )
 
+// UnmarshalJSON deserializes into a MobileClientPlatform instance
+func (u *MobileClientPlatform) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "iphone", "ipad", "android", "windows_phone", "blackberry", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MobileClientPlatform instance
+func (u MobileClientPlatform) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // MobileClientSession : Information about linked Dropbox mobile client sessions
 type MobileClientSession struct {
 	DeviceSession
@@ -2364,6 +5590,8 @@ type MobileClientSession struct {
 	OsVersion string `json:"os_version,omitempty"`
 	// LastCarrier : last carrier used by the device
 	LastCarrier string `json:"last_carrier,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMobileClientSession returns a new MobileClientSession instance
@@ -2375,10 +5603,22 @@ func NewMobileClientSession(SessionId string, DeviceName string, ClientType *Mob
 	return s
 }
 
+// MarshalJSON serializes a MobileClientSession instance
+func (u MobileClientSession) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MobileClientSession instance
+func (u *MobileClientSession) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RemovedStatus : has no documentation (yet)
 type RemovedStatus struct {
 	// IsRecoverable : True if the removed team member is recoverable.
 	IsRecoverable bool `json:"is_recoverable"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRemovedStatus returns a new RemovedStatus instance
@@ -2388,6 +5628,16 @@ func NewRemovedStatus(IsRecoverable bool) *RemovedStatus {
 	return s
 }
 
+// MarshalJSON serializes a RemovedStatus instance
+func (u RemovedStatus) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RemovedStatus instance
+func (u *RemovedStatus) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RevokeDesktopClientArg : has no documentation (yet)
 type RevokeDesktopClientArg struct {
 	DeviceSessionArg
@@ -2395,6 +5645,8 @@ type RevokeDesktopClientArg struct {
 	// possible only if supported by the desktop client and  will be made the
 	// next time the client access the account)
 	DeleteOnUnlink bool `json:"delete_on_unlink"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRevokeDesktopClientArg returns a new RevokeDesktopClientArg instance
@@ -2406,6 +5658,16 @@ func NewRevokeDesktopClientArg(SessionId string, TeamMemberId string) *RevokeDes
 	return s
 }
 
+// MarshalJSON serializes a RevokeDesktopClientArg instance
+func (u RevokeDesktopClientArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RevokeDesktopClientArg instance
+func (u *RevokeDesktopClientArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RevokeDeviceSessionArg : has no documentation (yet)
 type RevokeDeviceSessionArg struct {
 	dropbox.Tagged
@@ -2415,6 +5677,17 @@ type RevokeDeviceSessionArg struct {
 	DesktopClient *RevokeDesktopClientArg `json:"desktop_client,omitempty"`
 	// MobileClient : Unlink a linked mobile device
 	MobileClient *DeviceSessionArg `json:"mobile_client,omitempty"`
+	raw          string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RevokeDeviceSessionArg) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RevokeDeviceSessionArg
@@ -2440,7 +5713,7 @@ func (u *RevokeDeviceSessionArg) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "web_session":
 		err = json.Unmarshal(body, &u.WebSession)
@@ -2460,14 +5733,41 @@ func (u *RevokeDeviceSessionArg) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a RevokeDeviceSessionArg instance
+func (u RevokeDeviceSessionArg) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "web_session":
+		return dropbox.MarshalTagged(u.Tag, u.WebSession)
+
+	case "desktop_client":
+		return dropbox.MarshalTagged(u.Tag, u.DesktopClient)
+
+	case "mobile_client":
+		return dropbox.MarshalTagged(u.Tag, u.MobileClient)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// Validate checks that u satisfies the constraints of the API spec
+func (u *RevokeDeviceSessionArg) Validate() error {
+	return nil
+}
+
 // RevokeDeviceSessionBatchArg : has no documentation (yet)
 type RevokeDeviceSessionBatchArg struct {
 	// RevokeDevices : has no documentation (yet)
 	RevokeDevices []*RevokeDeviceSessionArg `json:"revoke_devices"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRevokeDeviceSessionBatchArg returns a new RevokeDeviceSessionBatchArg instance
@@ -2477,20 +5777,91 @@ func NewRevokeDeviceSessionBatchArg(RevokeDevices []*RevokeDeviceSessionArg) *Re
 	return s
 }
 
+// MarshalJSON serializes a RevokeDeviceSessionBatchArg instance
+func (u RevokeDeviceSessionBatchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RevokeDeviceSessionBatchArg instance
+func (u *RevokeDeviceSessionBatchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RevokeDeviceSessionBatchArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	for i, v := range s.RevokeDevices {
+		if v != nil {
+			if err := v.Validate(); err != nil {
+				return dropbox.NestValidationError(fmt.Sprintf("revoke_devices[%d]", i), err)
+			}
+		}
+	}
+	return nil
+}
+
 // RevokeDeviceSessionBatchError :
 type RevokeDeviceSessionBatchError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RevokeDeviceSessionBatchError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
+}
+
+// Valid tag values for RevokeDeviceSessionBatchError
+const (
+	RevokeDeviceSessionBatchErrorOther = "other"
+)
+
+// UnmarshalJSON deserializes into a RevokeDeviceSessionBatchError instance
+func (u *RevokeDeviceSessionBatchError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
 }
 
-// Valid tag values for RevokeDeviceSessionBatchError
-const (
-	RevokeDeviceSessionBatchErrorOther = "other"
-)
+// MarshalJSON serializes a RevokeDeviceSessionBatchError instance
+func (u RevokeDeviceSessionBatchError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRevokeDeviceSessionBatchError reports whether err carries a RevokeDeviceSessionBatchError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRevokeDeviceSessionBatchError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RevokeDeviceSessionBatchError)(nil), tags...)
+}
 
 // RevokeDeviceSessionBatchResult : has no documentation (yet)
 type RevokeDeviceSessionBatchResult struct {
 	// RevokeDevicesStatus : has no documentation (yet)
 	RevokeDevicesStatus []*RevokeDeviceSessionStatus `json:"revoke_devices_status"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRevokeDeviceSessionBatchResult returns a new RevokeDeviceSessionBatchResult instance
@@ -2500,9 +5871,30 @@ func NewRevokeDeviceSessionBatchResult(RevokeDevicesStatus []*RevokeDeviceSessio
 	return s
 }
 
+// MarshalJSON serializes a RevokeDeviceSessionBatchResult instance
+func (u RevokeDeviceSessionBatchResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RevokeDeviceSessionBatchResult instance
+func (u *RevokeDeviceSessionBatchResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RevokeDeviceSessionError : has no documentation (yet)
 type RevokeDeviceSessionError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RevokeDeviceSessionError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RevokeDeviceSessionError
@@ -2512,12 +5904,47 @@ const (
 	RevokeDeviceSessionErrorOther                 = "other"
 )
 
+// UnmarshalJSON deserializes into a RevokeDeviceSessionError instance
+func (u *RevokeDeviceSessionError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "device_session_not_found", "member_not_found", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a RevokeDeviceSessionError instance
+func (u RevokeDeviceSessionError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRevokeDeviceSessionError reports whether err carries a RevokeDeviceSessionError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRevokeDeviceSessionError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RevokeDeviceSessionError)(nil), tags...)
+}
+
 // RevokeDeviceSessionStatus : has no documentation (yet)
 type RevokeDeviceSessionStatus struct {
 	// Success : Result of the revoking request
 	Success bool `json:"success"`
 	// ErrorType : The error cause in case of a failure
 	ErrorType *RevokeDeviceSessionError `json:"error_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRevokeDeviceSessionStatus returns a new RevokeDeviceSessionStatus instance
@@ -2527,6 +5954,16 @@ func NewRevokeDeviceSessionStatus(Success bool) *RevokeDeviceSessionStatus {
 	return s
 }
 
+// MarshalJSON serializes a RevokeDeviceSessionStatus instance
+func (u RevokeDeviceSessionStatus) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RevokeDeviceSessionStatus instance
+func (u *RevokeDeviceSessionStatus) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RevokeLinkedApiAppArg : has no documentation (yet)
 type RevokeLinkedApiAppArg struct {
 	// AppId : The application's unique id
@@ -2536,6 +5973,8 @@ type RevokeLinkedApiAppArg struct {
 	// KeepAppFolder : Whether to keep the application dedicated folder (in case
 	// the application uses  one)
 	KeepAppFolder bool `json:"keep_app_folder"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRevokeLinkedApiAppArg returns a new RevokeLinkedApiAppArg instance
@@ -2547,10 +5986,27 @@ func NewRevokeLinkedApiAppArg(AppId string, TeamMemberId string) *RevokeLinkedAp
 	return s
 }
 
+// MarshalJSON serializes a RevokeLinkedApiAppArg instance
+func (u RevokeLinkedApiAppArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RevokeLinkedApiAppArg instance
+func (u *RevokeLinkedApiAppArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RevokeLinkedApiAppArg) Validate() error {
+	return nil
+}
+
 // RevokeLinkedApiAppBatchArg : has no documentation (yet)
 type RevokeLinkedApiAppBatchArg struct {
 	// RevokeLinkedApp : has no documentation (yet)
 	RevokeLinkedApp []*RevokeLinkedApiAppArg `json:"revoke_linked_app"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRevokeLinkedApiAppBatchArg returns a new RevokeLinkedApiAppBatchArg instance
@@ -2560,10 +6016,46 @@ func NewRevokeLinkedApiAppBatchArg(RevokeLinkedApp []*RevokeLinkedApiAppArg) *Re
 	return s
 }
 
+// MarshalJSON serializes a RevokeLinkedApiAppBatchArg instance
+func (u RevokeLinkedApiAppBatchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RevokeLinkedApiAppBatchArg instance
+func (u *RevokeLinkedApiAppBatchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *RevokeLinkedApiAppBatchArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	for i, v := range s.RevokeLinkedApp {
+		if v != nil {
+			if err := v.Validate(); err != nil {
+				return dropbox.NestValidationError(fmt.Sprintf("revoke_linked_app[%d]", i), err)
+			}
+		}
+	}
+	return nil
+}
+
 // RevokeLinkedAppBatchError : Error returned by
 // `linkedAppsRevokeLinkedAppBatch`.
 type RevokeLinkedAppBatchError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RevokeLinkedAppBatchError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RevokeLinkedAppBatchError
@@ -2571,10 +6063,45 @@ const (
 	RevokeLinkedAppBatchErrorOther = "other"
 )
 
+// UnmarshalJSON deserializes into a RevokeLinkedAppBatchError instance
+func (u *RevokeLinkedAppBatchError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a RevokeLinkedAppBatchError instance
+func (u RevokeLinkedAppBatchError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRevokeLinkedAppBatchError reports whether err carries a RevokeLinkedAppBatchError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRevokeLinkedAppBatchError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RevokeLinkedAppBatchError)(nil), tags...)
+}
+
 // RevokeLinkedAppBatchResult : has no documentation (yet)
 type RevokeLinkedAppBatchResult struct {
 	// RevokeLinkedAppStatus : has no documentation (yet)
 	RevokeLinkedAppStatus []*RevokeLinkedAppStatus `json:"revoke_linked_app_status"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRevokeLinkedAppBatchResult returns a new RevokeLinkedAppBatchResult instance
@@ -2584,9 +6111,30 @@ func NewRevokeLinkedAppBatchResult(RevokeLinkedAppStatus []*RevokeLinkedAppStatu
 	return s
 }
 
+// MarshalJSON serializes a RevokeLinkedAppBatchResult instance
+func (u RevokeLinkedAppBatchResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RevokeLinkedAppBatchResult instance
+func (u *RevokeLinkedAppBatchResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RevokeLinkedAppError : Error returned by `linkedAppsRevokeLinkedApp`.
 type RevokeLinkedAppError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u RevokeLinkedAppError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for RevokeLinkedAppError
@@ -2596,12 +6144,47 @@ const (
 	RevokeLinkedAppErrorOther          = "other"
 )
 
+// UnmarshalJSON deserializes into a RevokeLinkedAppError instance
+func (u *RevokeLinkedAppError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "app_not_found", "member_not_found", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a RevokeLinkedAppError instance
+func (u RevokeLinkedAppError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsRevokeLinkedAppError reports whether err carries a RevokeLinkedAppError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsRevokeLinkedAppError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*RevokeLinkedAppError)(nil), tags...)
+}
+
 // RevokeLinkedAppStatus : has no documentation (yet)
 type RevokeLinkedAppStatus struct {
 	// Success : Result of the revoking request
 	Success bool `json:"success"`
 	// ErrorType : The error cause in case of a failure
 	ErrorType *RevokeLinkedAppError `json:"error_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRevokeLinkedAppStatus returns a new RevokeLinkedAppStatus instance
@@ -2611,6 +6194,16 @@ func NewRevokeLinkedAppStatus(Success bool) *RevokeLinkedAppStatus {
 	return s
 }
 
+// MarshalJSON serializes a RevokeLinkedAppStatus instance
+func (u RevokeLinkedAppStatus) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RevokeLinkedAppStatus instance
+func (u *RevokeLinkedAppStatus) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // StorageBucket : Describes the number of users in a specific storage bucket.
 type StorageBucket struct {
 	// Bucket : The name of the storage bucket. For example, '1G' is a bucket of
@@ -2619,6 +6212,8 @@ type StorageBucket struct {
 	// Users : The number of people whose storage is in the range of this
 	// storage bucket.
 	Users uint64 `json:"users"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewStorageBucket returns a new StorageBucket instance
@@ -2629,9 +6224,30 @@ func NewStorageBucket(Bucket string, Users uint64) *StorageBucket {
 	return s
 }
 
+// MarshalJSON serializes a StorageBucket instance
+func (u StorageBucket) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a StorageBucket instance
+func (u *StorageBucket) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderAccessError : has no documentation (yet)
 type TeamFolderAccessError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderAccessError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderAccessError
@@ -2641,18 +6257,96 @@ const (
 	TeamFolderAccessErrorOther               = "other"
 )
 
+// UnmarshalJSON deserializes into a TeamFolderAccessError instance
+func (u *TeamFolderAccessError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_team_folder_id", "no_access", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderAccessError instance
+func (u TeamFolderAccessError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTeamFolderAccessError reports whether err carries a TeamFolderAccessError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTeamFolderAccessError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TeamFolderAccessError)(nil), tags...)
+}
+
 // TeamFolderActivateError :
 type TeamFolderActivateError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderActivateError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderActivateError
 const ()
 
+// UnmarshalJSON deserializes into a TeamFolderActivateError instance
+func (u *TeamFolderActivateError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderActivateError instance
+func (u TeamFolderActivateError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTeamFolderActivateError reports whether err carries a TeamFolderActivateError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTeamFolderActivateError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TeamFolderActivateError)(nil), tags...)
+}
+
 // TeamFolderIdArg : has no documentation (yet)
 type TeamFolderIdArg struct {
 	// TeamFolderId : The ID of the team folder.
 	TeamFolderId string `json:"team_folder_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderIdArg returns a new TeamFolderIdArg instance
@@ -2662,11 +6356,28 @@ func NewTeamFolderIdArg(TeamFolderId string) *TeamFolderIdArg {
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderIdArg instance
+func (u TeamFolderIdArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderIdArg instance
+func (u *TeamFolderIdArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *TeamFolderIdArg) Validate() error {
+	return nil
+}
+
 // TeamFolderArchiveArg : has no documentation (yet)
 type TeamFolderArchiveArg struct {
 	TeamFolderIdArg
 	// ForceAsyncOff : Whether to force the archive to happen synchronously.
 	ForceAsyncOff bool `json:"force_async_off"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderArchiveArg returns a new TeamFolderArchiveArg instance
@@ -2677,14 +6388,72 @@ func NewTeamFolderArchiveArg(TeamFolderId string) *TeamFolderArchiveArg {
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderArchiveArg instance
+func (u TeamFolderArchiveArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderArchiveArg instance
+func (u *TeamFolderArchiveArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *TeamFolderArchiveArg) Validate() error {
+	return nil
+}
+
 // TeamFolderArchiveError :
 type TeamFolderArchiveError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderArchiveError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderArchiveError
 const ()
 
+// UnmarshalJSON deserializes into a TeamFolderArchiveError instance
+func (u *TeamFolderArchiveError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderArchiveError instance
+func (u TeamFolderArchiveError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTeamFolderArchiveError reports whether err carries a TeamFolderArchiveError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTeamFolderArchiveError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TeamFolderArchiveError)(nil), tags...)
+}
+
 // TeamFolderArchiveJobStatus : has no documentation (yet)
 type TeamFolderArchiveJobStatus struct {
 	dropbox.Tagged
@@ -2694,12 +6463,24 @@ type TeamFolderArchiveJobStatus struct {
 	// Failed : Error occurred while performing an asynchronous job from
 	// `teamFolderArchive`.
 	Failed *TeamFolderArchiveError `json:"failed,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderArchiveJobStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderArchiveJobStatus
 const (
-	TeamFolderArchiveJobStatusComplete = "complete"
-	TeamFolderArchiveJobStatusFailed   = "failed"
+	TeamFolderArchiveJobStatusInProgress = "in_progress"
+	TeamFolderArchiveJobStatusComplete   = "complete"
+	TeamFolderArchiveJobStatusFailed     = "failed"
 )
 
 // UnmarshalJSON deserializes into a TeamFolderArchiveJobStatus instance
@@ -2718,7 +6499,7 @@ func (u *TeamFolderArchiveJobStatus) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
@@ -2732,26 +6513,67 @@ func (u *TeamFolderArchiveJobStatus) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "in_progress":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderArchiveJobStatus instance
+func (u TeamFolderArchiveJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *TeamFolderArchiveError `json:"failed"`
+		}{u.Tagged, u.Failed})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TeamFolderArchiveLaunch : has no documentation (yet)
 type TeamFolderArchiveLaunch struct {
 	dropbox.Tagged
+	// AsyncJobId : This response indicates that the processing is asynchronous.
+	// The string is an id that can be used to obtain the status of the
+	// asynchronous job.
+	AsyncJobId string `json:"async_job_id,omitempty"`
 	// Complete : has no documentation (yet)
 	Complete *TeamFolderMetadata `json:"complete,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderArchiveLaunch) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderArchiveLaunch
 const (
-	TeamFolderArchiveLaunchComplete = "complete"
+	TeamFolderArchiveLaunchAsyncJobId = "async_job_id"
+	TeamFolderArchiveLaunchComplete   = "complete"
 )
 
 // UnmarshalJSON deserializes into a TeamFolderArchiveLaunch instance
 func (u *TeamFolderArchiveLaunch) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// AsyncJobId : This response indicates that the processing is
+		// asynchronous. The string is an id that can be used to obtain the
+		// status of the asynchronous job.
+		AsyncJobId json.RawMessage `json:"async_job_id,omitempty"`
 		// Complete : has no documentation (yet)
 		Complete json.RawMessage `json:"complete,omitempty"`
 	}
@@ -2760,22 +6582,50 @@ func (u *TeamFolderArchiveLaunch) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
+	case "async_job_id":
+		err = json.Unmarshal(w.AsyncJobId, &u.AsyncJobId)
+
+		if err != nil {
+			return err
+		}
 	case "complete":
 		err = json.Unmarshal(body, &u.Complete)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderArchiveLaunch instance
+func (u TeamFolderArchiveLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+
+	case "complete":
+		return dropbox.MarshalTagged(u.Tag, u.Complete)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TeamFolderCreateArg : has no documentation (yet)
 type TeamFolderCreateArg struct {
 	// Name : Name for the new team folder.
 	Name string `json:"name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderCreateArg returns a new TeamFolderCreateArg instance
@@ -2785,9 +6635,35 @@ func NewTeamFolderCreateArg(Name string) *TeamFolderCreateArg {
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderCreateArg instance
+func (u TeamFolderCreateArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderCreateArg instance
+func (u *TeamFolderCreateArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *TeamFolderCreateArg) Validate() error {
+	return nil
+}
+
 // TeamFolderCreateError : has no documentation (yet)
 type TeamFolderCreateError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderCreateError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderCreateError
@@ -2798,6 +6674,39 @@ const (
 	TeamFolderCreateErrorOther                 = "other"
 )
 
+// UnmarshalJSON deserializes into a TeamFolderCreateError instance
+func (u *TeamFolderCreateError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_folder_name", "folder_name_already_used", "folder_name_reserved", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderCreateError instance
+func (u TeamFolderCreateError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTeamFolderCreateError reports whether err carries a TeamFolderCreateError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTeamFolderCreateError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TeamFolderCreateError)(nil), tags...)
+}
+
 // TeamFolderGetInfoItem : has no documentation (yet)
 type TeamFolderGetInfoItem struct {
 	dropbox.Tagged
@@ -2806,6 +6715,17 @@ type TeamFolderGetInfoItem struct {
 	IdNotFound string `json:"id_not_found,omitempty"`
 	// TeamFolderMetadata : Properties of a team folder.
 	TeamFolderMetadata *TeamFolderMetadata `json:"team_folder_metadata,omitempty"`
+	raw                string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderGetInfoItem) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderGetInfoItem
@@ -2818,6 +6738,9 @@ const (
 func (u *TeamFolderGetInfoItem) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// IdNotFound : An ID that was provided as a parameter to
+		// `teamFolderGetInfo` did not match any of the team's team folders.
+		IdNotFound json.RawMessage `json:"id_not_found,omitempty"`
 		// TeamFolderMetadata : Properties of a team folder.
 		TeamFolderMetadata json.RawMessage `json:"team_folder_metadata,omitempty"`
 	}
@@ -2826,10 +6749,10 @@ func (u *TeamFolderGetInfoItem) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "id_not_found":
-		err = json.Unmarshal(body, &u.IdNotFound)
+		err = json.Unmarshal(w.IdNotFound, &u.IdNotFound)
 
 		if err != nil {
 			return err
@@ -2840,14 +6763,36 @@ func (u *TeamFolderGetInfoItem) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderGetInfoItem instance
+func (u TeamFolderGetInfoItem) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "id_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			IdNotFound string `json:"id_not_found"`
+		}{u.Tagged, u.IdNotFound})
+
+	case "team_folder_metadata":
+		return dropbox.MarshalTagged(u.Tag, u.TeamFolderMetadata)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TeamFolderIdListArg : has no documentation (yet)
 type TeamFolderIdListArg struct {
 	// TeamFolderIds : The list of team folder IDs.
 	TeamFolderIds []string `json:"team_folder_ids"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderIdListArg returns a new TeamFolderIdListArg instance
@@ -2857,9 +6802,35 @@ func NewTeamFolderIdListArg(TeamFolderIds []string) *TeamFolderIdListArg {
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderIdListArg instance
+func (u TeamFolderIdListArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderIdListArg instance
+func (u *TeamFolderIdListArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *TeamFolderIdListArg) Validate() error {
+	return nil
+}
+
 // TeamFolderInvalidStatusError : has no documentation (yet)
 type TeamFolderInvalidStatusError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderInvalidStatusError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderInvalidStatusError
@@ -2870,10 +6841,45 @@ const (
 	TeamFolderInvalidStatusErrorOther             = "other"
 )
 
+// UnmarshalJSON deserializes into a TeamFolderInvalidStatusError instance
+func (u *TeamFolderInvalidStatusError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "active", "archived", "archive_in_progress", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderInvalidStatusError instance
+func (u TeamFolderInvalidStatusError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTeamFolderInvalidStatusError reports whether err carries a TeamFolderInvalidStatusError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTeamFolderInvalidStatusError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TeamFolderInvalidStatusError)(nil), tags...)
+}
+
 // TeamFolderListArg : has no documentation (yet)
 type TeamFolderListArg struct {
 	// Limit : The maximum number of results to return per request.
 	Limit uint32 `json:"limit"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderListArg returns a new TeamFolderListArg instance
@@ -2883,10 +6889,27 @@ func NewTeamFolderListArg() *TeamFolderListArg {
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderListArg instance
+func (u TeamFolderListArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderListArg instance
+func (u *TeamFolderListArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *TeamFolderListArg) Validate() error {
+	return nil
+}
+
 // TeamFolderListContinueArg : has no documentation (yet)
 type TeamFolderListContinueArg struct {
 	// Cursor : Indicates from what point to get the next set of team folders.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderListContinueArg returns a new TeamFolderListContinueArg instance
@@ -2896,9 +6919,35 @@ func NewTeamFolderListContinueArg(Cursor string) *TeamFolderListContinueArg {
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderListContinueArg instance
+func (u TeamFolderListContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderListContinueArg instance
+func (u *TeamFolderListContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *TeamFolderListContinueArg) Validate() error {
+	return nil
+}
+
 // TeamFolderListContinueError : has no documentation (yet)
 type TeamFolderListContinueError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderListContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderListContinueError
@@ -2907,10 +6956,45 @@ const (
 	TeamFolderListContinueErrorOther         = "other"
 )
 
+// UnmarshalJSON deserializes into a TeamFolderListContinueError instance
+func (u *TeamFolderListContinueError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_cursor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderListContinueError instance
+func (u TeamFolderListContinueError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTeamFolderListContinueError reports whether err carries a TeamFolderListContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTeamFolderListContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TeamFolderListContinueError)(nil), tags...)
+}
+
 // TeamFolderListError : has no documentation (yet)
 type TeamFolderListError struct {
 	// AccessError : has no documentation (yet)
 	AccessError *TeamFolderAccessError `json:"access_error"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderListError returns a new TeamFolderListError instance
@@ -2920,6 +7004,16 @@ func NewTeamFolderListError(AccessError *TeamFolderAccessError) *TeamFolderListE
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderListError instance
+func (u TeamFolderListError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderListError instance
+func (u *TeamFolderListError) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderListResult : Result for `teamFolderList` and
 // `teamFolderListContinue`.
 type TeamFolderListResult struct {
@@ -2932,6 +7026,8 @@ type TeamFolderListResult struct {
 	// returned yet. An additional call to `teamFolderListContinue` can retrieve
 	// them.
 	HasMore bool `json:"has_more"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderListResult returns a new TeamFolderListResult instance
@@ -2943,6 +7039,16 @@ func NewTeamFolderListResult(TeamFolders []*TeamFolderMetadata, Cursor string, H
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderListResult instance
+func (u TeamFolderListResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderListResult instance
+func (u *TeamFolderListResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderMetadata : Properties of a team folder.
 type TeamFolderMetadata struct {
 	// TeamFolderId : The ID of the team folder.
@@ -2951,6 +7057,8 @@ type TeamFolderMetadata struct {
 	Name string `json:"name"`
 	// Status : The status of the team folder.
 	Status *TeamFolderStatus `json:"status"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderMetadata returns a new TeamFolderMetadata instance
@@ -2962,19 +7070,74 @@ func NewTeamFolderMetadata(TeamFolderId string, Name string, Status *TeamFolderS
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderMetadata instance
+func (u TeamFolderMetadata) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderMetadata instance
+func (u *TeamFolderMetadata) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderPermanentlyDeleteError :
 type TeamFolderPermanentlyDeleteError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderPermanentlyDeleteError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderPermanentlyDeleteError
 const ()
 
+// UnmarshalJSON deserializes into a TeamFolderPermanentlyDeleteError instance
+func (u *TeamFolderPermanentlyDeleteError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderPermanentlyDeleteError instance
+func (u TeamFolderPermanentlyDeleteError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTeamFolderPermanentlyDeleteError reports whether err carries a TeamFolderPermanentlyDeleteError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTeamFolderPermanentlyDeleteError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TeamFolderPermanentlyDeleteError)(nil), tags...)
+}
+
 // TeamFolderRenameArg : has no documentation (yet)
 type TeamFolderRenameArg struct {
 	TeamFolderIdArg
 	// Name : New team folder name.
 	Name string `json:"name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderRenameArg returns a new TeamFolderRenameArg instance
@@ -2985,9 +7148,35 @@ func NewTeamFolderRenameArg(TeamFolderId string, Name string) *TeamFolderRenameA
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderRenameArg instance
+func (u TeamFolderRenameArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderRenameArg instance
+func (u *TeamFolderRenameArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *TeamFolderRenameArg) Validate() error {
+	return nil
+}
+
 // TeamFolderRenameError : has no documentation (yet)
 type TeamFolderRenameError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderRenameError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderRenameError
@@ -2997,9 +7186,53 @@ const (
 	TeamFolderRenameErrorFolderNameReserved    = "folder_name_reserved"
 )
 
+// UnmarshalJSON deserializes into a TeamFolderRenameError instance
+func (u *TeamFolderRenameError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "invalid_folder_name", "folder_name_already_used", "folder_name_reserved":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderRenameError instance
+func (u TeamFolderRenameError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTeamFolderRenameError reports whether err carries a TeamFolderRenameError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTeamFolderRenameError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TeamFolderRenameError)(nil), tags...)
+}
+
 // TeamFolderStatus : has no documentation (yet)
 type TeamFolderStatus struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderStatus
@@ -3010,6 +7243,32 @@ const (
 	TeamFolderStatusOther             = "other"
 )
 
+// UnmarshalJSON deserializes into a TeamFolderStatus instance
+func (u *TeamFolderStatus) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "active", "archived", "archive_in_progress", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderStatus instance
+func (u TeamFolderStatus) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TeamGetInfoResult : has no documentation (yet)
 type TeamGetInfoResult struct {
 	// Name : The name of the team.
@@ -3023,6 +7282,8 @@ type TeamGetInfoResult struct {
 	NumProvisionedUsers uint32 `json:"num_provisioned_users"`
 	// Policies : has no documentation (yet)
 	Policies *team_policies.TeamMemberPolicies `json:"policies"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamGetInfoResult returns a new TeamGetInfoResult instance
@@ -3036,12 +7297,24 @@ func NewTeamGetInfoResult(Name string, TeamId string, NumLicensedUsers uint32, N
 	return s
 }
 
+// MarshalJSON serializes a TeamGetInfoResult instance
+func (u TeamGetInfoResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamGetInfoResult instance
+func (u *TeamGetInfoResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamMemberInfo : Information about a team member.
 type TeamMemberInfo struct {
 	// Profile : Profile of a user as a member of a team.
 	Profile *TeamMemberProfile `json:"profile"`
 	// Role : The user's role in the team.
 	Role *AdminTier `json:"role"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamMemberInfo returns a new TeamMemberInfo instance
@@ -3052,11 +7325,23 @@ func NewTeamMemberInfo(Profile *TeamMemberProfile, Role *AdminTier) *TeamMemberI
 	return s
 }
 
+// MarshalJSON serializes a TeamMemberInfo instance
+func (u TeamMemberInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamMemberInfo instance
+func (u *TeamMemberInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamMemberProfile : Profile of a user as a member of a team.
 type TeamMemberProfile struct {
 	MemberProfile
 	// Groups : List of group IDs of groups that the user belongs to.
 	Groups []string `json:"groups"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamMemberProfile returns a new TeamMemberProfile instance
@@ -3072,12 +7357,33 @@ func NewTeamMemberProfile(TeamMemberId string, Email string, EmailVerified bool,
 	return s
 }
 
+// MarshalJSON serializes a TeamMemberProfile instance
+func (u TeamMemberProfile) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamMemberProfile instance
+func (u *TeamMemberProfile) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamMemberStatus : The user's status as a member of a specific team.
 type TeamMemberStatus struct {
 	dropbox.Tagged
 	// Removed : User is no longer a member of the team. Removed users are only
 	// listed when include_removed is true in members/list.
 	Removed *RemovedStatus `json:"removed,omitempty"`
+	raw     string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamMemberStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamMemberStatus
@@ -3101,7 +7407,7 @@ func (u *TeamMemberStatus) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "removed":
 		err = json.Unmarshal(body, &u.Removed)
@@ -3109,13 +7415,39 @@ func (u *TeamMemberStatus) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "active", "invited", "suspended":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a TeamMemberStatus instance
+func (u TeamMemberStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "removed":
+		return dropbox.MarshalTagged(u.Tag, u.Removed)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TeamMembershipType : has no documentation (yet)
 type TeamMembershipType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamMembershipType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamMembershipType
@@ -3124,10 +7456,47 @@ const (
 	TeamMembershipTypeLimited = "limited"
 )
 
+// UnmarshalJSON deserializes into a TeamMembershipType instance
+func (u *TeamMembershipType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "full", "limited":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamMembershipType instance
+func (u TeamMembershipType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TokenGetAuthenticatedAdminError : Error returned by
 // `tokenGetAuthenticatedAdmin`.
 type TokenGetAuthenticatedAdminError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TokenGetAuthenticatedAdminError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TokenGetAuthenticatedAdminError
@@ -3137,10 +7506,45 @@ const (
 	TokenGetAuthenticatedAdminErrorOther           = "other"
 )
 
+// UnmarshalJSON deserializes into a TokenGetAuthenticatedAdminError instance
+func (u *TokenGetAuthenticatedAdminError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "mapping_not_found", "admin_not_active", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TokenGetAuthenticatedAdminError instance
+func (u TokenGetAuthenticatedAdminError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsTokenGetAuthenticatedAdminError reports whether err carries a TokenGetAuthenticatedAdminError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsTokenGetAuthenticatedAdminError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*TokenGetAuthenticatedAdminError)(nil), tags...)
+}
+
 // TokenGetAuthenticatedAdminResult : Results for `tokenGetAuthenticatedAdmin`.
 type TokenGetAuthenticatedAdminResult struct {
 	// AdminProfile : The admin who authorized the token.
 	AdminProfile *TeamMemberProfile `json:"admin_profile"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTokenGetAuthenticatedAdminResult returns a new TokenGetAuthenticatedAdminResult instance
@@ -3150,6 +7554,16 @@ func NewTokenGetAuthenticatedAdminResult(AdminProfile *TeamMemberProfile) *Token
 	return s
 }
 
+// MarshalJSON serializes a TokenGetAuthenticatedAdminResult instance
+func (u TokenGetAuthenticatedAdminResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TokenGetAuthenticatedAdminResult instance
+func (u *TokenGetAuthenticatedAdminResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UpdatePropertyTemplateArg : has no documentation (yet)
 type UpdatePropertyTemplateArg struct {
 	// TemplateId : An identifier for property template added by
@@ -3164,6 +7578,8 @@ type UpdatePropertyTemplateArg struct {
 	// AddFields : This is a list of custom properties to add to the property
 	// template. There can be up to 64 properties in a single property template.
 	AddFields []*properties.PropertyFieldTemplate `json:"add_fields,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUpdatePropertyTemplateArg returns a new UpdatePropertyTemplateArg instance
@@ -3173,11 +7589,28 @@ func NewUpdatePropertyTemplateArg(TemplateId string) *UpdatePropertyTemplateArg
 	return s
 }
 
+// MarshalJSON serializes a UpdatePropertyTemplateArg instance
+func (u UpdatePropertyTemplateArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UpdatePropertyTemplateArg instance
+func (u *UpdatePropertyTemplateArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *UpdatePropertyTemplateArg) Validate() error {
+	return nil
+}
+
 // UpdatePropertyTemplateResult : has no documentation (yet)
 type UpdatePropertyTemplateResult struct {
 	// TemplateId : An identifier for property template added by
 	// `propertiesTemplateAdd`.
 	TemplateId string `json:"template_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUpdatePropertyTemplateResult returns a new UpdatePropertyTemplateResult instance
@@ -3187,11 +7620,32 @@ func NewUpdatePropertyTemplateResult(TemplateId string) *UpdatePropertyTemplateR
 	return s
 }
 
+// MarshalJSON serializes a UpdatePropertyTemplateResult instance
+func (u UpdatePropertyTemplateResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UpdatePropertyTemplateResult instance
+func (u *UpdatePropertyTemplateResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UploadApiRateLimitValue : The value for `Feature.upload_api_rate_limit`.
 type UploadApiRateLimitValue struct {
 	dropbox.Tagged
 	// Limit : The number of upload API calls allowed per month.
 	Limit uint32 `json:"limit,omitempty"`
+	raw   string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UploadApiRateLimitValue) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UploadApiRateLimitValue
@@ -3205,24 +7659,44 @@ const (
 func (u *UploadApiRateLimitValue) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// Limit : The number of upload API calls allowed per month.
+		Limit json.RawMessage `json:"limit,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "limit":
-		err = json.Unmarshal(body, &u.Limit)
+		err = json.Unmarshal(w.Limit, &u.Limit)
 
 		if err != nil {
 			return err
 		}
+	case "unlimited", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a UploadApiRateLimitValue instance
+func (u UploadApiRateLimitValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "limit":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Limit uint32 `json:"limit"`
+		}{u.Tagged, u.Limit})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // UserSelectorArg : Argument for selecting a single user, either by
 // team_member_id, external_id or email.
 type UserSelectorArg struct {
@@ -3233,6 +7707,17 @@ type UserSelectorArg struct {
 	ExternalId string `json:"external_id,omitempty"`
 	// Email : has no documentation (yet)
 	Email string `json:"email,omitempty"`
+	raw   string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UserSelectorArg) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UserSelectorArg
@@ -3246,33 +7731,73 @@ const (
 func (u *UserSelectorArg) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// TeamMemberId : has no documentation (yet)
+		TeamMemberId json.RawMessage `json:"team_member_id,omitempty"`
+		// ExternalId : has no documentation (yet)
+		ExternalId json.RawMessage `json:"external_id,omitempty"`
+		// Email : has no documentation (yet)
+		Email json.RawMessage `json:"email,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "team_member_id":
-		err = json.Unmarshal(body, &u.TeamMemberId)
+		err = json.Unmarshal(w.TeamMemberId, &u.TeamMemberId)
 
 		if err != nil {
 			return err
 		}
 	case "external_id":
-		err = json.Unmarshal(body, &u.ExternalId)
+		err = json.Unmarshal(w.ExternalId, &u.ExternalId)
 
 		if err != nil {
 			return err
 		}
 	case "email":
-		err = json.Unmarshal(body, &u.Email)
+		err = json.Unmarshal(w.Email, &u.Email)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a UserSelectorArg instance
+func (u UserSelectorArg) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "team_member_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamMemberId string `json:"team_member_id"`
+		}{u.Tagged, u.TeamMemberId})
+
+	case "external_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			ExternalId string `json:"external_id"`
+		}{u.Tagged, u.ExternalId})
+
+	case "email":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Email string `json:"email"`
+		}{u.Tagged, u.Email})
+
 	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// Validate checks that u satisfies the constraints of the API spec
+func (u *UserSelectorArg) Validate() error {
 	return nil
 }
 
@@ -3286,6 +7811,17 @@ type UsersSelectorArg struct {
 	ExternalIds []string `json:"external_ids,omitempty"`
 	// Emails : List of email addresses.
 	Emails []string `json:"emails,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u UsersSelectorArg) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UsersSelectorArg
@@ -3311,26 +7847,55 @@ func (u *UsersSelectorArg) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "team_member_ids":
-		err = json.Unmarshal(body, &u.TeamMemberIds)
+		err = json.Unmarshal(w.TeamMemberIds, &u.TeamMemberIds)
 
 		if err != nil {
 			return err
 		}
 	case "external_ids":
-		err = json.Unmarshal(body, &u.ExternalIds)
+		err = json.Unmarshal(w.ExternalIds, &u.ExternalIds)
 
 		if err != nil {
 			return err
 		}
 	case "emails":
-		err = json.Unmarshal(body, &u.Emails)
+		err = json.Unmarshal(w.Emails, &u.Emails)
 
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
+
+// MarshalJSON serializes a UsersSelectorArg instance
+func (u UsersSelectorArg) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "team_member_ids":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamMemberIds []string `json:"team_member_ids"`
+		}{u.Tagged, u.TeamMemberIds})
+
+	case "external_ids":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			ExternalIds []string `json:"external_ids"`
+		}{u.Tagged, u.ExternalIds})
+
+	case "emails":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Emails []string `json:"emails"`
+		}{u.Tagged, u.Emails})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
@@ -22,6 +22,7 @@
 package team_common
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
@@ -30,6 +31,17 @@ import (
 // GroupManagementType : The group type determines how a group is managed.
 type GroupManagementType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupManagementType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupManagementType
@@ -40,6 +52,32 @@ const (
 	GroupManagementTypeOther          = "other"
 )
 
+// UnmarshalJSON deserializes into a GroupManagementType instance
+func (u *GroupManagementType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "user_managed", "company_managed", "system_managed", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupManagementType instance
+func (u GroupManagementType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // GroupSummary : Information about a group.
 type GroupSummary struct {
 	// GroupName : has no documentation (yet)
@@ -53,6 +91,8 @@ type GroupSummary struct {
 	MemberCount uint32 `json:"member_count,omitempty"`
 	// GroupManagementType : Who is allowed to manage the group.
 	GroupManagementType *GroupManagementType `json:"group_management_type"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupSummary returns a new GroupSummary instance
@@ -64,9 +104,30 @@ func NewGroupSummary(GroupName string, GroupId string, GroupManagementType *Grou
 	return s
 }
 
+// MarshalJSON serializes a GroupSummary instance
+func (u GroupSummary) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupSummary instance
+func (u *GroupSummary) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupType : The group type determines how a group is created and managed.
 type GroupType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupType
@@ -76,12 +137,40 @@ const (
 	GroupTypeOther       = "other"
 )
 
+// UnmarshalJSON deserializes into a GroupType instance
+func (u *GroupType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "team", "user_managed", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupType instance
+func (u GroupType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TimeRange : Time range.
 type TimeRange struct {
 	// StartTime : Optional starting time (inclusive).
 	StartTime time.Time `json:"start_time,omitempty"`
 	// EndTime : Optional ending time (exclusive).
 	EndTime time.Time `json:"end_time,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTimeRange returns a new TimeRange instance
@@ -89,3 +178,13 @@ func NewTimeRange() *TimeRange {
 	s := new(TimeRange)
 	return s
 }
+
+// MarshalJSON serializes a TimeRange instance
+func (u TimeRange) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TimeRange instance
+func (u *TimeRange) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package team_logtest provides a fake team_log.Client for tests.
+package team_logtest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/team_log"
+)
+
+// ErrNotStubbed is returned by the routes of a Fake that are not stubbed
+var ErrNotStubbed = errors.New("team_logtest: route not stubbed")
+
+// Call is a call made to a Fake
+type Call struct {
+	// Name of the route, e.g. "get_events"
+	Route string
+	// Argument of the call (nil for routes without one)
+	Arg interface{}
+	// Content passed to upload routes
+	Content io.Reader
+}
+
+// Fake is a team_log.Client for tests. Each route calls the function in the
+// matching field, e.g. GetEventsFunc, or returns ErrNotStubbed if the field is
+// nil. All calls are recorded. The zero value is ready to use.
+type Fake struct {
+	// Stubs GetEvents and GetEventsContext
+	GetEventsFunc func(ctx context.Context, arg *team_log.GetTeamEventsArg) (res *team_log.GetTeamEventsResult, err error)
+	// Stubs GetEventsContinue and GetEventsContinueContext
+	GetEventsContinueFunc func(ctx context.Context, arg *team_log.GetTeamEventsContinueArg) (res *team_log.GetTeamEventsResult, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ team_log.Client = (*Fake)(nil)
+
+// Calls returns the calls made so far, or only those to the given route
+func (f *Fake) Calls(route ...string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []Call
+	for _, c := range f.calls {
+		if len(route) == 0 || c.Route == route[0] {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (f *Fake) record(route string, arg interface{}, content io.Reader) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, Call{route, arg, content})
+}
+
+// GetEvents implements team_log.Client
+func (f *Fake) GetEvents(arg *team_log.GetTeamEventsArg) (res *team_log.GetTeamEventsResult, err error) {
+	return f.GetEventsContext(context.Background(), arg)
+}
+
+// GetEventsContext implements team_log.Client
+func (f *Fake) GetEventsContext(ctx context.Context, arg *team_log.GetTeamEventsArg) (res *team_log.GetTeamEventsResult, err error) {
+	f.record("get_events", arg, nil)
+	if f.GetEventsFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetEventsFunc(ctx, arg)
+}
+
+// GetEventsContinue implements team_log.Client
+func (f *Fake) GetEventsContinue(arg *team_log.GetTeamEventsContinueArg) (res *team_log.GetTeamEventsResult, err error) {
+	return f.GetEventsContinueContext(context.Background(), arg)
+}
+
+// GetEventsContinueContext implements team_log.Client
+func (f *Fake) GetEventsContinueContext(ctx context.Context, arg *team_log.GetTeamEventsContinueArg) (res *team_log.GetTeamEventsResult, err error) {
+	f.record("get_events/continue", arg, nil)
+	if f.GetEventsContinueFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetEventsContinueFunc(ctx, arg)
+}
@@ -42,6 +42,17 @@ type AccessMethodLogInfo struct {
 	AdminConsole *WebSessionLogInfo `json:"admin_console,omitempty"`
 	// Api : Api session details.
 	Api *ApiSessionLogInfo `json:"api,omitempty"`
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AccessMethodLogInfo) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AccessMethodLogInfo
@@ -74,10 +85,10 @@ func (u *AccessMethodLogInfo) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "end_user":
-		u.EndUser, err = IsSessionLogInfoFromJSON(body)
+		u.EndUser, err = IsSessionLogInfoFromJSON(w.EndUser)
 
 		if err != nil {
 			return err
@@ -106,13 +117,45 @@ func (u *AccessMethodLogInfo) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a AccessMethodLogInfo instance
+func (u AccessMethodLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "end_user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			EndUser IsSessionLogInfo `json:"end_user"`
+		}{u.Tagged, u.EndUser})
+
+	case "sign_in_as":
+		return dropbox.MarshalTagged(u.Tag, u.SignInAs)
+
+	case "content_manager":
+		return dropbox.MarshalTagged(u.Tag, u.ContentManager)
+
+	case "admin_console":
+		return dropbox.MarshalTagged(u.Tag, u.AdminConsole)
+
+	case "api":
+		return dropbox.MarshalTagged(u.Tag, u.Api)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // AccountCaptureChangeAvailabilityDetails : Granted or revoked the option to
 // enable account capture on domains belonging to the team.
 type AccountCaptureChangeAvailabilityDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAccountCaptureChangeAvailabilityDetails returns a new AccountCaptureChangeAvailabilityDetails instance
@@ -121,6 +164,16 @@ func NewAccountCaptureChangeAvailabilityDetails() *AccountCaptureChangeAvailabil
 	return s
 }
 
+// MarshalJSON serializes a AccountCaptureChangeAvailabilityDetails instance
+func (u AccountCaptureChangeAvailabilityDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AccountCaptureChangeAvailabilityDetails instance
+func (u *AccountCaptureChangeAvailabilityDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // AccountCaptureChangePolicyDetails : Changed the account capture policy on a
 // domain belonging to the team.
 type AccountCaptureChangePolicyDetails struct {
@@ -129,6 +182,8 @@ type AccountCaptureChangePolicyDetails struct {
 	// PreviousValue : Previous account capture policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *AccountCapturePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAccountCaptureChangePolicyDetails returns a new AccountCaptureChangePolicyDetails instance
@@ -138,11 +193,23 @@ func NewAccountCaptureChangePolicyDetails(NewValue *AccountCapturePolicy) *Accou
 	return s
 }
 
+// MarshalJSON serializes a AccountCaptureChangePolicyDetails instance
+func (u AccountCaptureChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AccountCaptureChangePolicyDetails instance
+func (u *AccountCaptureChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // AccountCaptureMigrateAccountDetails : Account captured user migrated their
 // account to the team.
 type AccountCaptureMigrateAccountDetails struct {
 	// DomainName : Domain names.
 	DomainName []string `json:"domain_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAccountCaptureMigrateAccountDetails returns a new AccountCaptureMigrateAccountDetails instance
@@ -152,9 +219,30 @@ func NewAccountCaptureMigrateAccountDetails(DomainName []string) *AccountCapture
 	return s
 }
 
+// MarshalJSON serializes a AccountCaptureMigrateAccountDetails instance
+func (u AccountCaptureMigrateAccountDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AccountCaptureMigrateAccountDetails instance
+func (u *AccountCaptureMigrateAccountDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // AccountCapturePolicy : has no documentation (yet)
 type AccountCapturePolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AccountCapturePolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AccountCapturePolicy
@@ -165,11 +253,39 @@ const (
 	AccountCapturePolicyOther        = "other"
 )
 
+// UnmarshalJSON deserializes into a AccountCapturePolicy instance
+func (u *AccountCapturePolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "disabled", "invited_users", "all_users", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a AccountCapturePolicy instance
+func (u AccountCapturePolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // AccountCaptureRelinquishAccountDetails : Account captured user relinquished
 // their account by changing the email address associated with it.
 type AccountCaptureRelinquishAccountDetails struct {
 	// DomainName : Domain names.
 	DomainName []string `json:"domain_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAccountCaptureRelinquishAccountDetails returns a new AccountCaptureRelinquishAccountDetails instance
@@ -179,6 +295,16 @@ func NewAccountCaptureRelinquishAccountDetails(DomainName []string) *AccountCapt
 	return s
 }
 
+// MarshalJSON serializes a AccountCaptureRelinquishAccountDetails instance
+func (u AccountCaptureRelinquishAccountDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AccountCaptureRelinquishAccountDetails instance
+func (u *AccountCaptureRelinquishAccountDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ActorLogInfo : The entity who performed the action.
 type ActorLogInfo struct {
 	dropbox.Tagged
@@ -190,6 +316,17 @@ type ActorLogInfo struct {
 	App IsAppLogInfo `json:"app,omitempty"`
 	// Reseller : Action done by reseller.
 	Reseller *ResellerLogInfo `json:"reseller,omitempty"`
+	raw      string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ActorLogInfo) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ActorLogInfo
@@ -220,22 +357,22 @@ func (u *ActorLogInfo) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user":
-		u.User, err = IsUserLogInfoFromJSON(body)
+		u.User, err = IsUserLogInfoFromJSON(w.User)
 
 		if err != nil {
 			return err
 		}
 	case "admin":
-		u.Admin, err = IsUserLogInfoFromJSON(body)
+		u.Admin, err = IsUserLogInfoFromJSON(w.Admin)
 
 		if err != nil {
 			return err
 		}
 	case "app":
-		u.App, err = IsAppLogInfoFromJSON(body)
+		u.App, err = IsAppLogInfoFromJSON(w.App)
 
 		if err != nil {
 			return err
@@ -246,12 +383,47 @@ func (u *ActorLogInfo) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "dropbox", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ActorLogInfo instance
+func (u ActorLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			User IsUserLogInfo `json:"user"`
+		}{u.Tagged, u.User})
+
+	case "admin":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Admin IsUserLogInfo `json:"admin"`
+		}{u.Tagged, u.Admin})
+
+	case "app":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			App IsAppLogInfo `json:"app"`
+		}{u.Tagged, u.App})
+
+	case "reseller":
+		return dropbox.MarshalTagged(u.Tag, u.Reseller)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // AllowDownloadDisabledDetails : Disabled allow downloads.
 type AllowDownloadDisabledDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAllowDownloadDisabledDetails returns a new AllowDownloadDisabledDetails instance
@@ -260,8 +432,20 @@ func NewAllowDownloadDisabledDetails() *AllowDownloadDisabledDetails {
 	return s
 }
 
+// MarshalJSON serializes a AllowDownloadDisabledDetails instance
+func (u AllowDownloadDisabledDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AllowDownloadDisabledDetails instance
+func (u *AllowDownloadDisabledDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // AllowDownloadEnabledDetails : Enabled allow downloads.
 type AllowDownloadEnabledDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAllowDownloadEnabledDetails returns a new AllowDownloadEnabledDetails instance
@@ -270,10 +454,22 @@ func NewAllowDownloadEnabledDetails() *AllowDownloadEnabledDetails {
 	return s
 }
 
+// MarshalJSON serializes a AllowDownloadEnabledDetails instance
+func (u AllowDownloadEnabledDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AllowDownloadEnabledDetails instance
+func (u *AllowDownloadEnabledDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ApiSessionLogInfo : Api session.
 type ApiSessionLogInfo struct {
 	// RequestId : Api request ID.
 	RequestId string `json:"request_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewApiSessionLogInfo returns a new ApiSessionLogInfo instance
@@ -283,6 +479,16 @@ func NewApiSessionLogInfo(RequestId string) *ApiSessionLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a ApiSessionLogInfo instance
+func (u ApiSessionLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ApiSessionLogInfo instance
+func (u *ApiSessionLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // AppLinkTeamDetails : Linked an app for team.
 type AppLinkTeamDetails struct {
 	// AppInfo : Relevant application details.
@@ -316,6 +522,8 @@ type AppLogInfo struct {
 	// DisplayName : App display name. Might be missing due to historical data
 	// gap.
 	DisplayName string `json:"display_name,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAppLogInfo returns a new AppLogInfo instance
@@ -324,6 +532,16 @@ func NewAppLogInfo() *AppLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a AppLogInfo instance
+func (u AppLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a AppLogInfo instance
+func (u *AppLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // IsAppLogInfo is the interface type for AppLogInfo and its subtypes
 type IsAppLogInfo interface {
 	IsAppLogInfo()
@@ -340,6 +558,17 @@ type appLogInfoUnion struct {
 	UserLinkedApp *UserLinkedAppLogInfo `json:"user_linked_app,omitempty"`
 	// TeamLinkedApp : has no documentation (yet)
 	TeamLinkedApp *TeamLinkedAppLogInfo `json:"team_linked_app,omitempty"`
+	raw           string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u appLogInfoUnion) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AppLogInfo
@@ -365,7 +594,7 @@ func (u *appLogInfoUnion) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user_or_team_linked_app":
 		err = json.Unmarshal(body, &u.UserOrTeamLinkedApp)
@@ -385,6 +614,10 @@ func (u *appLogInfoUnion) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
@@ -446,6 +679,17 @@ type AssetLogInfo struct {
 	PaperDocument *PaperDocumentLogInfo `json:"paper_document,omitempty"`
 	// PaperFolder : Paper folder's details.
 	PaperFolder *PaperFolderLogInfo `json:"paper_folder,omitempty"`
+	raw         string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AssetLogInfo) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AssetLogInfo
@@ -475,7 +719,7 @@ func (u *AssetLogInfo) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "file":
 		err = json.Unmarshal(body, &u.File)
@@ -501,14 +745,40 @@ func (u *AssetLogInfo) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a AssetLogInfo instance
+func (u AssetLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "file":
+		return dropbox.MarshalTagged(u.Tag, u.File)
+
+	case "folder":
+		return dropbox.MarshalTagged(u.Tag, u.Folder)
+
+	case "paper_document":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocument)
+
+	case "paper_folder":
+		return dropbox.MarshalTagged(u.Tag, u.PaperFolder)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // CollectionShareDetails : Shared an album.
 type CollectionShareDetails struct {
 	// AlbumName : Album name.
 	AlbumName string `json:"album_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewCollectionShareDetails returns a new CollectionShareDetails instance
@@ -518,9 +788,30 @@ func NewCollectionShareDetails(AlbumName string) *CollectionShareDetails {
 	return s
 }
 
+// MarshalJSON serializes a CollectionShareDetails instance
+func (u CollectionShareDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a CollectionShareDetails instance
+func (u *CollectionShareDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // Confidentiality : has no documentation (yet)
 type Confidentiality struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u Confidentiality) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for Confidentiality
@@ -530,6 +821,32 @@ const (
 	ConfidentialityOther           = "other"
 )
 
+// UnmarshalJSON deserializes into a Confidentiality instance
+func (u *Confidentiality) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "confidential", "non_confidential", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a Confidentiality instance
+func (u Confidentiality) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ContextLogInfo : The primary entity on which the action was done.
 type ContextLogInfo struct {
 	dropbox.Tagged
@@ -537,6 +854,17 @@ type ContextLogInfo struct {
 	TeamMember *TeamMemberLogInfo `json:"team_member,omitempty"`
 	// NonTeamMember : Action was done on behalf of a non team member.
 	NonTeamMember *NonTeamMemberLogInfo `json:"non_team_member,omitempty"`
+	raw           string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ContextLogInfo) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ContextLogInfo
@@ -561,7 +889,7 @@ func (u *ContextLogInfo) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "team_member":
 		err = json.Unmarshal(body, &u.TeamMember)
@@ -575,12 +903,32 @@ func (u *ContextLogInfo) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "team", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ContextLogInfo instance
+func (u ContextLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "team_member":
+		return dropbox.MarshalTagged(u.Tag, u.TeamMember)
+
+	case "non_team_member":
+		return dropbox.MarshalTagged(u.Tag, u.NonTeamMember)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // CreateFolderDetails : Created folders.
 type CreateFolderDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewCreateFolderDetails returns a new CreateFolderDetails instance
@@ -589,6 +937,16 @@ func NewCreateFolderDetails() *CreateFolderDetails {
 	return s
 }
 
+// MarshalJSON serializes a CreateFolderDetails instance
+func (u CreateFolderDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a CreateFolderDetails instance
+func (u *CreateFolderDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DataPlacementRestrictionChangePolicyDetails : Set a restriction policy
 // regarding the location of data centers where team data resides.
 type DataPlacementRestrictionChangePolicyDetails struct {
@@ -596,6 +954,8 @@ type DataPlacementRestrictionChangePolicyDetails struct {
 	PreviousValue *PlacementRestriction `json:"previous_value"`
 	// NewValue : New placement restriction.
 	NewValue *PlacementRestriction `json:"new_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDataPlacementRestrictionChangePolicyDetails returns a new DataPlacementRestrictionChangePolicyDetails instance
@@ -606,6 +966,16 @@ func NewDataPlacementRestrictionChangePolicyDetails(PreviousValue *PlacementRest
 	return s
 }
 
+// MarshalJSON serializes a DataPlacementRestrictionChangePolicyDetails instance
+func (u DataPlacementRestrictionChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DataPlacementRestrictionChangePolicyDetails instance
+func (u *DataPlacementRestrictionChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DataPlacementRestrictionSatisfyPolicyDetails : Satisfied a previously set
 // restriction policy regarding the location of data centers where team data
 // resides (i.e. all data have been migrated according to the restriction
@@ -613,6 +983,8 @@ func NewDataPlacementRestrictionChangePolicyDetails(PreviousValue *PlacementRest
 type DataPlacementRestrictionSatisfyPolicyDetails struct {
 	// PlacementRestriction : Placement restriction.
 	PlacementRestriction *PlacementRestriction `json:"placement_restriction"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDataPlacementRestrictionSatisfyPolicyDetails returns a new DataPlacementRestrictionSatisfyPolicyDetails instance
@@ -622,10 +994,22 @@ func NewDataPlacementRestrictionSatisfyPolicyDetails(PlacementRestriction *Place
 	return s
 }
 
+// MarshalJSON serializes a DataPlacementRestrictionSatisfyPolicyDetails instance
+func (u DataPlacementRestrictionSatisfyPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DataPlacementRestrictionSatisfyPolicyDetails instance
+func (u *DataPlacementRestrictionSatisfyPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SessionLogInfo : Session's logged information.
 type SessionLogInfo struct {
 	// SessionId : Session ID. Might be missing due to historical data gap.
 	SessionId string `json:"session_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSessionLogInfo returns a new SessionLogInfo instance
@@ -634,6 +1018,16 @@ func NewSessionLogInfo() *SessionLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a SessionLogInfo instance
+func (u SessionLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SessionLogInfo instance
+func (u *SessionLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // IsSessionLogInfo is the interface type for SessionLogInfo and its subtypes
 type IsSessionLogInfo interface {
 	IsSessionLogInfo()
@@ -650,6 +1044,17 @@ type sessionLogInfoUnion struct {
 	Desktop *DesktopSessionLogInfo `json:"desktop,omitempty"`
 	// Mobile : has no documentation (yet)
 	Mobile *MobileSessionLogInfo `json:"mobile,omitempty"`
+	raw    string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u sessionLogInfoUnion) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SessionLogInfo
@@ -675,7 +1080,7 @@ func (u *sessionLogInfoUnion) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "web":
 		err = json.Unmarshal(body, &u.Web)
@@ -695,6 +1100,10 @@ func (u *sessionLogInfoUnion) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
@@ -722,6 +1131,8 @@ func IsSessionLogInfoFromJSON(data []byte) (IsSessionLogInfo, error) {
 // DesktopSessionLogInfo : Desktop session.
 type DesktopSessionLogInfo struct {
 	SessionLogInfo
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDesktopSessionLogInfo returns a new DesktopSessionLogInfo instance
@@ -730,6 +1141,16 @@ func NewDesktopSessionLogInfo() *DesktopSessionLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a DesktopSessionLogInfo instance, tagged as a `SessionLogInfo`
+func (u DesktopSessionLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("desktop", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DesktopSessionLogInfo instance
+func (u *DesktopSessionLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceApprovalsChangeDesktopPolicyDetails : Set or removed a limit on the
 // number of computers each team member can link to their work Dropbox account.
 type DeviceApprovalsChangeDesktopPolicyDetails struct {
@@ -739,6 +1160,8 @@ type DeviceApprovalsChangeDesktopPolicyDetails struct {
 	// PreviousValue : Previous desktop device approvals policy. Might be
 	// missing due to historical data gap.
 	PreviousValue *DeviceApprovalsPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceApprovalsChangeDesktopPolicyDetails returns a new DeviceApprovalsChangeDesktopPolicyDetails instance
@@ -747,6 +1170,16 @@ func NewDeviceApprovalsChangeDesktopPolicyDetails() *DeviceApprovalsChangeDeskto
 	return s
 }
 
+// MarshalJSON serializes a DeviceApprovalsChangeDesktopPolicyDetails instance
+func (u DeviceApprovalsChangeDesktopPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceApprovalsChangeDesktopPolicyDetails instance
+func (u *DeviceApprovalsChangeDesktopPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceApprovalsChangeMobilePolicyDetails : Set or removed a limit on the
 // number of mobiles devices each team member can link to their work Dropbox
 // account.
@@ -757,6 +1190,8 @@ type DeviceApprovalsChangeMobilePolicyDetails struct {
 	// PreviousValue : Previous mobile device approvals policy. Might be missing
 	// due to historical data gap.
 	PreviousValue *DeviceApprovalsPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceApprovalsChangeMobilePolicyDetails returns a new DeviceApprovalsChangeMobilePolicyDetails instance
@@ -765,6 +1200,16 @@ func NewDeviceApprovalsChangeMobilePolicyDetails() *DeviceApprovalsChangeMobileP
 	return s
 }
 
+// MarshalJSON serializes a DeviceApprovalsChangeMobilePolicyDetails instance
+func (u DeviceApprovalsChangeMobilePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceApprovalsChangeMobilePolicyDetails instance
+func (u *DeviceApprovalsChangeMobilePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceApprovalsChangeOverageActionDetails : Changed the action taken when a
 // team member is already over the limits (e.g when they join the team, an admin
 // lowers limits, etc.).
@@ -775,6 +1220,8 @@ type DeviceApprovalsChangeOverageActionDetails struct {
 	// PreviousValue : Previous over the limit policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *DeviceApprovalsRolloutPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceApprovalsChangeOverageActionDetails returns a new DeviceApprovalsChangeOverageActionDetails instance
@@ -783,6 +1230,16 @@ func NewDeviceApprovalsChangeOverageActionDetails() *DeviceApprovalsChangeOverag
 	return s
 }
 
+// MarshalJSON serializes a DeviceApprovalsChangeOverageActionDetails instance
+func (u DeviceApprovalsChangeOverageActionDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceApprovalsChangeOverageActionDetails instance
+func (u *DeviceApprovalsChangeOverageActionDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceApprovalsChangeUnlinkActionDetails : Changed the action taken with
 // respect to approval limits when a team member unlinks an approved device.
 type DeviceApprovalsChangeUnlinkActionDetails struct {
@@ -792,6 +1249,8 @@ type DeviceApprovalsChangeUnlinkActionDetails struct {
 	// PreviousValue : Previous device unlink policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *DeviceUnlinkPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceApprovalsChangeUnlinkActionDetails returns a new DeviceApprovalsChangeUnlinkActionDetails instance
@@ -800,9 +1259,30 @@ func NewDeviceApprovalsChangeUnlinkActionDetails() *DeviceApprovalsChangeUnlinkA
 	return s
 }
 
+// MarshalJSON serializes a DeviceApprovalsChangeUnlinkActionDetails instance
+func (u DeviceApprovalsChangeUnlinkActionDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceApprovalsChangeUnlinkActionDetails instance
+func (u *DeviceApprovalsChangeUnlinkActionDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceApprovalsPolicy : has no documentation (yet)
 type DeviceApprovalsPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DeviceApprovalsPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DeviceApprovalsPolicy
@@ -817,9 +1297,46 @@ const (
 	DeviceApprovalsPolicyOther     = "other"
 )
 
+// UnmarshalJSON deserializes into a DeviceApprovalsPolicy instance
+func (u *DeviceApprovalsPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "unlimited", "zero", "one", "two", "three", "four", "five", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a DeviceApprovalsPolicy instance
+func (u DeviceApprovalsPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // DeviceApprovalsRolloutPolicy : has no documentation (yet)
 type DeviceApprovalsRolloutPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DeviceApprovalsRolloutPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DeviceApprovalsRolloutPolicy
@@ -830,11 +1347,39 @@ const (
 	DeviceApprovalsRolloutPolicyOther        = "other"
 )
 
+// UnmarshalJSON deserializes into a DeviceApprovalsRolloutPolicy instance
+func (u *DeviceApprovalsRolloutPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "remove_oldest", "remove_all", "add_exception", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a DeviceApprovalsRolloutPolicy instance
+func (u DeviceApprovalsRolloutPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // DeviceChangeIpDesktopDetails : IP address associated with active desktop
 // session changed.
 type DeviceChangeIpDesktopDetails struct {
 	// DeviceInfo : Device information.
 	DeviceInfo *DeviceLogInfo `json:"device_info"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceChangeIpDesktopDetails returns a new DeviceChangeIpDesktopDetails instance
@@ -844,11 +1389,23 @@ func NewDeviceChangeIpDesktopDetails(DeviceInfo *DeviceLogInfo) *DeviceChangeIpD
 	return s
 }
 
+// MarshalJSON serializes a DeviceChangeIpDesktopDetails instance
+func (u DeviceChangeIpDesktopDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceChangeIpDesktopDetails instance
+func (u *DeviceChangeIpDesktopDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceChangeIpMobileDetails : IP address associated with active mobile
 // session changed.
 type DeviceChangeIpMobileDetails struct {
 	// DeviceInfo : Device information.
 	DeviceInfo *DeviceLogInfo `json:"device_info"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceChangeIpMobileDetails returns a new DeviceChangeIpMobileDetails instance
@@ -858,11 +1415,23 @@ func NewDeviceChangeIpMobileDetails(DeviceInfo *DeviceLogInfo) *DeviceChangeIpMo
 	return s
 }
 
+// MarshalJSON serializes a DeviceChangeIpMobileDetails instance
+func (u DeviceChangeIpMobileDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceChangeIpMobileDetails instance
+func (u *DeviceChangeIpMobileDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceChangeIpWebDetails : IP address associated with active Web session
 // changed.
 type DeviceChangeIpWebDetails struct {
 	// UserAgent : Web browser name.
 	UserAgent string `json:"user_agent"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceChangeIpWebDetails returns a new DeviceChangeIpWebDetails instance
@@ -872,6 +1441,16 @@ func NewDeviceChangeIpWebDetails(UserAgent string) *DeviceChangeIpWebDetails {
 	return s
 }
 
+// MarshalJSON serializes a DeviceChangeIpWebDetails instance
+func (u DeviceChangeIpWebDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceChangeIpWebDetails instance
+func (u *DeviceChangeIpWebDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceDeleteOnUnlinkFailDetails : Failed to delete all files from an unlinked
 // device.
 type DeviceDeleteOnUnlinkFailDetails struct {
@@ -879,6 +1458,8 @@ type DeviceDeleteOnUnlinkFailDetails struct {
 	DeviceInfo *DeviceLogInfo `json:"device_info"`
 	// NumFailures : The number of times that remote file deletion failed.
 	NumFailures int64 `json:"num_failures"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceDeleteOnUnlinkFailDetails returns a new DeviceDeleteOnUnlinkFailDetails instance
@@ -889,11 +1470,23 @@ func NewDeviceDeleteOnUnlinkFailDetails(DeviceInfo *DeviceLogInfo, NumFailures i
 	return s
 }
 
+// MarshalJSON serializes a DeviceDeleteOnUnlinkFailDetails instance
+func (u DeviceDeleteOnUnlinkFailDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceDeleteOnUnlinkFailDetails instance
+func (u *DeviceDeleteOnUnlinkFailDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceDeleteOnUnlinkSuccessDetails : Deleted all files from an unlinked
 // device.
 type DeviceDeleteOnUnlinkSuccessDetails struct {
 	// DeviceInfo : Device information.
 	DeviceInfo *DeviceLogInfo `json:"device_info"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceDeleteOnUnlinkSuccessDetails returns a new DeviceDeleteOnUnlinkSuccessDetails instance
@@ -903,11 +1496,23 @@ func NewDeviceDeleteOnUnlinkSuccessDetails(DeviceInfo *DeviceLogInfo) *DeviceDel
 	return s
 }
 
+// MarshalJSON serializes a DeviceDeleteOnUnlinkSuccessDetails instance
+func (u DeviceDeleteOnUnlinkSuccessDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceDeleteOnUnlinkSuccessDetails instance
+func (u *DeviceDeleteOnUnlinkSuccessDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceLinkFailDetails : Failed to link a device.
 type DeviceLinkFailDetails struct {
 	// DeviceType : A description of the device used while user approval
 	// blocked.
 	DeviceType *DeviceType `json:"device_type"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceLinkFailDetails returns a new DeviceLinkFailDetails instance
@@ -917,6 +1522,16 @@ func NewDeviceLinkFailDetails(DeviceType *DeviceType) *DeviceLinkFailDetails {
 	return s
 }
 
+// MarshalJSON serializes a DeviceLinkFailDetails instance
+func (u DeviceLinkFailDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceLinkFailDetails instance
+func (u *DeviceLinkFailDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceLinkSuccessDetails : Linked a device.
 type DeviceLinkSuccessDetails struct {
 	// DeviceInfo : Device information.
@@ -924,6 +1539,8 @@ type DeviceLinkSuccessDetails struct {
 	// AppVersion : Linking app version. Might be missing due to historical data
 	// gap.
 	AppVersion string `json:"app_version,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceLinkSuccessDetails returns a new DeviceLinkSuccessDetails instance
@@ -933,6 +1550,16 @@ func NewDeviceLinkSuccessDetails(DeviceInfo *DeviceLogInfo) *DeviceLinkSuccessDe
 	return s
 }
 
+// MarshalJSON serializes a DeviceLinkSuccessDetails instance
+func (u DeviceLinkSuccessDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceLinkSuccessDetails instance
+func (u *DeviceLinkSuccessDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceLogInfo : Device's logged information.
 type DeviceLogInfo struct {
 	// DeviceId : Device unique id. Might be missing due to historical data gap.
@@ -959,6 +1586,8 @@ type DeviceLogInfo struct {
 	// LastActivity : Last activity. Might be missing due to historical data
 	// gap.
 	LastActivity string `json:"last_activity,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceLogInfo returns a new DeviceLogInfo instance
@@ -967,8 +1596,20 @@ func NewDeviceLogInfo() *DeviceLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a DeviceLogInfo instance
+func (u DeviceLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceLogInfo instance
+func (u *DeviceLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceManagementDisabledDetails : Disable Device Management.
 type DeviceManagementDisabledDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceManagementDisabledDetails returns a new DeviceManagementDisabledDetails instance
@@ -977,8 +1618,20 @@ func NewDeviceManagementDisabledDetails() *DeviceManagementDisabledDetails {
 	return s
 }
 
+// MarshalJSON serializes a DeviceManagementDisabledDetails instance
+func (u DeviceManagementDisabledDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceManagementDisabledDetails instance
+func (u *DeviceManagementDisabledDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceManagementEnabledDetails : Enable Device Management.
 type DeviceManagementEnabledDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceManagementEnabledDetails returns a new DeviceManagementEnabledDetails instance
@@ -987,9 +1640,30 @@ func NewDeviceManagementEnabledDetails() *DeviceManagementEnabledDetails {
 	return s
 }
 
-// DeviceType : has no documentation (yet)
-type DeviceType struct {
+// MarshalJSON serializes a DeviceManagementEnabledDetails instance
+func (u DeviceManagementEnabledDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceManagementEnabledDetails instance
+func (u *DeviceManagementEnabledDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// DeviceType : has no documentation (yet)
+type DeviceType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DeviceType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DeviceType
@@ -999,6 +1673,32 @@ const (
 	DeviceTypeOther   = "other"
 )
 
+// UnmarshalJSON deserializes into a DeviceType instance
+func (u *DeviceType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "mobile", "desktop", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a DeviceType instance
+func (u DeviceType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // DeviceUnlinkDetails : Disconnected a device.
 type DeviceUnlinkDetails struct {
 	// DeviceInfo : Device information.
@@ -1006,6 +1706,8 @@ type DeviceUnlinkDetails struct {
 	// DeleteData : True if the user requested to delete data after device
 	// unlink, false otherwise.
 	DeleteData bool `json:"delete_data"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDeviceUnlinkDetails returns a new DeviceUnlinkDetails instance
@@ -1016,9 +1718,30 @@ func NewDeviceUnlinkDetails(DeviceInfo *DeviceLogInfo, DeleteData bool) *DeviceU
 	return s
 }
 
+// MarshalJSON serializes a DeviceUnlinkDetails instance
+func (u DeviceUnlinkDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DeviceUnlinkDetails instance
+func (u *DeviceUnlinkDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DeviceUnlinkPolicy : has no documentation (yet)
 type DeviceUnlinkPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u DeviceUnlinkPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for DeviceUnlinkPolicy
@@ -1028,8 +1751,36 @@ const (
 	DeviceUnlinkPolicyOther  = "other"
 )
 
+// UnmarshalJSON deserializes into a DeviceUnlinkPolicy instance
+func (u *DeviceUnlinkPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "remove", "keep", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a DeviceUnlinkPolicy instance
+func (u DeviceUnlinkPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // DisabledDomainInvitesDetails : Disabled domain invites.
 type DisabledDomainInvitesDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDisabledDomainInvitesDetails returns a new DisabledDomainInvitesDetails instance
@@ -1038,9 +1789,21 @@ func NewDisabledDomainInvitesDetails() *DisabledDomainInvitesDetails {
 	return s
 }
 
+// MarshalJSON serializes a DisabledDomainInvitesDetails instance
+func (u DisabledDomainInvitesDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DisabledDomainInvitesDetails instance
+func (u *DisabledDomainInvitesDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DomainInvitesApproveRequestToJoinTeamDetails : Approved a member's request to
 // join the team.
 type DomainInvitesApproveRequestToJoinTeamDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDomainInvitesApproveRequestToJoinTeamDetails returns a new DomainInvitesApproveRequestToJoinTeamDetails instance
@@ -1049,9 +1812,21 @@ func NewDomainInvitesApproveRequestToJoinTeamDetails() *DomainInvitesApproveRequ
 	return s
 }
 
+// MarshalJSON serializes a DomainInvitesApproveRequestToJoinTeamDetails instance
+func (u DomainInvitesApproveRequestToJoinTeamDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DomainInvitesApproveRequestToJoinTeamDetails instance
+func (u *DomainInvitesApproveRequestToJoinTeamDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DomainInvitesDeclineRequestToJoinTeamDetails : Declined a user's request to
 // join the team.
 type DomainInvitesDeclineRequestToJoinTeamDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDomainInvitesDeclineRequestToJoinTeamDetails returns a new DomainInvitesDeclineRequestToJoinTeamDetails instance
@@ -1060,6 +1835,16 @@ func NewDomainInvitesDeclineRequestToJoinTeamDetails() *DomainInvitesDeclineRequ
 	return s
 }
 
+// MarshalJSON serializes a DomainInvitesDeclineRequestToJoinTeamDetails instance
+func (u DomainInvitesDeclineRequestToJoinTeamDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DomainInvitesDeclineRequestToJoinTeamDetails instance
+func (u *DomainInvitesDeclineRequestToJoinTeamDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DomainInvitesEmailExistingUsersDetails : Sent domain invites to existing
 // domain accounts.
 type DomainInvitesEmailExistingUsersDetails struct {
@@ -1067,6 +1852,8 @@ type DomainInvitesEmailExistingUsersDetails struct {
 	DomainName []string `json:"domain_name"`
 	// NumRecipients : Number of recipients.
 	NumRecipients uint64 `json:"num_recipients"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDomainInvitesEmailExistingUsersDetails returns a new DomainInvitesEmailExistingUsersDetails instance
@@ -1077,8 +1864,20 @@ func NewDomainInvitesEmailExistingUsersDetails(DomainName []string, NumRecipient
 	return s
 }
 
+// MarshalJSON serializes a DomainInvitesEmailExistingUsersDetails instance
+func (u DomainInvitesEmailExistingUsersDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DomainInvitesEmailExistingUsersDetails instance
+func (u *DomainInvitesEmailExistingUsersDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DomainInvitesRequestToJoinTeamDetails : Asked to join the team.
 type DomainInvitesRequestToJoinTeamDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDomainInvitesRequestToJoinTeamDetails returns a new DomainInvitesRequestToJoinTeamDetails instance
@@ -1087,9 +1886,21 @@ func NewDomainInvitesRequestToJoinTeamDetails() *DomainInvitesRequestToJoinTeamD
 	return s
 }
 
+// MarshalJSON serializes a DomainInvitesRequestToJoinTeamDetails instance
+func (u DomainInvitesRequestToJoinTeamDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DomainInvitesRequestToJoinTeamDetails instance
+func (u *DomainInvitesRequestToJoinTeamDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DomainInvitesSetInviteNewUserPrefToNoDetails : Turned off u201cAutomatically
 // invite new usersu201d.
 type DomainInvitesSetInviteNewUserPrefToNoDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDomainInvitesSetInviteNewUserPrefToNoDetails returns a new DomainInvitesSetInviteNewUserPrefToNoDetails instance
@@ -1098,9 +1909,21 @@ func NewDomainInvitesSetInviteNewUserPrefToNoDetails() *DomainInvitesSetInviteNe
 	return s
 }
 
+// MarshalJSON serializes a DomainInvitesSetInviteNewUserPrefToNoDetails instance
+func (u DomainInvitesSetInviteNewUserPrefToNoDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DomainInvitesSetInviteNewUserPrefToNoDetails instance
+func (u *DomainInvitesSetInviteNewUserPrefToNoDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DomainInvitesSetInviteNewUserPrefToYesDetails : Turned on u201cAutomatically
 // invite new usersu201d.
 type DomainInvitesSetInviteNewUserPrefToYesDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDomainInvitesSetInviteNewUserPrefToYesDetails returns a new DomainInvitesSetInviteNewUserPrefToYesDetails instance
@@ -1109,6 +1932,16 @@ func NewDomainInvitesSetInviteNewUserPrefToYesDetails() *DomainInvitesSetInviteN
 	return s
 }
 
+// MarshalJSON serializes a DomainInvitesSetInviteNewUserPrefToYesDetails instance
+func (u DomainInvitesSetInviteNewUserPrefToYesDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DomainInvitesSetInviteNewUserPrefToYesDetails instance
+func (u *DomainInvitesSetInviteNewUserPrefToYesDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DomainVerificationAddDomainFailDetails : Failed to verify a domain belonging
 // to the team.
 type DomainVerificationAddDomainFailDetails struct {
@@ -1116,6 +1949,8 @@ type DomainVerificationAddDomainFailDetails struct {
 	DomainNames []string `json:"domain_names"`
 	// VerificationMethod : Domain name verification method.
 	VerificationMethod string `json:"verification_method,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDomainVerificationAddDomainFailDetails returns a new DomainVerificationAddDomainFailDetails instance
@@ -1125,6 +1960,16 @@ func NewDomainVerificationAddDomainFailDetails(DomainNames []string) *DomainVeri
 	return s
 }
 
+// MarshalJSON serializes a DomainVerificationAddDomainFailDetails instance
+func (u DomainVerificationAddDomainFailDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DomainVerificationAddDomainFailDetails instance
+func (u *DomainVerificationAddDomainFailDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DomainVerificationAddDomainSuccessDetails : Verified a domain belonging to
 // the team.
 type DomainVerificationAddDomainSuccessDetails struct {
@@ -1132,6 +1977,8 @@ type DomainVerificationAddDomainSuccessDetails struct {
 	DomainNames []string `json:"domain_names"`
 	// VerificationMethod : Domain name verification method.
 	VerificationMethod string `json:"verification_method,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDomainVerificationAddDomainSuccessDetails returns a new DomainVerificationAddDomainSuccessDetails instance
@@ -1141,11 +1988,23 @@ func NewDomainVerificationAddDomainSuccessDetails(DomainNames []string) *DomainV
 	return s
 }
 
+// MarshalJSON serializes a DomainVerificationAddDomainSuccessDetails instance
+func (u DomainVerificationAddDomainSuccessDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DomainVerificationAddDomainSuccessDetails instance
+func (u *DomainVerificationAddDomainSuccessDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // DomainVerificationRemoveDomainDetails : Removed a domain from the list of
 // verified domains belonging to the team.
 type DomainVerificationRemoveDomainDetails struct {
 	// DomainNames : Domain names.
 	DomainNames []string `json:"domain_names"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewDomainVerificationRemoveDomainDetails returns a new DomainVerificationRemoveDomainDetails instance
@@ -1155,9 +2014,21 @@ func NewDomainVerificationRemoveDomainDetails(DomainNames []string) *DomainVerif
 	return s
 }
 
+// MarshalJSON serializes a DomainVerificationRemoveDomainDetails instance
+func (u DomainVerificationRemoveDomainDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a DomainVerificationRemoveDomainDetails instance
+func (u *DomainVerificationRemoveDomainDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // EmmAddExceptionDetails : Added an exception for one or more team members to
 // optionally use the regular Dropbox app when EMM is enabled.
 type EmmAddExceptionDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewEmmAddExceptionDetails returns a new EmmAddExceptionDetails instance
@@ -1166,6 +2037,16 @@ func NewEmmAddExceptionDetails() *EmmAddExceptionDetails {
 	return s
 }
 
+// MarshalJSON serializes a EmmAddExceptionDetails instance
+func (u EmmAddExceptionDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a EmmAddExceptionDetails instance
+func (u *EmmAddExceptionDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // EmmChangePolicyDetails : Enabled or disabled enterprise mobility management
 // for team members.
 type EmmChangePolicyDetails struct {
@@ -1174,6 +2055,8 @@ type EmmChangePolicyDetails struct {
 	// PreviousValue : Previous enterprise mobility management policy. Might be
 	// missing due to historical data gap.
 	PreviousValue *OptionalChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewEmmChangePolicyDetails returns a new EmmChangePolicyDetails instance
@@ -1183,8 +2066,20 @@ func NewEmmChangePolicyDetails(NewValue *OptionalChangePolicy) *EmmChangePolicyD
 	return s
 }
 
+// MarshalJSON serializes a EmmChangePolicyDetails instance
+func (u EmmChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a EmmChangePolicyDetails instance
+func (u *EmmChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // EmmCreateExceptionsReportDetails : EMM excluded users report created.
 type EmmCreateExceptionsReportDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewEmmCreateExceptionsReportDetails returns a new EmmCreateExceptionsReportDetails instance
@@ -1193,8 +2088,20 @@ func NewEmmCreateExceptionsReportDetails() *EmmCreateExceptionsReportDetails {
 	return s
 }
 
+// MarshalJSON serializes a EmmCreateExceptionsReportDetails instance
+func (u EmmCreateExceptionsReportDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a EmmCreateExceptionsReportDetails instance
+func (u *EmmCreateExceptionsReportDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // EmmCreateUsageReportDetails : EMM mobile app usage report created.
 type EmmCreateUsageReportDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewEmmCreateUsageReportDetails returns a new EmmCreateUsageReportDetails instance
@@ -1203,8 +2110,20 @@ func NewEmmCreateUsageReportDetails() *EmmCreateUsageReportDetails {
 	return s
 }
 
+// MarshalJSON serializes a EmmCreateUsageReportDetails instance
+func (u EmmCreateUsageReportDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a EmmCreateUsageReportDetails instance
+func (u *EmmCreateUsageReportDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // EmmLoginSuccessDetails : Signed in using the Dropbox EMM app.
 type EmmLoginSuccessDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewEmmLoginSuccessDetails returns a new EmmLoginSuccessDetails instance
@@ -1213,9 +2132,21 @@ func NewEmmLoginSuccessDetails() *EmmLoginSuccessDetails {
 	return s
 }
 
+// MarshalJSON serializes a EmmLoginSuccessDetails instance
+func (u EmmLoginSuccessDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a EmmLoginSuccessDetails instance
+func (u *EmmLoginSuccessDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // EmmRefreshAuthTokenDetails : Refreshed the auth token used for setting up
 // enterprise mobility management.
 type EmmRefreshAuthTokenDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewEmmRefreshAuthTokenDetails returns a new EmmRefreshAuthTokenDetails instance
@@ -1224,9 +2155,21 @@ func NewEmmRefreshAuthTokenDetails() *EmmRefreshAuthTokenDetails {
 	return s
 }
 
+// MarshalJSON serializes a EmmRefreshAuthTokenDetails instance
+func (u EmmRefreshAuthTokenDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a EmmRefreshAuthTokenDetails instance
+func (u *EmmRefreshAuthTokenDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // EmmRemoveExceptionDetails : Removed an exception for one or more team members
 // to optionally use the regular Dropbox app when EMM is enabled.
 type EmmRemoveExceptionDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewEmmRemoveExceptionDetails returns a new EmmRemoveExceptionDetails instance
@@ -1235,9 +2178,30 @@ func NewEmmRemoveExceptionDetails() *EmmRemoveExceptionDetails {
 	return s
 }
 
+// MarshalJSON serializes a EmmRemoveExceptionDetails instance
+func (u EmmRemoveExceptionDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a EmmRemoveExceptionDetails instance
+func (u *EmmRemoveExceptionDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // EnableDisableChangePolicy : has no documentation (yet)
 type EnableDisableChangePolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u EnableDisableChangePolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for EnableDisableChangePolicy
@@ -1247,8 +2211,36 @@ const (
 	EnableDisableChangePolicyOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a EnableDisableChangePolicy instance
+func (u *EnableDisableChangePolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "disabled", "enabled", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a EnableDisableChangePolicy instance
+func (u EnableDisableChangePolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // EnabledDomainInvitesDetails : Enabled domain invites.
 type EnabledDomainInvitesDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewEnabledDomainInvitesDetails returns a new EnabledDomainInvitesDetails instance
@@ -1257,9 +2249,30 @@ func NewEnabledDomainInvitesDetails() *EnabledDomainInvitesDetails {
 	return s
 }
 
+// MarshalJSON serializes a EnabledDomainInvitesDetails instance
+func (u EnabledDomainInvitesDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a EnabledDomainInvitesDetails instance
+func (u *EnabledDomainInvitesDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // EventCategory : Category of events in event audit log.
 type EventCategory struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u EventCategory) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for EventCategory
@@ -1298,6 +2311,32 @@ const (
 	EventCategoryOther           = "other"
 )
 
+// UnmarshalJSON deserializes into a EventCategory instance
+func (u *EventCategory) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "account_capture", "administration", "apps", "authentication", "comments", "content_access", "devices", "device_approvals", "domains", "emm", "errors", "files", "file_operations", "file_requests", "groups", "logins", "members", "paper", "passwords", "reports", "sessions", "shared_files", "shared_folders", "shared_links", "sharing", "sharing_policies", "sso", "team_folders", "team_policies", "team_profile", "tfa", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a EventCategory instance
+func (u EventCategory) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // EventDetails : Additional fields depending on the event type.
 type EventDetails struct {
 	dropbox.Tagged
@@ -1956,6 +2995,17 @@ type EventDetails struct {
 	// MissingDetails : Hints that this event was returned with missing details
 	// due to an internal error.
 	MissingDetails *MissingDetails `json:"missing_details,omitempty"`
+	raw            string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u EventDetails) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for EventDetails
@@ -2906,7 +3956,7 @@ func (u *EventDetails) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "member_change_membership_type_details":
 		err = json.Unmarshal(body, &u.MemberChangeMembershipTypeDetails)
@@ -4474,103 +5524,909 @@ func (u *EventDetails) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
-// EventType : The type of the event.
-type EventType struct {
-	dropbox.Tagged
-}
+// MarshalJSON serializes a EventDetails instance
+func (u EventDetails) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "member_change_membership_type_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberChangeMembershipTypeDetails)
 
-// Valid tag values for EventType
-const (
-	EventTypeMemberChangeMembershipType               = "member_change_membership_type"
-	EventTypeMemberPermanentlyDeleteAccountContents   = "member_permanently_delete_account_contents"
-	EventTypeMemberSpaceLimitsChangeStatus            = "member_space_limits_change_status"
-	EventTypeMemberTransferAccountContents            = "member_transfer_account_contents"
-	EventTypePaperEnabledUsersGroupAddition           = "paper_enabled_users_group_addition"
-	EventTypePaperEnabledUsersGroupRemoval            = "paper_enabled_users_group_removal"
-	EventTypePaperExternalViewAllow                   = "paper_external_view_allow"
-	EventTypePaperExternalViewDefaultTeam             = "paper_external_view_default_team"
-	EventTypePaperExternalViewForbid                  = "paper_external_view_forbid"
-	EventTypeSfExternalInviteWarn                     = "sf_external_invite_warn"
-	EventTypeTeamMerge                                = "team_merge"
-	EventTypeAppLinkTeam                              = "app_link_team"
-	EventTypeAppLinkUser                              = "app_link_user"
-	EventTypeAppUnlinkTeam                            = "app_unlink_team"
-	EventTypeAppUnlinkUser                            = "app_unlink_user"
-	EventTypeDeviceChangeIpDesktop                    = "device_change_ip_desktop"
-	EventTypeDeviceChangeIpMobile                     = "device_change_ip_mobile"
-	EventTypeDeviceChangeIpWeb                        = "device_change_ip_web"
-	EventTypeDeviceDeleteOnUnlinkFail                 = "device_delete_on_unlink_fail"
-	EventTypeDeviceDeleteOnUnlinkSuccess              = "device_delete_on_unlink_success"
-	EventTypeDeviceLinkFail                           = "device_link_fail"
-	EventTypeDeviceLinkSuccess                        = "device_link_success"
-	EventTypeDeviceManagementDisabled                 = "device_management_disabled"
-	EventTypeDeviceManagementEnabled                  = "device_management_enabled"
-	EventTypeDeviceUnlink                             = "device_unlink"
-	EventTypeEmmRefreshAuthToken                      = "emm_refresh_auth_token"
-	EventTypeAccountCaptureChangeAvailability         = "account_capture_change_availability"
-	EventTypeAccountCaptureMigrateAccount             = "account_capture_migrate_account"
-	EventTypeAccountCaptureRelinquishAccount          = "account_capture_relinquish_account"
-	EventTypeDisabledDomainInvites                    = "disabled_domain_invites"
-	EventTypeDomainInvitesApproveRequestToJoinTeam    = "domain_invites_approve_request_to_join_team"
-	EventTypeDomainInvitesDeclineRequestToJoinTeam    = "domain_invites_decline_request_to_join_team"
-	EventTypeDomainInvitesEmailExistingUsers          = "domain_invites_email_existing_users"
-	EventTypeDomainInvitesRequestToJoinTeam           = "domain_invites_request_to_join_team"
-	EventTypeDomainInvitesSetInviteNewUserPrefToNo    = "domain_invites_set_invite_new_user_pref_to_no"
-	EventTypeDomainInvitesSetInviteNewUserPrefToYes   = "domain_invites_set_invite_new_user_pref_to_yes"
-	EventTypeDomainVerificationAddDomainFail          = "domain_verification_add_domain_fail"
-	EventTypeDomainVerificationAddDomainSuccess       = "domain_verification_add_domain_success"
-	EventTypeDomainVerificationRemoveDomain           = "domain_verification_remove_domain"
-	EventTypeEnabledDomainInvites                     = "enabled_domain_invites"
-	EventTypeCreateFolder                             = "create_folder"
-	EventTypeFileAdd                                  = "file_add"
-	EventTypeFileCopy                                 = "file_copy"
-	EventTypeFileDelete                               = "file_delete"
-	EventTypeFileDownload                             = "file_download"
-	EventTypeFileEdit                                 = "file_edit"
-	EventTypeFileGetCopyReference                     = "file_get_copy_reference"
-	EventTypeFileMove                                 = "file_move"
-	EventTypeFilePermanentlyDelete                    = "file_permanently_delete"
-	EventTypeFilePreview                              = "file_preview"
-	EventTypeFileRename                               = "file_rename"
-	EventTypeFileRestore                              = "file_restore"
-	EventTypeFileRevert                               = "file_revert"
-	EventTypeFileRollbackChanges                      = "file_rollback_changes"
-	EventTypeFileSaveCopyReference                    = "file_save_copy_reference"
-	EventTypeFileRequestAddDeadline                   = "file_request_add_deadline"
-	EventTypeFileRequestChangeFolder                  = "file_request_change_folder"
-	EventTypeFileRequestChangeTitle                   = "file_request_change_title"
-	EventTypeFileRequestClose                         = "file_request_close"
-	EventTypeFileRequestCreate                        = "file_request_create"
-	EventTypeFileRequestReceiveFile                   = "file_request_receive_file"
-	EventTypeFileRequestRemoveDeadline                = "file_request_remove_deadline"
-	EventTypeFileRequestSend                          = "file_request_send"
-	EventTypeGroupAddExternalId                       = "group_add_external_id"
-	EventTypeGroupAddMember                           = "group_add_member"
-	EventTypeGroupChangeExternalId                    = "group_change_external_id"
-	EventTypeGroupChangeManagementType                = "group_change_management_type"
-	EventTypeGroupChangeMemberRole                    = "group_change_member_role"
-	EventTypeGroupCreate                              = "group_create"
-	EventTypeGroupDelete                              = "group_delete"
-	EventTypeGroupDescriptionUpdated                  = "group_description_updated"
-	EventTypeGroupJoinPolicyUpdated                   = "group_join_policy_updated"
-	EventTypeGroupMoved                               = "group_moved"
-	EventTypeGroupRemoveExternalId                    = "group_remove_external_id"
-	EventTypeGroupRemoveMember                        = "group_remove_member"
-	EventTypeGroupRename                              = "group_rename"
-	EventTypeEmmLoginSuccess                          = "emm_login_success"
-	EventTypeLogout                                   = "logout"
-	EventTypePasswordLoginFail                        = "password_login_fail"
-	EventTypePasswordLoginSuccess                     = "password_login_success"
-	EventTypeResellerSupportSessionEnd                = "reseller_support_session_end"
-	EventTypeResellerSupportSessionStart              = "reseller_support_session_start"
-	EventTypeSignInAsSessionEnd                       = "sign_in_as_session_end"
-	EventTypeSignInAsSessionStart                     = "sign_in_as_session_start"
-	EventTypeSsoLoginFail                             = "sso_login_fail"
-	EventTypeMemberAddName                            = "member_add_name"
+	case "member_permanently_delete_account_contents_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberPermanentlyDeleteAccountContentsDetails)
+
+	case "member_space_limits_change_status_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberSpaceLimitsChangeStatusDetails)
+
+	case "member_transfer_account_contents_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberTransferAccountContentsDetails)
+
+	case "paper_enabled_users_group_addition_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperEnabledUsersGroupAdditionDetails)
+
+	case "paper_enabled_users_group_removal_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperEnabledUsersGroupRemovalDetails)
+
+	case "paper_external_view_allow_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperExternalViewAllowDetails)
+
+	case "paper_external_view_default_team_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperExternalViewDefaultTeamDetails)
+
+	case "paper_external_view_forbid_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperExternalViewForbidDetails)
+
+	case "sf_external_invite_warn_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfExternalInviteWarnDetails)
+
+	case "team_merge_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamMergeDetails)
+
+	case "app_link_team_details":
+		return dropbox.MarshalTagged(u.Tag, u.AppLinkTeamDetails)
+
+	case "app_link_user_details":
+		return dropbox.MarshalTagged(u.Tag, u.AppLinkUserDetails)
+
+	case "app_unlink_team_details":
+		return dropbox.MarshalTagged(u.Tag, u.AppUnlinkTeamDetails)
+
+	case "app_unlink_user_details":
+		return dropbox.MarshalTagged(u.Tag, u.AppUnlinkUserDetails)
+
+	case "device_change_ip_desktop_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceChangeIpDesktopDetails)
+
+	case "device_change_ip_mobile_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceChangeIpMobileDetails)
+
+	case "device_change_ip_web_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceChangeIpWebDetails)
+
+	case "device_delete_on_unlink_fail_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceDeleteOnUnlinkFailDetails)
+
+	case "device_delete_on_unlink_success_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceDeleteOnUnlinkSuccessDetails)
+
+	case "device_link_fail_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceLinkFailDetails)
+
+	case "device_link_success_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceLinkSuccessDetails)
+
+	case "device_management_disabled_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceManagementDisabledDetails)
+
+	case "device_management_enabled_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceManagementEnabledDetails)
+
+	case "device_unlink_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceUnlinkDetails)
+
+	case "emm_refresh_auth_token_details":
+		return dropbox.MarshalTagged(u.Tag, u.EmmRefreshAuthTokenDetails)
+
+	case "account_capture_change_availability_details":
+		return dropbox.MarshalTagged(u.Tag, u.AccountCaptureChangeAvailabilityDetails)
+
+	case "account_capture_migrate_account_details":
+		return dropbox.MarshalTagged(u.Tag, u.AccountCaptureMigrateAccountDetails)
+
+	case "account_capture_relinquish_account_details":
+		return dropbox.MarshalTagged(u.Tag, u.AccountCaptureRelinquishAccountDetails)
+
+	case "disabled_domain_invites_details":
+		return dropbox.MarshalTagged(u.Tag, u.DisabledDomainInvitesDetails)
+
+	case "domain_invites_approve_request_to_join_team_details":
+		return dropbox.MarshalTagged(u.Tag, u.DomainInvitesApproveRequestToJoinTeamDetails)
+
+	case "domain_invites_decline_request_to_join_team_details":
+		return dropbox.MarshalTagged(u.Tag, u.DomainInvitesDeclineRequestToJoinTeamDetails)
+
+	case "domain_invites_email_existing_users_details":
+		return dropbox.MarshalTagged(u.Tag, u.DomainInvitesEmailExistingUsersDetails)
+
+	case "domain_invites_request_to_join_team_details":
+		return dropbox.MarshalTagged(u.Tag, u.DomainInvitesRequestToJoinTeamDetails)
+
+	case "domain_invites_set_invite_new_user_pref_to_no_details":
+		return dropbox.MarshalTagged(u.Tag, u.DomainInvitesSetInviteNewUserPrefToNoDetails)
+
+	case "domain_invites_set_invite_new_user_pref_to_yes_details":
+		return dropbox.MarshalTagged(u.Tag, u.DomainInvitesSetInviteNewUserPrefToYesDetails)
+
+	case "domain_verification_add_domain_fail_details":
+		return dropbox.MarshalTagged(u.Tag, u.DomainVerificationAddDomainFailDetails)
+
+	case "domain_verification_add_domain_success_details":
+		return dropbox.MarshalTagged(u.Tag, u.DomainVerificationAddDomainSuccessDetails)
+
+	case "domain_verification_remove_domain_details":
+		return dropbox.MarshalTagged(u.Tag, u.DomainVerificationRemoveDomainDetails)
+
+	case "enabled_domain_invites_details":
+		return dropbox.MarshalTagged(u.Tag, u.EnabledDomainInvitesDetails)
+
+	case "create_folder_details":
+		return dropbox.MarshalTagged(u.Tag, u.CreateFolderDetails)
+
+	case "file_add_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileAddDetails)
+
+	case "file_copy_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileCopyDetails)
+
+	case "file_delete_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileDeleteDetails)
+
+	case "file_download_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileDownloadDetails)
+
+	case "file_edit_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileEditDetails)
+
+	case "file_get_copy_reference_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileGetCopyReferenceDetails)
+
+	case "file_move_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileMoveDetails)
+
+	case "file_permanently_delete_details":
+		return dropbox.MarshalTagged(u.Tag, u.FilePermanentlyDeleteDetails)
+
+	case "file_preview_details":
+		return dropbox.MarshalTagged(u.Tag, u.FilePreviewDetails)
+
+	case "file_rename_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRenameDetails)
+
+	case "file_restore_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRestoreDetails)
+
+	case "file_revert_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRevertDetails)
+
+	case "file_rollback_changes_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRollbackChangesDetails)
+
+	case "file_save_copy_reference_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileSaveCopyReferenceDetails)
+
+	case "file_request_add_deadline_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestAddDeadlineDetails)
+
+	case "file_request_change_folder_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestChangeFolderDetails)
+
+	case "file_request_change_title_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestChangeTitleDetails)
+
+	case "file_request_close_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestCloseDetails)
+
+	case "file_request_create_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestCreateDetails)
+
+	case "file_request_receive_file_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestReceiveFileDetails)
+
+	case "file_request_remove_deadline_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestRemoveDeadlineDetails)
+
+	case "file_request_send_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestSendDetails)
+
+	case "group_add_external_id_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupAddExternalIdDetails)
+
+	case "group_add_member_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupAddMemberDetails)
+
+	case "group_change_external_id_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupChangeExternalIdDetails)
+
+	case "group_change_management_type_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupChangeManagementTypeDetails)
+
+	case "group_change_member_role_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupChangeMemberRoleDetails)
+
+	case "group_create_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupCreateDetails)
+
+	case "group_delete_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupDeleteDetails)
+
+	case "group_description_updated_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupDescriptionUpdatedDetails)
+
+	case "group_join_policy_updated_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupJoinPolicyUpdatedDetails)
+
+	case "group_moved_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupMovedDetails)
+
+	case "group_remove_external_id_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupRemoveExternalIdDetails)
+
+	case "group_remove_member_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupRemoveMemberDetails)
+
+	case "group_rename_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupRenameDetails)
+
+	case "emm_login_success_details":
+		return dropbox.MarshalTagged(u.Tag, u.EmmLoginSuccessDetails)
+
+	case "logout_details":
+		return dropbox.MarshalTagged(u.Tag, u.LogoutDetails)
+
+	case "password_login_fail_details":
+		return dropbox.MarshalTagged(u.Tag, u.PasswordLoginFailDetails)
+
+	case "password_login_success_details":
+		return dropbox.MarshalTagged(u.Tag, u.PasswordLoginSuccessDetails)
+
+	case "reseller_support_session_end_details":
+		return dropbox.MarshalTagged(u.Tag, u.ResellerSupportSessionEndDetails)
+
+	case "reseller_support_session_start_details":
+		return dropbox.MarshalTagged(u.Tag, u.ResellerSupportSessionStartDetails)
+
+	case "sign_in_as_session_end_details":
+		return dropbox.MarshalTagged(u.Tag, u.SignInAsSessionEndDetails)
+
+	case "sign_in_as_session_start_details":
+		return dropbox.MarshalTagged(u.Tag, u.SignInAsSessionStartDetails)
+
+	case "sso_login_fail_details":
+		return dropbox.MarshalTagged(u.Tag, u.SsoLoginFailDetails)
+
+	case "member_add_name_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberAddNameDetails)
+
+	case "member_change_email_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberChangeEmailDetails)
+
+	case "member_change_name_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberChangeNameDetails)
+
+	case "member_change_role_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberChangeRoleDetails)
+
+	case "member_invite_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberInviteDetails)
+
+	case "member_join_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberJoinDetails)
+
+	case "member_leave_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberLeaveDetails)
+
+	case "member_recover_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberRecoverDetails)
+
+	case "member_suggest_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberSuggestDetails)
+
+	case "member_suspend_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberSuspendDetails)
+
+	case "member_unsuspend_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberUnsuspendDetails)
+
+	case "paper_content_add_member_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentAddMemberDetails)
+
+	case "paper_content_add_to_folder_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentAddToFolderDetails)
+
+	case "paper_content_archive_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentArchiveDetails)
+
+	case "paper_content_change_subscription_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentChangeSubscriptionDetails)
+
+	case "paper_content_create_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentCreateDetails)
+
+	case "paper_content_permanently_delete_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentPermanentlyDeleteDetails)
+
+	case "paper_content_remove_from_folder_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentRemoveFromFolderDetails)
+
+	case "paper_content_remove_member_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentRemoveMemberDetails)
+
+	case "paper_content_rename_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentRenameDetails)
+
+	case "paper_content_restore_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperContentRestoreDetails)
+
+	case "paper_doc_add_comment_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocAddCommentDetails)
+
+	case "paper_doc_change_member_role_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocChangeMemberRoleDetails)
+
+	case "paper_doc_change_sharing_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocChangeSharingPolicyDetails)
+
+	case "paper_doc_deleted_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocDeletedDetails)
+
+	case "paper_doc_delete_comment_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocDeleteCommentDetails)
+
+	case "paper_doc_download_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocDownloadDetails)
+
+	case "paper_doc_edit_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocEditDetails)
+
+	case "paper_doc_edit_comment_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocEditCommentDetails)
+
+	case "paper_doc_followed_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocFollowedDetails)
+
+	case "paper_doc_mention_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocMentionDetails)
+
+	case "paper_doc_request_access_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocRequestAccessDetails)
+
+	case "paper_doc_resolve_comment_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocResolveCommentDetails)
+
+	case "paper_doc_revert_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocRevertDetails)
+
+	case "paper_doc_slack_share_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocSlackShareDetails)
+
+	case "paper_doc_team_invite_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocTeamInviteDetails)
+
+	case "paper_doc_unresolve_comment_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocUnresolveCommentDetails)
+
+	case "paper_doc_view_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperDocViewDetails)
+
+	case "paper_folder_deleted_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperFolderDeletedDetails)
+
+	case "paper_folder_followed_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperFolderFollowedDetails)
+
+	case "paper_folder_team_invite_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperFolderTeamInviteDetails)
+
+	case "password_change_details":
+		return dropbox.MarshalTagged(u.Tag, u.PasswordChangeDetails)
+
+	case "password_reset_details":
+		return dropbox.MarshalTagged(u.Tag, u.PasswordResetDetails)
+
+	case "password_reset_all_details":
+		return dropbox.MarshalTagged(u.Tag, u.PasswordResetAllDetails)
+
+	case "emm_create_exceptions_report_details":
+		return dropbox.MarshalTagged(u.Tag, u.EmmCreateExceptionsReportDetails)
+
+	case "emm_create_usage_report_details":
+		return dropbox.MarshalTagged(u.Tag, u.EmmCreateUsageReportDetails)
+
+	case "smart_sync_create_admin_privilege_report_details":
+		return dropbox.MarshalTagged(u.Tag, u.SmartSyncCreateAdminPrivilegeReportDetails)
+
+	case "team_activity_create_report_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamActivityCreateReportDetails)
+
+	case "collection_share_details":
+		return dropbox.MarshalTagged(u.Tag, u.CollectionShareDetails)
+
+	case "file_add_comment_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileAddCommentDetails)
+
+	case "file_like_comment_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileLikeCommentDetails)
+
+	case "file_unlike_comment_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileUnlikeCommentDetails)
+
+	case "note_acl_invite_only_details":
+		return dropbox.MarshalTagged(u.Tag, u.NoteAclInviteOnlyDetails)
+
+	case "note_acl_link_details":
+		return dropbox.MarshalTagged(u.Tag, u.NoteAclLinkDetails)
+
+	case "note_acl_team_link_details":
+		return dropbox.MarshalTagged(u.Tag, u.NoteAclTeamLinkDetails)
+
+	case "note_shared_details":
+		return dropbox.MarshalTagged(u.Tag, u.NoteSharedDetails)
+
+	case "note_share_receive_details":
+		return dropbox.MarshalTagged(u.Tag, u.NoteShareReceiveDetails)
+
+	case "open_note_shared_details":
+		return dropbox.MarshalTagged(u.Tag, u.OpenNoteSharedDetails)
+
+	case "sf_add_group_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfAddGroupDetails)
+
+	case "sf_allow_non_members_to_view_shared_links_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfAllowNonMembersToViewSharedLinksDetails)
+
+	case "sf_invite_group_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfInviteGroupDetails)
+
+	case "sf_nest_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfNestDetails)
+
+	case "sf_team_decline_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfTeamDeclineDetails)
+
+	case "sf_team_grant_access_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfTeamGrantAccessDetails)
+
+	case "sf_team_invite_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfTeamInviteDetails)
+
+	case "sf_team_invite_change_role_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfTeamInviteChangeRoleDetails)
+
+	case "sf_team_join_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfTeamJoinDetails)
+
+	case "sf_team_join_from_oob_link_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfTeamJoinFromOobLinkDetails)
+
+	case "sf_team_uninvite_details":
+		return dropbox.MarshalTagged(u.Tag, u.SfTeamUninviteDetails)
+
+	case "shared_content_add_invitees_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentAddInviteesDetails)
+
+	case "shared_content_add_link_expiry_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentAddLinkExpiryDetails)
+
+	case "shared_content_add_link_password_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentAddLinkPasswordDetails)
+
+	case "shared_content_add_member_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentAddMemberDetails)
+
+	case "shared_content_change_downloads_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentChangeDownloadsPolicyDetails)
+
+	case "shared_content_change_invitee_role_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentChangeInviteeRoleDetails)
+
+	case "shared_content_change_link_audience_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentChangeLinkAudienceDetails)
+
+	case "shared_content_change_link_expiry_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentChangeLinkExpiryDetails)
+
+	case "shared_content_change_link_password_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentChangeLinkPasswordDetails)
+
+	case "shared_content_change_member_role_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentChangeMemberRoleDetails)
+
+	case "shared_content_change_viewer_info_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentChangeViewerInfoPolicyDetails)
+
+	case "shared_content_claim_invitation_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentClaimInvitationDetails)
+
+	case "shared_content_copy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentCopyDetails)
+
+	case "shared_content_download_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentDownloadDetails)
+
+	case "shared_content_relinquish_membership_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentRelinquishMembershipDetails)
+
+	case "shared_content_remove_invitee_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentRemoveInviteeDetails)
+
+	case "shared_content_remove_link_expiry_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentRemoveLinkExpiryDetails)
+
+	case "shared_content_remove_link_password_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentRemoveLinkPasswordDetails)
+
+	case "shared_content_remove_member_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentRemoveMemberDetails)
+
+	case "shared_content_request_access_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentRequestAccessDetails)
+
+	case "shared_content_unshare_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentUnshareDetails)
+
+	case "shared_content_view_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedContentViewDetails)
+
+	case "shared_folder_change_confidentiality_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedFolderChangeConfidentialityDetails)
+
+	case "shared_folder_change_link_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedFolderChangeLinkPolicyDetails)
+
+	case "shared_folder_change_member_management_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedFolderChangeMemberManagementPolicyDetails)
+
+	case "shared_folder_change_member_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedFolderChangeMemberPolicyDetails)
+
+	case "shared_folder_create_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedFolderCreateDetails)
+
+	case "shared_folder_mount_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedFolderMountDetails)
+
+	case "shared_folder_transfer_ownership_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedFolderTransferOwnershipDetails)
+
+	case "shared_folder_unmount_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedFolderUnmountDetails)
+
+	case "shared_note_opened_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharedNoteOpenedDetails)
+
+	case "shmodel_app_create_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelAppCreateDetails)
+
+	case "shmodel_create_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelCreateDetails)
+
+	case "shmodel_disable_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelDisableDetails)
+
+	case "shmodel_fb_share_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelFbShareDetails)
+
+	case "shmodel_group_share_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelGroupShareDetails)
+
+	case "shmodel_remove_expiration_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelRemoveExpirationDetails)
+
+	case "shmodel_set_expiration_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelSetExpirationDetails)
+
+	case "shmodel_team_copy_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelTeamCopyDetails)
+
+	case "shmodel_team_download_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelTeamDownloadDetails)
+
+	case "shmodel_team_share_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelTeamShareDetails)
+
+	case "shmodel_team_view_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelTeamViewDetails)
+
+	case "shmodel_visibility_password_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelVisibilityPasswordDetails)
+
+	case "shmodel_visibility_public_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelVisibilityPublicDetails)
+
+	case "shmodel_visibility_team_only_details":
+		return dropbox.MarshalTagged(u.Tag, u.ShmodelVisibilityTeamOnlyDetails)
+
+	case "remove_logout_url_details":
+		return dropbox.MarshalTagged(u.Tag, u.RemoveLogoutUrlDetails)
+
+	case "remove_sso_url_details":
+		return dropbox.MarshalTagged(u.Tag, u.RemoveSsoUrlDetails)
+
+	case "sso_change_cert_details":
+		return dropbox.MarshalTagged(u.Tag, u.SsoChangeCertDetails)
+
+	case "sso_change_login_url_details":
+		return dropbox.MarshalTagged(u.Tag, u.SsoChangeLoginUrlDetails)
+
+	case "sso_change_logout_url_details":
+		return dropbox.MarshalTagged(u.Tag, u.SsoChangeLogoutUrlDetails)
+
+	case "sso_change_saml_identity_mode_details":
+		return dropbox.MarshalTagged(u.Tag, u.SsoChangeSamlIdentityModeDetails)
+
+	case "team_folder_change_status_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamFolderChangeStatusDetails)
+
+	case "team_folder_create_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamFolderCreateDetails)
+
+	case "team_folder_downgrade_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamFolderDowngradeDetails)
+
+	case "team_folder_permanently_delete_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamFolderPermanentlyDeleteDetails)
+
+	case "team_folder_rename_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamFolderRenameDetails)
+
+	case "account_capture_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.AccountCaptureChangePolicyDetails)
+
+	case "allow_download_disabled_details":
+		return dropbox.MarshalTagged(u.Tag, u.AllowDownloadDisabledDetails)
+
+	case "allow_download_enabled_details":
+		return dropbox.MarshalTagged(u.Tag, u.AllowDownloadEnabledDetails)
+
+	case "data_placement_restriction_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.DataPlacementRestrictionChangePolicyDetails)
+
+	case "data_placement_restriction_satisfy_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.DataPlacementRestrictionSatisfyPolicyDetails)
+
+	case "device_approvals_change_desktop_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceApprovalsChangeDesktopPolicyDetails)
+
+	case "device_approvals_change_mobile_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceApprovalsChangeMobilePolicyDetails)
+
+	case "device_approvals_change_overage_action_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceApprovalsChangeOverageActionDetails)
+
+	case "device_approvals_change_unlink_action_details":
+		return dropbox.MarshalTagged(u.Tag, u.DeviceApprovalsChangeUnlinkActionDetails)
+
+	case "emm_add_exception_details":
+		return dropbox.MarshalTagged(u.Tag, u.EmmAddExceptionDetails)
+
+	case "emm_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.EmmChangePolicyDetails)
+
+	case "emm_remove_exception_details":
+		return dropbox.MarshalTagged(u.Tag, u.EmmRemoveExceptionDetails)
+
+	case "extended_version_history_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.ExtendedVersionHistoryChangePolicyDetails)
+
+	case "file_comments_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileCommentsChangePolicyDetails)
+
+	case "file_requests_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestsChangePolicyDetails)
+
+	case "file_requests_emails_enabled_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestsEmailsEnabledDetails)
+
+	case "file_requests_emails_restricted_to_team_only_details":
+		return dropbox.MarshalTagged(u.Tag, u.FileRequestsEmailsRestrictedToTeamOnlyDetails)
+
+	case "google_sso_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.GoogleSsoChangePolicyDetails)
+
+	case "group_user_management_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.GroupUserManagementChangePolicyDetails)
+
+	case "member_requests_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberRequestsChangePolicyDetails)
+
+	case "member_space_limits_add_exception_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberSpaceLimitsAddExceptionDetails)
+
+	case "member_space_limits_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberSpaceLimitsChangePolicyDetails)
+
+	case "member_space_limits_remove_exception_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberSpaceLimitsRemoveExceptionDetails)
+
+	case "member_suggestions_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.MemberSuggestionsChangePolicyDetails)
+
+	case "microsoft_office_addin_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.MicrosoftOfficeAddinChangePolicyDetails)
+
+	case "network_control_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.NetworkControlChangePolicyDetails)
+
+	case "paper_change_deployment_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperChangeDeploymentPolicyDetails)
+
+	case "paper_change_member_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperChangeMemberPolicyDetails)
+
+	case "paper_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.PaperChangePolicyDetails)
+
+	case "permanent_delete_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.PermanentDeleteChangePolicyDetails)
+
+	case "sharing_change_folder_join_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharingChangeFolderJoinPolicyDetails)
+
+	case "sharing_change_link_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharingChangeLinkPolicyDetails)
+
+	case "sharing_change_member_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SharingChangeMemberPolicyDetails)
+
+	case "smart_sync_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SmartSyncChangePolicyDetails)
+
+	case "sso_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.SsoChangePolicyDetails)
+
+	case "tfa_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.TfaChangePolicyDetails)
+
+	case "two_account_change_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.TwoAccountChangePolicyDetails)
+
+	case "web_sessions_change_fixed_length_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.WebSessionsChangeFixedLengthPolicyDetails)
+
+	case "web_sessions_change_idle_length_policy_details":
+		return dropbox.MarshalTagged(u.Tag, u.WebSessionsChangeIdleLengthPolicyDetails)
+
+	case "team_profile_add_logo_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamProfileAddLogoDetails)
+
+	case "team_profile_change_logo_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamProfileChangeLogoDetails)
+
+	case "team_profile_change_name_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamProfileChangeNameDetails)
+
+	case "team_profile_remove_logo_details":
+		return dropbox.MarshalTagged(u.Tag, u.TeamProfileRemoveLogoDetails)
+
+	case "tfa_add_backup_phone_details":
+		return dropbox.MarshalTagged(u.Tag, u.TfaAddBackupPhoneDetails)
+
+	case "tfa_add_security_key_details":
+		return dropbox.MarshalTagged(u.Tag, u.TfaAddSecurityKeyDetails)
+
+	case "tfa_change_backup_phone_details":
+		return dropbox.MarshalTagged(u.Tag, u.TfaChangeBackupPhoneDetails)
+
+	case "tfa_change_status_details":
+		return dropbox.MarshalTagged(u.Tag, u.TfaChangeStatusDetails)
+
+	case "tfa_remove_backup_phone_details":
+		return dropbox.MarshalTagged(u.Tag, u.TfaRemoveBackupPhoneDetails)
+
+	case "tfa_remove_security_key_details":
+		return dropbox.MarshalTagged(u.Tag, u.TfaRemoveSecurityKeyDetails)
+
+	case "tfa_reset_details":
+		return dropbox.MarshalTagged(u.Tag, u.TfaResetDetails)
+
+	case "missing_details":
+		return dropbox.MarshalTagged(u.Tag, u.MissingDetails)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// EventType : The type of the event.
+type EventType struct {
+	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u EventType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
+}
+
+// Valid tag values for EventType
+const (
+	EventTypeMemberChangeMembershipType               = "member_change_membership_type"
+	EventTypeMemberPermanentlyDeleteAccountContents   = "member_permanently_delete_account_contents"
+	EventTypeMemberSpaceLimitsChangeStatus            = "member_space_limits_change_status"
+	EventTypeMemberTransferAccountContents            = "member_transfer_account_contents"
+	EventTypePaperEnabledUsersGroupAddition           = "paper_enabled_users_group_addition"
+	EventTypePaperEnabledUsersGroupRemoval            = "paper_enabled_users_group_removal"
+	EventTypePaperExternalViewAllow                   = "paper_external_view_allow"
+	EventTypePaperExternalViewDefaultTeam             = "paper_external_view_default_team"
+	EventTypePaperExternalViewForbid                  = "paper_external_view_forbid"
+	EventTypeSfExternalInviteWarn                     = "sf_external_invite_warn"
+	EventTypeTeamMerge                                = "team_merge"
+	EventTypeAppLinkTeam                              = "app_link_team"
+	EventTypeAppLinkUser                              = "app_link_user"
+	EventTypeAppUnlinkTeam                            = "app_unlink_team"
+	EventTypeAppUnlinkUser                            = "app_unlink_user"
+	EventTypeDeviceChangeIpDesktop                    = "device_change_ip_desktop"
+	EventTypeDeviceChangeIpMobile                     = "device_change_ip_mobile"
+	EventTypeDeviceChangeIpWeb                        = "device_change_ip_web"
+	EventTypeDeviceDeleteOnUnlinkFail                 = "device_delete_on_unlink_fail"
+	EventTypeDeviceDeleteOnUnlinkSuccess              = "device_delete_on_unlink_success"
+	EventTypeDeviceLinkFail                           = "device_link_fail"
+	EventTypeDeviceLinkSuccess                        = "device_link_success"
+	EventTypeDeviceManagementDisabled                 = "device_management_disabled"
+	EventTypeDeviceManagementEnabled                  = "device_management_enabled"
+	EventTypeDeviceUnlink                             = "device_unlink"
+	EventTypeEmmRefreshAuthToken                      = "emm_refresh_auth_token"
+	EventTypeAccountCaptureChangeAvailability         = "account_capture_change_availability"
+	EventTypeAccountCaptureMigrateAccount             = "account_capture_migrate_account"
+	EventTypeAccountCaptureRelinquishAccount          = "account_capture_relinquish_account"
+	EventTypeDisabledDomainInvites                    = "disabled_domain_invites"
+	EventTypeDomainInvitesApproveRequestToJoinTeam    = "domain_invites_approve_request_to_join_team"
+	EventTypeDomainInvitesDeclineRequestToJoinTeam    = "domain_invites_decline_request_to_join_team"
+	EventTypeDomainInvitesEmailExistingUsers          = "domain_invites_email_existing_users"
+	EventTypeDomainInvitesRequestToJoinTeam           = "domain_invites_request_to_join_team"
+	EventTypeDomainInvitesSetInviteNewUserPrefToNo    = "domain_invites_set_invite_new_user_pref_to_no"
+	EventTypeDomainInvitesSetInviteNewUserPrefToYes   = "domain_invites_set_invite_new_user_pref_to_yes"
+	EventTypeDomainVerificationAddDomainFail          = "domain_verification_add_domain_fail"
+	EventTypeDomainVerificationAddDomainSuccess       = "domain_verification_add_domain_success"
+	EventTypeDomainVerificationRemoveDomain           = "domain_verification_remove_domain"
+	EventTypeEnabledDomainInvites                     = "enabled_domain_invites"
+	EventTypeCreateFolder                             = "create_folder"
+	EventTypeFileAdd                                  = "file_add"
+	EventTypeFileCopy                                 = "file_copy"
+	EventTypeFileDelete                               = "file_delete"
+	EventTypeFileDownload                             = "file_download"
+	EventTypeFileEdit                                 = "file_edit"
+	EventTypeFileGetCopyReference                     = "file_get_copy_reference"
+	EventTypeFileMove                                 = "file_move"
+	EventTypeFilePermanentlyDelete                    = "file_permanently_delete"
+	EventTypeFilePreview                              = "file_preview"
+	EventTypeFileRename                               = "file_rename"
+	EventTypeFileRestore                              = "file_restore"
+	EventTypeFileRevert                               = "file_revert"
+	EventTypeFileRollbackChanges                      = "file_rollback_changes"
+	EventTypeFileSaveCopyReference                    = "file_save_copy_reference"
+	EventTypeFileRequestAddDeadline                   = "file_request_add_deadline"
+	EventTypeFileRequestChangeFolder                  = "file_request_change_folder"
+	EventTypeFileRequestChangeTitle                   = "file_request_change_title"
+	EventTypeFileRequestClose                         = "file_request_close"
+	EventTypeFileRequestCreate                        = "file_request_create"
+	EventTypeFileRequestReceiveFile                   = "file_request_receive_file"
+	EventTypeFileRequestRemoveDeadline                = "file_request_remove_deadline"
+	EventTypeFileRequestSend                          = "file_request_send"
+	EventTypeGroupAddExternalId                       = "group_add_external_id"
+	EventTypeGroupAddMember                           = "group_add_member"
+	EventTypeGroupChangeExternalId                    = "group_change_external_id"
+	EventTypeGroupChangeManagementType                = "group_change_management_type"
+	EventTypeGroupChangeMemberRole                    = "group_change_member_role"
+	EventTypeGroupCreate                              = "group_create"
+	EventTypeGroupDelete                              = "group_delete"
+	EventTypeGroupDescriptionUpdated                  = "group_description_updated"
+	EventTypeGroupJoinPolicyUpdated                   = "group_join_policy_updated"
+	EventTypeGroupMoved                               = "group_moved"
+	EventTypeGroupRemoveExternalId                    = "group_remove_external_id"
+	EventTypeGroupRemoveMember                        = "group_remove_member"
+	EventTypeGroupRename                              = "group_rename"
+	EventTypeEmmLoginSuccess                          = "emm_login_success"
+	EventTypeLogout                                   = "logout"
+	EventTypePasswordLoginFail                        = "password_login_fail"
+	EventTypePasswordLoginSuccess                     = "password_login_success"
+	EventTypeResellerSupportSessionEnd                = "reseller_support_session_end"
+	EventTypeResellerSupportSessionStart              = "reseller_support_session_start"
+	EventTypeSignInAsSessionEnd                       = "sign_in_as_session_end"
+	EventTypeSignInAsSessionStart                     = "sign_in_as_session_start"
+	EventTypeSsoLoginFail                             = "sso_login_fail"
+	EventTypeMemberAddName                            = "member_add_name"
 	EventTypeMemberChangeEmail                        = "member_change_email"
 	EventTypeMemberChangeName                         = "member_change_name"
 	EventTypeMemberChangeRole                         = "member_change_role"
@@ -4748,6 +6604,32 @@ const (
 	EventTypeOther                                    = "other"
 )
 
+// UnmarshalJSON deserializes into a EventType instance
+func (u *EventType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "member_change_membership_type", "member_permanently_delete_account_contents", "member_space_limits_change_status", "member_transfer_account_contents", "paper_enabled_users_group_addition", "paper_enabled_users_group_removal", "paper_external_view_allow", "paper_external_view_default_team", "paper_external_view_forbid", "sf_external_invite_warn", "team_merge", "app_link_team", "app_link_user", "app_unlink_team", "app_unlink_user", "device_change_ip_desktop", "device_change_ip_mobile", "device_change_ip_web", "device_delete_on_unlink_fail", "device_delete_on_unlink_success", "device_link_fail", "device_link_success", "device_management_disabled", "device_management_enabled", "device_unlink", "emm_refresh_auth_token", "account_capture_change_availability", "account_capture_migrate_account", "account_capture_relinquish_account", "disabled_domain_invites", "domain_invites_approve_request_to_join_team", "domain_invites_decline_request_to_join_team", "domain_invites_email_existing_users", "domain_invites_request_to_join_team", "domain_invites_set_invite_new_user_pref_to_no", "domain_invites_set_invite_new_user_pref_to_yes", "domain_verification_add_domain_fail", "domain_verification_add_domain_success", "domain_verification_remove_domain", "enabled_domain_invites", "create_folder", "file_add", "file_copy", "file_delete", "file_download", "file_edit", "file_get_copy_reference", "file_move", "file_permanently_delete", "file_preview", "file_rename", "file_restore", "file_revert", "file_rollback_changes", "file_save_copy_reference", "file_request_add_deadline", "file_request_change_folder", "file_request_change_title", "file_request_close", "file_request_create", "file_request_receive_file", "file_request_remove_deadline", "file_request_send", "group_add_external_id", "group_add_member", "group_change_external_id", "group_change_management_type", "group_change_member_role", "group_create", "group_delete", "group_description_updated", "group_join_policy_updated", "group_moved", "group_remove_external_id", "group_remove_member", "group_rename", "emm_login_success", "logout", "password_login_fail", "password_login_success", "reseller_support_session_end", "reseller_support_session_start", "sign_in_as_session_end", "sign_in_as_session_start", "sso_login_fail", "member_add_name", "member_change_email", "member_change_name", "member_change_role", "member_invite", "member_join", "member_leave", "member_recover", "member_suggest", "member_suspend", "member_unsuspend", "paper_content_add_member", "paper_content_add_to_folder", "paper_content_archive", "paper_content_change_subscription", "paper_content_create", "paper_content_permanently_delete", "paper_content_remove_from_folder", "paper_content_remove_member", "paper_content_rename", "paper_content_restore", "paper_doc_add_comment", "paper_doc_change_member_role", "paper_doc_change_sharing_policy", "paper_doc_deleted", "paper_doc_delete_comment", "paper_doc_download", "paper_doc_edit", "paper_doc_edit_comment", "paper_doc_followed", "paper_doc_mention", "paper_doc_request_access", "paper_doc_resolve_comment", "paper_doc_revert", "paper_doc_slack_share", "paper_doc_team_invite", "paper_doc_unresolve_comment", "paper_doc_view", "paper_folder_deleted", "paper_folder_followed", "paper_folder_team_invite", "password_change", "password_reset", "password_reset_all", "emm_create_exceptions_report", "emm_create_usage_report", "smart_sync_create_admin_privilege_report", "team_activity_create_report", "collection_share", "file_add_comment", "file_like_comment", "file_unlike_comment", "note_acl_invite_only", "note_acl_link", "note_acl_team_link", "note_shared", "note_share_receive", "open_note_shared", "sf_add_group", "sf_allow_non_members_to_view_shared_links", "sf_invite_group", "sf_nest", "sf_team_decline", "sf_team_grant_access", "sf_team_invite", "sf_team_invite_change_role", "sf_team_join", "sf_team_join_from_oob_link", "sf_team_uninvite", "shared_content_add_invitees", "shared_content_add_link_expiry", "shared_content_add_link_password", "shared_content_add_member", "shared_content_change_downloads_policy", "shared_content_change_invitee_role", "shared_content_change_link_audience", "shared_content_change_link_expiry", "shared_content_change_link_password", "shared_content_change_member_role", "shared_content_change_viewer_info_policy", "shared_content_claim_invitation", "shared_content_copy", "shared_content_download", "shared_content_relinquish_membership", "shared_content_remove_invitee", "shared_content_remove_link_expiry", "shared_content_remove_link_password", "shared_content_remove_member", "shared_content_request_access", "shared_content_unshare", "shared_content_view", "shared_folder_change_confidentiality", "shared_folder_change_link_policy", "shared_folder_change_member_management_policy", "shared_folder_change_member_policy", "shared_folder_create", "shared_folder_mount", "shared_folder_transfer_ownership", "shared_folder_unmount", "shared_note_opened", "shmodel_app_create", "shmodel_create", "shmodel_disable", "shmodel_fb_share", "shmodel_group_share", "shmodel_remove_expiration", "shmodel_set_expiration", "shmodel_team_copy", "shmodel_team_download", "shmodel_team_share", "shmodel_team_view", "shmodel_visibility_password", "shmodel_visibility_public", "shmodel_visibility_team_only", "remove_logout_url", "remove_sso_url", "sso_change_cert", "sso_change_login_url", "sso_change_logout_url", "sso_change_saml_identity_mode", "team_folder_change_status", "team_folder_create", "team_folder_downgrade", "team_folder_permanently_delete", "team_folder_rename", "account_capture_change_policy", "allow_download_disabled", "allow_download_enabled", "data_placement_restriction_change_policy", "data_placement_restriction_satisfy_policy", "device_approvals_change_desktop_policy", "device_approvals_change_mobile_policy", "device_approvals_change_overage_action", "device_approvals_change_unlink_action", "emm_add_exception", "emm_change_policy", "emm_remove_exception", "extended_version_history_change_policy", "file_comments_change_policy", "file_requests_change_policy", "file_requests_emails_enabled", "file_requests_emails_restricted_to_team_only", "google_sso_change_policy", "group_user_management_change_policy", "member_requests_change_policy", "member_space_limits_add_exception", "member_space_limits_change_policy", "member_space_limits_remove_exception", "member_suggestions_change_policy", "microsoft_office_addin_change_policy", "network_control_change_policy", "paper_change_deployment_policy", "paper_change_member_policy", "paper_change_policy", "permanent_delete_change_policy", "sharing_change_folder_join_policy", "sharing_change_link_policy", "sharing_change_member_policy", "smart_sync_change_policy", "sso_change_policy", "tfa_change_policy", "two_account_change_policy", "web_sessions_change_fixed_length_policy", "web_sessions_change_idle_length_policy", "team_profile_add_logo", "team_profile_change_logo", "team_profile_change_name", "team_profile_remove_logo", "tfa_add_backup_phone", "tfa_add_security_key", "tfa_change_backup_phone", "tfa_change_status", "tfa_remove_backup_phone", "tfa_remove_security_key", "tfa_reset", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a EventType instance
+func (u EventType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ExtendedVersionHistoryChangePolicyDetails : Accepted or opted out of extended
 // version history.
 type ExtendedVersionHistoryChangePolicyDetails struct {
@@ -4756,6 +6638,8 @@ type ExtendedVersionHistoryChangePolicyDetails struct {
 	// PreviousValue : Previous extended version history policy. Might be
 	// missing due to historical data gap.
 	PreviousValue *ExtendedVersionHistoryPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewExtendedVersionHistoryChangePolicyDetails returns a new ExtendedVersionHistoryChangePolicyDetails instance
@@ -4765,9 +6649,30 @@ func NewExtendedVersionHistoryChangePolicyDetails(NewValue *ExtendedVersionHisto
 	return s
 }
 
+// MarshalJSON serializes a ExtendedVersionHistoryChangePolicyDetails instance
+func (u ExtendedVersionHistoryChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ExtendedVersionHistoryChangePolicyDetails instance
+func (u *ExtendedVersionHistoryChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ExtendedVersionHistoryPolicy : has no documentation (yet)
 type ExtendedVersionHistoryPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ExtendedVersionHistoryPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ExtendedVersionHistoryPolicy
@@ -4777,9 +6682,46 @@ const (
 	ExtendedVersionHistoryPolicyOther     = "other"
 )
 
+// UnmarshalJSON deserializes into a ExtendedVersionHistoryPolicy instance
+func (u *ExtendedVersionHistoryPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "limited", "unlimited", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ExtendedVersionHistoryPolicy instance
+func (u ExtendedVersionHistoryPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ExternalSharingAccessibilityPolicy : has no documentation (yet)
 type ExternalSharingAccessibilityPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ExternalSharingAccessibilityPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ExternalSharingAccessibilityPolicy
@@ -4790,9 +6732,46 @@ const (
 	ExternalSharingAccessibilityPolicyOther           = "other"
 )
 
+// UnmarshalJSON deserializes into a ExternalSharingAccessibilityPolicy instance
+func (u *ExternalSharingAccessibilityPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "team_only", "default_team_only", "default_anyone", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ExternalSharingAccessibilityPolicy instance
+func (u ExternalSharingAccessibilityPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // ExternalSharingPolicy : has no documentation (yet)
 type ExternalSharingPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ExternalSharingPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ExternalSharingPolicy
@@ -4802,12 +6781,40 @@ const (
 	ExternalSharingPolicyOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a ExternalSharingPolicy instance
+func (u *ExternalSharingPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "team_only", "anyone", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a ExternalSharingPolicy instance
+func (u ExternalSharingPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // FileAddCommentDetails : Added a file comment.
 type FileAddCommentDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
 	// CommentText : Comment text. Might be missing due to historical data gap.
 	CommentText string `json:"comment_text,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileAddCommentDetails returns a new FileAddCommentDetails instance
@@ -4817,8 +6824,20 @@ func NewFileAddCommentDetails(TargetIndex int64) *FileAddCommentDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileAddCommentDetails instance
+func (u FileAddCommentDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileAddCommentDetails instance
+func (u *FileAddCommentDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileAddDetails : Added files and/or folders.
 type FileAddDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileAddDetails returns a new FileAddDetails instance
@@ -4827,6 +6846,16 @@ func NewFileAddDetails() *FileAddDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileAddDetails instance
+func (u FileAddDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileAddDetails instance
+func (u *FileAddDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileCommentsChangePolicyDetails : Enabled or disabled commenting on team
 // files.
 type FileCommentsChangePolicyDetails struct {
@@ -4835,6 +6864,8 @@ type FileCommentsChangePolicyDetails struct {
 	// PreviousValue : Previous commenting on team files policy. Might be
 	// missing due to historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileCommentsChangePolicyDetails returns a new FileCommentsChangePolicyDetails instance
@@ -4844,12 +6875,24 @@ func NewFileCommentsChangePolicyDetails(NewValue *EnableDisableChangePolicy) *Fi
 	return s
 }
 
+// MarshalJSON serializes a FileCommentsChangePolicyDetails instance
+func (u FileCommentsChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileCommentsChangePolicyDetails instance
+func (u *FileCommentsChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileCopyDetails : Copied files and/or folders.
 type FileCopyDetails struct {
 	// SrcIndex : Source asset index.
 	SrcIndex int64 `json:"src_index"`
 	// DestIndex : Destination asset index.
 	DestIndex int64 `json:"dest_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileCopyDetails returns a new FileCopyDetails instance
@@ -4860,8 +6903,20 @@ func NewFileCopyDetails(SrcIndex int64, DestIndex int64) *FileCopyDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileCopyDetails instance
+func (u FileCopyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileCopyDetails instance
+func (u *FileCopyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileDeleteDetails : Deleted files and/or folders.
 type FileDeleteDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileDeleteDetails returns a new FileDeleteDetails instance
@@ -4870,8 +6925,20 @@ func NewFileDeleteDetails() *FileDeleteDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileDeleteDetails instance
+func (u FileDeleteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileDeleteDetails instance
+func (u *FileDeleteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileDownloadDetails : Downloaded files and/or folders.
 type FileDownloadDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileDownloadDetails returns a new FileDownloadDetails instance
@@ -4880,8 +6947,20 @@ func NewFileDownloadDetails() *FileDownloadDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileDownloadDetails instance
+func (u FileDownloadDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileDownloadDetails instance
+func (u *FileDownloadDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileEditDetails : Edited files.
 type FileEditDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileEditDetails returns a new FileEditDetails instance
@@ -4890,8 +6969,20 @@ func NewFileEditDetails() *FileEditDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileEditDetails instance
+func (u FileEditDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileEditDetails instance
+func (u *FileEditDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileGetCopyReferenceDetails : Create a copy reference to a file or folder.
 type FileGetCopyReferenceDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileGetCopyReferenceDetails returns a new FileGetCopyReferenceDetails instance
@@ -4900,12 +6991,24 @@ func NewFileGetCopyReferenceDetails() *FileGetCopyReferenceDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileGetCopyReferenceDetails instance
+func (u FileGetCopyReferenceDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileGetCopyReferenceDetails instance
+func (u *FileGetCopyReferenceDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileLikeCommentDetails : Liked a file comment.
 type FileLikeCommentDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
 	// CommentText : Comment text. Might be missing due to historical data gap.
 	CommentText string `json:"comment_text,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileLikeCommentDetails returns a new FileLikeCommentDetails instance
@@ -4915,6 +7018,16 @@ func NewFileLikeCommentDetails(TargetIndex int64) *FileLikeCommentDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileLikeCommentDetails instance
+func (u FileLikeCommentDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileLikeCommentDetails instance
+func (u *FileLikeCommentDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileOrFolderLogInfo : Generic information relevant both for files and folders
 type FileOrFolderLogInfo struct {
 	// Path : Path relative to event context.
@@ -4923,6 +7036,8 @@ type FileOrFolderLogInfo struct {
 	DisplayName string `json:"display_name,omitempty"`
 	// FileId : Unique ID. Might be missing due to historical data gap.
 	FileId string `json:"file_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileOrFolderLogInfo returns a new FileOrFolderLogInfo instance
@@ -4932,9 +7047,21 @@ func NewFileOrFolderLogInfo(Path *PathLogInfo) *FileOrFolderLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a FileOrFolderLogInfo instance
+func (u FileOrFolderLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileOrFolderLogInfo instance
+func (u *FileOrFolderLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileLogInfo : File's logged information.
 type FileLogInfo struct {
 	FileOrFolderLogInfo
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileLogInfo returns a new FileLogInfo instance
@@ -4944,12 +7071,24 @@ func NewFileLogInfo(Path *PathLogInfo) *FileLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a FileLogInfo instance
+func (u FileLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileLogInfo instance
+func (u *FileLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileMoveDetails : Moved files and/or folders.
 type FileMoveDetails struct {
 	// SrcIndex : Source asset index.
 	SrcIndex int64 `json:"src_index"`
 	// DestIndex : Destination asset index.
 	DestIndex int64 `json:"dest_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileMoveDetails returns a new FileMoveDetails instance
@@ -4960,8 +7099,20 @@ func NewFileMoveDetails(SrcIndex int64, DestIndex int64) *FileMoveDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileMoveDetails instance
+func (u FileMoveDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileMoveDetails instance
+func (u *FileMoveDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FilePermanentlyDeleteDetails : Permanently deleted files and/or folders.
 type FilePermanentlyDeleteDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFilePermanentlyDeleteDetails returns a new FilePermanentlyDeleteDetails instance
@@ -4970,8 +7121,20 @@ func NewFilePermanentlyDeleteDetails() *FilePermanentlyDeleteDetails {
 	return s
 }
 
+// MarshalJSON serializes a FilePermanentlyDeleteDetails instance
+func (u FilePermanentlyDeleteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FilePermanentlyDeleteDetails instance
+func (u *FilePermanentlyDeleteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FilePreviewDetails : Previewed files and/or folders.
 type FilePreviewDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFilePreviewDetails returns a new FilePreviewDetails instance
@@ -4980,12 +7143,24 @@ func NewFilePreviewDetails() *FilePreviewDetails {
 	return s
 }
 
+// MarshalJSON serializes a FilePreviewDetails instance
+func (u FilePreviewDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FilePreviewDetails instance
+func (u *FilePreviewDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRenameDetails : Renamed files and/or folders.
 type FileRenameDetails struct {
 	// SrcIndex : Source asset index.
 	SrcIndex int64 `json:"src_index"`
 	// DestIndex : Destination asset index.
 	DestIndex int64 `json:"dest_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRenameDetails returns a new FileRenameDetails instance
@@ -4996,10 +7171,22 @@ func NewFileRenameDetails(SrcIndex int64, DestIndex int64) *FileRenameDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileRenameDetails instance
+func (u FileRenameDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRenameDetails instance
+func (u *FileRenameDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestAddDeadlineDetails : Added a deadline to a file request.
 type FileRequestAddDeadlineDetails struct {
 	// RequestTitle : File request title.
 	RequestTitle string `json:"request_title"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestAddDeadlineDetails returns a new FileRequestAddDeadlineDetails instance
@@ -5009,10 +7196,22 @@ func NewFileRequestAddDeadlineDetails(RequestTitle string) *FileRequestAddDeadli
 	return s
 }
 
+// MarshalJSON serializes a FileRequestAddDeadlineDetails instance
+func (u FileRequestAddDeadlineDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestAddDeadlineDetails instance
+func (u *FileRequestAddDeadlineDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestChangeFolderDetails : Changed the file request folder.
 type FileRequestChangeFolderDetails struct {
 	// RequestTitle : File request title.
 	RequestTitle string `json:"request_title"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestChangeFolderDetails returns a new FileRequestChangeFolderDetails instance
@@ -5022,10 +7221,22 @@ func NewFileRequestChangeFolderDetails(RequestTitle string) *FileRequestChangeFo
 	return s
 }
 
+// MarshalJSON serializes a FileRequestChangeFolderDetails instance
+func (u FileRequestChangeFolderDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestChangeFolderDetails instance
+func (u *FileRequestChangeFolderDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestChangeTitleDetails : Change the file request title.
 type FileRequestChangeTitleDetails struct {
 	// RequestTitle : File request title.
 	RequestTitle string `json:"request_title"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestChangeTitleDetails returns a new FileRequestChangeTitleDetails instance
@@ -5035,10 +7246,22 @@ func NewFileRequestChangeTitleDetails(RequestTitle string) *FileRequestChangeTit
 	return s
 }
 
+// MarshalJSON serializes a FileRequestChangeTitleDetails instance
+func (u FileRequestChangeTitleDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestChangeTitleDetails instance
+func (u *FileRequestChangeTitleDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestCloseDetails : Closed a file request.
 type FileRequestCloseDetails struct {
 	// RequestTitle : File request title.
 	RequestTitle string `json:"request_title"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestCloseDetails returns a new FileRequestCloseDetails instance
@@ -5048,10 +7271,22 @@ func NewFileRequestCloseDetails(RequestTitle string) *FileRequestCloseDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileRequestCloseDetails instance
+func (u FileRequestCloseDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestCloseDetails instance
+func (u *FileRequestCloseDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestCreateDetails : Created a file request.
 type FileRequestCreateDetails struct {
 	// RequestTitle : File request title.
 	RequestTitle string `json:"request_title"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestCreateDetails returns a new FileRequestCreateDetails instance
@@ -5061,12 +7296,24 @@ func NewFileRequestCreateDetails(RequestTitle string) *FileRequestCreateDetails
 	return s
 }
 
+// MarshalJSON serializes a FileRequestCreateDetails instance
+func (u FileRequestCreateDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestCreateDetails instance
+func (u *FileRequestCreateDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestReceiveFileDetails : Received files for a file request.
 type FileRequestReceiveFileDetails struct {
 	// RequestTitle : File request title.
 	RequestTitle string `json:"request_title"`
 	// SubmittedFileNames : Submitted file names.
 	SubmittedFileNames []string `json:"submitted_file_names"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestReceiveFileDetails returns a new FileRequestReceiveFileDetails instance
@@ -5077,10 +7324,22 @@ func NewFileRequestReceiveFileDetails(RequestTitle string, SubmittedFileNames []
 	return s
 }
 
+// MarshalJSON serializes a FileRequestReceiveFileDetails instance
+func (u FileRequestReceiveFileDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestReceiveFileDetails instance
+func (u *FileRequestReceiveFileDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestRemoveDeadlineDetails : Removed the file request deadline.
 type FileRequestRemoveDeadlineDetails struct {
 	// RequestTitle : File request title.
 	RequestTitle string `json:"request_title"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestRemoveDeadlineDetails returns a new FileRequestRemoveDeadlineDetails instance
@@ -5090,10 +7349,22 @@ func NewFileRequestRemoveDeadlineDetails(RequestTitle string) *FileRequestRemove
 	return s
 }
 
+// MarshalJSON serializes a FileRequestRemoveDeadlineDetails instance
+func (u FileRequestRemoveDeadlineDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestRemoveDeadlineDetails instance
+func (u *FileRequestRemoveDeadlineDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestSendDetails : Sent file request to users via email.
 type FileRequestSendDetails struct {
 	// RequestTitle : File request title.
 	RequestTitle string `json:"request_title"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestSendDetails returns a new FileRequestSendDetails instance
@@ -5103,6 +7374,16 @@ func NewFileRequestSendDetails(RequestTitle string) *FileRequestSendDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileRequestSendDetails instance
+func (u FileRequestSendDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestSendDetails instance
+func (u *FileRequestSendDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestsChangePolicyDetails : Enabled or disabled file requests.
 type FileRequestsChangePolicyDetails struct {
 	// NewValue : New file requests policy.
@@ -5110,6 +7391,8 @@ type FileRequestsChangePolicyDetails struct {
 	// PreviousValue : Previous file requests policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestsChangePolicyDetails returns a new FileRequestsChangePolicyDetails instance
@@ -5119,8 +7402,20 @@ func NewFileRequestsChangePolicyDetails(NewValue *EnableDisableChangePolicy) *Fi
 	return s
 }
 
+// MarshalJSON serializes a FileRequestsChangePolicyDetails instance
+func (u FileRequestsChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestsChangePolicyDetails instance
+func (u *FileRequestsChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestsEmailsEnabledDetails : Enabled file request emails for everyone.
 type FileRequestsEmailsEnabledDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestsEmailsEnabledDetails returns a new FileRequestsEmailsEnabledDetails instance
@@ -5129,9 +7424,21 @@ func NewFileRequestsEmailsEnabledDetails() *FileRequestsEmailsEnabledDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileRequestsEmailsEnabledDetails instance
+func (u FileRequestsEmailsEnabledDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestsEmailsEnabledDetails instance
+func (u *FileRequestsEmailsEnabledDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRequestsEmailsRestrictedToTeamOnlyDetails : Allowed file request emails
 // for the team.
 type FileRequestsEmailsRestrictedToTeamOnlyDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRequestsEmailsRestrictedToTeamOnlyDetails returns a new FileRequestsEmailsRestrictedToTeamOnlyDetails instance
@@ -5140,8 +7447,20 @@ func NewFileRequestsEmailsRestrictedToTeamOnlyDetails() *FileRequestsEmailsRestr
 	return s
 }
 
+// MarshalJSON serializes a FileRequestsEmailsRestrictedToTeamOnlyDetails instance
+func (u FileRequestsEmailsRestrictedToTeamOnlyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRequestsEmailsRestrictedToTeamOnlyDetails instance
+func (u *FileRequestsEmailsRestrictedToTeamOnlyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRestoreDetails : Restored deleted files and/or folders.
 type FileRestoreDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRestoreDetails returns a new FileRestoreDetails instance
@@ -5150,8 +7469,20 @@ func NewFileRestoreDetails() *FileRestoreDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileRestoreDetails instance
+func (u FileRestoreDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRestoreDetails instance
+func (u *FileRestoreDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRevertDetails : Reverted files to a previous version.
 type FileRevertDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRevertDetails returns a new FileRevertDetails instance
@@ -5160,8 +7491,20 @@ func NewFileRevertDetails() *FileRevertDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileRevertDetails instance
+func (u FileRevertDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRevertDetails instance
+func (u *FileRevertDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileRollbackChangesDetails : Rolled back file change location changes.
 type FileRollbackChangesDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileRollbackChangesDetails returns a new FileRollbackChangesDetails instance
@@ -5170,12 +7513,24 @@ func NewFileRollbackChangesDetails() *FileRollbackChangesDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileRollbackChangesDetails instance
+func (u FileRollbackChangesDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileRollbackChangesDetails instance
+func (u *FileRollbackChangesDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileSaveCopyReferenceDetails : Save a file or folder using a copy reference.
 type FileSaveCopyReferenceDetails struct {
 	// SrcIndex : Source asset index.
 	SrcIndex int64 `json:"src_index"`
 	// DestIndex : Destination asset index.
 	DestIndex int64 `json:"dest_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileSaveCopyReferenceDetails returns a new FileSaveCopyReferenceDetails instance
@@ -5186,12 +7541,24 @@ func NewFileSaveCopyReferenceDetails(SrcIndex int64, DestIndex int64) *FileSaveC
 	return s
 }
 
+// MarshalJSON serializes a FileSaveCopyReferenceDetails instance
+func (u FileSaveCopyReferenceDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileSaveCopyReferenceDetails instance
+func (u *FileSaveCopyReferenceDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FileUnlikeCommentDetails : Unliked a file comment.
 type FileUnlikeCommentDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
 	// CommentText : Comment text. Might be missing due to historical data gap.
 	CommentText string `json:"comment_text,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFileUnlikeCommentDetails returns a new FileUnlikeCommentDetails instance
@@ -5201,9 +7568,21 @@ func NewFileUnlikeCommentDetails(TargetIndex int64) *FileUnlikeCommentDetails {
 	return s
 }
 
+// MarshalJSON serializes a FileUnlikeCommentDetails instance
+func (u FileUnlikeCommentDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FileUnlikeCommentDetails instance
+func (u *FileUnlikeCommentDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FolderLogInfo : Folder's logged information.
 type FolderLogInfo struct {
 	FileOrFolderLogInfo
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFolderLogInfo returns a new FolderLogInfo instance
@@ -5213,6 +7592,16 @@ func NewFolderLogInfo(Path *PathLogInfo) *FolderLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a FolderLogInfo instance
+func (u FolderLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FolderLogInfo instance
+func (u *FolderLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GeoLocationLogInfo : Geographic location details.
 type GeoLocationLogInfo struct {
 	// City : City name.
@@ -5223,6 +7612,8 @@ type GeoLocationLogInfo struct {
 	Country string `json:"country,omitempty"`
 	// IpAddress : IP address.
 	IpAddress string `json:"ip_address"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGeoLocationLogInfo returns a new GeoLocationLogInfo instance
@@ -5232,6 +7623,16 @@ func NewGeoLocationLogInfo(IpAddress string) *GeoLocationLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a GeoLocationLogInfo instance
+func (u GeoLocationLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GeoLocationLogInfo instance
+func (u *GeoLocationLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetTeamEventsArg : has no documentation (yet)
 type GetTeamEventsArg struct {
 	// Limit : Number of results to return per call.
@@ -5241,6 +7642,8 @@ type GetTeamEventsArg struct {
 	AccountId string `json:"account_id,omitempty"`
 	// Time : Filter by time range.
 	Time *team_common.TimeRange `json:"time,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetTeamEventsArg returns a new GetTeamEventsArg instance
@@ -5250,10 +7653,42 @@ func NewGetTeamEventsArg() *GetTeamEventsArg {
 	return s
 }
 
+// MarshalJSON serializes a GetTeamEventsArg instance
+func (u GetTeamEventsArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetTeamEventsArg instance
+func (u *GetTeamEventsArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetTeamEventsArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Limit < 1 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at least 1",
+		}
+	}
+	if s.Limit > 1000 {
+		return &dropbox.ValidationError{
+			Field:  "limit",
+			Reason: "must be at most 1000",
+		}
+	}
+	return nil
+}
+
 // GetTeamEventsContinueArg : has no documentation (yet)
 type GetTeamEventsContinueArg struct {
 	// Cursor : Indicates from what point to get the next set of events.
 	Cursor string `json:"cursor"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetTeamEventsContinueArg returns a new GetTeamEventsContinueArg instance
@@ -5263,10 +7698,36 @@ func NewGetTeamEventsContinueArg(Cursor string) *GetTeamEventsContinueArg {
 	return s
 }
 
+// MarshalJSON serializes a GetTeamEventsContinueArg instance
+func (u GetTeamEventsContinueArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetTeamEventsContinueArg instance
+func (u *GetTeamEventsContinueArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetTeamEventsContinueArg) Validate() error {
+	return nil
+}
+
 // GetTeamEventsContinueError : Errors that can be raised when calling
 // `getEventsContinue`.
 type GetTeamEventsContinueError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetTeamEventsContinueError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetTeamEventsContinueError
@@ -5275,9 +7736,53 @@ const (
 	GetTeamEventsContinueErrorOther     = "other"
 )
 
+// UnmarshalJSON deserializes into a GetTeamEventsContinueError instance
+func (u *GetTeamEventsContinueError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "bad_cursor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GetTeamEventsContinueError instance
+func (u GetTeamEventsContinueError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetTeamEventsContinueError reports whether err carries a GetTeamEventsContinueError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetTeamEventsContinueError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetTeamEventsContinueError)(nil), tags...)
+}
+
 // GetTeamEventsError : Errors that can be raised when calling `getEvents`.
 type GetTeamEventsError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetTeamEventsError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetTeamEventsError
@@ -5287,6 +7792,39 @@ const (
 	GetTeamEventsErrorOther             = "other"
 )
 
+// UnmarshalJSON deserializes into a GetTeamEventsError instance
+func (u *GetTeamEventsError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "account_id_not_found", "invalid_time_range", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GetTeamEventsError instance
+func (u GetTeamEventsError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetTeamEventsError reports whether err carries a GetTeamEventsError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetTeamEventsError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetTeamEventsError)(nil), tags...)
+}
+
 // GetTeamEventsResult : has no documentation (yet)
 type GetTeamEventsResult struct {
 	// Events : List of events.
@@ -5298,6 +7836,8 @@ type GetTeamEventsResult struct {
 	// returned yet. An additional call to `getEventsContinue` can retrieve
 	// them.
 	HasMore bool `json:"has_more"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetTeamEventsResult returns a new GetTeamEventsResult instance
@@ -5309,6 +7849,16 @@ func NewGetTeamEventsResult(Events []*TeamEvent, Cursor string, HasMore bool) *G
 	return s
 }
 
+// MarshalJSON serializes a GetTeamEventsResult instance
+func (u GetTeamEventsResult) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetTeamEventsResult instance
+func (u *GetTeamEventsResult) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GoogleSsoChangePolicyDetails : Enabled or disabled Google single sign-on for
 // the team.
 type GoogleSsoChangePolicyDetails struct {
@@ -5317,6 +7867,8 @@ type GoogleSsoChangePolicyDetails struct {
 	// PreviousValue : Previous Google single sign-on policy. Might be missing
 	// due to historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGoogleSsoChangePolicyDetails returns a new GoogleSsoChangePolicyDetails instance
@@ -5326,12 +7878,24 @@ func NewGoogleSsoChangePolicyDetails(NewValue *EnableDisableChangePolicy) *Googl
 	return s
 }
 
+// MarshalJSON serializes a GoogleSsoChangePolicyDetails instance
+func (u GoogleSsoChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GoogleSsoChangePolicyDetails instance
+func (u *GoogleSsoChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupAddExternalIdDetails : Added an external ID for group.
 type GroupAddExternalIdDetails struct {
 	// GroupInfo : Group details.
 	GroupInfo *GroupLogInfo `json:"group_info"`
 	// NewValue : Current external id.
 	NewValue string `json:"new_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupAddExternalIdDetails returns a new GroupAddExternalIdDetails instance
@@ -5342,12 +7906,24 @@ func NewGroupAddExternalIdDetails(GroupInfo *GroupLogInfo, NewValue string) *Gro
 	return s
 }
 
+// MarshalJSON serializes a GroupAddExternalIdDetails instance
+func (u GroupAddExternalIdDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupAddExternalIdDetails instance
+func (u *GroupAddExternalIdDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupAddMemberDetails : Added team members to a group.
 type GroupAddMemberDetails struct {
 	// GroupInfo : Group details.
 	GroupInfo *GroupLogInfo `json:"group_info"`
 	// IsGroupOwner : Is group owner.
 	IsGroupOwner bool `json:"is_group_owner"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupAddMemberDetails returns a new GroupAddMemberDetails instance
@@ -5358,6 +7934,16 @@ func NewGroupAddMemberDetails(GroupInfo *GroupLogInfo, IsGroupOwner bool) *Group
 	return s
 }
 
+// MarshalJSON serializes a GroupAddMemberDetails instance
+func (u GroupAddMemberDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupAddMemberDetails instance
+func (u *GroupAddMemberDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupChangeExternalIdDetails : Changed the external ID for group.
 type GroupChangeExternalIdDetails struct {
 	// GroupInfo : Group details.
@@ -5366,6 +7952,8 @@ type GroupChangeExternalIdDetails struct {
 	NewValue string `json:"new_value"`
 	// PreviousValue : Old external id.
 	PreviousValue string `json:"previous_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupChangeExternalIdDetails returns a new GroupChangeExternalIdDetails instance
@@ -5377,6 +7965,16 @@ func NewGroupChangeExternalIdDetails(GroupInfo *GroupLogInfo, NewValue string, P
 	return s
 }
 
+// MarshalJSON serializes a GroupChangeExternalIdDetails instance
+func (u GroupChangeExternalIdDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupChangeExternalIdDetails instance
+func (u *GroupChangeExternalIdDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupChangeManagementTypeDetails : Changed group management type.
 type GroupChangeManagementTypeDetails struct {
 	// GroupInfo : Group details.
@@ -5386,6 +7984,8 @@ type GroupChangeManagementTypeDetails struct {
 	// PreviousValue : Previous group management type. Might be missing due to
 	// historical data gap.
 	PreviousValue *GroupManagementType `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupChangeManagementTypeDetails returns a new GroupChangeManagementTypeDetails instance
@@ -5396,6 +7996,16 @@ func NewGroupChangeManagementTypeDetails(GroupInfo *GroupLogInfo, NewValue *Grou
 	return s
 }
 
+// MarshalJSON serializes a GroupChangeManagementTypeDetails instance
+func (u GroupChangeManagementTypeDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupChangeManagementTypeDetails instance
+func (u *GroupChangeManagementTypeDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupChangeMemberRoleDetails : Changed the manager permissions belonging to a
 // group member.
 type GroupChangeMemberRoleDetails struct {
@@ -5403,6 +8013,8 @@ type GroupChangeMemberRoleDetails struct {
 	GroupInfo *GroupLogInfo `json:"group_info"`
 	// IsGroupOwner : Is group owner.
 	IsGroupOwner bool `json:"is_group_owner"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupChangeMemberRoleDetails returns a new GroupChangeMemberRoleDetails instance
@@ -5413,6 +8025,16 @@ func NewGroupChangeMemberRoleDetails(GroupInfo *GroupLogInfo, IsGroupOwner bool)
 	return s
 }
 
+// MarshalJSON serializes a GroupChangeMemberRoleDetails instance
+func (u GroupChangeMemberRoleDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupChangeMemberRoleDetails instance
+func (u *GroupChangeMemberRoleDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupCreateDetails : Created a group.
 type GroupCreateDetails struct {
 	// GroupInfo : Group details.
@@ -5422,6 +8044,8 @@ type GroupCreateDetails struct {
 	IsAdminManaged bool `json:"is_admin_managed,omitempty"`
 	// JoinPolicy : Group join policy.
 	JoinPolicy *GroupJoinPolicy `json:"join_policy"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupCreateDetails returns a new GroupCreateDetails instance
@@ -5432,6 +8056,16 @@ func NewGroupCreateDetails(GroupInfo *GroupLogInfo, JoinPolicy *GroupJoinPolicy)
 	return s
 }
 
+// MarshalJSON serializes a GroupCreateDetails instance
+func (u GroupCreateDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupCreateDetails instance
+func (u *GroupCreateDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupDeleteDetails : Deleted a group.
 type GroupDeleteDetails struct {
 	// GroupInfo : Group details.
@@ -5439,6 +8073,8 @@ type GroupDeleteDetails struct {
 	// IsAdminManaged : Is admin managed group. Might be missing due to
 	// historical data gap.
 	IsAdminManaged bool `json:"is_admin_managed,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupDeleteDetails returns a new GroupDeleteDetails instance
@@ -5448,10 +8084,22 @@ func NewGroupDeleteDetails(GroupInfo *GroupLogInfo) *GroupDeleteDetails {
 	return s
 }
 
+// MarshalJSON serializes a GroupDeleteDetails instance
+func (u GroupDeleteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupDeleteDetails instance
+func (u *GroupDeleteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupDescriptionUpdatedDetails : Updated a group.
 type GroupDescriptionUpdatedDetails struct {
 	// GroupInfo : Group details.
 	GroupInfo *GroupLogInfo `json:"group_info"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupDescriptionUpdatedDetails returns a new GroupDescriptionUpdatedDetails instance
@@ -5461,9 +8109,30 @@ func NewGroupDescriptionUpdatedDetails(GroupInfo *GroupLogInfo) *GroupDescriptio
 	return s
 }
 
+// MarshalJSON serializes a GroupDescriptionUpdatedDetails instance
+func (u GroupDescriptionUpdatedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupDescriptionUpdatedDetails instance
+func (u *GroupDescriptionUpdatedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupJoinPolicy : has no documentation (yet)
 type GroupJoinPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupJoinPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupJoinPolicy
@@ -5473,6 +8142,32 @@ const (
 	GroupJoinPolicyOther         = "other"
 )
 
+// UnmarshalJSON deserializes into a GroupJoinPolicy instance
+func (u *GroupJoinPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "open", "request_to_join", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupJoinPolicy instance
+func (u GroupJoinPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // GroupJoinPolicyUpdatedDetails : Updated a group join policy.
 type GroupJoinPolicyUpdatedDetails struct {
 	// GroupInfo : Group details.
@@ -5482,6 +8177,8 @@ type GroupJoinPolicyUpdatedDetails struct {
 	IsAdminManaged bool `json:"is_admin_managed,omitempty"`
 	// JoinPolicy : Group join policy.
 	JoinPolicy *GroupJoinPolicy `json:"join_policy"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupJoinPolicyUpdatedDetails returns a new GroupJoinPolicyUpdatedDetails instance
@@ -5492,6 +8189,16 @@ func NewGroupJoinPolicyUpdatedDetails(GroupInfo *GroupLogInfo, JoinPolicy *Group
 	return s
 }
 
+// MarshalJSON serializes a GroupJoinPolicyUpdatedDetails instance
+func (u GroupJoinPolicyUpdatedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupJoinPolicyUpdatedDetails instance
+func (u *GroupJoinPolicyUpdatedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupLogInfo : Group's logged information.
 type GroupLogInfo struct {
 	// GroupId : The unique id of this group. Might be missing due to historical
@@ -5502,6 +8209,8 @@ type GroupLogInfo struct {
 	// ExternalId : External group ID. Might be missing due to historical data
 	// gap.
 	ExternalId string `json:"external_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupLogInfo returns a new GroupLogInfo instance
@@ -5511,9 +8220,30 @@ func NewGroupLogInfo(DisplayName string) *GroupLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a GroupLogInfo instance
+func (u GroupLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupLogInfo instance
+func (u *GroupLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupManagementType : has no documentation (yet)
 type GroupManagementType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupManagementType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupManagementType
@@ -5523,10 +8253,38 @@ const (
 	GroupManagementTypeOther                 = "other"
 )
 
+// UnmarshalJSON deserializes into a GroupManagementType instance
+func (u *GroupManagementType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "admin_management_group", "member_management_group", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupManagementType instance
+func (u GroupManagementType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // GroupMovedDetails : Moved a group.
 type GroupMovedDetails struct {
 	// GroupInfo : Group details.
 	GroupInfo *GroupLogInfo `json:"group_info"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupMovedDetails returns a new GroupMovedDetails instance
@@ -5536,12 +8294,24 @@ func NewGroupMovedDetails(GroupInfo *GroupLogInfo) *GroupMovedDetails {
 	return s
 }
 
+// MarshalJSON serializes a GroupMovedDetails instance
+func (u GroupMovedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupMovedDetails instance
+func (u *GroupMovedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupRemoveExternalIdDetails : Removed the external ID for group.
 type GroupRemoveExternalIdDetails struct {
 	// GroupInfo : Group details.
 	GroupInfo *GroupLogInfo `json:"group_info"`
 	// PreviousValue : Old external id.
 	PreviousValue string `json:"previous_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupRemoveExternalIdDetails returns a new GroupRemoveExternalIdDetails instance
@@ -5552,10 +8322,22 @@ func NewGroupRemoveExternalIdDetails(GroupInfo *GroupLogInfo, PreviousValue stri
 	return s
 }
 
+// MarshalJSON serializes a GroupRemoveExternalIdDetails instance
+func (u GroupRemoveExternalIdDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupRemoveExternalIdDetails instance
+func (u *GroupRemoveExternalIdDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupRemoveMemberDetails : Removed team members from a group.
 type GroupRemoveMemberDetails struct {
 	// GroupInfo : Group details.
 	GroupInfo *GroupLogInfo `json:"group_info"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupRemoveMemberDetails returns a new GroupRemoveMemberDetails instance
@@ -5565,12 +8347,24 @@ func NewGroupRemoveMemberDetails(GroupInfo *GroupLogInfo) *GroupRemoveMemberDeta
 	return s
 }
 
+// MarshalJSON serializes a GroupRemoveMemberDetails instance
+func (u GroupRemoveMemberDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupRemoveMemberDetails instance
+func (u *GroupRemoveMemberDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupRenameDetails : Renamed a group.
 type GroupRenameDetails struct {
 	// GroupInfo : Group details.
 	GroupInfo *GroupLogInfo `json:"group_info"`
 	// PreviousValue : Previous display name.
 	PreviousValue string `json:"previous_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupRenameDetails returns a new GroupRenameDetails instance
@@ -5581,6 +8375,16 @@ func NewGroupRenameDetails(GroupInfo *GroupLogInfo, PreviousValue string) *Group
 	return s
 }
 
+// MarshalJSON serializes a GroupRenameDetails instance
+func (u GroupRenameDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupRenameDetails instance
+func (u *GroupRenameDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupUserManagementChangePolicyDetails : Changed who can create groups.
 type GroupUserManagementChangePolicyDetails struct {
 	// NewValue : New group users management policy.
@@ -5588,6 +8392,8 @@ type GroupUserManagementChangePolicyDetails struct {
 	// PreviousValue : Previous group users management policy. Might be missing
 	// due to historical data gap.
 	PreviousValue *GroupsUserManagementPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGroupUserManagementChangePolicyDetails returns a new GroupUserManagementChangePolicyDetails instance
@@ -5597,9 +8403,30 @@ func NewGroupUserManagementChangePolicyDetails(NewValue *GroupsUserManagementPol
 	return s
 }
 
+// MarshalJSON serializes a GroupUserManagementChangePolicyDetails instance
+func (u GroupUserManagementChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GroupUserManagementChangePolicyDetails instance
+func (u *GroupUserManagementChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GroupsUserManagementPolicy : has no documentation (yet)
 type GroupsUserManagementPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GroupsUserManagementPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GroupsUserManagementPolicy
@@ -5609,12 +8436,40 @@ const (
 	GroupsUserManagementPolicyOther      = "other"
 )
 
+// UnmarshalJSON deserializes into a GroupsUserManagementPolicy instance
+func (u *GroupsUserManagementPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "all_users", "only_admins", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GroupsUserManagementPolicy instance
+func (u GroupsUserManagementPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // HostLogInfo : Host details.
 type HostLogInfo struct {
 	// HostId : Host ID. Might be missing due to historical data gap.
 	HostId uint64 `json:"host_id,omitempty"`
 	// HostName : Host name. Might be missing due to historical data gap.
 	HostName string `json:"host_name,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewHostLogInfo returns a new HostLogInfo instance
@@ -5623,9 +8478,30 @@ func NewHostLogInfo() *HostLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a HostLogInfo instance
+func (u HostLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a HostLogInfo instance
+func (u *HostLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // LinkAudience : has no documentation (yet)
 type LinkAudience struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u LinkAudience) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for LinkAudience
@@ -5636,8 +8512,36 @@ const (
 	LinkAudienceOther   = "other"
 )
 
+// UnmarshalJSON deserializes into a LinkAudience instance
+func (u *LinkAudience) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "public", "team", "members", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a LinkAudience instance
+func (u LinkAudience) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // LogoutDetails : Signed out.
 type LogoutDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewLogoutDetails returns a new LogoutDetails instance
@@ -5646,10 +8550,22 @@ func NewLogoutDetails() *LogoutDetails {
 	return s
 }
 
+// MarshalJSON serializes a LogoutDetails instance
+func (u LogoutDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a LogoutDetails instance
+func (u *LogoutDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberAddNameDetails : Set team member name when joining team.
 type MemberAddNameDetails struct {
 	// NewValue : User's name.
 	NewValue *UserNameLogInfo `json:"new_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberAddNameDetails returns a new MemberAddNameDetails instance
@@ -5659,6 +8575,16 @@ func NewMemberAddNameDetails(NewValue *UserNameLogInfo) *MemberAddNameDetails {
 	return s
 }
 
+// MarshalJSON serializes a MemberAddNameDetails instance
+func (u MemberAddNameDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberAddNameDetails instance
+func (u *MemberAddNameDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberChangeEmailDetails : Changed team member email address.
 type MemberChangeEmailDetails struct {
 	// NewValue : New email.
@@ -5666,6 +8592,8 @@ type MemberChangeEmailDetails struct {
 	// PreviousValue : Previous email. Might be missing due to historical data
 	// gap.
 	PreviousValue string `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberChangeEmailDetails returns a new MemberChangeEmailDetails instance
@@ -5675,6 +8603,16 @@ func NewMemberChangeEmailDetails(NewValue string) *MemberChangeEmailDetails {
 	return s
 }
 
+// MarshalJSON serializes a MemberChangeEmailDetails instance
+func (u MemberChangeEmailDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberChangeEmailDetails instance
+func (u *MemberChangeEmailDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberChangeMembershipTypeDetails : Changed the membership type (limited vs
 // full) for team member.
 type MemberChangeMembershipTypeDetails struct {
@@ -5682,6 +8620,8 @@ type MemberChangeMembershipTypeDetails struct {
 	PrevMembershipType int64 `json:"prev_membership_type"`
 	// NewMembershipType : New membership type.
 	NewMembershipType int64 `json:"new_membership_type"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberChangeMembershipTypeDetails returns a new MemberChangeMembershipTypeDetails instance
@@ -5692,12 +8632,24 @@ func NewMemberChangeMembershipTypeDetails(PrevMembershipType int64, NewMembershi
 	return s
 }
 
+// MarshalJSON serializes a MemberChangeMembershipTypeDetails instance
+func (u MemberChangeMembershipTypeDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberChangeMembershipTypeDetails instance
+func (u *MemberChangeMembershipTypeDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberChangeNameDetails : Changed team member name.
 type MemberChangeNameDetails struct {
 	// NewValue : New user's name.
 	NewValue *UserNameLogInfo `json:"new_value"`
 	// PreviousValue : Previous user's name.
 	PreviousValue *UserNameLogInfo `json:"previous_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberChangeNameDetails returns a new MemberChangeNameDetails instance
@@ -5708,6 +8660,16 @@ func NewMemberChangeNameDetails(NewValue *UserNameLogInfo, PreviousValue *UserNa
 	return s
 }
 
+// MarshalJSON serializes a MemberChangeNameDetails instance
+func (u MemberChangeNameDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberChangeNameDetails instance
+func (u *MemberChangeNameDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberChangeRoleDetails : Change the admin permissions belonging to team
 // member.
 type MemberChangeRoleDetails struct {
@@ -5716,6 +8678,8 @@ type MemberChangeRoleDetails struct {
 	// PreviousValue : Previous admin role. Might be missing due to historical
 	// data gap.
 	PreviousValue string `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberChangeRoleDetails returns a new MemberChangeRoleDetails instance
@@ -5724,8 +8688,20 @@ func NewMemberChangeRoleDetails() *MemberChangeRoleDetails {
 	return s
 }
 
+// MarshalJSON serializes a MemberChangeRoleDetails instance
+func (u MemberChangeRoleDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberChangeRoleDetails instance
+func (u *MemberChangeRoleDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberInviteDetails : Invited a user to join the team.
 type MemberInviteDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberInviteDetails returns a new MemberInviteDetails instance
@@ -5734,6 +8710,16 @@ func NewMemberInviteDetails() *MemberInviteDetails {
 	return s
 }
 
+// MarshalJSON serializes a MemberInviteDetails instance
+func (u MemberInviteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberInviteDetails instance
+func (u *MemberInviteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberJoinDetails : Joined the team.
 type MemberJoinDetails struct {
 	// LinkedApps : Linked Applications.
@@ -5758,6 +8744,8 @@ type MemberLeaveDetails struct {
 	// MemberWasOnTeam : True if the member had joined the team before leaving,
 	// False otherwise.
 	MemberWasOnTeam bool `json:"member_was_on_team"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberLeaveDetails returns a new MemberLeaveDetails instance
@@ -5767,9 +8755,21 @@ func NewMemberLeaveDetails(MemberWasOnTeam bool) *MemberLeaveDetails {
 	return s
 }
 
+// MarshalJSON serializes a MemberLeaveDetails instance
+func (u MemberLeaveDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberLeaveDetails instance
+func (u *MemberLeaveDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberPermanentlyDeleteAccountContentsDetails : Permanently deleted contents
 // of a removed team member account.
 type MemberPermanentlyDeleteAccountContentsDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberPermanentlyDeleteAccountContentsDetails returns a new MemberPermanentlyDeleteAccountContentsDetails instance
@@ -5778,8 +8778,20 @@ func NewMemberPermanentlyDeleteAccountContentsDetails() *MemberPermanentlyDelete
 	return s
 }
 
+// MarshalJSON serializes a MemberPermanentlyDeleteAccountContentsDetails instance
+func (u MemberPermanentlyDeleteAccountContentsDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberPermanentlyDeleteAccountContentsDetails instance
+func (u *MemberPermanentlyDeleteAccountContentsDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberRecoverDetails : Recovered a removed member.
 type MemberRecoverDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberRecoverDetails returns a new MemberRecoverDetails instance
@@ -5788,9 +8800,30 @@ func NewMemberRecoverDetails() *MemberRecoverDetails {
 	return s
 }
 
+// MarshalJSON serializes a MemberRecoverDetails instance
+func (u MemberRecoverDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberRecoverDetails instance
+func (u *MemberRecoverDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberRequestsChangePolicy : has no documentation (yet)
 type MemberRequestsChangePolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u MemberRequestsChangePolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for MemberRequestsChangePolicy
@@ -5801,6 +8834,32 @@ const (
 	MemberRequestsChangePolicyOther           = "other"
 )
 
+// UnmarshalJSON deserializes into a MemberRequestsChangePolicy instance
+func (u *MemberRequestsChangePolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "disabled", "require_approval", "auto_approval", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a MemberRequestsChangePolicy instance
+func (u MemberRequestsChangePolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // MemberRequestsChangePolicyDetails : Changed whether users can find the team
 // when not invited.
 type MemberRequestsChangePolicyDetails struct {
@@ -5809,6 +8868,8 @@ type MemberRequestsChangePolicyDetails struct {
 	// PreviousValue : Previous member change requests policy. Might be missing
 	// due to historical data gap.
 	PreviousValue *MemberRequestsChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberRequestsChangePolicyDetails returns a new MemberRequestsChangePolicyDetails instance
@@ -5818,9 +8879,21 @@ func NewMemberRequestsChangePolicyDetails(NewValue *MemberRequestsChangePolicy)
 	return s
 }
 
+// MarshalJSON serializes a MemberRequestsChangePolicyDetails instance
+func (u MemberRequestsChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberRequestsChangePolicyDetails instance
+func (u *MemberRequestsChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberSpaceLimitsAddExceptionDetails : Added an exception for one or more
 // team members to bypass space limits imposed by policy.
 type MemberSpaceLimitsAddExceptionDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberSpaceLimitsAddExceptionDetails returns a new MemberSpaceLimitsAddExceptionDetails instance
@@ -5829,6 +8902,16 @@ func NewMemberSpaceLimitsAddExceptionDetails() *MemberSpaceLimitsAddExceptionDet
 	return s
 }
 
+// MarshalJSON serializes a MemberSpaceLimitsAddExceptionDetails instance
+func (u MemberSpaceLimitsAddExceptionDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberSpaceLimitsAddExceptionDetails instance
+func (u *MemberSpaceLimitsAddExceptionDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberSpaceLimitsChangePolicyDetails : Changed the storage limits applied to
 // team members by policy.
 type MemberSpaceLimitsChangePolicyDetails struct {
@@ -5836,6 +8919,8 @@ type MemberSpaceLimitsChangePolicyDetails struct {
 	PreviousValue *SpaceLimitsLevel `json:"previous_value"`
 	// NewValue : New storage limits policy.
 	NewValue *SpaceLimitsLevel `json:"new_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberSpaceLimitsChangePolicyDetails returns a new MemberSpaceLimitsChangePolicyDetails instance
@@ -5846,6 +8931,16 @@ func NewMemberSpaceLimitsChangePolicyDetails(PreviousValue *SpaceLimitsLevel, Ne
 	return s
 }
 
+// MarshalJSON serializes a MemberSpaceLimitsChangePolicyDetails instance
+func (u MemberSpaceLimitsChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberSpaceLimitsChangePolicyDetails instance
+func (u *MemberSpaceLimitsChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberSpaceLimitsChangeStatusDetails : Changed the status with respect to
 // whether the team member is under or over storage quota specified by policy.
 type MemberSpaceLimitsChangeStatusDetails struct {
@@ -5853,6 +8948,8 @@ type MemberSpaceLimitsChangeStatusDetails struct {
 	PreviousStatus *SpaceLimitsStatus `json:"previous_status"`
 	// NewStatus : New storage quota status.
 	NewStatus *SpaceLimitsStatus `json:"new_status"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberSpaceLimitsChangeStatusDetails returns a new MemberSpaceLimitsChangeStatusDetails instance
@@ -5863,9 +8960,21 @@ func NewMemberSpaceLimitsChangeStatusDetails(PreviousStatus *SpaceLimitsStatus,
 	return s
 }
 
+// MarshalJSON serializes a MemberSpaceLimitsChangeStatusDetails instance
+func (u MemberSpaceLimitsChangeStatusDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberSpaceLimitsChangeStatusDetails instance
+func (u *MemberSpaceLimitsChangeStatusDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberSpaceLimitsRemoveExceptionDetails : Removed an exception for one or
 // more team members to bypass space limits imposed by policy.
 type MemberSpaceLimitsRemoveExceptionDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberSpaceLimitsRemoveExceptionDetails returns a new MemberSpaceLimitsRemoveExceptionDetails instance
@@ -5874,8 +8983,20 @@ func NewMemberSpaceLimitsRemoveExceptionDetails() *MemberSpaceLimitsRemoveExcept
 	return s
 }
 
+// MarshalJSON serializes a MemberSpaceLimitsRemoveExceptionDetails instance
+func (u MemberSpaceLimitsRemoveExceptionDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberSpaceLimitsRemoveExceptionDetails instance
+func (u *MemberSpaceLimitsRemoveExceptionDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberSuggestDetails : Suggested a new team member to be added to the team.
 type MemberSuggestDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberSuggestDetails returns a new MemberSuggestDetails instance
@@ -5884,6 +9005,16 @@ func NewMemberSuggestDetails() *MemberSuggestDetails {
 	return s
 }
 
+// MarshalJSON serializes a MemberSuggestDetails instance
+func (u MemberSuggestDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberSuggestDetails instance
+func (u *MemberSuggestDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberSuggestionsChangePolicyDetails : Enabled or disabled the option for
 // team members to suggest new members to add to the team.
 type MemberSuggestionsChangePolicyDetails struct {
@@ -5892,6 +9023,8 @@ type MemberSuggestionsChangePolicyDetails struct {
 	// PreviousValue : Previous team member suggestions policy. Might be missing
 	// due to historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberSuggestionsChangePolicyDetails returns a new MemberSuggestionsChangePolicyDetails instance
@@ -5901,8 +9034,20 @@ func NewMemberSuggestionsChangePolicyDetails(NewValue *EnableDisableChangePolicy
 	return s
 }
 
+// MarshalJSON serializes a MemberSuggestionsChangePolicyDetails instance
+func (u MemberSuggestionsChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberSuggestionsChangePolicyDetails instance
+func (u *MemberSuggestionsChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberSuspendDetails : Suspended a team member.
 type MemberSuspendDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberSuspendDetails returns a new MemberSuspendDetails instance
@@ -5911,6 +9056,16 @@ func NewMemberSuspendDetails() *MemberSuspendDetails {
 	return s
 }
 
+// MarshalJSON serializes a MemberSuspendDetails instance
+func (u MemberSuspendDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberSuspendDetails instance
+func (u *MemberSuspendDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberTransferAccountContentsDetails : Transferred contents of a removed team
 // member account to another member.
 type MemberTransferAccountContentsDetails struct {
@@ -5918,6 +9073,8 @@ type MemberTransferAccountContentsDetails struct {
 	SrcIndex int64 `json:"src_index"`
 	// DestIndex : Destination asset index.
 	DestIndex int64 `json:"dest_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberTransferAccountContentsDetails returns a new MemberTransferAccountContentsDetails instance
@@ -5928,8 +9085,20 @@ func NewMemberTransferAccountContentsDetails(SrcIndex int64, DestIndex int64) *M
 	return s
 }
 
+// MarshalJSON serializes a MemberTransferAccountContentsDetails instance
+func (u MemberTransferAccountContentsDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberTransferAccountContentsDetails instance
+func (u *MemberTransferAccountContentsDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MemberUnsuspendDetails : Unsuspended a team member.
 type MemberUnsuspendDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMemberUnsuspendDetails returns a new MemberUnsuspendDetails instance
@@ -5938,6 +9107,16 @@ func NewMemberUnsuspendDetails() *MemberUnsuspendDetails {
 	return s
 }
 
+// MarshalJSON serializes a MemberUnsuspendDetails instance
+func (u MemberUnsuspendDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MemberUnsuspendDetails instance
+func (u *MemberUnsuspendDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MicrosoftOfficeAddinChangePolicyDetails : Enabled or disabled the Microsoft
 // Office add-in, which lets team members save files to Dropbox directly from
 // Microsoft Office.
@@ -5947,6 +9126,8 @@ type MicrosoftOfficeAddinChangePolicyDetails struct {
 	// PreviousValue : Previous Microsoft Office addin policy. Might be missing
 	// due to historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMicrosoftOfficeAddinChangePolicyDetails returns a new MicrosoftOfficeAddinChangePolicyDetails instance
@@ -5956,9 +9137,21 @@ func NewMicrosoftOfficeAddinChangePolicyDetails(NewValue *EnableDisableChangePol
 	return s
 }
 
+// MarshalJSON serializes a MicrosoftOfficeAddinChangePolicyDetails instance
+func (u MicrosoftOfficeAddinChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MicrosoftOfficeAddinChangePolicyDetails instance
+func (u *MicrosoftOfficeAddinChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MissingDetails : An indication that an event was returned with missing
 // details
 type MissingDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMissingDetails returns a new MissingDetails instance
@@ -5967,9 +9160,21 @@ func NewMissingDetails() *MissingDetails {
 	return s
 }
 
+// MarshalJSON serializes a MissingDetails instance
+func (u MissingDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MissingDetails instance
+func (u *MissingDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // MobileSessionLogInfo : Mobile session.
 type MobileSessionLogInfo struct {
 	SessionLogInfo
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewMobileSessionLogInfo returns a new MobileSessionLogInfo instance
@@ -5978,6 +9183,16 @@ func NewMobileSessionLogInfo() *MobileSessionLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a MobileSessionLogInfo instance, tagged as a `SessionLogInfo`
+func (u MobileSessionLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("mobile", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a MobileSessionLogInfo instance
+func (u *MobileSessionLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // NamespaceRelativePathLogInfo : Namespace relative path details.
 type NamespaceRelativePathLogInfo struct {
 	// NsId : Namespace ID. Might be missing due to historical data gap.
@@ -5985,6 +9200,8 @@ type NamespaceRelativePathLogInfo struct {
 	// RelativePath : A path relative to the specified namespace ID. Might be
 	// missing due to historical data gap.
 	RelativePath string `json:"relative_path,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewNamespaceRelativePathLogInfo returns a new NamespaceRelativePathLogInfo instance
@@ -5993,6 +9210,16 @@ func NewNamespaceRelativePathLogInfo() *NamespaceRelativePathLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a NamespaceRelativePathLogInfo instance
+func (u NamespaceRelativePathLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a NamespaceRelativePathLogInfo instance
+func (u *NamespaceRelativePathLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // NetworkControlChangePolicyDetails : Enabled or disabled network control.
 type NetworkControlChangePolicyDetails struct {
 	// NewValue : New network control policy.
@@ -6000,6 +9227,8 @@ type NetworkControlChangePolicyDetails struct {
 	// PreviousValue : Previous network control policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewNetworkControlChangePolicyDetails returns a new NetworkControlChangePolicyDetails instance
@@ -6009,6 +9238,16 @@ func NewNetworkControlChangePolicyDetails(NewValue *EnableDisableChangePolicy) *
 	return s
 }
 
+// MarshalJSON serializes a NetworkControlChangePolicyDetails instance
+func (u NetworkControlChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a NetworkControlChangePolicyDetails instance
+func (u *NetworkControlChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UserLogInfo : User's logged information.
 type UserLogInfo struct {
 	// AccountId : User unique ID. Might be missing due to historical data gap.
@@ -6018,6 +9257,8 @@ type UserLogInfo struct {
 	DisplayName string `json:"display_name,omitempty"`
 	// Email : User email address. Might be missing due to historical data gap.
 	Email string `json:"email,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUserLogInfo returns a new UserLogInfo instance
@@ -6026,6 +9267,16 @@ func NewUserLogInfo() *UserLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a UserLogInfo instance
+func (u UserLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UserLogInfo instance
+func (u *UserLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // IsUserLogInfo is the interface type for UserLogInfo and its subtypes
 type IsUserLogInfo interface {
 	IsUserLogInfo()
@@ -6040,6 +9291,17 @@ type userLogInfoUnion struct {
 	TeamMember *TeamMemberLogInfo `json:"team_member,omitempty"`
 	// NonTeamMember : has no documentation (yet)
 	NonTeamMember *NonTeamMemberLogInfo `json:"non_team_member,omitempty"`
+	raw           string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u userLogInfoUnion) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for UserLogInfo
@@ -6062,7 +9324,7 @@ func (u *userLogInfoUnion) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "team_member":
 		err = json.Unmarshal(body, &u.TeamMember)
@@ -6076,6 +9338,10 @@ func (u *userLogInfoUnion) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
@@ -6100,6 +9366,8 @@ func IsUserLogInfoFromJSON(data []byte) (IsUserLogInfo, error) {
 // NonTeamMemberLogInfo : Non team member's logged information.
 type NonTeamMemberLogInfo struct {
 	UserLogInfo
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewNonTeamMemberLogInfo returns a new NonTeamMemberLogInfo instance
@@ -6108,8 +9376,20 @@ func NewNonTeamMemberLogInfo() *NonTeamMemberLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a NonTeamMemberLogInfo instance, tagged as a `UserLogInfo`
+func (u NonTeamMemberLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("non_team_member", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a NonTeamMemberLogInfo instance
+func (u *NonTeamMemberLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // NoteAclInviteOnlyDetails : Changed a Paper document to be invite-only.
 type NoteAclInviteOnlyDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewNoteAclInviteOnlyDetails returns a new NoteAclInviteOnlyDetails instance
@@ -6118,8 +9398,20 @@ func NewNoteAclInviteOnlyDetails() *NoteAclInviteOnlyDetails {
 	return s
 }
 
+// MarshalJSON serializes a NoteAclInviteOnlyDetails instance
+func (u NoteAclInviteOnlyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a NoteAclInviteOnlyDetails instance
+func (u *NoteAclInviteOnlyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // NoteAclLinkDetails : Changed a Paper document to be link accessible.
 type NoteAclLinkDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewNoteAclLinkDetails returns a new NoteAclLinkDetails instance
@@ -6128,9 +9420,21 @@ func NewNoteAclLinkDetails() *NoteAclLinkDetails {
 	return s
 }
 
+// MarshalJSON serializes a NoteAclLinkDetails instance
+func (u NoteAclLinkDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a NoteAclLinkDetails instance
+func (u *NoteAclLinkDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // NoteAclTeamLinkDetails : Changed a Paper document to be link accessible for
 // the team.
 type NoteAclTeamLinkDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewNoteAclTeamLinkDetails returns a new NoteAclTeamLinkDetails instance
@@ -6139,8 +9443,20 @@ func NewNoteAclTeamLinkDetails() *NoteAclTeamLinkDetails {
 	return s
 }
 
+// MarshalJSON serializes a NoteAclTeamLinkDetails instance
+func (u NoteAclTeamLinkDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a NoteAclTeamLinkDetails instance
+func (u *NoteAclTeamLinkDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // NoteShareReceiveDetails : Shared Paper document received.
 type NoteShareReceiveDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewNoteShareReceiveDetails returns a new NoteShareReceiveDetails instance
@@ -6149,8 +9465,20 @@ func NewNoteShareReceiveDetails() *NoteShareReceiveDetails {
 	return s
 }
 
+// MarshalJSON serializes a NoteShareReceiveDetails instance
+func (u NoteShareReceiveDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a NoteShareReceiveDetails instance
+func (u *NoteShareReceiveDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // NoteSharedDetails : Shared a Paper doc.
 type NoteSharedDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewNoteSharedDetails returns a new NoteSharedDetails instance
@@ -6159,8 +9487,20 @@ func NewNoteSharedDetails() *NoteSharedDetails {
 	return s
 }
 
+// MarshalJSON serializes a NoteSharedDetails instance
+func (u NoteSharedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a NoteSharedDetails instance
+func (u *NoteSharedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // OpenNoteSharedDetails : Opened a shared Paper doc.
 type OpenNoteSharedDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewOpenNoteSharedDetails returns a new OpenNoteSharedDetails instance
@@ -6169,9 +9509,30 @@ func NewOpenNoteSharedDetails() *OpenNoteSharedDetails {
 	return s
 }
 
+// MarshalJSON serializes a OpenNoteSharedDetails instance
+func (u OpenNoteSharedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a OpenNoteSharedDetails instance
+func (u *OpenNoteSharedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // OptionalChangePolicy : has no documentation (yet)
 type OptionalChangePolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u OptionalChangePolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for OptionalChangePolicy
@@ -6182,6 +9543,32 @@ const (
 	OptionalChangePolicyOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a OptionalChangePolicy instance
+func (u *OptionalChangePolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "disabled", "optional", "required", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a OptionalChangePolicy instance
+func (u OptionalChangePolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // OriginLogInfo : The origin from which the actor performed the action.
 type OriginLogInfo struct {
 	// GeoLocation : Geographic location details.
@@ -6190,6 +9577,8 @@ type OriginLogInfo struct {
 	Host *HostLogInfo `json:"host,omitempty"`
 	// AccessMethod : The method that was used to perform the action.
 	AccessMethod *AccessMethodLogInfo `json:"access_method"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewOriginLogInfo returns a new OriginLogInfo instance
@@ -6199,9 +9588,30 @@ func NewOriginLogInfo(AccessMethod *AccessMethodLogInfo) *OriginLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a OriginLogInfo instance
+func (u OriginLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a OriginLogInfo instance
+func (u *OriginLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperAccessType : has no documentation (yet)
 type PaperAccessType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PaperAccessType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PaperAccessType
@@ -6212,6 +9622,32 @@ const (
 	PaperAccessTypeOther     = "other"
 )
 
+// UnmarshalJSON deserializes into a PaperAccessType instance
+func (u *PaperAccessType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "viewer", "commenter", "editor", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PaperAccessType instance
+func (u PaperAccessType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PaperChangeDeploymentPolicyDetails : Changed whether Dropbox Paper, when
 // enabled, is deployed to all teams or to specific members of the team.
 type PaperChangeDeploymentPolicyDetails struct {
@@ -6220,6 +9656,8 @@ type PaperChangeDeploymentPolicyDetails struct {
 	// PreviousValue : Previous Dropbox Paper deployment policy. Might be
 	// missing due to historical data gap.
 	PreviousValue *PaperDeploymentPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperChangeDeploymentPolicyDetails returns a new PaperChangeDeploymentPolicyDetails instance
@@ -6229,6 +9667,16 @@ func NewPaperChangeDeploymentPolicyDetails(NewValue *PaperDeploymentPolicy) *Pap
 	return s
 }
 
+// MarshalJSON serializes a PaperChangeDeploymentPolicyDetails instance
+func (u PaperChangeDeploymentPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperChangeDeploymentPolicyDetails instance
+func (u *PaperChangeDeploymentPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperChangeMemberPolicyDetails : Changed whether team members can share Paper
 // documents externally (i.e. outside the team), and if so, whether they should
 // be accessible only by team members or anyone by default.
@@ -6238,6 +9686,8 @@ type PaperChangeMemberPolicyDetails struct {
 	// PreviousValue : Previous paper external accessibility policy. Might be
 	// missing due to historical data gap.
 	PreviousValue *ExternalSharingAccessibilityPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperChangeMemberPolicyDetails returns a new PaperChangeMemberPolicyDetails instance
@@ -6247,6 +9697,16 @@ func NewPaperChangeMemberPolicyDetails(NewValue *ExternalSharingAccessibilityPol
 	return s
 }
 
+// MarshalJSON serializes a PaperChangeMemberPolicyDetails instance
+func (u PaperChangeMemberPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperChangeMemberPolicyDetails instance
+func (u *PaperChangeMemberPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperChangePolicyDetails : Enabled or disabled Dropbox Paper for the team.
 type PaperChangePolicyDetails struct {
 	// NewValue : New Dropbox Paper policy.
@@ -6254,6 +9714,8 @@ type PaperChangePolicyDetails struct {
 	// PreviousValue : Previous Dropbox Paper policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperChangePolicyDetails returns a new PaperChangePolicyDetails instance
@@ -6263,11 +9725,23 @@ func NewPaperChangePolicyDetails(NewValue *EnableDisableChangePolicy) *PaperChan
 	return s
 }
 
+// MarshalJSON serializes a PaperChangePolicyDetails instance
+func (u PaperChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperChangePolicyDetails instance
+func (u *PaperChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentAddMemberDetails : Added users to the membership of a Paper doc
 // or folder.
 type PaperContentAddMemberDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentAddMemberDetails returns a new PaperContentAddMemberDetails instance
@@ -6277,6 +9751,16 @@ func NewPaperContentAddMemberDetails(EventUuid string) *PaperContentAddMemberDet
 	return s
 }
 
+// MarshalJSON serializes a PaperContentAddMemberDetails instance
+func (u PaperContentAddMemberDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentAddMemberDetails instance
+func (u *PaperContentAddMemberDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentAddToFolderDetails : Added Paper doc or folder to a folder.
 type PaperContentAddToFolderDetails struct {
 	// EventUuid : Event unique identifier.
@@ -6285,6 +9769,8 @@ type PaperContentAddToFolderDetails struct {
 	TargetIndex int64 `json:"target_index"`
 	// ParentIndex : Parent asset index.
 	ParentIndex int64 `json:"parent_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentAddToFolderDetails returns a new PaperContentAddToFolderDetails instance
@@ -6296,10 +9782,22 @@ func NewPaperContentAddToFolderDetails(EventUuid string, TargetIndex int64, Pare
 	return s
 }
 
+// MarshalJSON serializes a PaperContentAddToFolderDetails instance
+func (u PaperContentAddToFolderDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentAddToFolderDetails instance
+func (u *PaperContentAddToFolderDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentArchiveDetails : Archived Paper doc or folder.
 type PaperContentArchiveDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentArchiveDetails returns a new PaperContentArchiveDetails instance
@@ -6309,6 +9807,16 @@ func NewPaperContentArchiveDetails(EventUuid string) *PaperContentArchiveDetails
 	return s
 }
 
+// MarshalJSON serializes a PaperContentArchiveDetails instance
+func (u PaperContentArchiveDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentArchiveDetails instance
+func (u *PaperContentArchiveDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentChangeSubscriptionDetails : Followed or unfollowed a Paper doc or
 // folder.
 type PaperContentChangeSubscriptionDetails struct {
@@ -6319,6 +9827,8 @@ type PaperContentChangeSubscriptionDetails struct {
 	// PreviousSubscriptionLevel : Previous subscription level. Might be missing
 	// due to historical data gap.
 	PreviousSubscriptionLevel *PaperTaggedValue `json:"previous_subscription_level,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentChangeSubscriptionDetails returns a new PaperContentChangeSubscriptionDetails instance
@@ -6329,10 +9839,22 @@ func NewPaperContentChangeSubscriptionDetails(EventUuid string, NewSubscriptionL
 	return s
 }
 
+// MarshalJSON serializes a PaperContentChangeSubscriptionDetails instance
+func (u PaperContentChangeSubscriptionDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentChangeSubscriptionDetails instance
+func (u *PaperContentChangeSubscriptionDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentCreateDetails : Created a Paper doc or folder.
 type PaperContentCreateDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentCreateDetails returns a new PaperContentCreateDetails instance
@@ -6342,11 +9864,23 @@ func NewPaperContentCreateDetails(EventUuid string) *PaperContentCreateDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperContentCreateDetails instance
+func (u PaperContentCreateDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentCreateDetails instance
+func (u *PaperContentCreateDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentPermanentlyDeleteDetails : Permanently deleted a Paper doc or
 // folder.
 type PaperContentPermanentlyDeleteDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentPermanentlyDeleteDetails returns a new PaperContentPermanentlyDeleteDetails instance
@@ -6356,11 +9890,23 @@ func NewPaperContentPermanentlyDeleteDetails(EventUuid string) *PaperContentPerm
 	return s
 }
 
+// MarshalJSON serializes a PaperContentPermanentlyDeleteDetails instance
+func (u PaperContentPermanentlyDeleteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentPermanentlyDeleteDetails instance
+func (u *PaperContentPermanentlyDeleteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentRemoveFromFolderDetails : Removed Paper doc or folder from a
 // folder.
 type PaperContentRemoveFromFolderDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentRemoveFromFolderDetails returns a new PaperContentRemoveFromFolderDetails instance
@@ -6370,11 +9916,23 @@ func NewPaperContentRemoveFromFolderDetails(EventUuid string) *PaperContentRemov
 	return s
 }
 
+// MarshalJSON serializes a PaperContentRemoveFromFolderDetails instance
+func (u PaperContentRemoveFromFolderDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentRemoveFromFolderDetails instance
+func (u *PaperContentRemoveFromFolderDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentRemoveMemberDetails : Removed a user from the membership of a
 // Paper doc or folder.
 type PaperContentRemoveMemberDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentRemoveMemberDetails returns a new PaperContentRemoveMemberDetails instance
@@ -6384,10 +9942,22 @@ func NewPaperContentRemoveMemberDetails(EventUuid string) *PaperContentRemoveMem
 	return s
 }
 
+// MarshalJSON serializes a PaperContentRemoveMemberDetails instance
+func (u PaperContentRemoveMemberDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentRemoveMemberDetails instance
+func (u *PaperContentRemoveMemberDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentRenameDetails : Renamed Paper doc or folder.
 type PaperContentRenameDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentRenameDetails returns a new PaperContentRenameDetails instance
@@ -6397,10 +9967,22 @@ func NewPaperContentRenameDetails(EventUuid string) *PaperContentRenameDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperContentRenameDetails instance
+func (u PaperContentRenameDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentRenameDetails instance
+func (u *PaperContentRenameDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperContentRestoreDetails : Restored an archived Paper doc or folder.
 type PaperContentRestoreDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperContentRestoreDetails returns a new PaperContentRestoreDetails instance
@@ -6410,9 +9992,30 @@ func NewPaperContentRestoreDetails(EventUuid string) *PaperContentRestoreDetails
 	return s
 }
 
+// MarshalJSON serializes a PaperContentRestoreDetails instance
+func (u PaperContentRestoreDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperContentRestoreDetails instance
+func (u *PaperContentRestoreDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDeploymentPolicy : has no documentation (yet)
 type PaperDeploymentPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PaperDeploymentPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PaperDeploymentPolicy
@@ -6422,12 +10025,40 @@ const (
 	PaperDeploymentPolicyOther   = "other"
 )
 
+// UnmarshalJSON deserializes into a PaperDeploymentPolicy instance
+func (u *PaperDeploymentPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "partial", "full", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PaperDeploymentPolicy instance
+func (u PaperDeploymentPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PaperDocAddCommentDetails : Added a Paper doc comment.
 type PaperDocAddCommentDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
 	// CommentText : Comment text. Might be missing due to historical data gap.
 	CommentText string `json:"comment_text,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocAddCommentDetails returns a new PaperDocAddCommentDetails instance
@@ -6437,6 +10068,16 @@ func NewPaperDocAddCommentDetails(EventUuid string) *PaperDocAddCommentDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperDocAddCommentDetails instance
+func (u PaperDocAddCommentDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocAddCommentDetails instance
+func (u *PaperDocAddCommentDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocChangeMemberRoleDetails : Changed the access type of a Paper doc
 // member.
 type PaperDocChangeMemberRoleDetails struct {
@@ -6444,6 +10085,8 @@ type PaperDocChangeMemberRoleDetails struct {
 	EventUuid string `json:"event_uuid"`
 	// AccessType : Paper doc access type.
 	AccessType *PaperAccessType `json:"access_type"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocChangeMemberRoleDetails returns a new PaperDocChangeMemberRoleDetails instance
@@ -6454,6 +10097,16 @@ func NewPaperDocChangeMemberRoleDetails(EventUuid string, AccessType *PaperAcces
 	return s
 }
 
+// MarshalJSON serializes a PaperDocChangeMemberRoleDetails instance
+func (u PaperDocChangeMemberRoleDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocChangeMemberRoleDetails instance
+func (u *PaperDocChangeMemberRoleDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocChangeSharingPolicyDetails : Changed the sharing policy for Paper
 // doc.
 type PaperDocChangeSharingPolicyDetails struct {
@@ -6465,6 +10118,8 @@ type PaperDocChangeSharingPolicyDetails struct {
 	// TeamSharingPolicy : Sharing policy with team. Might be missing due to
 	// historical data gap.
 	TeamSharingPolicy string `json:"team_sharing_policy,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocChangeSharingPolicyDetails returns a new PaperDocChangeSharingPolicyDetails instance
@@ -6474,12 +10129,24 @@ func NewPaperDocChangeSharingPolicyDetails(EventUuid string) *PaperDocChangeShar
 	return s
 }
 
+// MarshalJSON serializes a PaperDocChangeSharingPolicyDetails instance
+func (u PaperDocChangeSharingPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocChangeSharingPolicyDetails instance
+func (u *PaperDocChangeSharingPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocDeleteCommentDetails : Deleted a Paper doc comment.
 type PaperDocDeleteCommentDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
 	// CommentText : Comment text. Might be missing due to historical data gap.
 	CommentText string `json:"comment_text,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocDeleteCommentDetails returns a new PaperDocDeleteCommentDetails instance
@@ -6489,10 +10156,22 @@ func NewPaperDocDeleteCommentDetails(EventUuid string) *PaperDocDeleteCommentDet
 	return s
 }
 
+// MarshalJSON serializes a PaperDocDeleteCommentDetails instance
+func (u PaperDocDeleteCommentDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocDeleteCommentDetails instance
+func (u *PaperDocDeleteCommentDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocDeletedDetails : Paper doc archived.
 type PaperDocDeletedDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocDeletedDetails returns a new PaperDocDeletedDetails instance
@@ -6502,6 +10181,16 @@ func NewPaperDocDeletedDetails(EventUuid string) *PaperDocDeletedDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperDocDeletedDetails instance
+func (u PaperDocDeletedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocDeletedDetails instance
+func (u *PaperDocDeletedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocDownloadDetails : Downloaded a Paper doc in a particular output
 // format.
 type PaperDocDownloadDetails struct {
@@ -6509,6 +10198,8 @@ type PaperDocDownloadDetails struct {
 	EventUuid string `json:"event_uuid"`
 	// ExportFileFormat : Export file format.
 	ExportFileFormat *PaperDownloadFormat `json:"export_file_format"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocDownloadDetails returns a new PaperDocDownloadDetails instance
@@ -6519,12 +10210,24 @@ func NewPaperDocDownloadDetails(EventUuid string, ExportFileFormat *PaperDownloa
 	return s
 }
 
+// MarshalJSON serializes a PaperDocDownloadDetails instance
+func (u PaperDocDownloadDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocDownloadDetails instance
+func (u *PaperDocDownloadDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocEditCommentDetails : Edited a Paper doc comment.
 type PaperDocEditCommentDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
 	// CommentText : Comment text. Might be missing due to historical data gap.
 	CommentText string `json:"comment_text,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocEditCommentDetails returns a new PaperDocEditCommentDetails instance
@@ -6534,10 +10237,22 @@ func NewPaperDocEditCommentDetails(EventUuid string) *PaperDocEditCommentDetails
 	return s
 }
 
+// MarshalJSON serializes a PaperDocEditCommentDetails instance
+func (u PaperDocEditCommentDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocEditCommentDetails instance
+func (u *PaperDocEditCommentDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocEditDetails : Edited a Paper doc.
 type PaperDocEditDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocEditDetails returns a new PaperDocEditDetails instance
@@ -6547,10 +10262,22 @@ func NewPaperDocEditDetails(EventUuid string) *PaperDocEditDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperDocEditDetails instance
+func (u PaperDocEditDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocEditDetails instance
+func (u *PaperDocEditDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocFollowedDetails : Followed a Paper doc.
 type PaperDocFollowedDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocFollowedDetails returns a new PaperDocFollowedDetails instance
@@ -6560,10 +10287,22 @@ func NewPaperDocFollowedDetails(EventUuid string) *PaperDocFollowedDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperDocFollowedDetails instance
+func (u PaperDocFollowedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocFollowedDetails instance
+func (u *PaperDocFollowedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocMentionDetails : Mentioned a member in a Paper doc.
 type PaperDocMentionDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocMentionDetails returns a new PaperDocMentionDetails instance
@@ -6573,10 +10312,22 @@ func NewPaperDocMentionDetails(EventUuid string) *PaperDocMentionDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperDocMentionDetails instance
+func (u PaperDocMentionDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocMentionDetails instance
+func (u *PaperDocMentionDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocRequestAccessDetails : Requested to be a member on a Paper doc.
 type PaperDocRequestAccessDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocRequestAccessDetails returns a new PaperDocRequestAccessDetails instance
@@ -6586,12 +10337,24 @@ func NewPaperDocRequestAccessDetails(EventUuid string) *PaperDocRequestAccessDet
 	return s
 }
 
+// MarshalJSON serializes a PaperDocRequestAccessDetails instance
+func (u PaperDocRequestAccessDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocRequestAccessDetails instance
+func (u *PaperDocRequestAccessDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocResolveCommentDetails : Paper doc comment resolved.
 type PaperDocResolveCommentDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
 	// CommentText : Comment text. Might be missing due to historical data gap.
 	CommentText string `json:"comment_text,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocResolveCommentDetails returns a new PaperDocResolveCommentDetails instance
@@ -6601,10 +10364,22 @@ func NewPaperDocResolveCommentDetails(EventUuid string) *PaperDocResolveCommentD
 	return s
 }
 
+// MarshalJSON serializes a PaperDocResolveCommentDetails instance
+func (u PaperDocResolveCommentDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocResolveCommentDetails instance
+func (u *PaperDocResolveCommentDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocRevertDetails : Restored a Paper doc to previous revision.
 type PaperDocRevertDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocRevertDetails returns a new PaperDocRevertDetails instance
@@ -6614,10 +10389,22 @@ func NewPaperDocRevertDetails(EventUuid string) *PaperDocRevertDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperDocRevertDetails instance
+func (u PaperDocRevertDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocRevertDetails instance
+func (u *PaperDocRevertDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocSlackShareDetails : Paper doc link shared via slack.
 type PaperDocSlackShareDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocSlackShareDetails returns a new PaperDocSlackShareDetails instance
@@ -6627,10 +10414,22 @@ func NewPaperDocSlackShareDetails(EventUuid string) *PaperDocSlackShareDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperDocSlackShareDetails instance
+func (u PaperDocSlackShareDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocSlackShareDetails instance
+func (u *PaperDocSlackShareDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocTeamInviteDetails : Paper doc shared with team member.
 type PaperDocTeamInviteDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocTeamInviteDetails returns a new PaperDocTeamInviteDetails instance
@@ -6640,12 +10439,24 @@ func NewPaperDocTeamInviteDetails(EventUuid string) *PaperDocTeamInviteDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperDocTeamInviteDetails instance
+func (u PaperDocTeamInviteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocTeamInviteDetails instance
+func (u *PaperDocTeamInviteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocUnresolveCommentDetails : Unresolved a Paper doc comment.
 type PaperDocUnresolveCommentDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
 	// CommentText : Comment text. Might be missing due to historical data gap.
 	CommentText string `json:"comment_text,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocUnresolveCommentDetails returns a new PaperDocUnresolveCommentDetails instance
@@ -6655,10 +10466,22 @@ func NewPaperDocUnresolveCommentDetails(EventUuid string) *PaperDocUnresolveComm
 	return s
 }
 
+// MarshalJSON serializes a PaperDocUnresolveCommentDetails instance
+func (u PaperDocUnresolveCommentDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocUnresolveCommentDetails instance
+func (u *PaperDocUnresolveCommentDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocViewDetails : Viewed Paper doc.
 type PaperDocViewDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocViewDetails returns a new PaperDocViewDetails instance
@@ -6668,12 +10491,24 @@ func NewPaperDocViewDetails(EventUuid string) *PaperDocViewDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperDocViewDetails instance
+func (u PaperDocViewDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocViewDetails instance
+func (u *PaperDocViewDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDocumentLogInfo : Paper document's logged information.
 type PaperDocumentLogInfo struct {
 	// DocId : Papers document Id.
 	DocId string `json:"doc_id"`
 	// DocTitle : Paper document title.
 	DocTitle string `json:"doc_title"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperDocumentLogInfo returns a new PaperDocumentLogInfo instance
@@ -6684,9 +10519,30 @@ func NewPaperDocumentLogInfo(DocId string, DocTitle string) *PaperDocumentLogInf
 	return s
 }
 
+// MarshalJSON serializes a PaperDocumentLogInfo instance
+func (u PaperDocumentLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperDocumentLogInfo instance
+func (u *PaperDocumentLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperDownloadFormat : has no documentation (yet)
 type PaperDownloadFormat struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PaperDownloadFormat) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PaperDownloadFormat
@@ -6697,9 +10553,37 @@ const (
 	PaperDownloadFormatOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a PaperDownloadFormat instance
+func (u *PaperDownloadFormat) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "docx", "html", "markdown", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PaperDownloadFormat instance
+func (u PaperDownloadFormat) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PaperEnabledUsersGroupAdditionDetails : Users added to Paper enabled users
 // list.
 type PaperEnabledUsersGroupAdditionDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperEnabledUsersGroupAdditionDetails returns a new PaperEnabledUsersGroupAdditionDetails instance
@@ -6708,9 +10592,21 @@ func NewPaperEnabledUsersGroupAdditionDetails() *PaperEnabledUsersGroupAdditionD
 	return s
 }
 
+// MarshalJSON serializes a PaperEnabledUsersGroupAdditionDetails instance
+func (u PaperEnabledUsersGroupAdditionDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperEnabledUsersGroupAdditionDetails instance
+func (u *PaperEnabledUsersGroupAdditionDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperEnabledUsersGroupRemovalDetails : Users removed from Paper enabled users
 // list.
 type PaperEnabledUsersGroupRemovalDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperEnabledUsersGroupRemovalDetails returns a new PaperEnabledUsersGroupRemovalDetails instance
@@ -6719,9 +10615,21 @@ func NewPaperEnabledUsersGroupRemovalDetails() *PaperEnabledUsersGroupRemovalDet
 	return s
 }
 
+// MarshalJSON serializes a PaperEnabledUsersGroupRemovalDetails instance
+func (u PaperEnabledUsersGroupRemovalDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperEnabledUsersGroupRemovalDetails instance
+func (u *PaperEnabledUsersGroupRemovalDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperExternalViewAllowDetails : Paper external sharing policy changed:
 // anyone.
 type PaperExternalViewAllowDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperExternalViewAllowDetails returns a new PaperExternalViewAllowDetails instance
@@ -6730,9 +10638,21 @@ func NewPaperExternalViewAllowDetails() *PaperExternalViewAllowDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperExternalViewAllowDetails instance
+func (u PaperExternalViewAllowDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperExternalViewAllowDetails instance
+func (u *PaperExternalViewAllowDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperExternalViewDefaultTeamDetails : Paper external sharing policy changed:
 // default team.
 type PaperExternalViewDefaultTeamDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperExternalViewDefaultTeamDetails returns a new PaperExternalViewDefaultTeamDetails instance
@@ -6741,9 +10661,21 @@ func NewPaperExternalViewDefaultTeamDetails() *PaperExternalViewDefaultTeamDetai
 	return s
 }
 
+// MarshalJSON serializes a PaperExternalViewDefaultTeamDetails instance
+func (u PaperExternalViewDefaultTeamDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperExternalViewDefaultTeamDetails instance
+func (u *PaperExternalViewDefaultTeamDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperExternalViewForbidDetails : Paper external sharing policy changed:
 // team-only.
 type PaperExternalViewForbidDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperExternalViewForbidDetails returns a new PaperExternalViewForbidDetails instance
@@ -6752,10 +10684,22 @@ func NewPaperExternalViewForbidDetails() *PaperExternalViewForbidDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperExternalViewForbidDetails instance
+func (u PaperExternalViewForbidDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperExternalViewForbidDetails instance
+func (u *PaperExternalViewForbidDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperFolderDeletedDetails : Paper folder archived.
 type PaperFolderDeletedDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperFolderDeletedDetails returns a new PaperFolderDeletedDetails instance
@@ -6765,10 +10709,22 @@ func NewPaperFolderDeletedDetails(EventUuid string) *PaperFolderDeletedDetails {
 	return s
 }
 
+// MarshalJSON serializes a PaperFolderDeletedDetails instance
+func (u PaperFolderDeletedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperFolderDeletedDetails instance
+func (u *PaperFolderDeletedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperFolderFollowedDetails : Followed a Paper folder.
 type PaperFolderFollowedDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperFolderFollowedDetails returns a new PaperFolderFollowedDetails instance
@@ -6778,12 +10734,24 @@ func NewPaperFolderFollowedDetails(EventUuid string) *PaperFolderFollowedDetails
 	return s
 }
 
+// MarshalJSON serializes a PaperFolderFollowedDetails instance
+func (u PaperFolderFollowedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperFolderFollowedDetails instance
+func (u *PaperFolderFollowedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperFolderLogInfo : Paper folder's logged information.
 type PaperFolderLogInfo struct {
 	// FolderId : Papers folder Id.
 	FolderId string `json:"folder_id"`
 	// FolderName : Paper folder name.
 	FolderName string `json:"folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperFolderLogInfo returns a new PaperFolderLogInfo instance
@@ -6794,10 +10762,22 @@ func NewPaperFolderLogInfo(FolderId string, FolderName string) *PaperFolderLogIn
 	return s
 }
 
+// MarshalJSON serializes a PaperFolderLogInfo instance
+func (u PaperFolderLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperFolderLogInfo instance
+func (u *PaperFolderLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperFolderTeamInviteDetails : Paper folder shared with team member.
 type PaperFolderTeamInviteDetails struct {
 	// EventUuid : Event unique identifier.
 	EventUuid string `json:"event_uuid"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperFolderTeamInviteDetails returns a new PaperFolderTeamInviteDetails instance
@@ -6807,10 +10787,22 @@ func NewPaperFolderTeamInviteDetails(EventUuid string) *PaperFolderTeamInviteDet
 	return s
 }
 
+// MarshalJSON serializes a PaperFolderTeamInviteDetails instance
+func (u PaperFolderTeamInviteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperFolderTeamInviteDetails instance
+func (u *PaperFolderTeamInviteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PaperTaggedValue : Paper tagged value.
 type PaperTaggedValue struct {
 	// Tag : Tag.
 	Tag string `json:"tag"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPaperTaggedValue returns a new PaperTaggedValue instance
@@ -6820,6 +10812,16 @@ func NewPaperTaggedValue(Tag string) *PaperTaggedValue {
 	return s
 }
 
+// MarshalJSON serializes a PaperTaggedValue instance
+func (u PaperTaggedValue) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PaperTaggedValue instance
+func (u *PaperTaggedValue) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ParticipantLogInfo : A user or group
 type ParticipantLogInfo struct {
 	dropbox.Tagged
@@ -6827,6 +10829,17 @@ type ParticipantLogInfo struct {
 	User IsUserLogInfo `json:"user,omitempty"`
 	// Group : Group details.
 	Group *GroupLogInfo `json:"group,omitempty"`
+	raw   string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u ParticipantLogInfo) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for ParticipantLogInfo
@@ -6850,10 +10863,10 @@ func (u *ParticipantLogInfo) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "user":
-		u.User, err = IsUserLogInfoFromJSON(body)
+		u.User, err = IsUserLogInfoFromJSON(w.User)
 
 		if err != nil {
 			return err
@@ -6864,12 +10877,35 @@ func (u *ParticipantLogInfo) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a ParticipantLogInfo instance
+func (u ParticipantLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			User IsUserLogInfo `json:"user"`
+		}{u.Tagged, u.User})
+
+	case "group":
+		return dropbox.MarshalTagged(u.Tag, u.Group)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // PasswordChangeDetails : Changed password.
 type PasswordChangeDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPasswordChangeDetails returns a new PasswordChangeDetails instance
@@ -6878,11 +10914,23 @@ func NewPasswordChangeDetails() *PasswordChangeDetails {
 	return s
 }
 
+// MarshalJSON serializes a PasswordChangeDetails instance
+func (u PasswordChangeDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PasswordChangeDetails instance
+func (u *PasswordChangeDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PasswordLoginFailDetails : Failed to sign in using a password.
 type PasswordLoginFailDetails struct {
 	// FailureReason : Login failure reason. Might be missing due to historical
 	// data gap.
 	FailureReason string `json:"failure_reason,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPasswordLoginFailDetails returns a new PasswordLoginFailDetails instance
@@ -6891,10 +10939,22 @@ func NewPasswordLoginFailDetails() *PasswordLoginFailDetails {
 	return s
 }
 
+// MarshalJSON serializes a PasswordLoginFailDetails instance
+func (u PasswordLoginFailDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PasswordLoginFailDetails instance
+func (u *PasswordLoginFailDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PasswordLoginSuccessDetails : Signed in using a password.
 type PasswordLoginSuccessDetails struct {
 	// IsEmmManaged : Tells if the user signed in from an EMM managed device.
 	IsEmmManaged bool `json:"is_emm_managed"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPasswordLoginSuccessDetails returns a new PasswordLoginSuccessDetails instance
@@ -6904,8 +10964,20 @@ func NewPasswordLoginSuccessDetails(IsEmmManaged bool) *PasswordLoginSuccessDeta
 	return s
 }
 
+// MarshalJSON serializes a PasswordLoginSuccessDetails instance
+func (u PasswordLoginSuccessDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PasswordLoginSuccessDetails instance
+func (u *PasswordLoginSuccessDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PasswordResetAllDetails : Reset all team member passwords.
 type PasswordResetAllDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPasswordResetAllDetails returns a new PasswordResetAllDetails instance
@@ -6914,8 +10986,20 @@ func NewPasswordResetAllDetails() *PasswordResetAllDetails {
 	return s
 }
 
+// MarshalJSON serializes a PasswordResetAllDetails instance
+func (u PasswordResetAllDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PasswordResetAllDetails instance
+func (u *PasswordResetAllDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PasswordResetDetails : Reset password.
 type PasswordResetDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPasswordResetDetails returns a new PasswordResetDetails instance
@@ -6924,6 +11008,16 @@ func NewPasswordResetDetails() *PasswordResetDetails {
 	return s
 }
 
+// MarshalJSON serializes a PasswordResetDetails instance
+func (u PasswordResetDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PasswordResetDetails instance
+func (u *PasswordResetDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PathLogInfo : Path's details.
 type PathLogInfo struct {
 	// Contextual : Fully qualified path relative to event's context. Might be
@@ -6932,6 +11026,8 @@ type PathLogInfo struct {
 	// NamespaceRelative : Path relative to the namespace containing the
 	// content.
 	NamespaceRelative *NamespaceRelativePathLogInfo `json:"namespace_relative"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPathLogInfo returns a new PathLogInfo instance
@@ -6941,6 +11037,16 @@ func NewPathLogInfo(NamespaceRelative *NamespaceRelativePathLogInfo) *PathLogInf
 	return s
 }
 
+// MarshalJSON serializes a PathLogInfo instance
+func (u PathLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PathLogInfo instance
+func (u *PathLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PermanentDeleteChangePolicyDetails : Enabled or disabled the ability of team
 // members to permanently delete content.
 type PermanentDeleteChangePolicyDetails struct {
@@ -6949,6 +11055,8 @@ type PermanentDeleteChangePolicyDetails struct {
 	// PreviousValue : Previous permanent delete content policy. Might be
 	// missing due to historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewPermanentDeleteChangePolicyDetails returns a new PermanentDeleteChangePolicyDetails instance
@@ -6958,9 +11066,30 @@ func NewPermanentDeleteChangePolicyDetails(NewValue *EnableDisableChangePolicy)
 	return s
 }
 
+// MarshalJSON serializes a PermanentDeleteChangePolicyDetails instance
+func (u PermanentDeleteChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a PermanentDeleteChangePolicyDetails instance
+func (u *PermanentDeleteChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // PlacementRestriction : has no documentation (yet)
 type PlacementRestriction struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u PlacementRestriction) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for PlacementRestriction
@@ -6970,6 +11099,32 @@ const (
 	PlacementRestrictionOther      = "other"
 )
 
+// UnmarshalJSON deserializes into a PlacementRestriction instance
+func (u *PlacementRestriction) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "europe_only", "none", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a PlacementRestriction instance
+func (u PlacementRestriction) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // RemoveLogoutUrlDetails : Removed single sign-on logout URL.
 type RemoveLogoutUrlDetails struct {
 	// PreviousSsoLogoutUrl : Previous single sign-on logout URL.
@@ -6977,6 +11132,8 @@ type RemoveLogoutUrlDetails struct {
 	// NewSsoLogoutUrl : New single sign-on logout URL. Might be missing due to
 	// historical data gap.
 	NewSsoLogoutUrl string `json:"new_sso_logout_url,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRemoveLogoutUrlDetails returns a new RemoveLogoutUrlDetails instance
@@ -6986,10 +11143,22 @@ func NewRemoveLogoutUrlDetails(PreviousSsoLogoutUrl string) *RemoveLogoutUrlDeta
 	return s
 }
 
+// MarshalJSON serializes a RemoveLogoutUrlDetails instance
+func (u RemoveLogoutUrlDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RemoveLogoutUrlDetails instance
+func (u *RemoveLogoutUrlDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // RemoveSsoUrlDetails : Changed the sign-out URL for SSO.
 type RemoveSsoUrlDetails struct {
 	// PreviousSsoUrl : Previous SSO Url.
 	PreviousSsoUrl string `json:"previous_sso_url"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewRemoveSsoUrlDetails returns a new RemoveSsoUrlDetails instance
@@ -6999,12 +11168,24 @@ func NewRemoveSsoUrlDetails(PreviousSsoUrl string) *RemoveSsoUrlDetails {
 	return s
 }
 
+// MarshalJSON serializes a RemoveSsoUrlDetails instance
+func (u RemoveSsoUrlDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a RemoveSsoUrlDetails instance
+func (u *RemoveSsoUrlDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ResellerLogInfo : Reseller information.
 type ResellerLogInfo struct {
 	// ResellerName : Reseller name.
 	ResellerName string `json:"reseller_name"`
 	// ResellerId : Reseller ID.
 	ResellerId string `json:"reseller_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewResellerLogInfo returns a new ResellerLogInfo instance
@@ -7015,8 +11196,20 @@ func NewResellerLogInfo(ResellerName string, ResellerId string) *ResellerLogInfo
 	return s
 }
 
+// MarshalJSON serializes a ResellerLogInfo instance
+func (u ResellerLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ResellerLogInfo instance
+func (u *ResellerLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ResellerSupportSessionEndDetails : Ended reseller support session.
 type ResellerSupportSessionEndDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewResellerSupportSessionEndDetails returns a new ResellerSupportSessionEndDetails instance
@@ -7025,8 +11218,20 @@ func NewResellerSupportSessionEndDetails() *ResellerSupportSessionEndDetails {
 	return s
 }
 
+// MarshalJSON serializes a ResellerSupportSessionEndDetails instance
+func (u ResellerSupportSessionEndDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ResellerSupportSessionEndDetails instance
+func (u *ResellerSupportSessionEndDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ResellerSupportSessionStartDetails : Started reseller support session.
 type ResellerSupportSessionStartDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewResellerSupportSessionStartDetails returns a new ResellerSupportSessionStartDetails instance
@@ -7035,9 +11240,30 @@ func NewResellerSupportSessionStartDetails() *ResellerSupportSessionStartDetails
 	return s
 }
 
+// MarshalJSON serializes a ResellerSupportSessionStartDetails instance
+func (u ResellerSupportSessionStartDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ResellerSupportSessionStartDetails instance
+func (u *ResellerSupportSessionStartDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SessionsManagementIdleLengthPolicy : has no documentation (yet)
 type SessionsManagementIdleLengthPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SessionsManagementIdleLengthPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SessionsManagementIdleLengthPolicy
@@ -7052,9 +11278,46 @@ const (
 	SessionsManagementIdleLengthPolicyOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a SessionsManagementIdleLengthPolicy instance
+func (u *SessionsManagementIdleLengthPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "none", "minute_10", "minute_30", "hour_1", "hour_8", "hour_24", "hour_48", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SessionsManagementIdleLengthPolicy instance
+func (u SessionsManagementIdleLengthPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SessionsManagementSessionLengthPolicy : has no documentation (yet)
 type SessionsManagementSessionLengthPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SessionsManagementSessionLengthPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SessionsManagementSessionLengthPolicy
@@ -7069,6 +11332,32 @@ const (
 	SessionsManagementSessionLengthPolicyOther  = "other"
 )
 
+// UnmarshalJSON deserializes into a SessionsManagementSessionLengthPolicy instance
+func (u *SessionsManagementSessionLengthPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "day_1", "day_7", "day_14", "month_1", "month_3", "month_6", "year_1", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SessionsManagementSessionLengthPolicy instance
+func (u SessionsManagementSessionLengthPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SfAddGroupDetails : Added the team to a shared folder.
 type SfAddGroupDetails struct {
 	// TargetIndex : Target asset index.
@@ -7080,6 +11369,8 @@ type SfAddGroupDetails struct {
 	SharingPermission string `json:"sharing_permission,omitempty"`
 	// TeamName : Team name.
 	TeamName string `json:"team_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfAddGroupDetails returns a new SfAddGroupDetails instance
@@ -7091,6 +11382,16 @@ func NewSfAddGroupDetails(TargetIndex int64, OriginalFolderName string, TeamName
 	return s
 }
 
+// MarshalJSON serializes a SfAddGroupDetails instance
+func (u SfAddGroupDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfAddGroupDetails instance
+func (u *SfAddGroupDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfAllowNonMembersToViewSharedLinksDetails : Allowed non collaborators to view
 // links to files in a shared folder.
 type SfAllowNonMembersToViewSharedLinksDetails struct {
@@ -7101,6 +11402,8 @@ type SfAllowNonMembersToViewSharedLinksDetails struct {
 	// SharedFolderType : Shared folder type. Might be missing due to historical
 	// data gap.
 	SharedFolderType string `json:"shared_folder_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfAllowNonMembersToViewSharedLinksDetails returns a new SfAllowNonMembersToViewSharedLinksDetails instance
@@ -7111,9 +11414,21 @@ func NewSfAllowNonMembersToViewSharedLinksDetails(TargetIndex int64, OriginalFol
 	return s
 }
 
+// MarshalJSON serializes a SfAllowNonMembersToViewSharedLinksDetails instance
+func (u SfAllowNonMembersToViewSharedLinksDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfAllowNonMembersToViewSharedLinksDetails instance
+func (u *SfAllowNonMembersToViewSharedLinksDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfExternalInviteWarnDetails : Admin settings: team members see a warning
 // before sharing folders outside the team (DEPRECATED FEATURE).
 type SfExternalInviteWarnDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfExternalInviteWarnDetails returns a new SfExternalInviteWarnDetails instance
@@ -7122,10 +11437,22 @@ func NewSfExternalInviteWarnDetails() *SfExternalInviteWarnDetails {
 	return s
 }
 
+// MarshalJSON serializes a SfExternalInviteWarnDetails instance
+func (u SfExternalInviteWarnDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfExternalInviteWarnDetails instance
+func (u *SfExternalInviteWarnDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfInviteGroupDetails : Invited a group to a shared folder.
 type SfInviteGroupDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfInviteGroupDetails returns a new SfInviteGroupDetails instance
@@ -7135,6 +11462,16 @@ func NewSfInviteGroupDetails(TargetIndex int64) *SfInviteGroupDetails {
 	return s
 }
 
+// MarshalJSON serializes a SfInviteGroupDetails instance
+func (u SfInviteGroupDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfInviteGroupDetails instance
+func (u *SfInviteGroupDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfNestDetails : Changed parent of shared folder.
 type SfNestDetails struct {
 	// TargetIndex : Target asset index.
@@ -7147,6 +11484,8 @@ type SfNestDetails struct {
 	// NewParentNsId : New parent namespace ID. Might be missing due to
 	// historical data gap.
 	NewParentNsId string `json:"new_parent_ns_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfNestDetails returns a new SfNestDetails instance
@@ -7157,6 +11496,16 @@ func NewSfNestDetails(TargetIndex int64, OriginalFolderName string) *SfNestDetai
 	return s
 }
 
+// MarshalJSON serializes a SfNestDetails instance
+func (u SfNestDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfNestDetails instance
+func (u *SfNestDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfTeamDeclineDetails : Declined a team member's invitation to a shared
 // folder.
 type SfTeamDeclineDetails struct {
@@ -7164,6 +11513,8 @@ type SfTeamDeclineDetails struct {
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfTeamDeclineDetails returns a new SfTeamDeclineDetails instance
@@ -7174,12 +11525,24 @@ func NewSfTeamDeclineDetails(TargetIndex int64, OriginalFolderName string) *SfTe
 	return s
 }
 
+// MarshalJSON serializes a SfTeamDeclineDetails instance
+func (u SfTeamDeclineDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfTeamDeclineDetails instance
+func (u *SfTeamDeclineDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfTeamGrantAccessDetails : Granted access to a shared folder.
 type SfTeamGrantAccessDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfTeamGrantAccessDetails returns a new SfTeamGrantAccessDetails instance
@@ -7190,6 +11553,16 @@ func NewSfTeamGrantAccessDetails(TargetIndex int64, OriginalFolderName string) *
 	return s
 }
 
+// MarshalJSON serializes a SfTeamGrantAccessDetails instance
+func (u SfTeamGrantAccessDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfTeamGrantAccessDetails instance
+func (u *SfTeamGrantAccessDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfTeamInviteChangeRoleDetails : Changed a team member's role in a shared
 // folder.
 type SfTeamInviteChangeRoleDetails struct {
@@ -7203,6 +11576,8 @@ type SfTeamInviteChangeRoleDetails struct {
 	// PreviousSharingPermission : Previous sharing permission. Might be missing
 	// due to historical data gap.
 	PreviousSharingPermission string `json:"previous_sharing_permission,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfTeamInviteChangeRoleDetails returns a new SfTeamInviteChangeRoleDetails instance
@@ -7213,6 +11588,16 @@ func NewSfTeamInviteChangeRoleDetails(TargetIndex int64, OriginalFolderName stri
 	return s
 }
 
+// MarshalJSON serializes a SfTeamInviteChangeRoleDetails instance
+func (u SfTeamInviteChangeRoleDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfTeamInviteChangeRoleDetails instance
+func (u *SfTeamInviteChangeRoleDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfTeamInviteDetails : Invited team members to a shared folder.
 type SfTeamInviteDetails struct {
 	// TargetIndex : Target asset index.
@@ -7222,6 +11607,8 @@ type SfTeamInviteDetails struct {
 	// SharingPermission : Sharing permission. Might be missing due to
 	// historical data gap.
 	SharingPermission string `json:"sharing_permission,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfTeamInviteDetails returns a new SfTeamInviteDetails instance
@@ -7232,12 +11619,24 @@ func NewSfTeamInviteDetails(TargetIndex int64, OriginalFolderName string) *SfTea
 	return s
 }
 
+// MarshalJSON serializes a SfTeamInviteDetails instance
+func (u SfTeamInviteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfTeamInviteDetails instance
+func (u *SfTeamInviteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfTeamJoinDetails : Joined a team member's shared folder.
 type SfTeamJoinDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfTeamJoinDetails returns a new SfTeamJoinDetails instance
@@ -7248,6 +11647,16 @@ func NewSfTeamJoinDetails(TargetIndex int64, OriginalFolderName string) *SfTeamJ
 	return s
 }
 
+// MarshalJSON serializes a SfTeamJoinDetails instance
+func (u SfTeamJoinDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfTeamJoinDetails instance
+func (u *SfTeamJoinDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfTeamJoinFromOobLinkDetails : Joined a team member's shared folder from a
 // link.
 type SfTeamJoinFromOobLinkDetails struct {
@@ -7260,6 +11669,8 @@ type SfTeamJoinFromOobLinkDetails struct {
 	// SharingPermission : Sharing permission. Might be missing due to
 	// historical data gap.
 	SharingPermission string `json:"sharing_permission,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfTeamJoinFromOobLinkDetails returns a new SfTeamJoinFromOobLinkDetails instance
@@ -7270,12 +11681,24 @@ func NewSfTeamJoinFromOobLinkDetails(TargetIndex int64, OriginalFolderName strin
 	return s
 }
 
+// MarshalJSON serializes a SfTeamJoinFromOobLinkDetails instance
+func (u SfTeamJoinFromOobLinkDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfTeamJoinFromOobLinkDetails instance
+func (u *SfTeamJoinFromOobLinkDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SfTeamUninviteDetails : Unshared a folder with a team member.
 type SfTeamUninviteDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSfTeamUninviteDetails returns a new SfTeamUninviteDetails instance
@@ -7286,6 +11709,16 @@ func NewSfTeamUninviteDetails(TargetIndex int64, OriginalFolderName string) *SfT
 	return s
 }
 
+// MarshalJSON serializes a SfTeamUninviteDetails instance
+func (u SfTeamUninviteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SfTeamUninviteDetails instance
+func (u *SfTeamUninviteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentAddInviteesDetails : Sent an email invitation to the membership
 // of a shared file or folder.
 type SharedContentAddInviteesDetails struct {
@@ -7296,6 +11729,8 @@ type SharedContentAddInviteesDetails struct {
 	// SharingPermission : Sharing permission. Might be missing due to
 	// historical data gap.
 	SharingPermission string `json:"sharing_permission,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentAddInviteesDetails returns a new SharedContentAddInviteesDetails instance
@@ -7305,6 +11740,16 @@ func NewSharedContentAddInviteesDetails(TargetIndex int64) *SharedContentAddInvi
 	return s
 }
 
+// MarshalJSON serializes a SharedContentAddInviteesDetails instance
+func (u SharedContentAddInviteesDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentAddInviteesDetails instance
+func (u *SharedContentAddInviteesDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentAddLinkExpiryDetails : Added an expiry to the link for the
 // shared file or folder.
 type SharedContentAddLinkExpiryDetails struct {
@@ -7320,6 +11765,8 @@ type SharedContentAddLinkExpiryDetails struct {
 	// ExpirationDays : The number of days from the starting expiration date
 	// after which the link will expire.
 	ExpirationDays int64 `json:"expiration_days"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentAddLinkExpiryDetails returns a new SharedContentAddLinkExpiryDetails instance
@@ -7331,6 +11778,16 @@ func NewSharedContentAddLinkExpiryDetails(TargetIndex int64, ExpirationStartDate
 	return s
 }
 
+// MarshalJSON serializes a SharedContentAddLinkExpiryDetails instance
+func (u SharedContentAddLinkExpiryDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentAddLinkExpiryDetails instance
+func (u *SharedContentAddLinkExpiryDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentAddLinkPasswordDetails : Added a password to the link for the
 // shared file or folder.
 type SharedContentAddLinkPasswordDetails struct {
@@ -7341,6 +11798,8 @@ type SharedContentAddLinkPasswordDetails struct {
 	// SharedFolderType : Shared folder type. Might be missing due to historical
 	// data gap.
 	SharedFolderType string `json:"shared_folder_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentAddLinkPasswordDetails returns a new SharedContentAddLinkPasswordDetails instance
@@ -7350,6 +11809,16 @@ func NewSharedContentAddLinkPasswordDetails(TargetIndex int64) *SharedContentAdd
 	return s
 }
 
+// MarshalJSON serializes a SharedContentAddLinkPasswordDetails instance
+func (u SharedContentAddLinkPasswordDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentAddLinkPasswordDetails instance
+func (u *SharedContentAddLinkPasswordDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentAddMemberDetails : Added users and/or groups to the membership
 // of a shared file or folder.
 type SharedContentAddMemberDetails struct {
@@ -7363,6 +11832,8 @@ type SharedContentAddMemberDetails struct {
 	// SharedFolderType : Shared folder type. Might be missing due to historical
 	// data gap.
 	SharedFolderType string `json:"shared_folder_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentAddMemberDetails returns a new SharedContentAddMemberDetails instance
@@ -7372,6 +11843,16 @@ func NewSharedContentAddMemberDetails(TargetIndex int64) *SharedContentAddMember
 	return s
 }
 
+// MarshalJSON serializes a SharedContentAddMemberDetails instance
+func (u SharedContentAddMemberDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentAddMemberDetails instance
+func (u *SharedContentAddMemberDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentChangeDownloadsPolicyDetails : Changed whether members can
 // download the shared file or folder.
 type SharedContentChangeDownloadsPolicyDetails struct {
@@ -7387,6 +11868,8 @@ type SharedContentChangeDownloadsPolicyDetails struct {
 	// PreviousValue : Previous downlaod policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentChangeDownloadsPolicyDetails returns a new SharedContentChangeDownloadsPolicyDetails instance
@@ -7397,6 +11880,16 @@ func NewSharedContentChangeDownloadsPolicyDetails(TargetIndex int64, NewValue *E
 	return s
 }
 
+// MarshalJSON serializes a SharedContentChangeDownloadsPolicyDetails instance
+func (u SharedContentChangeDownloadsPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentChangeDownloadsPolicyDetails instance
+func (u *SharedContentChangeDownloadsPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentChangeInviteeRoleDetails : Changed the access type of an invitee
 // to a shared file or folder before the invitation was claimed.
 type SharedContentChangeInviteeRoleDetails struct {
@@ -7410,6 +11903,8 @@ type SharedContentChangeInviteeRoleDetails struct {
 	// SharingPermission : Sharing permission. Might be missing due to
 	// historical data gap.
 	SharingPermission string `json:"sharing_permission,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentChangeInviteeRoleDetails returns a new SharedContentChangeInviteeRoleDetails instance
@@ -7420,6 +11915,16 @@ func NewSharedContentChangeInviteeRoleDetails(TargetIndex int64, OriginalFolderN
 	return s
 }
 
+// MarshalJSON serializes a SharedContentChangeInviteeRoleDetails instance
+func (u SharedContentChangeInviteeRoleDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentChangeInviteeRoleDetails instance
+func (u *SharedContentChangeInviteeRoleDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentChangeLinkAudienceDetails : Changed the audience of the link for
 // a shared file or folder.
 type SharedContentChangeLinkAudienceDetails struct {
@@ -7435,6 +11940,8 @@ type SharedContentChangeLinkAudienceDetails struct {
 	// PreviousValue : Previous link audience value. Might be missing due to
 	// historical data gap.
 	PreviousValue *LinkAudience `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentChangeLinkAudienceDetails returns a new SharedContentChangeLinkAudienceDetails instance
@@ -7445,6 +11952,16 @@ func NewSharedContentChangeLinkAudienceDetails(TargetIndex int64, NewValue *Link
 	return s
 }
 
+// MarshalJSON serializes a SharedContentChangeLinkAudienceDetails instance
+func (u SharedContentChangeLinkAudienceDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentChangeLinkAudienceDetails instance
+func (u *SharedContentChangeLinkAudienceDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentChangeLinkExpiryDetails : Changed the expiry of the link for the
 // shared file or folder.
 type SharedContentChangeLinkExpiryDetails struct {
@@ -7460,6 +11977,8 @@ type SharedContentChangeLinkExpiryDetails struct {
 	// ExpirationDays : The number of days from the starting expiration date
 	// after which the link will expire.
 	ExpirationDays int64 `json:"expiration_days"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentChangeLinkExpiryDetails returns a new SharedContentChangeLinkExpiryDetails instance
@@ -7471,6 +11990,16 @@ func NewSharedContentChangeLinkExpiryDetails(TargetIndex int64, ExpirationStartD
 	return s
 }
 
+// MarshalJSON serializes a SharedContentChangeLinkExpiryDetails instance
+func (u SharedContentChangeLinkExpiryDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentChangeLinkExpiryDetails instance
+func (u *SharedContentChangeLinkExpiryDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentChangeLinkPasswordDetails : Changed the password on the link for
 // the shared file or folder.
 type SharedContentChangeLinkPasswordDetails struct {
@@ -7481,6 +12010,8 @@ type SharedContentChangeLinkPasswordDetails struct {
 	// SharedFolderType : Shared folder type. Might be missing due to historical
 	// data gap.
 	SharedFolderType string `json:"shared_folder_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentChangeLinkPasswordDetails returns a new SharedContentChangeLinkPasswordDetails instance
@@ -7490,6 +12021,16 @@ func NewSharedContentChangeLinkPasswordDetails(TargetIndex int64) *SharedContent
 	return s
 }
 
+// MarshalJSON serializes a SharedContentChangeLinkPasswordDetails instance
+func (u SharedContentChangeLinkPasswordDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentChangeLinkPasswordDetails instance
+func (u *SharedContentChangeLinkPasswordDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentChangeMemberRoleDetails : Changed the access type of a shared
 // file or folder member.
 type SharedContentChangeMemberRoleDetails struct {
@@ -7506,6 +12047,8 @@ type SharedContentChangeMemberRoleDetails struct {
 	// PreviousSharingPermission : Previous sharing permission. Might be missing
 	// due to historical data gap.
 	PreviousSharingPermission string `json:"previous_sharing_permission,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentChangeMemberRoleDetails returns a new SharedContentChangeMemberRoleDetails instance
@@ -7515,6 +12058,16 @@ func NewSharedContentChangeMemberRoleDetails(TargetIndex int64) *SharedContentCh
 	return s
 }
 
+// MarshalJSON serializes a SharedContentChangeMemberRoleDetails instance
+func (u SharedContentChangeMemberRoleDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentChangeMemberRoleDetails instance
+func (u *SharedContentChangeMemberRoleDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentChangeViewerInfoPolicyDetails : Changed whether members can see
 // who viewed the shared file or folder.
 type SharedContentChangeViewerInfoPolicyDetails struct {
@@ -7530,6 +12083,8 @@ type SharedContentChangeViewerInfoPolicyDetails struct {
 	// PreviousValue : Previous view info policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentChangeViewerInfoPolicyDetails returns a new SharedContentChangeViewerInfoPolicyDetails instance
@@ -7540,6 +12095,16 @@ func NewSharedContentChangeViewerInfoPolicyDetails(TargetIndex int64, NewValue *
 	return s
 }
 
+// MarshalJSON serializes a SharedContentChangeViewerInfoPolicyDetails instance
+func (u SharedContentChangeViewerInfoPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentChangeViewerInfoPolicyDetails instance
+func (u *SharedContentChangeViewerInfoPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentClaimInvitationDetails : Claimed membership to a team member's
 // shared folder.
 type SharedContentClaimInvitationDetails struct {
@@ -7549,6 +12114,8 @@ type SharedContentClaimInvitationDetails struct {
 	OriginalFolderName string `json:"original_folder_name,omitempty"`
 	// SharedContentLink : Shared content link.
 	SharedContentLink string `json:"shared_content_link,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentClaimInvitationDetails returns a new SharedContentClaimInvitationDetails instance
@@ -7558,6 +12125,16 @@ func NewSharedContentClaimInvitationDetails(TargetIndex int64) *SharedContentCla
 	return s
 }
 
+// MarshalJSON serializes a SharedContentClaimInvitationDetails instance
+func (u SharedContentClaimInvitationDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentClaimInvitationDetails instance
+func (u *SharedContentClaimInvitationDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentCopyDetails : Copied the shared file or folder to own Dropbox.
 type SharedContentCopyDetails struct {
 	// SharedContentLink : Shared content link.
@@ -7571,6 +12148,8 @@ type SharedContentCopyDetails struct {
 	DestIndex int64 `json:"dest_index"`
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentCopyDetails returns a new SharedContentCopyDetails instance
@@ -7583,6 +12162,16 @@ func NewSharedContentCopyDetails(SharedContentLink string, SrcIndex int64, DestI
 	return s
 }
 
+// MarshalJSON serializes a SharedContentCopyDetails instance
+func (u SharedContentCopyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentCopyDetails instance
+func (u *SharedContentCopyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentDownloadDetails : Downloaded the shared file or folder.
 type SharedContentDownloadDetails struct {
 	// SharedContentLink : Shared content link.
@@ -7592,6 +12181,8 @@ type SharedContentDownloadDetails struct {
 	SharingPermission string `json:"sharing_permission,omitempty"`
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentDownloadDetails returns a new SharedContentDownloadDetails instance
@@ -7602,6 +12193,16 @@ func NewSharedContentDownloadDetails(SharedContentLink string, TargetIndex int64
 	return s
 }
 
+// MarshalJSON serializes a SharedContentDownloadDetails instance
+func (u SharedContentDownloadDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentDownloadDetails instance
+func (u *SharedContentDownloadDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentRelinquishMembershipDetails : Left the membership of a shared
 // file or folder.
 type SharedContentRelinquishMembershipDetails struct {
@@ -7609,6 +12210,8 @@ type SharedContentRelinquishMembershipDetails struct {
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentRelinquishMembershipDetails returns a new SharedContentRelinquishMembershipDetails instance
@@ -7619,6 +12222,16 @@ func NewSharedContentRelinquishMembershipDetails(TargetIndex int64, OriginalFold
 	return s
 }
 
+// MarshalJSON serializes a SharedContentRelinquishMembershipDetails instance
+func (u SharedContentRelinquishMembershipDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentRelinquishMembershipDetails instance
+func (u *SharedContentRelinquishMembershipDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentRemoveInviteeDetails : Removed an invitee from the membership of
 // a shared file or folder before it was claimed.
 type SharedContentRemoveInviteeDetails struct {
@@ -7626,6 +12239,8 @@ type SharedContentRemoveInviteeDetails struct {
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentRemoveInviteeDetails returns a new SharedContentRemoveInviteeDetails instance
@@ -7636,6 +12251,16 @@ func NewSharedContentRemoveInviteeDetails(TargetIndex int64, OriginalFolderName
 	return s
 }
 
+// MarshalJSON serializes a SharedContentRemoveInviteeDetails instance
+func (u SharedContentRemoveInviteeDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentRemoveInviteeDetails instance
+func (u *SharedContentRemoveInviteeDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentRemoveLinkExpiryDetails : Removed the expiry of the link for the
 // shared file or folder.
 type SharedContentRemoveLinkExpiryDetails struct {
@@ -7646,6 +12271,8 @@ type SharedContentRemoveLinkExpiryDetails struct {
 	// SharedFolderType : Shared folder type. Might be missing due to historical
 	// data gap.
 	SharedFolderType string `json:"shared_folder_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentRemoveLinkExpiryDetails returns a new SharedContentRemoveLinkExpiryDetails instance
@@ -7655,6 +12282,16 @@ func NewSharedContentRemoveLinkExpiryDetails(TargetIndex int64) *SharedContentRe
 	return s
 }
 
+// MarshalJSON serializes a SharedContentRemoveLinkExpiryDetails instance
+func (u SharedContentRemoveLinkExpiryDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentRemoveLinkExpiryDetails instance
+func (u *SharedContentRemoveLinkExpiryDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentRemoveLinkPasswordDetails : Removed the password on the link for
 // the shared file or folder.
 type SharedContentRemoveLinkPasswordDetails struct {
@@ -7665,6 +12302,8 @@ type SharedContentRemoveLinkPasswordDetails struct {
 	// SharedFolderType : Shared folder type. Might be missing due to historical
 	// data gap.
 	SharedFolderType string `json:"shared_folder_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentRemoveLinkPasswordDetails returns a new SharedContentRemoveLinkPasswordDetails instance
@@ -7674,6 +12313,16 @@ func NewSharedContentRemoveLinkPasswordDetails(TargetIndex int64) *SharedContent
 	return s
 }
 
+// MarshalJSON serializes a SharedContentRemoveLinkPasswordDetails instance
+func (u SharedContentRemoveLinkPasswordDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentRemoveLinkPasswordDetails instance
+func (u *SharedContentRemoveLinkPasswordDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentRemoveMemberDetails : Removed a user or a group from the
 // membership of a shared file or folder.
 type SharedContentRemoveMemberDetails struct {
@@ -7687,6 +12336,8 @@ type SharedContentRemoveMemberDetails struct {
 	// SharedFolderType : Shared folder type. Might be missing due to historical
 	// data gap.
 	SharedFolderType string `json:"shared_folder_type,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentRemoveMemberDetails returns a new SharedContentRemoveMemberDetails instance
@@ -7696,6 +12347,16 @@ func NewSharedContentRemoveMemberDetails(TargetIndex int64) *SharedContentRemove
 	return s
 }
 
+// MarshalJSON serializes a SharedContentRemoveMemberDetails instance
+func (u SharedContentRemoveMemberDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentRemoveMemberDetails instance
+func (u *SharedContentRemoveMemberDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentRequestAccessDetails : Requested to be on the membership of a
 // shared file or folder.
 type SharedContentRequestAccessDetails struct {
@@ -7705,6 +12366,8 @@ type SharedContentRequestAccessDetails struct {
 	OriginalFolderName string `json:"original_folder_name,omitempty"`
 	// SharedContentLink : Shared content link.
 	SharedContentLink string `json:"shared_content_link,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentRequestAccessDetails returns a new SharedContentRequestAccessDetails instance
@@ -7714,6 +12377,16 @@ func NewSharedContentRequestAccessDetails(TargetIndex int64) *SharedContentReque
 	return s
 }
 
+// MarshalJSON serializes a SharedContentRequestAccessDetails instance
+func (u SharedContentRequestAccessDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentRequestAccessDetails instance
+func (u *SharedContentRequestAccessDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentUnshareDetails : Unshared a shared file or folder by clearing
 // its membership and turning off its link.
 type SharedContentUnshareDetails struct {
@@ -7721,6 +12394,8 @@ type SharedContentUnshareDetails struct {
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentUnshareDetails returns a new SharedContentUnshareDetails instance
@@ -7730,6 +12405,16 @@ func NewSharedContentUnshareDetails(TargetIndex int64) *SharedContentUnshareDeta
 	return s
 }
 
+// MarshalJSON serializes a SharedContentUnshareDetails instance
+func (u SharedContentUnshareDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentUnshareDetails instance
+func (u *SharedContentUnshareDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedContentViewDetails : Previewed the shared file or folder.
 type SharedContentViewDetails struct {
 	// SharedContentLink : Shared content link.
@@ -7739,6 +12424,8 @@ type SharedContentViewDetails struct {
 	SharingPermission string `json:"sharing_permission,omitempty"`
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedContentViewDetails returns a new SharedContentViewDetails instance
@@ -7749,6 +12436,16 @@ func NewSharedContentViewDetails(SharedContentLink string, TargetIndex int64) *S
 	return s
 }
 
+// MarshalJSON serializes a SharedContentViewDetails instance
+func (u SharedContentViewDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedContentViewDetails instance
+func (u *SharedContentViewDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderChangeConfidentialityDetails : Set or unset the confidential flag
 // on a shared folder.
 type SharedFolderChangeConfidentialityDetails struct {
@@ -7761,6 +12458,8 @@ type SharedFolderChangeConfidentialityDetails struct {
 	// PreviousValue : Previous confidentiality value. Might be missing due to
 	// historical data gap.
 	PreviousValue *Confidentiality `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderChangeConfidentialityDetails returns a new SharedFolderChangeConfidentialityDetails instance
@@ -7772,6 +12471,16 @@ func NewSharedFolderChangeConfidentialityDetails(TargetIndex int64, OriginalFold
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderChangeConfidentialityDetails instance
+func (u SharedFolderChangeConfidentialityDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderChangeConfidentialityDetails instance
+func (u *SharedFolderChangeConfidentialityDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderChangeLinkPolicyDetails : Changed who can access the shared
 // folder via a link.
 type SharedFolderChangeLinkPolicyDetails struct {
@@ -7787,6 +12496,8 @@ type SharedFolderChangeLinkPolicyDetails struct {
 	// PreviousValue : Previous shared folder link policy. Might be missing due
 	// to historical data gap.
 	PreviousValue *SharedFolderLinkPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderChangeLinkPolicyDetails returns a new SharedFolderChangeLinkPolicyDetails instance
@@ -7798,6 +12509,16 @@ func NewSharedFolderChangeLinkPolicyDetails(TargetIndex int64, OriginalFolderNam
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderChangeLinkPolicyDetails instance
+func (u SharedFolderChangeLinkPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderChangeLinkPolicyDetails instance
+func (u *SharedFolderChangeLinkPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderChangeMemberManagementPolicyDetails : Changed who can manage the
 // membership of a shared folder.
 type SharedFolderChangeMemberManagementPolicyDetails struct {
@@ -7813,6 +12534,8 @@ type SharedFolderChangeMemberManagementPolicyDetails struct {
 	// PreviousValue : Previous membership management policy. Might be missing
 	// due to historical data gap.
 	PreviousValue *SharedFolderMembershipManagementPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderChangeMemberManagementPolicyDetails returns a new SharedFolderChangeMemberManagementPolicyDetails instance
@@ -7824,6 +12547,16 @@ func NewSharedFolderChangeMemberManagementPolicyDetails(TargetIndex int64, Origi
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderChangeMemberManagementPolicyDetails instance
+func (u SharedFolderChangeMemberManagementPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderChangeMemberManagementPolicyDetails instance
+func (u *SharedFolderChangeMemberManagementPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderChangeMemberPolicyDetails : Changed who can become a member of
 // the shared folder.
 type SharedFolderChangeMemberPolicyDetails struct {
@@ -7839,6 +12572,8 @@ type SharedFolderChangeMemberPolicyDetails struct {
 	// PreviousValue : Previous external invite policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *ExternalSharingPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderChangeMemberPolicyDetails returns a new SharedFolderChangeMemberPolicyDetails instance
@@ -7850,6 +12585,16 @@ func NewSharedFolderChangeMemberPolicyDetails(TargetIndex int64, OriginalFolderN
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderChangeMemberPolicyDetails instance
+func (u SharedFolderChangeMemberPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderChangeMemberPolicyDetails instance
+func (u *SharedFolderChangeMemberPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderCreateDetails : Created a shared folder.
 type SharedFolderCreateDetails struct {
 	// TargetIndex : Target asset index.
@@ -7857,6 +12602,8 @@ type SharedFolderCreateDetails struct {
 	// ParentNsId : Parent namespace ID. Might be missing due to historical data
 	// gap.
 	ParentNsId string `json:"parent_ns_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderCreateDetails returns a new SharedFolderCreateDetails instance
@@ -7866,9 +12613,30 @@ func NewSharedFolderCreateDetails(TargetIndex int64) *SharedFolderCreateDetails
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderCreateDetails instance
+func (u SharedFolderCreateDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderCreateDetails instance
+func (u *SharedFolderCreateDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderLinkPolicy : has no documentation (yet)
 type SharedFolderLinkPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedFolderLinkPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedFolderLinkPolicy
@@ -7879,12 +12647,40 @@ const (
 	SharedFolderLinkPolicyOther          = "other"
 )
 
+// UnmarshalJSON deserializes into a SharedFolderLinkPolicy instance
+func (u *SharedFolderLinkPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "members_only", "members_and_team", "anyone", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedFolderLinkPolicy instance
+func (u SharedFolderLinkPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharedFolderLogInfo : SharedFolder's logged information.
 type SharedFolderLogInfo struct {
 	// NsId : Namespace ID. Might be missing due to historical data gap.
 	NsId string `json:"ns_id,omitempty"`
 	// DisplayName : Shared folder display name.
 	DisplayName string `json:"display_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderLogInfo returns a new SharedFolderLogInfo instance
@@ -7894,9 +12690,30 @@ func NewSharedFolderLogInfo(DisplayName string) *SharedFolderLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderLogInfo instance
+func (u SharedFolderLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderLogInfo instance
+func (u *SharedFolderLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderMembershipManagementPolicy : has no documentation (yet)
 type SharedFolderMembershipManagementPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedFolderMembershipManagementPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedFolderMembershipManagementPolicy
@@ -7906,12 +12723,40 @@ const (
 	SharedFolderMembershipManagementPolicyOther   = "other"
 )
 
+// UnmarshalJSON deserializes into a SharedFolderMembershipManagementPolicy instance
+func (u *SharedFolderMembershipManagementPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "owner", "editors", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedFolderMembershipManagementPolicy instance
+func (u SharedFolderMembershipManagementPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharedFolderMountDetails : Added a shared folder to own Dropbox.
 type SharedFolderMountDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderMountDetails returns a new SharedFolderMountDetails instance
@@ -7922,6 +12767,16 @@ func NewSharedFolderMountDetails(TargetIndex int64, OriginalFolderName string) *
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderMountDetails instance
+func (u SharedFolderMountDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderMountDetails instance
+func (u *SharedFolderMountDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderTransferOwnershipDetails : Transferred the ownership of a shared
 // folder to another member.
 type SharedFolderTransferOwnershipDetails struct {
@@ -7929,6 +12784,8 @@ type SharedFolderTransferOwnershipDetails struct {
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderTransferOwnershipDetails returns a new SharedFolderTransferOwnershipDetails instance
@@ -7939,12 +12796,24 @@ func NewSharedFolderTransferOwnershipDetails(TargetIndex int64, OriginalFolderNa
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderTransferOwnershipDetails instance
+func (u SharedFolderTransferOwnershipDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderTransferOwnershipDetails instance
+func (u *SharedFolderTransferOwnershipDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedFolderUnmountDetails : Deleted a shared folder from Dropbox.
 type SharedFolderUnmountDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
 	// OriginalFolderName : Original shared folder name.
 	OriginalFolderName string `json:"original_folder_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedFolderUnmountDetails returns a new SharedFolderUnmountDetails instance
@@ -7955,8 +12824,20 @@ func NewSharedFolderUnmountDetails(TargetIndex int64, OriginalFolderName string)
 	return s
 }
 
+// MarshalJSON serializes a SharedFolderUnmountDetails instance
+func (u SharedFolderUnmountDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedFolderUnmountDetails instance
+func (u *SharedFolderUnmountDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharedNoteOpenedDetails : Shared Paper document was opened.
 type SharedNoteOpenedDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharedNoteOpenedDetails returns a new SharedNoteOpenedDetails instance
@@ -7965,6 +12846,16 @@ func NewSharedNoteOpenedDetails() *SharedNoteOpenedDetails {
 	return s
 }
 
+// MarshalJSON serializes a SharedNoteOpenedDetails instance
+func (u SharedNoteOpenedDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharedNoteOpenedDetails instance
+func (u *SharedNoteOpenedDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharingChangeFolderJoinPolicyDetails : Changed whether team members can join
 // shared folders owned externally (i.e. outside the team).
 type SharingChangeFolderJoinPolicyDetails struct {
@@ -7973,6 +12864,8 @@ type SharingChangeFolderJoinPolicyDetails struct {
 	// PreviousValue : Previous external join policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *ExternalSharingPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharingChangeFolderJoinPolicyDetails returns a new SharingChangeFolderJoinPolicyDetails instance
@@ -7982,6 +12875,16 @@ func NewSharingChangeFolderJoinPolicyDetails(NewValue *ExternalSharingPolicy) *S
 	return s
 }
 
+// MarshalJSON serializes a SharingChangeFolderJoinPolicyDetails instance
+func (u SharingChangeFolderJoinPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharingChangeFolderJoinPolicyDetails instance
+func (u *SharingChangeFolderJoinPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharingChangeLinkPolicyDetails : Changed whether team members can share links
 // externally (i.e. outside the team), and if so, whether links should be
 // accessible only by team members or anyone by default.
@@ -7991,6 +12894,8 @@ type SharingChangeLinkPolicyDetails struct {
 	// PreviousValue : Previous external link accessibility policy. Might be
 	// missing due to historical data gap.
 	PreviousValue *ExternalSharingAccessibilityPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharingChangeLinkPolicyDetails returns a new SharingChangeLinkPolicyDetails instance
@@ -8000,6 +12905,16 @@ func NewSharingChangeLinkPolicyDetails(NewValue *ExternalSharingAccessibilityPol
 	return s
 }
 
+// MarshalJSON serializes a SharingChangeLinkPolicyDetails instance
+func (u SharingChangeLinkPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharingChangeLinkPolicyDetails instance
+func (u *SharingChangeLinkPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SharingChangeMemberPolicyDetails : Changed whether team members can share
 // files and folders externally (i.e. outside the team).
 type SharingChangeMemberPolicyDetails struct {
@@ -8008,6 +12923,8 @@ type SharingChangeMemberPolicyDetails struct {
 	// PreviousValue : Previous external invite policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *ExternalSharingPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSharingChangeMemberPolicyDetails returns a new SharingChangeMemberPolicyDetails instance
@@ -8017,6 +12934,16 @@ func NewSharingChangeMemberPolicyDetails(NewValue *ExternalSharingPolicy) *Shari
 	return s
 }
 
+// MarshalJSON serializes a SharingChangeMemberPolicyDetails instance
+func (u SharingChangeMemberPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SharingChangeMemberPolicyDetails instance
+func (u *SharingChangeMemberPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelAppCreateDetails : Created a link to a file using an app.
 type ShmodelAppCreateDetails struct {
 	// SharingPermission : Sharing permission. Might be missing due to
@@ -8024,6 +12951,8 @@ type ShmodelAppCreateDetails struct {
 	SharingPermission string `json:"sharing_permission,omitempty"`
 	// TokenKey : Shared link token key.
 	TokenKey string `json:"token_key,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelAppCreateDetails returns a new ShmodelAppCreateDetails instance
@@ -8032,6 +12961,16 @@ func NewShmodelAppCreateDetails() *ShmodelAppCreateDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelAppCreateDetails instance
+func (u ShmodelAppCreateDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelAppCreateDetails instance
+func (u *ShmodelAppCreateDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelCreateDetails : Created a new link.
 type ShmodelCreateDetails struct {
 	// SharingPermission : Sharing permission. Might be missing due to
@@ -8039,6 +12978,8 @@ type ShmodelCreateDetails struct {
 	SharingPermission string `json:"sharing_permission,omitempty"`
 	// TokenKey : Shared link token key.
 	TokenKey string `json:"token_key,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelCreateDetails returns a new ShmodelCreateDetails instance
@@ -8047,6 +12988,16 @@ func NewShmodelCreateDetails() *ShmodelCreateDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelCreateDetails instance
+func (u ShmodelCreateDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelCreateDetails instance
+func (u *ShmodelCreateDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelDisableDetails : Removed a link.
 type ShmodelDisableDetails struct {
 	// SharingPermission : Sharing permission. Might be missing due to
@@ -8054,6 +13005,8 @@ type ShmodelDisableDetails struct {
 	SharingPermission string `json:"sharing_permission,omitempty"`
 	// TokenKey : Shared link token key.
 	TokenKey string `json:"token_key,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelDisableDetails returns a new ShmodelDisableDetails instance
@@ -8062,10 +13015,22 @@ func NewShmodelDisableDetails() *ShmodelDisableDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelDisableDetails instance
+func (u ShmodelDisableDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelDisableDetails instance
+func (u *ShmodelDisableDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelFbShareDetails : Shared a link with Facebook users.
 type ShmodelFbShareDetails struct {
 	// SharingNonMemberRecipients : Sharing non member recipients.
 	SharingNonMemberRecipients []*NonTeamMemberLogInfo `json:"sharing_non_member_recipients"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelFbShareDetails returns a new ShmodelFbShareDetails instance
@@ -8075,8 +13040,20 @@ func NewShmodelFbShareDetails(SharingNonMemberRecipients []*NonTeamMemberLogInfo
 	return s
 }
 
+// MarshalJSON serializes a ShmodelFbShareDetails instance
+func (u ShmodelFbShareDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelFbShareDetails instance
+func (u *ShmodelFbShareDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelGroupShareDetails : Shared a link with a group.
 type ShmodelGroupShareDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelGroupShareDetails returns a new ShmodelGroupShareDetails instance
@@ -8085,8 +13062,20 @@ func NewShmodelGroupShareDetails() *ShmodelGroupShareDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelGroupShareDetails instance
+func (u ShmodelGroupShareDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelGroupShareDetails instance
+func (u *ShmodelGroupShareDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelRemoveExpirationDetails : Removed the expiration date from a link.
 type ShmodelRemoveExpirationDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelRemoveExpirationDetails returns a new ShmodelRemoveExpirationDetails instance
@@ -8095,6 +13084,16 @@ func NewShmodelRemoveExpirationDetails() *ShmodelRemoveExpirationDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelRemoveExpirationDetails instance
+func (u ShmodelRemoveExpirationDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelRemoveExpirationDetails instance
+func (u *ShmodelRemoveExpirationDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelSetExpirationDetails : Added an expiration date to a link.
 type ShmodelSetExpirationDetails struct {
 	// ExpirationStartDate : Expiration starting date.
@@ -8102,6 +13101,8 @@ type ShmodelSetExpirationDetails struct {
 	// ExpirationDays : The number of days from the starting expiration date
 	// after which the link will expire.
 	ExpirationDays int64 `json:"expiration_days"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelSetExpirationDetails returns a new ShmodelSetExpirationDetails instance
@@ -8112,9 +13113,21 @@ func NewShmodelSetExpirationDetails(ExpirationStartDate string, ExpirationDays i
 	return s
 }
 
+// MarshalJSON serializes a ShmodelSetExpirationDetails instance
+func (u ShmodelSetExpirationDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelSetExpirationDetails instance
+func (u *ShmodelSetExpirationDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelTeamCopyDetails : Added a team member's file/folder to their Dropbox
 // from a link.
 type ShmodelTeamCopyDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelTeamCopyDetails returns a new ShmodelTeamCopyDetails instance
@@ -8123,9 +13136,21 @@ func NewShmodelTeamCopyDetails() *ShmodelTeamCopyDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelTeamCopyDetails instance
+func (u ShmodelTeamCopyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelTeamCopyDetails instance
+func (u *ShmodelTeamCopyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelTeamDownloadDetails : Downloaded a team member's file/folder from a
 // link.
 type ShmodelTeamDownloadDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelTeamDownloadDetails returns a new ShmodelTeamDownloadDetails instance
@@ -8134,8 +13159,20 @@ func NewShmodelTeamDownloadDetails() *ShmodelTeamDownloadDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelTeamDownloadDetails instance
+func (u ShmodelTeamDownloadDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelTeamDownloadDetails instance
+func (u *ShmodelTeamDownloadDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelTeamShareDetails : Shared a link with team members.
 type ShmodelTeamShareDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelTeamShareDetails returns a new ShmodelTeamShareDetails instance
@@ -8144,8 +13181,20 @@ func NewShmodelTeamShareDetails() *ShmodelTeamShareDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelTeamShareDetails instance
+func (u ShmodelTeamShareDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelTeamShareDetails instance
+func (u *ShmodelTeamShareDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelTeamViewDetails : Opened a team member's link.
 type ShmodelTeamViewDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelTeamViewDetails returns a new ShmodelTeamViewDetails instance
@@ -8154,8 +13203,20 @@ func NewShmodelTeamViewDetails() *ShmodelTeamViewDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelTeamViewDetails instance
+func (u ShmodelTeamViewDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelTeamViewDetails instance
+func (u *ShmodelTeamViewDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelVisibilityPasswordDetails : Password-protected a link.
 type ShmodelVisibilityPasswordDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelVisibilityPasswordDetails returns a new ShmodelVisibilityPasswordDetails instance
@@ -8164,9 +13225,21 @@ func NewShmodelVisibilityPasswordDetails() *ShmodelVisibilityPasswordDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelVisibilityPasswordDetails instance
+func (u ShmodelVisibilityPasswordDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelVisibilityPasswordDetails instance
+func (u *ShmodelVisibilityPasswordDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelVisibilityPublicDetails : Made a file/folder visible to anyone with
 // the link.
 type ShmodelVisibilityPublicDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelVisibilityPublicDetails returns a new ShmodelVisibilityPublicDetails instance
@@ -8175,9 +13248,21 @@ func NewShmodelVisibilityPublicDetails() *ShmodelVisibilityPublicDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelVisibilityPublicDetails instance
+func (u ShmodelVisibilityPublicDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelVisibilityPublicDetails instance
+func (u *ShmodelVisibilityPublicDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // ShmodelVisibilityTeamOnlyDetails : Made a file/folder visible only to team
 // members with the link.
 type ShmodelVisibilityTeamOnlyDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewShmodelVisibilityTeamOnlyDetails returns a new ShmodelVisibilityTeamOnlyDetails instance
@@ -8186,8 +13271,20 @@ func NewShmodelVisibilityTeamOnlyDetails() *ShmodelVisibilityTeamOnlyDetails {
 	return s
 }
 
+// MarshalJSON serializes a ShmodelVisibilityTeamOnlyDetails instance
+func (u ShmodelVisibilityTeamOnlyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a ShmodelVisibilityTeamOnlyDetails instance
+func (u *ShmodelVisibilityTeamOnlyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SignInAsSessionEndDetails : Ended admin sign-in-as session.
 type SignInAsSessionEndDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSignInAsSessionEndDetails returns a new SignInAsSessionEndDetails instance
@@ -8196,8 +13293,20 @@ func NewSignInAsSessionEndDetails() *SignInAsSessionEndDetails {
 	return s
 }
 
+// MarshalJSON serializes a SignInAsSessionEndDetails instance
+func (u SignInAsSessionEndDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SignInAsSessionEndDetails instance
+func (u *SignInAsSessionEndDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SignInAsSessionStartDetails : Started admin sign-in-as session.
 type SignInAsSessionStartDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSignInAsSessionStartDetails returns a new SignInAsSessionStartDetails instance
@@ -8206,6 +13315,16 @@ func NewSignInAsSessionStartDetails() *SignInAsSessionStartDetails {
 	return s
 }
 
+// MarshalJSON serializes a SignInAsSessionStartDetails instance
+func (u SignInAsSessionStartDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SignInAsSessionStartDetails instance
+func (u *SignInAsSessionStartDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SmartSyncChangePolicyDetails : Changed the default Smart Sync policy for team
 // members.
 type SmartSyncChangePolicyDetails struct {
@@ -8214,6 +13333,8 @@ type SmartSyncChangePolicyDetails struct {
 	// PreviousValue : Previous smart sync policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *SmartSyncPolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSmartSyncChangePolicyDetails returns a new SmartSyncChangePolicyDetails instance
@@ -8223,9 +13344,21 @@ func NewSmartSyncChangePolicyDetails(NewValue *SmartSyncPolicy) *SmartSyncChange
 	return s
 }
 
+// MarshalJSON serializes a SmartSyncChangePolicyDetails instance
+func (u SmartSyncChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SmartSyncChangePolicyDetails instance
+func (u *SmartSyncChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SmartSyncCreateAdminPrivilegeReportDetails : Smart Sync non-admin devices
 // report created.
 type SmartSyncCreateAdminPrivilegeReportDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSmartSyncCreateAdminPrivilegeReportDetails returns a new SmartSyncCreateAdminPrivilegeReportDetails instance
@@ -8234,9 +13367,30 @@ func NewSmartSyncCreateAdminPrivilegeReportDetails() *SmartSyncCreateAdminPrivil
 	return s
 }
 
+// MarshalJSON serializes a SmartSyncCreateAdminPrivilegeReportDetails instance
+func (u SmartSyncCreateAdminPrivilegeReportDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SmartSyncCreateAdminPrivilegeReportDetails instance
+func (u *SmartSyncCreateAdminPrivilegeReportDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SmartSyncPolicy : has no documentation (yet)
 type SmartSyncPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SmartSyncPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SmartSyncPolicy
@@ -8246,9 +13400,46 @@ const (
 	SmartSyncPolicyOther     = "other"
 )
 
+// UnmarshalJSON deserializes into a SmartSyncPolicy instance
+func (u *SmartSyncPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "local_only", "synced", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SmartSyncPolicy instance
+func (u SmartSyncPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SpaceLimitsLevel : has no documentation (yet)
 type SpaceLimitsLevel struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SpaceLimitsLevel) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SpaceLimitsLevel
@@ -8260,9 +13451,46 @@ const (
 	SpaceLimitsLevelOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a SpaceLimitsLevel instance
+func (u *SpaceLimitsLevel) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "generous", "moderate", "no_limit", "strict", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SpaceLimitsLevel instance
+func (u SpaceLimitsLevel) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SpaceLimitsStatus : has no documentation (yet)
 type SpaceLimitsStatus struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SpaceLimitsStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SpaceLimitsStatus
@@ -8273,6 +13501,32 @@ const (
 	SpaceLimitsStatusOther       = "other"
 )
 
+// UnmarshalJSON deserializes into a SpaceLimitsStatus instance
+func (u *SpaceLimitsStatus) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "within_quota", "near_quota", "over_quota", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SpaceLimitsStatus instance
+func (u SpaceLimitsStatus) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SsoChangeCertDetails : Changed the X.509 certificate for SSO.
 type SsoChangeCertDetails struct {
 	// Subject : Certificate subject.
@@ -8289,6 +13543,8 @@ type SsoChangeCertDetails struct {
 	Sha1Fingerprint string `json:"sha1_fingerprint"`
 	// CommonName : Certificate common name.
 	CommonName string `json:"common_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSsoChangeCertDetails returns a new SsoChangeCertDetails instance
@@ -8304,12 +13560,24 @@ func NewSsoChangeCertDetails(Subject string, Issuer string, IssueDate string, Ex
 	return s
 }
 
+// MarshalJSON serializes a SsoChangeCertDetails instance
+func (u SsoChangeCertDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SsoChangeCertDetails instance
+func (u *SsoChangeCertDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SsoChangeLoginUrlDetails : Changed the sign-in URL for SSO.
 type SsoChangeLoginUrlDetails struct {
 	// PreviousSsoUrl : Previous SSO Url.
 	PreviousSsoUrl string `json:"previous_sso_url"`
 	// NewSsoUrl : New SSO Url.
 	NewSsoUrl string `json:"new_sso_url"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSsoChangeLoginUrlDetails returns a new SsoChangeLoginUrlDetails instance
@@ -8320,6 +13588,16 @@ func NewSsoChangeLoginUrlDetails(PreviousSsoUrl string, NewSsoUrl string) *SsoCh
 	return s
 }
 
+// MarshalJSON serializes a SsoChangeLoginUrlDetails instance
+func (u SsoChangeLoginUrlDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SsoChangeLoginUrlDetails instance
+func (u *SsoChangeLoginUrlDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SsoChangeLogoutUrlDetails : Changed the sign-out URL for SSO.
 type SsoChangeLogoutUrlDetails struct {
 	// PreviousSsoLogoutUrl : Previous single sign-on logout URL.
@@ -8327,6 +13605,8 @@ type SsoChangeLogoutUrlDetails struct {
 	// NewSsoLogoutUrl : New single sign-on logout URL. Might be missing due to
 	// historical data gap.
 	NewSsoLogoutUrl string `json:"new_sso_logout_url,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSsoChangeLogoutUrlDetails returns a new SsoChangeLogoutUrlDetails instance
@@ -8336,6 +13616,16 @@ func NewSsoChangeLogoutUrlDetails(PreviousSsoLogoutUrl string) *SsoChangeLogoutU
 	return s
 }
 
+// MarshalJSON serializes a SsoChangeLogoutUrlDetails instance
+func (u SsoChangeLogoutUrlDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SsoChangeLogoutUrlDetails instance
+func (u *SsoChangeLogoutUrlDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SsoChangePolicyDetails : Change the single sign-on policy for the team.
 type SsoChangePolicyDetails struct {
 	// NewValue : New single sign-on policy.
@@ -8343,6 +13633,8 @@ type SsoChangePolicyDetails struct {
 	// PreviousValue : Previous single sign-on policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *OptionalChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSsoChangePolicyDetails returns a new SsoChangePolicyDetails instance
@@ -8352,12 +13644,24 @@ func NewSsoChangePolicyDetails(NewValue *OptionalChangePolicy) *SsoChangePolicyD
 	return s
 }
 
+// MarshalJSON serializes a SsoChangePolicyDetails instance
+func (u SsoChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SsoChangePolicyDetails instance
+func (u *SsoChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SsoChangeSamlIdentityModeDetails : Changed the SAML identity mode for SSO.
 type SsoChangeSamlIdentityModeDetails struct {
 	// PreviousSamlIdentityMode : Previous single sign-on identity mode.
 	PreviousSamlIdentityMode int64 `json:"previous_saml_identity_mode"`
 	// NewSamlIdentityMode : New single sign-on identity mode.
 	NewSamlIdentityMode int64 `json:"new_saml_identity_mode"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSsoChangeSamlIdentityModeDetails returns a new SsoChangeSamlIdentityModeDetails instance
@@ -8368,12 +13672,24 @@ func NewSsoChangeSamlIdentityModeDetails(PreviousSamlIdentityMode int64, NewSaml
 	return s
 }
 
+// MarshalJSON serializes a SsoChangeSamlIdentityModeDetails instance
+func (u SsoChangeSamlIdentityModeDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SsoChangeSamlIdentityModeDetails instance
+func (u *SsoChangeSamlIdentityModeDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SsoLoginFailDetails : Failed to sign in using SSO.
 type SsoLoginFailDetails struct {
 	// SystemMessage : A technical description of the error.
 	SystemMessage string `json:"system_message"`
 	// AdminMessage : A user friendly description of the error.
 	AdminMessage string `json:"admin_message"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSsoLoginFailDetails returns a new SsoLoginFailDetails instance
@@ -8384,12 +13700,24 @@ func NewSsoLoginFailDetails(SystemMessage string, AdminMessage string) *SsoLogin
 	return s
 }
 
+// MarshalJSON serializes a SsoLoginFailDetails instance
+func (u SsoLoginFailDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SsoLoginFailDetails instance
+func (u *SsoLoginFailDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamActivityCreateReportDetails : Created a team activity report.
 type TeamActivityCreateReportDetails struct {
 	// StartDate : Report start date.
 	StartDate string `json:"start_date"`
 	// EndDate : Report end date.
 	EndDate string `json:"end_date"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamActivityCreateReportDetails returns a new TeamActivityCreateReportDetails instance
@@ -8400,6 +13728,16 @@ func NewTeamActivityCreateReportDetails(StartDate string, EndDate string) *TeamA
 	return s
 }
 
+// MarshalJSON serializes a TeamActivityCreateReportDetails instance
+func (u TeamActivityCreateReportDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamActivityCreateReportDetails instance
+func (u *TeamActivityCreateReportDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamEvent : An audit log event.
 type TeamEvent struct {
 	// Timestamp : The Dropbox timestamp representing when the action was taken.
@@ -8433,6 +13771,8 @@ type TeamEvent struct {
 	// Details : The variable event schema applicable to this type of action,
 	// instantiated with respect to this particular action.
 	Details *EventDetails `json:"details"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamEvent returns a new TeamEvent instance
@@ -8448,6 +13788,16 @@ func NewTeamEvent(Timestamp time.Time, EventCategories []*EventCategory, Actor *
 	return s
 }
 
+// MarshalJSON serializes a TeamEvent instance
+func (u TeamEvent) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamEvent instance
+func (u *TeamEvent) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderChangeStatusDetails : Changed the archival status of a team folder.
 type TeamFolderChangeStatusDetails struct {
 	// NewStatus : New team folder status.
@@ -8455,6 +13805,8 @@ type TeamFolderChangeStatusDetails struct {
 	// PreviousStatus : Previous team folder status. Might be missing due to
 	// historical data gap.
 	PreviousStatus *TeamFolderStatus `json:"previous_status,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderChangeStatusDetails returns a new TeamFolderChangeStatusDetails instance
@@ -8464,8 +13816,20 @@ func NewTeamFolderChangeStatusDetails(NewStatus *TeamFolderStatus) *TeamFolderCh
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderChangeStatusDetails instance
+func (u TeamFolderChangeStatusDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderChangeStatusDetails instance
+func (u *TeamFolderChangeStatusDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderCreateDetails : Created a new team folder in active status.
 type TeamFolderCreateDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderCreateDetails returns a new TeamFolderCreateDetails instance
@@ -8474,11 +13838,23 @@ func NewTeamFolderCreateDetails() *TeamFolderCreateDetails {
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderCreateDetails instance
+func (u TeamFolderCreateDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderCreateDetails instance
+func (u *TeamFolderCreateDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderDowngradeDetails : Downgraded a team folder to a regular shared
 // folder.
 type TeamFolderDowngradeDetails struct {
 	// TargetIndex : Target asset index.
 	TargetIndex int64 `json:"target_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderDowngradeDetails returns a new TeamFolderDowngradeDetails instance
@@ -8488,9 +13864,21 @@ func NewTeamFolderDowngradeDetails(TargetIndex int64) *TeamFolderDowngradeDetail
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderDowngradeDetails instance
+func (u TeamFolderDowngradeDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderDowngradeDetails instance
+func (u *TeamFolderDowngradeDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderPermanentlyDeleteDetails : Permanently deleted an archived team
 // folder.
 type TeamFolderPermanentlyDeleteDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderPermanentlyDeleteDetails returns a new TeamFolderPermanentlyDeleteDetails instance
@@ -8499,12 +13887,24 @@ func NewTeamFolderPermanentlyDeleteDetails() *TeamFolderPermanentlyDeleteDetails
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderPermanentlyDeleteDetails instance
+func (u TeamFolderPermanentlyDeleteDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderPermanentlyDeleteDetails instance
+func (u *TeamFolderPermanentlyDeleteDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderRenameDetails : Renamed an active or archived team folder.
 type TeamFolderRenameDetails struct {
 	// SrcIndex : Source asset index.
 	SrcIndex int64 `json:"src_index"`
 	// DestIndex : Destination asset index.
 	DestIndex int64 `json:"dest_index"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamFolderRenameDetails returns a new TeamFolderRenameDetails instance
@@ -8515,9 +13915,30 @@ func NewTeamFolderRenameDetails(SrcIndex int64, DestIndex int64) *TeamFolderRena
 	return s
 }
 
+// MarshalJSON serializes a TeamFolderRenameDetails instance
+func (u TeamFolderRenameDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamFolderRenameDetails instance
+func (u *TeamFolderRenameDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamFolderStatus : has no documentation (yet)
 type TeamFolderStatus struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TeamFolderStatus) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TeamFolderStatus
@@ -8527,9 +13948,37 @@ const (
 	TeamFolderStatusOther     = "other"
 )
 
+// UnmarshalJSON deserializes into a TeamFolderStatus instance
+func (u *TeamFolderStatus) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "archive", "unarchive", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TeamFolderStatus instance
+func (u TeamFolderStatus) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TeamLinkedAppLogInfo : Team linked app
 type TeamLinkedAppLogInfo struct {
 	AppLogInfo
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamLinkedAppLogInfo returns a new TeamLinkedAppLogInfo instance
@@ -8538,6 +13987,16 @@ func NewTeamLinkedAppLogInfo() *TeamLinkedAppLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a TeamLinkedAppLogInfo instance, tagged as a `AppLogInfo`
+func (u TeamLinkedAppLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("team_linked_app", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamLinkedAppLogInfo instance
+func (u *TeamLinkedAppLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamMemberLogInfo : Team member's logged information.
 type TeamMemberLogInfo struct {
 	UserLogInfo
@@ -8546,6 +14005,8 @@ type TeamMemberLogInfo struct {
 	TeamMemberId string `json:"team_member_id,omitempty"`
 	// MemberExternalId : Team member external ID.
 	MemberExternalId string `json:"member_external_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamMemberLogInfo returns a new TeamMemberLogInfo instance
@@ -8554,6 +14015,16 @@ func NewTeamMemberLogInfo() *TeamMemberLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a TeamMemberLogInfo instance, tagged as a `UserLogInfo`
+func (u TeamMemberLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("team_member", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamMemberLogInfo instance
+func (u *TeamMemberLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamMergeDetails : Merged the team into another team.
 type TeamMergeDetails struct {
 	// MergedFromTeamName : Merged from team name. Might be missing due to
@@ -8562,6 +14033,8 @@ type TeamMergeDetails struct {
 	// MergedToTeamName : Merged to team name. Might be missing due to
 	// historical data gap.
 	MergedToTeamName string `json:"merged_to_team_name,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamMergeDetails returns a new TeamMergeDetails instance
@@ -8570,9 +14043,21 @@ func NewTeamMergeDetails() *TeamMergeDetails {
 	return s
 }
 
+// MarshalJSON serializes a TeamMergeDetails instance
+func (u TeamMergeDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamMergeDetails instance
+func (u *TeamMergeDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamProfileAddLogoDetails : Added a team logo to be displayed on shared link
 // headers.
 type TeamProfileAddLogoDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamProfileAddLogoDetails returns a new TeamProfileAddLogoDetails instance
@@ -8581,9 +14066,21 @@ func NewTeamProfileAddLogoDetails() *TeamProfileAddLogoDetails {
 	return s
 }
 
+// MarshalJSON serializes a TeamProfileAddLogoDetails instance
+func (u TeamProfileAddLogoDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamProfileAddLogoDetails instance
+func (u *TeamProfileAddLogoDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamProfileChangeLogoDetails : Changed the team logo to be displayed on
 // shared link headers.
 type TeamProfileChangeLogoDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamProfileChangeLogoDetails returns a new TeamProfileChangeLogoDetails instance
@@ -8592,12 +14089,24 @@ func NewTeamProfileChangeLogoDetails() *TeamProfileChangeLogoDetails {
 	return s
 }
 
+// MarshalJSON serializes a TeamProfileChangeLogoDetails instance
+func (u TeamProfileChangeLogoDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamProfileChangeLogoDetails instance
+func (u *TeamProfileChangeLogoDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamProfileChangeNameDetails : Changed the team name.
 type TeamProfileChangeNameDetails struct {
 	// TeamDisplayName : Team's display name.
 	TeamDisplayName string `json:"team_display_name"`
 	// TeamLegalName : Team's legal name.
 	TeamLegalName string `json:"team_legal_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamProfileChangeNameDetails returns a new TeamProfileChangeNameDetails instance
@@ -8608,9 +14117,21 @@ func NewTeamProfileChangeNameDetails(TeamDisplayName string, TeamLegalName strin
 	return s
 }
 
+// MarshalJSON serializes a TeamProfileChangeNameDetails instance
+func (u TeamProfileChangeNameDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamProfileChangeNameDetails instance
+func (u *TeamProfileChangeNameDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamProfileRemoveLogoDetails : Removed the team logo to be displayed on
 // shared link headers.
 type TeamProfileRemoveLogoDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamProfileRemoveLogoDetails returns a new TeamProfileRemoveLogoDetails instance
@@ -8619,8 +14140,20 @@ func NewTeamProfileRemoveLogoDetails() *TeamProfileRemoveLogoDetails {
 	return s
 }
 
+// MarshalJSON serializes a TeamProfileRemoveLogoDetails instance
+func (u TeamProfileRemoveLogoDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamProfileRemoveLogoDetails instance
+func (u *TeamProfileRemoveLogoDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TfaAddBackupPhoneDetails : Added a backup phone for two-step verification.
 type TfaAddBackupPhoneDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTfaAddBackupPhoneDetails returns a new TfaAddBackupPhoneDetails instance
@@ -8629,8 +14162,20 @@ func NewTfaAddBackupPhoneDetails() *TfaAddBackupPhoneDetails {
 	return s
 }
 
+// MarshalJSON serializes a TfaAddBackupPhoneDetails instance
+func (u TfaAddBackupPhoneDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TfaAddBackupPhoneDetails instance
+func (u *TfaAddBackupPhoneDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TfaAddSecurityKeyDetails : Added a security key for two-step verification.
 type TfaAddSecurityKeyDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTfaAddSecurityKeyDetails returns a new TfaAddSecurityKeyDetails instance
@@ -8639,9 +14184,21 @@ func NewTfaAddSecurityKeyDetails() *TfaAddSecurityKeyDetails {
 	return s
 }
 
+// MarshalJSON serializes a TfaAddSecurityKeyDetails instance
+func (u TfaAddSecurityKeyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TfaAddSecurityKeyDetails instance
+func (u *TfaAddSecurityKeyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TfaChangeBackupPhoneDetails : Changed the backup phone for two-step
 // verification.
 type TfaChangeBackupPhoneDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTfaChangeBackupPhoneDetails returns a new TfaChangeBackupPhoneDetails instance
@@ -8650,6 +14207,16 @@ func NewTfaChangeBackupPhoneDetails() *TfaChangeBackupPhoneDetails {
 	return s
 }
 
+// MarshalJSON serializes a TfaChangeBackupPhoneDetails instance
+func (u TfaChangeBackupPhoneDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TfaChangeBackupPhoneDetails instance
+func (u *TfaChangeBackupPhoneDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TfaChangePolicyDetails : Change two-step verification policy for the team.
 type TfaChangePolicyDetails struct {
 	// NewValue : New change policy.
@@ -8657,6 +14224,8 @@ type TfaChangePolicyDetails struct {
 	// PreviousValue : Previous change policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *OptionalChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTfaChangePolicyDetails returns a new TfaChangePolicyDetails instance
@@ -8666,6 +14235,16 @@ func NewTfaChangePolicyDetails(NewValue *OptionalChangePolicy) *TfaChangePolicyD
 	return s
 }
 
+// MarshalJSON serializes a TfaChangePolicyDetails instance
+func (u TfaChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TfaChangePolicyDetails instance
+func (u *TfaChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TfaChangeStatusDetails : Enabled, disabled or changed the configuration for
 // two-step verification.
 type TfaChangeStatusDetails struct {
@@ -8676,6 +14255,8 @@ type TfaChangeStatusDetails struct {
 	PreviousValue *TfaConfiguration `json:"previous_value,omitempty"`
 	// UsedRescueCode : Used two factor authentication code.
 	UsedRescueCode bool `json:"used_rescue_code,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTfaChangeStatusDetails returns a new TfaChangeStatusDetails instance
@@ -8685,9 +14266,30 @@ func NewTfaChangeStatusDetails(NewValue *TfaConfiguration) *TfaChangeStatusDetai
 	return s
 }
 
+// MarshalJSON serializes a TfaChangeStatusDetails instance
+func (u TfaChangeStatusDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TfaChangeStatusDetails instance
+func (u *TfaChangeStatusDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TfaConfiguration : has no documentation (yet)
 type TfaConfiguration struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u TfaConfiguration) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for TfaConfiguration
@@ -8699,9 +14301,37 @@ const (
 	TfaConfigurationOther         = "other"
 )
 
+// UnmarshalJSON deserializes into a TfaConfiguration instance
+func (u *TfaConfiguration) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "disabled", "enabled", "sms", "authenticator", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a TfaConfiguration instance
+func (u TfaConfiguration) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TfaRemoveBackupPhoneDetails : Removed the backup phone for two-step
 // verification.
 type TfaRemoveBackupPhoneDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTfaRemoveBackupPhoneDetails returns a new TfaRemoveBackupPhoneDetails instance
@@ -8710,9 +14340,21 @@ func NewTfaRemoveBackupPhoneDetails() *TfaRemoveBackupPhoneDetails {
 	return s
 }
 
+// MarshalJSON serializes a TfaRemoveBackupPhoneDetails instance
+func (u TfaRemoveBackupPhoneDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TfaRemoveBackupPhoneDetails instance
+func (u *TfaRemoveBackupPhoneDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TfaRemoveSecurityKeyDetails : Removed a security key for two-step
 // verification.
 type TfaRemoveSecurityKeyDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTfaRemoveSecurityKeyDetails returns a new TfaRemoveSecurityKeyDetails instance
@@ -8721,8 +14363,20 @@ func NewTfaRemoveSecurityKeyDetails() *TfaRemoveSecurityKeyDetails {
 	return s
 }
 
+// MarshalJSON serializes a TfaRemoveSecurityKeyDetails instance
+func (u TfaRemoveSecurityKeyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TfaRemoveSecurityKeyDetails instance
+func (u *TfaRemoveSecurityKeyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TfaResetDetails : Reset two-step verification for team member.
 type TfaResetDetails struct {
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTfaResetDetails returns a new TfaResetDetails instance
@@ -8731,6 +14385,16 @@ func NewTfaResetDetails() *TfaResetDetails {
 	return s
 }
 
+// MarshalJSON serializes a TfaResetDetails instance
+func (u TfaResetDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TfaResetDetails instance
+func (u *TfaResetDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TwoAccountChangePolicyDetails : Enabled or disabled the option for team
 // members to link a personal Dropbox account in addition to their work account
 // to the same computer.
@@ -8740,6 +14404,8 @@ type TwoAccountChangePolicyDetails struct {
 	// PreviousValue : Previous two account policy. Might be missing due to
 	// historical data gap.
 	PreviousValue *EnableDisableChangePolicy `json:"previous_value,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTwoAccountChangePolicyDetails returns a new TwoAccountChangePolicyDetails instance
@@ -8749,9 +14415,21 @@ func NewTwoAccountChangePolicyDetails(NewValue *EnableDisableChangePolicy) *TwoA
 	return s
 }
 
+// MarshalJSON serializes a TwoAccountChangePolicyDetails instance
+func (u TwoAccountChangePolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TwoAccountChangePolicyDetails instance
+func (u *TwoAccountChangePolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UserLinkedAppLogInfo : User linked app
 type UserLinkedAppLogInfo struct {
 	AppLogInfo
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUserLinkedAppLogInfo returns a new UserLinkedAppLogInfo instance
@@ -8760,6 +14438,16 @@ func NewUserLinkedAppLogInfo() *UserLinkedAppLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a UserLinkedAppLogInfo instance, tagged as a `AppLogInfo`
+func (u UserLinkedAppLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("user_linked_app", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UserLinkedAppLogInfo instance
+func (u *UserLinkedAppLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UserNameLogInfo : User's name logged information
 type UserNameLogInfo struct {
 	// GivenName : Given name.
@@ -8768,6 +14456,8 @@ type UserNameLogInfo struct {
 	Surname string `json:"surname"`
 	// Locale : Locale. Might be missing due to historical data gap.
 	Locale string `json:"locale,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUserNameLogInfo returns a new UserNameLogInfo instance
@@ -8778,10 +14468,22 @@ func NewUserNameLogInfo(GivenName string, Surname string) *UserNameLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a UserNameLogInfo instance
+func (u UserNameLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UserNameLogInfo instance
+func (u *UserNameLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // UserOrTeamLinkedAppLogInfo : User or team linked app. Used when linked type
 // is missing due to historical data gap.
 type UserOrTeamLinkedAppLogInfo struct {
 	AppLogInfo
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewUserOrTeamLinkedAppLogInfo returns a new UserOrTeamLinkedAppLogInfo instance
@@ -8790,9 +14492,21 @@ func NewUserOrTeamLinkedAppLogInfo() *UserOrTeamLinkedAppLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a UserOrTeamLinkedAppLogInfo instance, tagged as a `AppLogInfo`
+func (u UserOrTeamLinkedAppLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("user_or_team_linked_app", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a UserOrTeamLinkedAppLogInfo instance
+func (u *UserOrTeamLinkedAppLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // WebSessionLogInfo : Web session.
 type WebSessionLogInfo struct {
 	SessionLogInfo
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewWebSessionLogInfo returns a new WebSessionLogInfo instance
@@ -8801,6 +14515,16 @@ func NewWebSessionLogInfo() *WebSessionLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a WebSessionLogInfo instance, tagged as a `SessionLogInfo`
+func (u WebSessionLogInfo) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("web", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a WebSessionLogInfo instance
+func (u *WebSessionLogInfo) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // WebSessionsChangeFixedLengthPolicyDetails : Changed how long team members can
 // stay signed in to Dropbox on the web.
 type WebSessionsChangeFixedLengthPolicyDetails struct {
@@ -8808,6 +14532,8 @@ type WebSessionsChangeFixedLengthPolicyDetails struct {
 	NewValue *SessionsManagementSessionLengthPolicy `json:"new_value"`
 	// PreviousValue : Previous session length policy.
 	PreviousValue *SessionsManagementSessionLengthPolicy `json:"previous_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewWebSessionsChangeFixedLengthPolicyDetails returns a new WebSessionsChangeFixedLengthPolicyDetails instance
@@ -8818,6 +14544,16 @@ func NewWebSessionsChangeFixedLengthPolicyDetails(NewValue *SessionsManagementSe
 	return s
 }
 
+// MarshalJSON serializes a WebSessionsChangeFixedLengthPolicyDetails instance
+func (u WebSessionsChangeFixedLengthPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a WebSessionsChangeFixedLengthPolicyDetails instance
+func (u *WebSessionsChangeFixedLengthPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // WebSessionsChangeIdleLengthPolicyDetails : Changed how long team members can
 // be idle while signed in to Dropbox on the web.
 type WebSessionsChangeIdleLengthPolicyDetails struct {
@@ -8825,6 +14561,8 @@ type WebSessionsChangeIdleLengthPolicyDetails struct {
 	NewValue *SessionsManagementIdleLengthPolicy `json:"new_value"`
 	// PreviousValue : Previous idle length policy.
 	PreviousValue *SessionsManagementIdleLengthPolicy `json:"previous_value"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewWebSessionsChangeIdleLengthPolicyDetails returns a new WebSessionsChangeIdleLengthPolicyDetails instance
@@ -8834,3 +14572,13 @@ func NewWebSessionsChangeIdleLengthPolicyDetails(NewValue *SessionsManagementIdl
 	s.PreviousValue = PreviousValue
 	return s
 }
+
+// MarshalJSON serializes a WebSessionsChangeIdleLengthPolicyDetails instance
+func (u WebSessionsChangeIdleLengthPolicyDetails) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a WebSessionsChangeIdleLengthPolicyDetails instance
+func (u *WebSessionsChangeIdleLengthPolicyDetails) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
@@ -21,11 +21,26 @@
 // Package team_policies : has no documentation (yet)
 package team_policies
 
-import "github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+import (
+	"encoding/json"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+)
 
 // EmmState : has no documentation (yet)
 type EmmState struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u EmmState) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for EmmState
@@ -36,10 +51,47 @@ const (
 	EmmStateOther    = "other"
 )
 
+// UnmarshalJSON deserializes into a EmmState instance
+func (u *EmmState) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "disabled", "optional", "required", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a EmmState instance
+func (u EmmState) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharedFolderJoinPolicy : Policy governing which shared folders a team member
 // can join.
 type SharedFolderJoinPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedFolderJoinPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedFolderJoinPolicy
@@ -49,10 +101,47 @@ const (
 	SharedFolderJoinPolicyOther        = "other"
 )
 
+// UnmarshalJSON deserializes into a SharedFolderJoinPolicy instance
+func (u *SharedFolderJoinPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "from_team_only", "from_anyone", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedFolderJoinPolicy instance
+func (u SharedFolderJoinPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharedFolderMemberPolicy : Policy governing who can be a member of a folder
 // shared by a team member.
 type SharedFolderMemberPolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedFolderMemberPolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedFolderMemberPolicy
@@ -62,10 +151,47 @@ const (
 	SharedFolderMemberPolicyOther  = "other"
 )
 
+// UnmarshalJSON deserializes into a SharedFolderMemberPolicy instance
+func (u *SharedFolderMemberPolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "team", "anyone", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedFolderMemberPolicy instance
+func (u SharedFolderMemberPolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SharedLinkCreatePolicy : Policy governing the visibility of shared links.
 // This policy can apply to newly created shared links, or all shared links.
 type SharedLinkCreatePolicy struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SharedLinkCreatePolicy) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SharedLinkCreatePolicy
@@ -76,6 +202,32 @@ const (
 	SharedLinkCreatePolicyOther           = "other"
 )
 
+// UnmarshalJSON deserializes into a SharedLinkCreatePolicy instance
+func (u *SharedLinkCreatePolicy) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "default_public", "default_team_only", "team_only", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a SharedLinkCreatePolicy instance
+func (u SharedLinkCreatePolicy) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // TeamMemberPolicies : Policies governing team members.
 type TeamMemberPolicies struct {
 	// Sharing : Policies governing sharing.
@@ -87,6 +239,8 @@ type TeamMemberPolicies struct {
 	// devices. This is a new feature and in the future we'll be adding more new
 	// fields and additional documentation.
 	EmmState *EmmState `json:"emm_state"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamMemberPolicies returns a new TeamMemberPolicies instance
@@ -97,6 +251,16 @@ func NewTeamMemberPolicies(Sharing *TeamSharingPolicies, EmmState *EmmState) *Te
 	return s
 }
 
+// MarshalJSON serializes a TeamMemberPolicies instance
+func (u TeamMemberPolicies) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamMemberPolicies instance
+func (u *TeamMemberPolicies) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamSharingPolicies : Policies governing sharing within and outside of the
 // team.
 type TeamSharingPolicies struct {
@@ -106,6 +270,8 @@ type TeamSharingPolicies struct {
 	SharedFolderJoinPolicy *SharedFolderJoinPolicy `json:"shared_folder_join_policy"`
 	// SharedLinkCreatePolicy : Who can view shared links owned by team members.
 	SharedLinkCreatePolicy *SharedLinkCreatePolicy `json:"shared_link_create_policy"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamSharingPolicies returns a new TeamSharingPolicies instance
@@ -116,3 +282,13 @@ func NewTeamSharingPolicies(SharedFolderMemberPolicy *SharedFolderMemberPolicy,
 	s.SharedLinkCreatePolicy = SharedLinkCreatePolicy
 	return s
 }
+
+// MarshalJSON serializes a TeamSharingPolicies instance
+func (u TeamSharingPolicies) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamSharingPolicies instance
+func (u *TeamSharingPolicies) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
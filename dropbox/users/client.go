@@ -22,41 +22,64 @@ package users
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
-	"log"
 	"net/http"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/auth"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/common"
 )
 
 // Client interface describes all routes in this namespace
 type Client interface {
 	// GetAccount : Get information about a user's account.
 	GetAccount(arg *GetAccountArg) (res *BasicAccount, err error)
+	// GetAccountContext : Like `getAccount`, but the request is bound to ctx.
+	GetAccountContext(ctx context.Context, arg *GetAccountArg) (res *BasicAccount, err error)
 	// GetAccountBatch : Get information about multiple user accounts.  At most
 	// 300 accounts may be queried per request.
 	GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccount, err error)
+	// GetAccountBatchContext : Like `getAccountBatch`, but the request is bound
+	// to ctx.
+	GetAccountBatchContext(ctx context.Context, arg *GetAccountBatchArg) (res []*BasicAccount, err error)
 	// GetCurrentAccount : Get information about the current user's account.
 	GetCurrentAccount() (res *FullAccount, err error)
+	// GetCurrentAccountContext : Like `getCurrentAccount`, but the request is
+	// bound to ctx.
+	GetCurrentAccountContext(ctx context.Context) (res *FullAccount, err error)
 	// GetSpaceUsage : Get the space usage information for the current user's
 	// account.
 	GetSpaceUsage() (res *SpaceUsage, err error)
+	// GetSpaceUsageContext : Like `getSpaceUsage`, but the request is bound to
+	// ctx.
+	GetSpaceUsageContext(ctx context.Context) (res *SpaceUsage, err error)
 }
 
-type apiImpl dropbox.Context
+// ClientImpl implements Client by calling the Dropbox API
+type ClientImpl dropbox.Context
 
-//GetAccountAPIError is an error-wrapper for the get_account route
+var _ Client = (*ClientImpl)(nil)
+
+// GetAccountAPIError is an error-wrapper for the get_account route
 type GetAccountAPIError struct {
 	dropbox.APIError
 	EndpointError *GetAccountError `json:"error"`
 }
 
-func (dbx *apiImpl) GetAccount(arg *GetAccountArg) (res *BasicAccount, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetAccountAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetAccount(arg *GetAccountArg) (res *BasicAccount, err error) {
+	return dbx.GetAccountContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetAccountContext(ctx context.Context, arg *GetAccountArg) (res *BasicAccount, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -69,19 +92,20 @@ func (dbx *apiImpl) GetAccount(arg *GetAccountArg) (res *BasicAccount, err error
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "users", "get_account", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "users", "get_account", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -92,9 +116,6 @@ func (dbx *apiImpl) GetAccount(arg *GetAccountArg) (res *BasicAccount, err error
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -112,31 +133,36 @@ func (dbx *apiImpl) GetAccount(arg *GetAccountArg) (res *BasicAccount, err error
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetAccountBatchAPIError is an error-wrapper for the get_account_batch route
+// GetAccountBatchAPIError is an error-wrapper for the get_account_batch route
 type GetAccountBatchAPIError struct {
 	dropbox.APIError
 	EndpointError *GetAccountBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccount, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetAccountBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccount, err error) {
+	return dbx.GetAccountBatchContext(context.Background(), arg)
+}
 
-	if dbx.Config.Verbose {
-		log.Printf("arg: %v", arg)
+func (dbx *ClientImpl) GetAccountBatchContext(ctx context.Context, arg *GetAccountBatchArg) (res []*BasicAccount, err error) {
+	if err = arg.Validate(); err != nil {
+		return
 	}
 	b, err := json.Marshal(arg)
 	if err != nil {
@@ -149,19 +175,20 @@ func (dbx *apiImpl) GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccoun
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "users", "get_account_batch", headers, bytes.NewReader(b))
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "users", "get_account_batch", headers, bytes.NewReader(b))
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -172,9 +199,6 @@ func (dbx *apiImpl) GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccoun
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -192,46 +216,52 @@ func (dbx *apiImpl) GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccoun
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetCurrentAccountAPIError is an error-wrapper for the get_current_account route
+// GetCurrentAccountAPIError is an error-wrapper for the get_current_account route
 type GetCurrentAccountAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) GetCurrentAccount() (res *FullAccount, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetCurrentAccountAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetCurrentAccount() (res *FullAccount, err error) {
+	return dbx.GetCurrentAccountContext(context.Background())
+}
 
+func (dbx *ClientImpl) GetCurrentAccountContext(ctx context.Context) (res *FullAccount, err error) {
 	headers := map[string]string{}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "users", "get_current_account", headers, nil)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "users", "get_current_account", headers, nil)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -242,9 +272,6 @@ func (dbx *apiImpl) GetCurrentAccount() (res *FullAccount, err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -262,46 +289,52 @@ func (dbx *apiImpl) GetCurrentAccount() (res *FullAccount, err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
-//GetSpaceUsageAPIError is an error-wrapper for the get_space_usage route
+// GetSpaceUsageAPIError is an error-wrapper for the get_space_usage route
 type GetSpaceUsageAPIError struct {
 	dropbox.APIError
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) GetSpaceUsage() (res *SpaceUsage, err error) {
-	cli := dbx.Client
+// Unwrap returns the embedded dropbox.APIError
+func (e GetSpaceUsageAPIError) Unwrap() error {
+	return e.APIError
+}
+
+func (dbx *ClientImpl) GetSpaceUsage() (res *SpaceUsage, err error) {
+	return dbx.GetSpaceUsageContext(context.Background())
+}
 
+func (dbx *ClientImpl) GetSpaceUsageContext(ctx context.Context) (res *SpaceUsage, err error) {
 	headers := map[string]string{}
 	if dbx.Config.AsMemberID != "" {
 		headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID
 	}
-
-	req, err := (*dropbox.Context)(dbx).NewRequest("api", "rpc", true, "users", "get_space_usage", headers, nil)
+	pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)
 	if err != nil {
 		return
 	}
-	if dbx.Config.Verbose {
-		log.Printf("req: %v", req)
+	if pathRoot != "" {
+		headers["Dropbox-API-Path-Root"] = pathRoot
 	}
 
-	resp, err := cli.Do(req)
-	if dbx.Config.Verbose {
-		log.Printf("resp: %v", resp)
+	req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "api", "rpc", "user", "users", "get_space_usage", headers, nil)
+	if err != nil {
+		return
 	}
+
+	resp, err := (*dropbox.Context)(dbx).Do(req)
 	if err != nil {
 		return
 	}
@@ -312,9 +345,6 @@ func (dbx *apiImpl) GetSpaceUsage() (res *SpaceUsage, err error) {
 		return
 	}
 
-	if dbx.Config.Verbose {
-		log.Printf("body: %s", body)
-	}
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(body, &res)
 		if err != nil {
@@ -332,22 +362,26 @@ func (dbx *apiImpl) GetSpaceUsage() (res *SpaceUsage, err error) {
 		err = apiError
 		return
 	}
-	var apiError dropbox.APIError
-	if resp.StatusCode == http.StatusBadRequest {
-		apiError.ErrorSummary = string(body)
-		err = apiError
+	err = auth.HandleCommonAuthErrors(resp, body)
+	if err != nil {
 		return
 	}
-	err = json.Unmarshal(body, &apiError)
+	err = common.HandleCommonPathRootErrors(resp, body)
 	if err != nil {
 		return
 	}
-	err = apiError
+	err = dropbox.HandleCommonAPIErrors(resp, body)
 	return
 }
 
 // New returns a Client implementation for this namespace
-func New(c dropbox.Config) *apiImpl {
-	ctx := apiImpl(dropbox.NewContext(c))
+func New(c dropbox.Config) *ClientImpl {
+	return NewFromContext(dropbox.NewContext(c))
+}
+
+// NewFromContext returns a Client implementation for this namespace
+// that shares the http.Client and tokens of c
+func NewFromContext(c dropbox.Context) *ClientImpl {
+	ctx := ClientImpl(c)
 	return &ctx
 }
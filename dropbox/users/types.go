@@ -24,6 +24,8 @@ package users
 
 import (
 	"encoding/json"
+	"fmt"
+	"unicode/utf8"
 
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
 	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/team_policies"
@@ -47,6 +49,8 @@ type Account struct {
 	ProfilePhotoUrl string `json:"profile_photo_url,omitempty"`
 	// Disabled : Whether the user has been disabled.
 	Disabled bool `json:"disabled"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewAccount returns a new Account instance
@@ -60,6 +64,16 @@ func NewAccount(AccountId string, Name *Name, Email string, EmailVerified bool,
 	return s
 }
 
+// MarshalJSON serializes a Account instance
+func (u Account) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a Account instance
+func (u *Account) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // BasicAccount : Basic information about any account.
 type BasicAccount struct {
 	Account
@@ -69,6 +83,8 @@ type BasicAccount struct {
 	// TeamMemberId : The user's unique team member id. This field will only be
 	// present if the user is part of a team and `is_teammate` is true.
 	TeamMemberId string `json:"team_member_id,omitempty"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewBasicAccount returns a new BasicAccount instance
@@ -83,6 +99,16 @@ func NewBasicAccount(AccountId string, Name *Name, Email string, EmailVerified b
 	return s
 }
 
+// MarshalJSON serializes a BasicAccount instance
+func (u BasicAccount) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a BasicAccount instance
+func (u *BasicAccount) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FullAccount : Detailed information about the current user's account.
 type FullAccount struct {
 	Account
@@ -107,6 +133,8 @@ type FullAccount struct {
 	IsPaired bool `json:"is_paired"`
 	// AccountType : What type of account this user has.
 	AccountType *users_common.AccountType `json:"account_type"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFullAccount returns a new FullAccount instance
@@ -124,12 +152,24 @@ func NewFullAccount(AccountId string, Name *Name, Email string, EmailVerified bo
 	return s
 }
 
+// MarshalJSON serializes a FullAccount instance
+func (u FullAccount) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FullAccount instance
+func (u *FullAccount) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // Team : Information about a team.
 type Team struct {
 	// Id : The team's unique ID.
 	Id string `json:"id"`
 	// Name : The name of the team.
 	Name string `json:"name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeam returns a new Team instance
@@ -140,11 +180,23 @@ func NewTeam(Id string, Name string) *Team {
 	return s
 }
 
+// MarshalJSON serializes a Team instance
+func (u Team) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a Team instance
+func (u *Team) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // FullTeam : Detailed information about a team.
 type FullTeam struct {
 	Team
 	// SharingPolicies : Team policies governing sharing.
 	SharingPolicies *team_policies.TeamSharingPolicies `json:"sharing_policies"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewFullTeam returns a new FullTeam instance
@@ -156,10 +208,22 @@ func NewFullTeam(Id string, Name string, SharingPolicies *team_policies.TeamShar
 	return s
 }
 
+// MarshalJSON serializes a FullTeam instance
+func (u FullTeam) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a FullTeam instance
+func (u *FullTeam) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // GetAccountArg : has no documentation (yet)
 type GetAccountArg struct {
 	// AccountId : A user's account identifier.
 	AccountId string `json:"account_id"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetAccountArg returns a new GetAccountArg instance
@@ -169,11 +233,43 @@ func NewGetAccountArg(AccountId string) *GetAccountArg {
 	return s
 }
 
+// MarshalJSON serializes a GetAccountArg instance
+func (u GetAccountArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetAccountArg instance
+func (u *GetAccountArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetAccountArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if utf8.RuneCountInString(s.AccountId) < 40 {
+		return &dropbox.ValidationError{
+			Field:  "account_id",
+			Reason: "must have at least 40 characters",
+		}
+	}
+	if utf8.RuneCountInString(s.AccountId) > 40 {
+		return &dropbox.ValidationError{
+			Field:  "account_id",
+			Reason: "must have at most 40 characters",
+		}
+	}
+	return nil
+}
+
 // GetAccountBatchArg : has no documentation (yet)
 type GetAccountBatchArg struct {
 	// AccountIds : List of user account identifiers.  Should not contain any
 	// duplicate account IDs.
 	AccountIds []string `json:"account_ids"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewGetAccountBatchArg returns a new GetAccountBatchArg instance
@@ -183,12 +279,61 @@ func NewGetAccountBatchArg(AccountIds []string) *GetAccountBatchArg {
 	return s
 }
 
+// MarshalJSON serializes a GetAccountBatchArg instance
+func (u GetAccountBatchArg) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a GetAccountBatchArg instance
+func (u *GetAccountBatchArg) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
+// Validate checks that s satisfies the constraints of the API spec
+func (s *GetAccountBatchArg) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if len(s.AccountIds) < 1 {
+		return &dropbox.ValidationError{
+			Field:  "account_ids",
+			Reason: "must have at least 1 items",
+		}
+	}
+	for i, v := range s.AccountIds {
+		if utf8.RuneCountInString(v) < 40 {
+			return &dropbox.ValidationError{
+				Field:  fmt.Sprintf("account_ids[%d]", i),
+				Reason: "must have at least 40 characters",
+			}
+		}
+		if utf8.RuneCountInString(v) > 40 {
+			return &dropbox.ValidationError{
+				Field:  fmt.Sprintf("account_ids[%d]", i),
+				Reason: "must have at most 40 characters",
+			}
+		}
+	}
+	return nil
+}
+
 // GetAccountBatchError : has no documentation (yet)
 type GetAccountBatchError struct {
 	dropbox.Tagged
 	// NoAccount : The value is an account ID specified in
 	// `GetAccountBatchArg.account_ids` that does not exist.
 	NoAccount string `json:"no_account,omitempty"`
+	raw       string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetAccountBatchError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetAccountBatchError
@@ -201,27 +346,66 @@ const (
 func (u *GetAccountBatchError) UnmarshalJSON(body []byte) error {
 	type wrap struct {
 		dropbox.Tagged
+		// NoAccount : The value is an account ID specified in
+		// `GetAccountBatchArg.account_ids` that does not exist.
+		NoAccount json.RawMessage `json:"no_account,omitempty"`
 	}
 	var w wrap
 	var err error
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "no_account":
-		err = json.Unmarshal(body, &u.NoAccount)
+		err = json.Unmarshal(w.NoAccount, &u.NoAccount)
 
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a GetAccountBatchError instance
+func (u GetAccountBatchError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "no_account":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			NoAccount string `json:"no_account"`
+		}{u.Tagged, u.NoAccount})
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetAccountBatchError reports whether err carries a GetAccountBatchError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetAccountBatchError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetAccountBatchError)(nil), tags...)
+}
+
 // GetAccountError : has no documentation (yet)
 type GetAccountError struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u GetAccountError) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for GetAccountError
@@ -230,10 +414,45 @@ const (
 	GetAccountErrorOther     = "other"
 )
 
+// UnmarshalJSON deserializes into a GetAccountError instance
+func (u *GetAccountError) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "no_account", "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a GetAccountError instance
+func (u GetAccountError) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
+// IsGetAccountError reports whether err carries a GetAccountError, either as the endpoint
+// error of a route or nested inside it, whose tag is one of tags (or any
+// tag if none are given).
+func IsGetAccountError(err error, tags ...string) bool {
+	return dropbox.IsEndpointError(err, (*GetAccountError)(nil), tags...)
+}
+
 // IndividualSpaceAllocation : has no documentation (yet)
 type IndividualSpaceAllocation struct {
 	// Allocated : The total space allocated to the user's account (bytes).
 	Allocated uint64 `json:"allocated"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewIndividualSpaceAllocation returns a new IndividualSpaceAllocation instance
@@ -243,6 +462,16 @@ func NewIndividualSpaceAllocation(Allocated uint64) *IndividualSpaceAllocation {
 	return s
 }
 
+// MarshalJSON serializes a IndividualSpaceAllocation instance
+func (u IndividualSpaceAllocation) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a IndividualSpaceAllocation instance
+func (u *IndividualSpaceAllocation) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // Name : Representations for a person's name to assist with
 // internationalization.
 type Name struct {
@@ -260,6 +489,8 @@ type Name struct {
 	// AbbreviatedName : An abbreviated form of the person's name. Their
 	// initials in most locales.
 	AbbreviatedName string `json:"abbreviated_name"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewName returns a new Name instance
@@ -273,6 +504,16 @@ func NewName(GivenName string, Surname string, FamiliarName string, DisplayName
 	return s
 }
 
+// MarshalJSON serializes a Name instance
+func (u Name) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a Name instance
+func (u *Name) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // SpaceAllocation : Space is allocated differently based on the type of
 // account.
 type SpaceAllocation struct {
@@ -282,6 +523,17 @@ type SpaceAllocation struct {
 	Individual *IndividualSpaceAllocation `json:"individual,omitempty"`
 	// Team : The user shares space with other members of their team.
 	Team *TeamSpaceAllocation `json:"team,omitempty"`
+	raw  string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u SpaceAllocation) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for SpaceAllocation
@@ -306,7 +558,7 @@ func (u *SpaceAllocation) UnmarshalJSON(body []byte) error {
 	if err = json.Unmarshal(body, &w); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
 	switch u.Tag {
 	case "individual":
 		err = json.Unmarshal(body, &u.Individual)
@@ -320,16 +572,36 @@ func (u *SpaceAllocation) UnmarshalJSON(body []byte) error {
 		if err != nil {
 			return err
 		}
+	case "other":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
 	}
 	return nil
 }
 
+// MarshalJSON serializes a SpaceAllocation instance
+func (u SpaceAllocation) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "individual":
+		return dropbox.MarshalTagged(u.Tag, u.Individual)
+
+	case "team":
+		return dropbox.MarshalTagged(u.Tag, u.Team)
+
+	}
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
+
 // SpaceUsage : Information about a user's space usage and quota.
 type SpaceUsage struct {
 	// Used : The user's total space usage (bytes).
 	Used uint64 `json:"used"`
 	// Allocation : The user's space allocation.
 	Allocation *SpaceAllocation `json:"allocation"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewSpaceUsage returns a new SpaceUsage instance
@@ -340,12 +612,24 @@ func NewSpaceUsage(Used uint64, Allocation *SpaceAllocation) *SpaceUsage {
 	return s
 }
 
+// MarshalJSON serializes a SpaceUsage instance
+func (u SpaceUsage) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a SpaceUsage instance
+func (u *SpaceUsage) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
+
 // TeamSpaceAllocation : has no documentation (yet)
 type TeamSpaceAllocation struct {
 	// Used : The total space currently used by the user's team (bytes).
 	Used uint64 `json:"used"`
 	// Allocated : The total space allocated to the user's team (bytes).
 	Allocated uint64 `json:"allocated"`
+	// Extra : Fields unknown to the SDK, serialized back as they were
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // NewTeamSpaceAllocation returns a new TeamSpaceAllocation instance
@@ -355,3 +639,13 @@ func NewTeamSpaceAllocation(Used uint64, Allocated uint64) *TeamSpaceAllocation
 	s.Allocated = Allocated
 	return s
 }
+
+// MarshalJSON serializes a TeamSpaceAllocation instance
+func (u TeamSpaceAllocation) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalStruct("", &u, u.Extra)
+}
+
+// UnmarshalJSON deserializes into a TeamSpaceAllocation instance
+func (u *TeamSpaceAllocation) UnmarshalJSON(body []byte) error {
+	return dropbox.UnmarshalStruct(body, u, &u.Extra)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package userstest provides a fake users.Client for tests.
+package userstest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/users"
+)
+
+// ErrNotStubbed is returned by the routes of a Fake that are not stubbed
+var ErrNotStubbed = errors.New("userstest: route not stubbed")
+
+// Call is a call made to a Fake
+type Call struct {
+	// Name of the route, e.g. "get_account"
+	Route string
+	// Argument of the call (nil for routes without one)
+	Arg interface{}
+	// Content passed to upload routes
+	Content io.Reader
+}
+
+// Fake is a users.Client for tests. Each route calls the function in the
+// matching field, e.g. GetAccountFunc, or returns ErrNotStubbed if the field is
+// nil. All calls are recorded. The zero value is ready to use.
+type Fake struct {
+	// Stubs GetAccount and GetAccountContext
+	GetAccountFunc func(ctx context.Context, arg *users.GetAccountArg) (res *users.BasicAccount, err error)
+	// Stubs GetAccountBatch and GetAccountBatchContext
+	GetAccountBatchFunc func(ctx context.Context, arg *users.GetAccountBatchArg) (res []*users.BasicAccount, err error)
+	// Stubs GetCurrentAccount and GetCurrentAccountContext
+	GetCurrentAccountFunc func(ctx context.Context) (res *users.FullAccount, err error)
+	// Stubs GetSpaceUsage and GetSpaceUsageContext
+	GetSpaceUsageFunc func(ctx context.Context) (res *users.SpaceUsage, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ users.Client = (*Fake)(nil)
+
+// Calls returns the calls made so far, or only those to the given route
+func (f *Fake) Calls(route ...string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []Call
+	for _, c := range f.calls {
+		if len(route) == 0 || c.Route == route[0] {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (f *Fake) record(route string, arg interface{}, content io.Reader) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, Call{route, arg, content})
+}
+
+// GetAccount implements users.Client
+func (f *Fake) GetAccount(arg *users.GetAccountArg) (res *users.BasicAccount, err error) {
+	return f.GetAccountContext(context.Background(), arg)
+}
+
+// GetAccountContext implements users.Client
+func (f *Fake) GetAccountContext(ctx context.Context, arg *users.GetAccountArg) (res *users.BasicAccount, err error) {
+	f.record("get_account", arg, nil)
+	if f.GetAccountFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetAccountFunc(ctx, arg)
+}
+
+// GetAccountBatch implements users.Client
+func (f *Fake) GetAccountBatch(arg *users.GetAccountBatchArg) (res []*users.BasicAccount, err error) {
+	return f.GetAccountBatchContext(context.Background(), arg)
+}
+
+// GetAccountBatchContext implements users.Client
+func (f *Fake) GetAccountBatchContext(ctx context.Context, arg *users.GetAccountBatchArg) (res []*users.BasicAccount, err error) {
+	f.record("get_account_batch", arg, nil)
+	if f.GetAccountBatchFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetAccountBatchFunc(ctx, arg)
+}
+
+// GetCurrentAccount implements users.Client
+func (f *Fake) GetCurrentAccount() (res *users.FullAccount, err error) {
+	return f.GetCurrentAccountContext(context.Background())
+}
+
+// GetCurrentAccountContext implements users.Client
+func (f *Fake) GetCurrentAccountContext(ctx context.Context) (res *users.FullAccount, err error) {
+	f.record("get_current_account", nil, nil)
+	if f.GetCurrentAccountFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetCurrentAccountFunc(ctx)
+}
+
+// GetSpaceUsage implements users.Client
+func (f *Fake) GetSpaceUsage() (res *users.SpaceUsage, err error) {
+	return f.GetSpaceUsageContext(context.Background())
+}
+
+// GetSpaceUsageContext implements users.Client
+func (f *Fake) GetSpaceUsageContext(ctx context.Context) (res *users.SpaceUsage, err error) {
+	f.record("get_space_usage", nil, nil)
+	if f.GetSpaceUsageFunc == nil {
+		err = ErrNotStubbed
+		return
+	}
+	return f.GetSpaceUsageFunc(ctx)
+}
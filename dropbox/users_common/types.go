@@ -22,11 +22,26 @@
 // the users namespace
 package users_common
 
-import "github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+import (
+	"encoding/json"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+)
 
 // AccountType : What type of account this user has.
 type AccountType struct {
 	dropbox.Tagged
+	raw string
+}
+
+// Raw returns the JSON that u was decoded from if its tag is unknown to
+// this version of the SDK, e.g. a variant added to the API since, and nil
+// otherwise. It is serialized back as is.
+func (u AccountType) Raw() json.RawMessage {
+	if u.raw == "" {
+		return nil
+	}
+	return json.RawMessage(u.raw)
 }
 
 // Valid tag values for AccountType
@@ -35,3 +50,29 @@ const (
 	AccountTypePro      = "pro"
 	AccountTypeBusiness = "business"
 )
+
+// UnmarshalJSON deserializes into a AccountType instance
+func (u *AccountType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""
+	switch u.Tag {
+	case "basic", "pro", "business":
+	default:
+		// Keep what this version of the SDK cannot decode
+		u.raw = string(body)
+
+	}
+	return nil
+}
+
+// MarshalJSON serializes a AccountType instance
+func (u AccountType) MarshalJSON() ([]byte, error) {
+	return dropbox.MarshalUnion(u.Tagged, u.Raw())
+}
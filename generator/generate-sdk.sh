@@ -13,6 +13,7 @@ gen_dir=$(dirname ${base_dir})/dropbox
 
 stone -v -a :all go_types.stoneg.py "$gen_dir" "$spec_dir"/*.stone
 stone -v -a :all go_client.stoneg.py "$gen_dir" "$spec_dir"/*.stone
+stone -v -a :all go_fakes.stoneg.py "$gen_dir" "$spec_dir"/*.stone
 
 # Update SDK and API spec versions
 sdk_version="1.0.0-beta"
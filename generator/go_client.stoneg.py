@@ -2,18 +2,35 @@ import os
 
 from stone.generator import CodeGenerator
 from stone.data_type import (
+    is_struct_type,
+    is_union_type,
     is_void_type,
-    is_struct_type
 )
 
 from go_helpers import (
     HEADER,
+    fmt_route_call,
+    fmt_route_signature,
     fmt_type,
     fmt_var,
     generate_doc,
 )
 
 
+# Namespaces whose user routes a team admin can call with
+# Dropbox-API-Select-Admin, for specs that predate the select_admin_mode
+# route attribute.
+ADMIN_NAMESPACES = {'files', 'sharing'}
+
+
+def _selects_admin(namespace, route):
+    if route.attrs.get('auth', '') != 'user':
+        return False
+    if 'select_admin_mode' in route.attrs:
+        return bool(route.attrs['select_admin_mode'])
+    return namespace.name in ADMIN_NAMESPACES
+
+
 class GoClientGenerator(CodeGenerator):
     def generate(self, api):
         for namespace in api.namespaces.values():
@@ -33,54 +50,55 @@ class GoClientGenerator(CodeGenerator):
             with self.block('type Client interface'):
                 for route in namespace.routes:
                     generate_doc(self, route)
-                    self.emit(self._generate_route_signature(namespace, route))
+                    self.emit(fmt_route_signature(namespace, route))
+                    self.emit_wrapped_text(
+                        '%sContext : Like `%s`, but the request is bound to ctx.' %
+                        (fmt_var(route.name), fmt_var(route.name, export=False)),
+                        prefix='// ')
+                    self.emit(fmt_route_signature(namespace, route, with_ctx=True))
             self.emit()
 
-            self.emit('type apiImpl dropbox.Context')
+            self.emit('// ClientImpl implements Client by calling the Dropbox API')
+            self.emit('type ClientImpl dropbox.Context')
+            self.emit()
+            self.emit('var _ Client = (*ClientImpl)(nil)')
+            self.emit()
             for route in namespace.routes:
                 self._generate_route(namespace, route)
             self.emit('// New returns a Client implementation for this namespace')
-            with self.block('func New(c dropbox.Config) *apiImpl'):
-                self.emit('ctx := apiImpl(dropbox.NewContext(c))')
+            with self.block('func New(c dropbox.Config) *ClientImpl'):
+                self.emit('return NewFromContext(dropbox.NewContext(c))')
+            self.emit()
+            self.emit('// NewFromContext returns a Client implementation for this namespace')
+            self.emit('// that shares the http.Client and tokens of c')
+            with self.block('func NewFromContext(c dropbox.Context) *ClientImpl'):
+                self.emit('ctx := ClientImpl(c)')
                 self.emit('return &ctx')
 
-    def _generate_route_signature(self, namespace, route):
-        req = fmt_type(route.arg_data_type, namespace)
-        res = fmt_type(route.result_data_type, namespace, use_interface=True)
-        fn = fmt_var(route.name)
-        style = route.attrs.get('style', 'rpc')
-
-        arg = '' if is_void_type(route.arg_data_type) else 'arg {req}'
-        ret = '(err error)' if is_void_type(route.result_data_type) else \
-            '(res {res}, err error)'
-        signature = '{fn}(' + arg + ') ' + ret
-        if style == 'download':
-            signature = '{fn}(' + arg + \
-                ') (res {res}, content io.ReadCloser, err error)'
-        elif style == 'upload':
-            signature = '{fn}(' + arg + ', content io.Reader) ' + ret
-            if is_void_type(route.arg_data_type):
-                signature = '{fn}(content io.Reader) ' + ret
-        return signature.format(fn=fn, req=req, res=res)
-
-
     def _generate_route(self, namespace, route):
         out = self.emit
         fn = fmt_var(route.name)
         err = fmt_type(route.error_data_type, namespace)
-        out('//%sAPIError is an error-wrapper for the %s route' %
+        out('// %sAPIError is an error-wrapper for the %s route' %
             (fn, route.name))
         with self.block('type {fn}APIError struct'.format(fn=fn)):
             out('dropbox.APIError')
             out('EndpointError {err} `json:"error"`'.format(err=err))
         out()
+        out('// Unwrap returns the embedded dropbox.APIError')
+        with self.block('func (e {fn}APIError) Unwrap() error'.format(fn=fn)):
+            out('return e.APIError')
+        out()
 
-        signature = 'func (dbx *apiImpl) ' + self._generate_route_signature(
+        signature = 'func (dbx *ClientImpl) ' + fmt_route_signature(
             namespace, route)
         with self.block(signature):
-            out('cli := dbx.Client')
-            out()
+            out('return ' + fmt_route_call('dbx', route))
+        out()
 
+        signature = 'func (dbx *ClientImpl) ' + fmt_route_signature(
+            namespace, route, with_ctx=True)
+        with self.block(signature):
             self._generate_request(namespace, route)
             self._generate_post()
             self._generate_response(route)
@@ -89,7 +107,7 @@ class GoClientGenerator(CodeGenerator):
                 ok_check += ' || resp.StatusCode == http.StatusPartialContent'
             with self.block(ok_check):
                 self._generate_result(route)
-            self._generate_error_handling(route)
+            self._generate_error_handling(namespace, route)
 
         out()
 
@@ -100,9 +118,12 @@ class GoClientGenerator(CodeGenerator):
         style = route.attrs.get('style', 'rpc')
 
         body = 'nil'
+        arg = route.arg_data_type
+        if is_union_type(arg) or \
+                (is_struct_type(arg) and not arg.has_enumerated_subtypes()):
+            with self.block('if err = arg.Validate(); err != nil'):
+                out('return')
         if not is_void_type(route.arg_data_type):
-            with self.block('if dbx.Config.Verbose'):
-                out('log.Printf("arg: %v", arg)')
             out('b, err := json.Marshal(arg)')
             with self.block('if err != nil'):
                 out('return')
@@ -128,26 +149,32 @@ class GoClientGenerator(CodeGenerator):
         if fmt_var(route.name) == "Download":
             out('for k, v := range arg.ExtraHeaders { headers[k] = v }')
         if auth != 'noauth' and auth != 'team':
-            with self.block('if dbx.Config.AsMemberID != ""'):
+            out('if dbx.Config.AsMemberID != "" {')
+            with self.indent():
                 out('headers["Dropbox-API-Select-User"] = dbx.Config.AsMemberID')
+            if _selects_admin(namespace, route):
+                out('} else if dbx.Config.AsAdminID != "" {')
+                with self.indent():
+                    out('headers["Dropbox-API-Select-Admin"] = dbx.Config.AsAdminID')
+            out('}')
+        if auth == 'user':
+            out('pathRoot, err := (*dropbox.Context)(dbx).PathRootHeader(ctx)')
+            with self.block('if err != nil'):
+                out('return')
+            with self.block('if pathRoot != ""'):
+                out('headers["Dropbox-API-Path-Root"] = pathRoot')
         out()
 
-        authed = 'false' if auth == 'noauth' else 'true'
-        out('req, err := (*dropbox.Context)(dbx).NewRequest("{}", "{}", {}, "{}", "{}", headers, {})'.format(
-            host, style, authed, namespace.name, route.name, body))
+        out('req, err := (*dropbox.Context)(dbx).NewRequestContext(ctx, "{}", "{}", "{}", "{}", "{}", headers, {})'.format(
+            host, style, auth, namespace.name, route.name, body))
         with self.block('if err != nil'):
             out('return')
-        with self.block('if dbx.Config.Verbose'):
-            out('log.Printf("req: %v", req)')
         out()
 
     def _generate_post(self):
         out = self.emit
 
-        out('resp, err := cli.Do(req)')
-        with self.block('if dbx.Config.Verbose'):
-            out('log.Printf("resp: %v", resp)')
-
+        out('resp, err := (*dropbox.Context)(dbx).Do(req)')
         with self.block('if err != nil'):
             out('return')
         out()
@@ -157,18 +184,21 @@ class GoClientGenerator(CodeGenerator):
         style = route.attrs.get('style', 'rpc')
         if style == 'download':
             out('body := []byte(resp.Header.Get("Dropbox-API-Result"))')
-            out('content = resp.Body')
         else:
             out('defer resp.Body.Close()')
             with self.block('body, err := ioutil.ReadAll(resp.Body);'
                             'if err != nil'):
                 out('return')
             out()
-        with self.block('if dbx.Config.Verbose'):
-            out('log.Printf("body: %s", body)')
 
-    def _generate_error_handling(self, route):
+    def _generate_error_handling(self, namespace, route):
         out = self.emit
+        style = route.attrs.get('style', 'rpc')
+        if style == 'download':
+            out('defer resp.Body.Close()')
+            with self.block('body, err = ioutil.ReadAll(resp.Body);'
+                            'if err != nil'):
+                out('return')
         with self.block('if resp.StatusCode == http.StatusConflict'):
             out('var apiError %sAPIError' % fmt_var(route.name))
             with self.block('err = json.Unmarshal(body, &apiError);'
@@ -176,19 +206,21 @@ class GoClientGenerator(CodeGenerator):
                 out('return')
             out('err = apiError')
             out('return')
-        out('var apiError dropbox.APIError')
-        with self.block('if resp.StatusCode == http.StatusBadRequest'):
-            out('apiError.ErrorSummary = string(body)')
-            out('err = apiError')
-            out('return')
-        with self.block('err = json.Unmarshal(body, &apiError);'
-                        'if err != nil'):
+        auth_ns = '' if namespace.name == 'auth' else 'auth.'
+        out('err = %sHandleCommonAuthErrors(resp, body)' % auth_ns)
+        with self.block('if err != nil'):
             out('return')
-        out('err = apiError')
+        if route.attrs.get('auth', '') == 'user':
+            out('err = common.HandleCommonPathRootErrors(resp, body)')
+            with self.block('if err != nil'):
+                out('return')
+        out('err = dropbox.HandleCommonAPIErrors(resp, body)')
         out('return')
 
     def _generate_result(self, route):
         out = self.emit
+        if route.attrs.get('style', 'rpc') == 'download':
+            out('content = resp.Body')
         if is_struct_type(route.result_data_type) and \
                 route.result_data_type.has_enumerated_subtypes():
             out('var tmp %sUnion' % fmt_var(route.result_data_type.name, export=False))
@@ -0,0 +1,117 @@
+import os
+
+from stone.api import ApiNamespace
+from stone.generator import CodeGenerator
+from stone.data_type import is_void_type
+
+from go_helpers import (
+    HEADER,
+    fmt_route_call,
+    fmt_route_signature,
+    fmt_var,
+)
+
+
+class GoFakesGenerator(CodeGenerator):
+    """Generates a package <namespace>test with a fake Client for every
+    namespace with routes."""
+
+    def generate(self, api):
+        for namespace in api.namespaces.values():
+            if len(namespace.routes) > 0:
+                self._generate_fake(namespace)
+
+    def _generate_fake(self, namespace):
+        pkg = namespace.name + 'test'
+        # Types are qualified relative to the fake's package
+        fake_ns = ApiNamespace(pkg)
+        file_name = os.path.join(self.target_folder_path, namespace.name,
+                                 pkg, 'fake.go')
+        with self.output_to_relative_path(file_name):
+            self.emit_raw(HEADER)
+            self.emit()
+            self.emit('// Package %s provides a fake %s.Client for tests.' %
+                      (pkg, namespace.name))
+            self.emit('package %s' % pkg)
+            self.emit()
+            self.emit('// ErrNotStubbed is returned by the routes of a Fake '
+                      'that are not stubbed')
+            self.emit('var ErrNotStubbed = errors.New("%s: route not stubbed")' % pkg)
+            self.emit()
+            self.emit('// Call is a call made to a Fake')
+            with self.block('type Call struct'):
+                self.emit('// Name of the route, e.g. "%s"' % namespace.routes[0].name)
+                self.emit('Route string')
+                self.emit('// Argument of the call (nil for routes without one)')
+                self.emit('Arg interface{}')
+                self.emit('// Content passed to upload routes')
+                self.emit('Content io.Reader')
+            self.emit()
+            self.emit_wrapped_text(
+                'Fake is a %s.Client for tests. Each route calls the '
+                'function in the matching field, e.g. %sFunc, or returns '
+                'ErrNotStubbed if the field is nil. All calls are recorded. '
+                'The zero value is ready to use.' %
+                (namespace.name, fmt_var(namespace.routes[0].name)),
+                prefix='// ')
+            with self.block('type Fake struct'):
+                for route in namespace.routes:
+                    fn = fmt_var(route.name)
+                    self.emit('// Stubs %s and %sContext' % (fn, fn))
+                    sig = fmt_route_signature(fake_ns, route, with_ctx=True)
+                    self.emit('%sFunc func%s' % (fn, sig[len(fn + 'Context'):]))
+                self.emit()
+                self.emit('mu    sync.Mutex')
+                self.emit('calls []Call')
+            self.emit()
+            self.emit('var _ %s.Client = (*Fake)(nil)' % namespace.name)
+            self.emit()
+            self.emit('// Calls returns the calls made so far, or only those to the '
+                      'given route')
+            with self.block('func (f *Fake) Calls(route ...string) []Call'):
+                self.emit('f.mu.Lock()')
+                self.emit('defer f.mu.Unlock()')
+                self.emit('var calls []Call')
+                with self.block('for _, c := range f.calls'):
+                    with self.block('if len(route) == 0 || c.Route == route[0]'):
+                        self.emit('calls = append(calls, c)')
+                self.emit('return calls')
+            self.emit()
+            with self.block('func (f *Fake) record(route string, arg interface{}, '
+                            'content io.Reader)'):
+                self.emit('f.mu.Lock()')
+                self.emit('defer f.mu.Unlock()')
+                self.emit('f.calls = append(f.calls, Call{route, arg, content})')
+            self.emit()
+            for route in namespace.routes:
+                self._generate_route(namespace, fake_ns, route)
+
+    def _generate_route(self, namespace, fake_ns, route):
+        out = self.emit
+        fn = fmt_var(route.name)
+        style = route.attrs.get('style', 'rpc')
+
+        out('// %s implements %s.Client' % (fn, namespace.name))
+        with self.block('func (f *Fake) ' + fmt_route_signature(fake_ns, route)):
+            out('return ' + fmt_route_call('f', route))
+        out()
+
+        args = ['ctx']
+        arg = 'nil'
+        if not is_void_type(route.arg_data_type):
+            args.append('arg')
+            arg = 'arg'
+        content = 'nil'
+        if style == 'upload':
+            args.append('content')
+            content = 'content'
+        out('// %sContext implements %s.Client' % (fn, namespace.name))
+        with self.block('func (f *Fake) ' +
+                        fmt_route_signature(fake_ns, route, with_ctx=True)):
+            out('f.record("%s", %s, %s)' % (route.name, arg, content))
+            with self.block('if f.%sFunc == nil' % fn):
+                out('err = ErrNotStubbed')
+                out('return')
+            out('return f.%sFunc(%s)' % (fn, ', '.join(args)))
+        out()
+
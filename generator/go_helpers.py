@@ -13,6 +13,7 @@ from stone.data_type import (
     is_composite_type,
     is_list_type,
     is_struct_type,
+    is_void_type,
     Void,
 )
 from stone.target import helpers
@@ -84,6 +85,44 @@ def fmt_type(data_type, namespace=None, use_interface=False):
         return _type_table.get(data_type.__class__, '*' + type_name)
 
 
+def fmt_route_signature(namespace, route, with_ctx=False):
+    """Formats the signature of a route's method in a Client, with types
+    qualified relative to namespace."""
+    req = fmt_type(route.arg_data_type, namespace)
+    res = fmt_type(route.result_data_type, namespace, use_interface=True)
+    fn = fmt_var(route.name)
+    style = route.attrs.get('style', 'rpc')
+
+    args = []
+    if with_ctx:
+        fn += 'Context'
+        args.append('ctx context.Context')
+    if not is_void_type(route.arg_data_type):
+        args.append('arg {req}')
+    if style == 'upload':
+        args.append('content io.Reader')
+    ret = '(err error)' if is_void_type(route.result_data_type) else \
+        '(res {res}, err error)'
+    if style == 'download':
+        ret = '(res {res}, content io.ReadCloser, err error)'
+    signature = '{fn}(' + ', '.join(args) + ') ' + ret
+    return signature.format(fn=fn, req=req, res=res)
+
+
+def fmt_route_call(receiver, route):
+    """Formats the call that the method without a context makes to its
+    Context variant."""
+    style = route.attrs.get('style', 'rpc')
+    args = ['context.Background()']
+    if not is_void_type(route.arg_data_type):
+        args.append('arg')
+    if style == 'upload':
+        args.append('content')
+    return '{recv}.{fn}Context({args})'.format(recv=receiver,
+                                               fn=fmt_var(route.name),
+                                               args=', '.join(args))
+
+
 def fmt_var(name, export=True, check_reserved=False):
     s = helpers.fmt_pascal(name) if export else helpers.fmt_camel(name)
     return _rename_if_reserved(s) if check_reserved else s
@@ -128,7 +167,7 @@ def _needs_base_type(data_type):
 
 
 def needs_base_type(struct):
-    for field in struct.fields:
+    for field in struct.all_fields:
         if _needs_base_type(field.data_type):
             return True
     return False
@@ -0,0 +1,102 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+)
+
+// The errors that any route may return live here rather than in package
+// dropbox because they carry the unions of this namespace, and this package
+// imports dropbox.
+
+// AuthAPIError is returned when a request fails with 401 Unauthorized, e.g.
+// because the access token is invalid or the user is suspended.
+type AuthAPIError struct {
+	dropbox.APIError
+	AuthError *AuthError `json:"error"`
+}
+
+// Unwrap returns the embedded dropbox.APIError
+func (e AuthAPIError) Unwrap() error {
+	return e.APIError
+}
+
+// AccessAPIError is returned when a request fails with 403 Forbidden because
+// the caller does not have access to the route.
+type AccessAPIError struct {
+	dropbox.APIError
+	AccessError *AccessError `json:"error"`
+}
+
+// Unwrap returns the embedded dropbox.APIError
+func (e AccessAPIError) Unwrap() error {
+	return e.APIError
+}
+
+// RateLimitAPIError is returned when a request fails with 429 Too Many
+// Requests. RateLimitError.RetryAfter holds the number of seconds to wait.
+type RateLimitAPIError struct {
+	dropbox.APIError
+	RateLimitError *RateLimitError `json:"error"`
+}
+
+// Unwrap returns the embedded dropbox.APIError
+func (e RateLimitAPIError) Unwrap() error {
+	return e.APIError
+}
+
+// HandleCommonAuthErrors decodes the auth related errors that any route may
+// return. It returns nil if resp is not one of them.
+func HandleCommonAuthErrors(resp *http.Response, body []byte) error {
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		var apiError AuthAPIError
+		if err := json.Unmarshal(body, &apiError); err != nil {
+			apiError.ErrorSummary = string(body)
+		}
+		return apiError
+	case http.StatusForbidden:
+		var apiError AccessAPIError
+		if err := json.Unmarshal(body, &apiError); err != nil {
+			apiError.ErrorSummary = string(body)
+		}
+		return apiError
+	case http.StatusTooManyRequests:
+		var apiError RateLimitAPIError
+		if err := json.Unmarshal(body, &apiError); err != nil {
+			apiError.ErrorSummary = string(body)
+		}
+		if apiError.RateLimitError == nil {
+			apiError.RateLimitError = NewRateLimitError(
+				&RateLimitReason{Tagged: dropbox.Tagged{Tag: RateLimitReasonTooManyRequests}})
+			if secs, err := strconv.ParseUint(resp.Header.Get("Retry-After"), 10, 64); err == nil {
+				apiError.RateLimitError.RetryAfter = secs
+			}
+		}
+		return apiError
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package client provides a single Client for all Dropbox API namespaces.
+package client
+
+import (
+	"sync"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/auth"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/paper"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/sharing"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/team"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/team_log"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/users"
+)
+
+// Client gives access to the clients of every namespace. They are created
+// on first use and share one http.Client, token source, rate limiter and
+// the rest of the Config.
+type Client struct {
+	ctx dropbox.Context
+
+	mu      sync.Mutex
+	auth    auth.Client
+	files   files.Client
+	paper   paper.Client
+	sharing sharing.Client
+	team    team.Client
+	teamLog team_log.Client
+	users   users.Client
+}
+
+// New returns a Client with the given Config.
+func New(c dropbox.Config) *Client {
+	return &Client{ctx: dropbox.NewContext(c)}
+}
+
+// Config returns the Config of the Client.
+func (c *Client) Config() dropbox.Config {
+	return c.ctx.Config
+}
+
+// AsMember returns a Client that calls user routes as the team member with
+// the given ID, like Config.AsMemberID, instead of any admin set by AsAdmin.
+// It shares the http.Client and tokens of c.
+func (c *Client) AsMember(id string) *Client {
+	ctx := c.ctx
+	ctx.Config.AsMemberID = id
+	ctx.Config.AsAdminID = ""
+	return &Client{ctx: ctx}
+}
+
+// AsAdmin returns a Client that calls user routes as the team admin with the
+// given ID, like Config.AsAdminID, instead of any member set by AsMember. It
+// shares the http.Client and tokens of c.
+func (c *Client) AsAdmin(id string) *Client {
+	ctx := c.ctx
+	ctx.Config.AsAdminID = id
+	ctx.Config.AsMemberID = ""
+	return &Client{ctx: ctx}
+}
+
+// Auth returns the client for the auth namespace.
+func (c *Client) Auth() auth.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.auth == nil {
+		c.auth = auth.NewFromContext(c.ctx)
+	}
+	return c.auth
+}
+
+// Files returns the client for the files namespace.
+func (c *Client) Files() files.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.files == nil {
+		c.files = files.NewFromContext(c.ctx)
+	}
+	return c.files
+}
+
+// Paper returns the client for the paper namespace.
+func (c *Client) Paper() paper.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.paper == nil {
+		c.paper = paper.NewFromContext(c.ctx)
+	}
+	return c.paper
+}
+
+// Sharing returns the client for the sharing namespace.
+func (c *Client) Sharing() sharing.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.sharing == nil {
+		c.sharing = sharing.NewFromContext(c.ctx)
+	}
+	return c.sharing
+}
+
+// Team returns the client for the team namespace.
+func (c *Client) Team() team.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.team == nil {
+		c.team = team.NewFromContext(c.ctx)
+	}
+	return c.team
+}
+
+// TeamLog returns the client for the team_log namespace.
+func (c *Client) TeamLog() team_log.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.teamLog == nil {
+		c.teamLog = team_log.NewFromContext(c.ctx)
+	}
+	return c.teamLog
+}
+
+// Users returns the client for the users namespace.
+func (c *Client) Users() users.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.users == nil {
+		c.users = users.NewFromContext(c.ctx)
+	}
+	return c.users
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+// recorder records the headers of the last request and fails it.
+type recorder struct {
+	header http.Header
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.header = req.Header
+	return nil, errors.New("not sent")
+}
+
+func TestSelectHeaders(t *testing.T) {
+	rec := &recorder{}
+	c := New(dropbox.Config{Token: "token", Client: &http.Client{Transport: rec}})
+	member := c.AsAdmin("dbmid:admin").AsMember("dbmid:member")
+	admin := c.AsMember("dbmid:member").AsAdmin("dbmid:admin")
+
+	for _, test := range []struct {
+		name  string
+		call  func()
+		user  string
+		admin string
+	}{
+		{"member files", func() { member.Files().GetMetadata(files.NewGetMetadataArg("/a")) }, "dbmid:member", ""},
+		{"admin files", func() { admin.Files().GetMetadata(files.NewGetMetadataArg("/a")) }, "", "dbmid:admin"},
+		{"admin token revoke", func() { admin.Auth().TokenRevoke() }, "", ""},
+		{"admin current account", func() { admin.Users().GetCurrentAccount() }, "", ""},
+	} {
+		rec.header = nil
+		test.call()
+		if rec.header == nil {
+			t.Errorf("%s: no request sent", test.name)
+			continue
+		}
+		if got := rec.header.Get("Dropbox-API-Select-User"); got != test.user {
+			t.Errorf("%s: Select-User = %q, want %q", test.name, got, test.user)
+		}
+		if got := rec.header.Get("Dropbox-API-Select-Admin"); got != test.admin {
+			t.Errorf("%s: Select-Admin = %q, want %q", test.name, got, test.admin)
+		}
+	}
+}
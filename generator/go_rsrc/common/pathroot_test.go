@@ -0,0 +1,125 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/common"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+// pathRootServer answers every request with status and body, recording the
+// Dropbox-API-Path-Root header of the last one.
+func pathRootServer(t *testing.T, status int, body string) (*httptest.Server, *string) {
+	header := new(string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*header = r.Header.Get("Dropbox-API-Path-Root")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	return server, header
+}
+
+func pathRootClient(server *httptest.Server, root *common.PathRoot) files.Client {
+	config := dropbox.Config{
+		Token: "token",
+		URLGenerator: func(hostType, style, namespace, route string) string {
+			return server.URL + "/2/" + namespace + "/" + route
+		},
+	}
+	if root != nil {
+		config.PathRoot = root
+	}
+	return files.New(config)
+}
+
+const folder = `{".tag":"folder","name":"a","id":"id:a","path_lower":"/a","path_display":"/a"}`
+
+func TestPathRootHeader(t *testing.T) {
+	server, header := pathRootServer(t, http.StatusOK, folder)
+	defer server.Close()
+	team := &common.PathRoot{Tagged: dropbox.Tagged{Tag: common.PathRootTeam}, Team: "123"}
+	shared := &common.PathRoot{Tagged: dropbox.Tagged{Tag: common.PathRootSharedFolder}, SharedFolder: "456"}
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		name string
+		root *common.PathRoot
+		ctx  context.Context
+		want string
+	}{
+		{"none", nil, ctx, ""},
+		{"config", team, ctx, `{".tag":"team","team":"123"}`},
+		{"context", nil, dropbox.WithPathRoot(ctx, shared), `{".tag":"shared_folder","shared_folder":"456"}`},
+		{"override", team, dropbox.WithPathRoot(ctx, shared), `{".tag":"shared_folder","shared_folder":"456"}`},
+	} {
+		*header = ""
+		dbx := pathRootClient(server, test.root)
+		if _, err := dbx.GetMetadataContext(test.ctx, files.NewGetMetadataArg("/a")); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if *header != test.want {
+			t.Errorf("%s: Dropbox-API-Path-Root = %q, want %q", test.name, *header, test.want)
+		}
+	}
+}
+
+func TestPathRootError(t *testing.T) {
+	for _, test := range []struct {
+		body     string
+		tag      string
+		pathRoot string
+	}{
+		{`{"error_summary":"invalid/..","error":{".tag":"invalid","path_root":"789"}}`, common.PathRootErrorInvalid, "789"},
+		{`{"error_summary":"no_permission/..","error":{".tag":"no_permission"}}`, common.PathRootErrorNoPermission, ""},
+		// Later versions of the API renamed invalid to invalid_root; the
+		// tag is kept even though this SDK has no field for it.
+		{`{"error_summary":"invalid_root/..","error":{".tag":"invalid_root","path_root":"789"}}`, "invalid_root", ""},
+	} {
+		server, _ := pathRootServer(t, http.StatusUnprocessableEntity, test.body)
+		dbx := pathRootClient(server, &common.PathRoot{Tagged: dropbox.Tagged{Tag: common.PathRootTeam}, Team: "123"})
+		_, err := dbx.GetMetadata(files.NewGetMetadataArg("/a"))
+		server.Close()
+
+		apiErr, ok := err.(common.PathRootAPIError)
+		if !ok {
+			t.Errorf("%s: got %T %v, want common.PathRootAPIError", test.tag, err, err)
+			continue
+		}
+		if apiErr.PathRootError == nil || apiErr.PathRootError.Tag != test.tag {
+			t.Errorf("%s: got %+v", test.tag, apiErr.PathRootError)
+			continue
+		}
+		if apiErr.ErrorSummary != test.tag+"/.." {
+			t.Errorf("%s: ErrorSummary = %q", test.tag, apiErr.ErrorSummary)
+		}
+		if test.pathRoot != "" && (apiErr.PathRootError.Invalid == nil || apiErr.PathRootError.Invalid.PathRoot != test.pathRoot) {
+			t.Errorf("%s: Invalid = %+v, want path_root %q", test.tag, apiErr.PathRootError.Invalid, test.pathRoot)
+		}
+	}
+}
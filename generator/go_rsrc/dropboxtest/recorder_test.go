@@ -0,0 +1,184 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+)
+
+// binary is a download body that isn't valid UTF-8.
+var binary = func() []byte {
+	b := make([]byte, 256)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}()
+
+// recordedServer serves test/token, test/count and test/download, leaking
+// credentials in its responses.
+func recordedServer() *httptest.Server {
+	var count int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2/test/token":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"refresh_token": "sekrit-body", "name": "token"}`)
+		case "/2/test/count":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"count": %d}`, atomic.AddInt32(&count, 1))
+		case "/2/test/download":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Dropbox-API-Result", `{"id_token": "sekrit-result", "size": 256}`)
+			w.Write(binary)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+// send sends a request for test/route through rec. arg is sent as the body
+// of RPC routes and as the Dropbox-API-Arg header of the others.
+func send(rec *Recorder, url string, style, route, arg string) (*http.Response, []byte, error) {
+	c := dropbox.NewContext(dropbox.Config{
+		Client: rec.Client(),
+		URLGenerator: func(hostType, style, namespace, route string) string {
+			return url + "/2/" + namespace + "/" + route
+		},
+	})
+	headers := map[string]string{"Content-Type": "application/json"}
+	body := strings.NewReader(arg)
+	if style != "rpc" {
+		headers = map[string]string{"Dropbox-API-Arg": arg}
+		body = strings.NewReader("")
+	}
+	req, err := c.NewRequestContext(context.Background(), "api", style, "user", "test", route, headers, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	return resp, b, err
+}
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dropboxtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	server := recordedServer()
+	rec := NewRecorder(dir, ModeRecord)
+	for _, r := range []struct{ style, route, arg string }{
+		{"rpc", "token", `{"b": 1, "a": {"y": 2, "x": 1}, "access_token": "sekrit-arg"}`},
+		{"download", "download", `{"path": "/bin", "password": "sekrit-header"}`},
+		{"rpc", "count", `{}`},
+		{"rpc", "count", `{}`},
+	} {
+		if _, _, err := send(rec, server.URL, r.style, r.route, r.arg); err != nil {
+			t.Fatalf("recording %s: %v", r.route, err)
+		}
+	}
+	server.Close()
+
+	// Credentials are scrubbed from arguments, bodies and results
+	var downloads int
+	err = filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if bytes.Contains(b, []byte("sekrit")) {
+			t.Errorf("%s contains a credential:\n%s", p, b)
+		}
+		if strings.HasPrefix(filepath.Base(p), "download-") {
+			downloads++
+			if !bytes.Contains(b, []byte(`"base64": true`)) {
+				t.Errorf("binary body not base64 encoded:\n%s", b)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if downloads != 1 {
+		t.Errorf("%d download recordings, want 1", downloads)
+	}
+
+	// The server is gone, so everything below is replayed
+	rec = NewRecorder(dir, ModeReplay)
+	_, body, err := send(rec, server.URL, "rpc", "token", `{"access_token": "other", "a": {"x": 1, "y": 2}, "b": 1}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"name":"token","refresh_token":"REDACTED"}`; string(body) != want {
+		t.Errorf("token replayed %s, want %s", body, want)
+	}
+
+	resp, body, err := send(rec, server.URL, "download", "download", `{"password": "other", "path": "/bin"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(body, binary) {
+		t.Errorf("download replayed %q", body)
+	}
+	if got, want := resp.Header.Get("Dropbox-API-Result"), `{"id_token":"REDACTED","size":256}`; got != want {
+		t.Errorf("Dropbox-API-Result replayed %s, want %s", got, want)
+	}
+
+	var counts []string
+	for i := 0; i < 3; i++ {
+		_, body, err := send(rec, server.URL, "rpc", "count", `{}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts = append(counts, string(body))
+	}
+	if got, want := strings.Join(counts, " "), `{"count":1} {"count":2} {"count":2}`; got != want {
+		t.Errorf("count replayed %s, want %s", got, want)
+	}
+
+	_, _, err = send(rec, server.URL, "rpc", "token", `{"b": 2}`)
+	if err == nil || !strings.Contains(err.Error(), `no recording of test/token with {"b":2}`) {
+		t.Errorf("unrecorded request: %v", err)
+	}
+}
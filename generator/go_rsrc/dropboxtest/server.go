@@ -0,0 +1,312 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dropboxtest provides an in-memory emulation of the Dropbox API for
+// tests. It speaks the v2 wire protocol, so the generated clients can be
+// pointed at it with Server.Config.
+package dropboxtest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+// Server emulates the files namespace of the Dropbox API, backed by an
+// in-memory tree that starts out empty.
+type Server struct {
+	*httptest.Server
+
+	// Maximum number of entries returned by each list_folder call
+	PageSize int
+
+	mu       sync.Mutex
+	nodes    map[string]*node   // live entries by lower-cased path
+	history  map[string][]*node // revisions of files by lower-cased path
+	deleted  map[string]string  // display paths of deleted entries by lower-cased path
+	journal  []change
+	changed  chan struct{} // closed and replaced on every change
+	sessions map[string]*session
+	jobs     map[string]interface{} // results of batch jobs by ID
+	seq      int
+	done     chan struct{}
+	closing  sync.Once
+}
+
+// node is a file or folder.
+type node struct {
+	path           string // as displayed
+	id             string
+	folder         bool
+	content        []byte
+	rev            string
+	clientModified time.Time
+	serverModified time.Time
+}
+
+// change is an entry in the journal that cursors point into.
+type change struct {
+	lower    string
+	metadata files.IsMetadata
+}
+
+// session is an upload session.
+type session struct {
+	content []byte
+	closed  bool
+	// A concurrent session holds its chunks by offset until it is
+	// finished, and ends where the chunk that closed it ends
+	concurrent bool
+	chunks     map[uint64][]byte
+	end        uint64
+}
+
+// NewServer starts a Server. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		PageSize: 500,
+		nodes:    make(map[string]*node),
+		history:  make(map[string][]*node),
+		deleted:  make(map[string]string),
+		changed:  make(chan struct{}),
+		sessions: make(map[string]*session),
+		jobs:     make(map[string]interface{}),
+		done:     make(chan struct{}),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close shuts the server down, ending pending long polls. It may be called
+// more than once.
+func (s *Server) Close() {
+	s.closing.Do(func() {
+		close(s.done)
+		s.Server.Close()
+	})
+}
+
+// Config returns a Config for clients that talk to s.
+func (s *Server) Config() dropbox.Config {
+	return dropbox.Config{
+		Token: "dropboxtest",
+		URLGenerator: func(hostType string, style string, namespace string, route string) string {
+			return fmt.Sprintf("%s/2/%s/%s", s.URL, namespace, route)
+		},
+	}
+}
+
+// route is an emulated route. Handlers are called with Server.mu held,
+// except for those marked unlocked.
+type route struct {
+	style    string
+	unlocked bool
+	handle   func(s *Server, r *request) (res interface{}, content []byte, err error)
+}
+
+// request is a decoded request to a route.
+type request struct {
+	ctx     context.Context
+	arg     []byte
+	content []byte
+}
+
+// decode unmarshals the argument of r into v, reporting a bad request if
+// that fails.
+func (r *request) decode(v interface{}) error {
+	if err := json.Unmarshal(r.arg, v); err != nil {
+		return badRequest("invalid argument: %v", err)
+	}
+	return nil
+}
+
+// endpointError is the error of a route, returned with a 409.
+type endpointError struct {
+	err interface{}
+}
+
+func (e endpointError) Error() string {
+	return summary(e.err)
+}
+
+// badRequestError is returned as a 400 with a plain text body.
+type badRequestError string
+
+func (e badRequestError) Error() string {
+	return string(e)
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return badRequestError(fmt.Sprintf(format, args...))
+}
+
+// summary returns the error_summary for err: the tags of err and of the
+// unions nested in it.
+func summary(err interface{}) string {
+	b, _ := json.Marshal(err)
+	var tags []string
+	for b != nil {
+		var u map[string]json.RawMessage
+		var tag string
+		if json.Unmarshal(b, &u) != nil || json.Unmarshal(u[".tag"], &tag) != nil {
+			break
+		}
+		tags = append(tags, tag)
+		b = u[tag]
+		if b == nil {
+			// A struct member is flattened, look for a union in its fields
+			b = nestedUnion(u)
+		}
+	}
+	return strings.Join(tags, "/") + "/.."
+}
+
+// nestedUnion returns the first field of u that is a union, in the order
+// of the field names.
+func nestedUnion(u map[string]json.RawMessage) json.RawMessage {
+	var names []string
+	for name := range u {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var v map[string]json.RawMessage
+		if name != ".tag" && json.Unmarshal(u[name], &v) == nil && v[".tag"] != nil {
+			return u[name]
+		}
+	}
+	return nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	rt, ok := routes[strings.TrimPrefix(req.URL.Path, "/2/files/")]
+	if !ok || !strings.HasPrefix(req.URL.Path, "/2/files/") {
+		http.Error(w, "Unknown API function: "+req.URL.Path, http.StatusNotFound)
+		return
+	}
+	r := &request{ctx: req.Context()}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if rt.style == "rpc" {
+		r.arg = body
+	} else {
+		r.arg = []byte(req.Header.Get("Dropbox-API-Arg"))
+		r.content = body
+	}
+	if len(r.arg) == 0 {
+		r.arg = []byte("null")
+	}
+	if !rt.unlocked {
+		s.mu.Lock()
+	}
+	res, content, err := rt.handle(s, r)
+	if !rt.unlocked {
+		s.mu.Unlock()
+	}
+	switch e := err.(type) {
+	case nil:
+	case endpointError:
+		writeJSON(w, http.StatusConflict, struct {
+			ErrorSummary string      `json:"error_summary"`
+			Error        interface{} `json:"error"`
+		}{e.Error(), e.err})
+		return
+	default:
+		http.Error(w, "Error in call to API function: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if rt.style == "download" {
+		b, err := json.Marshal(res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Dropbox-API-Result", string(b))
+		w.Write(content)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+// cursor is the decoded form of the cursors returned by list_folder.
+type cursor struct {
+	// Lower-cased path of the folder listed
+	Path      string `json:"path"`
+	Recursive bool   `json:"recursive"`
+	// Offset into the folder's entries while the listing is incomplete,
+	// -1 once it has completed
+	Offset int `json:"offset"`
+	// Position in the journal from which changes are reported
+	Position int `json:"position"`
+}
+
+func (c cursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (c cursor, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(b, &c)
+	}
+	if err != nil {
+		return c, badRequest("invalid cursor")
+	}
+	return c, nil
+}
+
+// contains reports whether the change to lower is visible in the listing
+// of c.
+func (c cursor) contains(lower string) bool {
+	if c.Path != "" && lower == c.Path {
+		return c.Recursive
+	}
+	if !strings.HasPrefix(lower, c.Path+"/") {
+		return false
+	}
+	return c.Recursive || !strings.Contains(lower[len(c.Path)+1:], "/")
+}
@@ -0,0 +1,90 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import "reflect"
+
+// IsEndpointError reports whether err, or any error it wraps, carries a
+// union of the same type as union whose tag is one of tags. The union may be
+// the route's endpoint error itself or nested arbitrarily deep inside it, such
+// as the `LookupError` in `DownloadError.Path`. A nil union matches unions of
+// any type; no tags matches any tag.
+func IsEndpointError(err error, union interface{}, tags ...string) bool {
+	var want reflect.Type
+	if union != nil {
+		want = reflect.TypeOf(union)
+	}
+	for err != nil {
+		if findTagged(reflect.ValueOf(err), want, tags) {
+			return true
+		}
+		u, ok := err.(interface {
+			Unwrap() error
+		})
+		if !ok {
+			return false
+		}
+		err = u.Unwrap()
+	}
+	return false
+}
+
+var taggedType = reflect.TypeOf(Tagged{})
+
+// findTagged walks v depth first looking for a matching union.
+func findTagged(v reflect.Value, want reflect.Type, tags []string) bool {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return false
+		}
+		if tagged := v.Elem(); tagged.Kind() == reflect.Struct {
+			if f, ok := tagged.Type().FieldByName("Tagged"); ok && f.Type == taggedType &&
+				(want == nil || v.Type() == want) &&
+				matchTag(tagged.FieldByIndex(f.Index).Interface().(Tagged).Tag, tags) {
+				return true
+			}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if (f.Kind() == reflect.Ptr || f.Kind() == reflect.Struct) && f.Type() != taggedType &&
+			f.CanInterface() && findTagged(f, want, tags) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchTag(tag string, tags []string) bool {
+	if len(tags) == 0 {
+		return true
+	}
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Checkpoint is the state of an upload session, from which an Uploader
+// resumes an upload.
+type Checkpoint struct {
+	SessionID string `json:"session_id"`
+	// Number of bytes that Dropbox has received
+	Offset uint64 `json:"offset"`
+	// Hex SHA-256 of those bytes, to detect content that changed since
+	Hash string `json:"hash"`
+}
+
+// CheckpointStore persists the Checkpoints of an Uploader, keyed by the path
+// that is uploaded to.
+type CheckpointStore interface {
+	// Load returns the Checkpoint saved for key, or nil if there is none
+	Load(key string) (*Checkpoint, error)
+	// Save replaces the Checkpoint saved for key
+	Save(key string, cp *Checkpoint) error
+	// Delete removes the Checkpoint saved for key, if any
+	Delete(key string) error
+}
+
+// FileCheckpointStore is a CheckpointStore that saves Checkpoints as files
+// in Dir.
+type FileCheckpointStore struct {
+	Dir string
+}
+
+func (s FileCheckpointStore) file(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.Dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+// Load implements CheckpointStore.
+func (s FileCheckpointStore) Load(key string) (*Checkpoint, error) {
+	b, err := ioutil.ReadFile(s.file(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cp Checkpoint
+	if err := json.Unmarshal(b, &cp); err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
+
+// Save implements CheckpointStore. Checkpoints are replaced atomically.
+func (s FileCheckpointStore) Save(key string, cp *Checkpoint) error {
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Dir, 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(s.Dir, "checkpoint")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.file(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// Delete implements CheckpointStore.
+func (s FileCheckpointStore) Delete(key string) error {
+	err := os.Remove(s.file(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/dropboxtest"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+func hashOf(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestUploaderResume(t *testing.T) {
+	data := content(2*chunk + 3)
+	changed := append([]byte{data[0] + 1}, data[1:]...)
+
+	for _, test := range []struct {
+		name string
+		// Fails the first upload, leaving a checkpoint
+		fail    map[int]failure
+		content []byte // uploaded by the second upload
+		routes  string // called by the second upload
+		offset  uint64 // of the checkpoint after the first upload
+	}{
+		{"resume", map[int]failure{2: failBefore}, data, "finish", 2 * chunk},
+		{"offset correction", map[int]failure{1: failAfter}, data, "append finish", chunk},
+		{"changed content", map[int]failure{2: failBefore}, changed, "start append finish", 2 * chunk},
+	} {
+		dir, err := ioutil.TempDir("", "checkpoints")
+		if err != nil {
+			t.Fatal(err)
+		}
+		store := files.FileCheckpointStore{Dir: dir}
+		s := dropboxtest.NewServer()
+		c := newClient(s)
+		c.fail = func(call int, route string) failure { return test.fail[call] }
+		u := files.NewUploader(c)
+		u.ChunkSize = chunk
+		u.Checkpoints = store
+		u.MaxResumes = -1
+
+		if _, err = u.Upload(context.Background(), files.NewCommitInfo("/f"), bytes.NewReader(data)); err == nil {
+			t.Fatalf("%s: first upload succeeded", test.name)
+		}
+		cp, err := store.Load("/f")
+		if err != nil || cp == nil || cp.Offset != test.offset || cp.Hash != hashOf(data[:cp.Offset]) {
+			t.Fatalf("%s: checkpoint %+v, %v", test.name, cp, err)
+		}
+
+		c.routes, c.fail = nil, nil
+		u.MaxResumes = 0
+		if _, err = u.Upload(context.Background(), files.NewCommitInfo("/f"), bytes.NewReader(test.content)); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if got := c.called(); got != test.routes {
+			t.Errorf("%s: called %s, want %s", test.name, got, test.routes)
+		}
+		if !bytes.Equal(download(t, s, "/f"), test.content) {
+			t.Errorf("%s: content differs", test.name)
+		}
+		if cp, err = store.Load("/f"); cp != nil || err != nil {
+			t.Errorf("%s: checkpoint %+v, %v left after the upload", test.name, cp, err)
+		}
+		s.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestUploaderResumeExpired(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store := files.FileCheckpointStore{Dir: dir}
+	s := dropboxtest.NewServer()
+	defer s.Close()
+	c := newClient(s)
+	u := files.NewUploader(c)
+	u.ChunkSize = chunk
+	u.Checkpoints = store
+	data := content(2*chunk + 3)
+
+	// A session that the server doesn't know, as if it had expired
+	err = store.Save("/f", &files.Checkpoint{SessionID: "expired", Offset: chunk, Hash: hashOf(data[:chunk])})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = u.Upload(context.Background(), files.NewCommitInfo("/f"), bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.called(), "append start append finish"; got != want {
+		t.Errorf("called %s, want %s", got, want)
+	}
+	if !bytes.Equal(download(t, s, "/f"), data) {
+		t.Error("content differs")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+)
+
+// ContentHashBlockSize is the size of the blocks that a content hash is
+// computed over.
+const ContentHashBlockSize = 4 << 20
+
+// contentHash computes the content_hash of a file: the SHA-256 of the
+// concatenated SHA-256 hashes of its 4 MiB blocks. See
+// https://www.dropbox.com/developers/reference/content-hash
+type contentHash struct {
+	block  hash.Hash // of the current block
+	n      int       // bytes in the current block
+	blocks []byte    // hashes of the complete blocks
+}
+
+// NewContentHash returns a hash.Hash computing the `ContentHash` of
+// FileMetadata, e.g. to skip uploading files that are unchanged or to verify
+// downloads. Its Sum is the binary hash; the API uses its hex encoding.
+func NewContentHash() hash.Hash {
+	return &contentHash{block: sha256.New()}
+}
+
+// ContentHash returns the hex encoded content hash of what is read from r
+// until EOF, as in FileMetadata.ContentHash.
+func ContentHash(r io.Reader) (string, error) {
+	h := NewContentHash()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (h *contentHash) Write(p []byte) (int, error) {
+	written := len(p)
+	for len(p) > 0 {
+		n := ContentHashBlockSize - h.n
+		if n > len(p) {
+			n = len(p)
+		}
+		h.block.Write(p[:n])
+		h.n += n
+		p = p[n:]
+		if h.n == ContentHashBlockSize {
+			h.blocks = h.block.Sum(h.blocks)
+			h.block.Reset()
+			h.n = 0
+		}
+	}
+	return written, nil
+}
+
+// Sum appends the hash to b without changing the state of h.
+func (h *contentHash) Sum(b []byte) []byte {
+	overall := sha256.New()
+	overall.Write(h.blocks)
+	if h.n > 0 {
+		overall.Write(h.block.Sum(nil))
+	}
+	return overall.Sum(b)
+}
+
+func (h *contentHash) Reset() {
+	h.block.Reset()
+	h.n = 0
+	h.blocks = h.blocks[:0]
+}
+
+func (h *contentHash) Size() int {
+	return sha256.Size
+}
+
+func (h *contentHash) BlockSize() int {
+	return sha256.BlockSize
+}
@@ -0,0 +1,244 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/dropboxtest"
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+const chunk = 4 << 20
+
+// failure is how a call to a client fails.
+type failure int
+
+const (
+	succeed    failure = iota
+	failBefore         // the request doesn't reach Dropbox
+	failAfter          // Dropbox handles the request but the response is lost
+)
+
+// client wraps the client of a dropboxtest.Server, recording the upload
+// routes that are called and failing them as decided by fail.
+type client struct {
+	files.Client
+	fail func(call int, route string) failure
+
+	mu      sync.Mutex
+	routes  []string
+	batches []int // number of entries of each finish_batch
+	pending int   // number of checks of finish_batch that are in progress
+}
+
+func newClient(s *dropboxtest.Server) *client {
+	return &client{Client: files.New(s.Config())}
+}
+
+func (c *client) do(route string, send func() error) error {
+	c.mu.Lock()
+	c.routes = append(c.routes, route)
+	f := succeed
+	if c.fail != nil {
+		f = c.fail(len(c.routes)-1, route)
+	}
+	c.mu.Unlock()
+	if f == failBefore {
+		return &url.Error{Op: "Post", URL: route, Err: errors.New("connection refused")}
+	}
+	err := send()
+	if err == nil && f == failAfter {
+		return &url.Error{Op: "Post", URL: route, Err: errors.New("connection reset")}
+	}
+	return err
+}
+
+// called returns the routes called so far, separated by spaces.
+func (c *client) called() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return strings.Join(c.routes, " ")
+}
+
+func (c *client) UploadContext(ctx context.Context, arg *files.CommitInfo, content io.Reader) (res *files.FileMetadata, err error) {
+	err = c.do("upload", func() error {
+		res, err = c.Client.UploadContext(ctx, arg, content)
+		return err
+	})
+	return res, err
+}
+
+func (c *client) UploadSessionStartContext(ctx context.Context, arg *files.UploadSessionStartArg, content io.Reader) (res *files.UploadSessionStartResult, err error) {
+	err = c.do("start", func() error {
+		res, err = c.Client.UploadSessionStartContext(ctx, arg, content)
+		return err
+	})
+	return res, err
+}
+
+func (c *client) UploadSessionAppendV2Context(ctx context.Context, arg *files.UploadSessionAppendArg, content io.Reader) error {
+	return c.do("append", func() error {
+		return c.Client.UploadSessionAppendV2Context(ctx, arg, content)
+	})
+}
+
+func (c *client) UploadSessionFinishContext(ctx context.Context, arg *files.UploadSessionFinishArg, content io.Reader) (res *files.FileMetadata, err error) {
+	err = c.do("finish", func() error {
+		res, err = c.Client.UploadSessionFinishContext(ctx, arg, content)
+		return err
+	})
+	return res, err
+}
+
+// content returns n bytes of random content.
+func content(n int) []byte {
+	b := make([]byte, n)
+	rand.New(rand.NewSource(int64(n))).Read(b)
+	return b
+}
+
+// onlyReader hides the Seek method of its reader.
+type onlyReader struct {
+	io.Reader
+}
+
+// download returns the content of p.
+func download(t *testing.T, s *dropboxtest.Server, p string) []byte {
+	_, body, err := files.New(s.Config()).Download(files.NewDownloadArg(p))
+	if err != nil {
+		t.Fatalf("download %s: %v", p, err)
+	}
+	defer body.Close()
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestUploader(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		size   int
+		routes string
+	}{
+		{"empty", 0, "upload"},
+		{"small", 10, "upload"},
+		{"one chunk", chunk, "upload"},
+		{"two chunks", 2 * chunk, "start finish"},
+		{"chunks", 2*chunk + 3, "start append finish"},
+	} {
+		s := dropboxtest.NewServer()
+		c := newClient(s)
+		u := files.NewUploader(c)
+		u.ChunkSize = chunk
+		var progress []int64
+		u.Progress = func(uploaded int64) { progress = append(progress, uploaded) }
+		data := content(test.size)
+
+		res, err := u.Upload(context.Background(), files.NewCommitInfo("/f"), onlyReader{bytes.NewReader(data)})
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if got := c.called(); got != test.routes {
+			t.Errorf("%s: called %s, want %s", test.name, got, test.routes)
+		}
+		if res.Size != uint64(test.size) || !bytes.Equal(download(t, s, "/f"), data) {
+			t.Errorf("%s: uploaded %d bytes, want %d", test.name, res.Size, test.size)
+		}
+		if len(progress) == 0 || progress[len(progress)-1] != int64(test.size) {
+			t.Errorf("%s: progress %v", test.name, progress)
+		}
+		s.Close()
+	}
+}
+
+func TestUploaderRecover(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		fail   map[int]failure
+		routes string
+	}{
+		{"lost start", map[int]failure{0: failBefore}, "start start append finish"},
+		{"lost append", map[int]failure{1: failBefore}, "start append append finish"},
+		{"incorrect offset", map[int]failure{1: failAfter}, "start append append finish"},
+		{"lost finish", map[int]failure{2: failBefore}, "start append finish finish"},
+	} {
+		s := dropboxtest.NewServer()
+		c := newClient(s)
+		c.fail = func(call int, route string) failure { return test.fail[call] }
+		u := files.NewUploader(c)
+		u.ChunkSize = chunk
+		data := content(2*chunk + 3)
+
+		if _, err := u.Upload(context.Background(), files.NewCommitInfo("/f"), bytes.NewReader(data)); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if got := c.called(); got != test.routes {
+			t.Errorf("%s: called %s, want %s", test.name, got, test.routes)
+		}
+		if !bytes.Equal(download(t, s, "/f"), data) {
+			t.Errorf("%s: content differs", test.name)
+		}
+		s.Close()
+	}
+}
+
+func TestUploaderNoRecover(t *testing.T) {
+	s := dropboxtest.NewServer()
+	defer s.Close()
+	c := newClient(s)
+	c.fail = func(call int, route string) failure {
+		if call == 1 {
+			return failBefore
+		}
+		return succeed
+	}
+	u := files.NewUploader(c)
+	u.ChunkSize = chunk
+	data := content(2*chunk + 3)
+
+	// Content that can't be read again can't be sent again
+	if _, err := u.Upload(context.Background(), files.NewCommitInfo("/f"), onlyReader{bytes.NewReader(data)}); err == nil {
+		t.Error("recovered without a Seeker")
+	}
+
+	// Nor can it be sent again more than MaxResumes times
+	c.routes = nil
+	c.fail = func(call int, route string) failure { return failBefore }
+	u.MaxResumes = 2
+	if _, err := u.Upload(context.Background(), files.NewCommitInfo("/g"), bytes.NewReader(data)); err == nil {
+		t.Error("recovered from persistent errors")
+	}
+	if got := c.called(); got != "start start start" {
+		t.Errorf("called %s, want 3 attempts", got)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// logRequests sends an RPC, an upload and a download request, each
+// answered with an error, and returns what c logged.
+func logRequests(t *testing.T, conf Config) string {
+	s := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Dropbox-Request-Id", "req1")
+		w.Header().Set("Dropbox-API-Result", `{"path_display": "/secret/result.txt", "access_token": "sekrit-result"}`)
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error_summary": "path/conflict/file/..", "error": {".tag": "path", "path": {".tag": "conflict"}}, "user_message": {"text": "/secret/error.txt exists"}}`))
+	})
+	defer s.Close()
+	var buf bytes.Buffer
+	conf.Token = "sekrit-token"
+	conf.Logger = StdLogger{Logger: log.New(&buf, "", 0), Level: LogDebug}
+	c := s.context(conf)
+
+	for _, req := range []struct {
+		style string
+		arg   string
+		body  string
+	}{
+		{"rpc", "", `{"path": "/secret/rpc.txt", "oauth1_token": "sekrit-arg", "id_token": "sekrit-id"}`},
+		{"upload", `{"path": "/secret/upload.txt"}`, "sekrit-content"},
+		{"download", `{"path": "/secret/download.txt"}`, ""},
+	} {
+		headers := map[string]string{"Content-Type": "application/json"}
+		if req.arg != "" {
+			headers = map[string]string{"Dropbox-API-Arg": req.arg}
+		}
+		r, err := c.NewRequestContext(context.Background(), "api", req.style, "user", "files", req.style, headers, strings.NewReader(req.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := c.Do(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	return buf.String()
+}
+
+func TestLogRedaction(t *testing.T) {
+	for _, logPaths := range []bool{false, true} {
+		logged := logRequests(t, Config{LogPaths: logPaths})
+		for _, secret := range []string{"sekrit", "Bearer", "Authorization"} {
+			if strings.Contains(logged, secret) {
+				t.Errorf("LogPaths %v: %q logged:\n%s", logPaths, secret, logged)
+			}
+		}
+		for _, want := range []string{"route=rpc", "route=upload", "route=download", "request_id=req1", "error_summary=path/conflict/file/.."} {
+			if !strings.Contains(logged, want) {
+				t.Errorf("LogPaths %v: %q not logged:\n%s", logPaths, want, logged)
+			}
+		}
+		// Error bodies and results are never logged beyond the summary
+		for _, path := range []string{"/secret/error.txt", "/secret/result.txt"} {
+			if strings.Contains(logged, path) {
+				t.Errorf("LogPaths %v: %s logged:\n%s", logPaths, path, logged)
+			}
+		}
+		for _, path := range []string{"/secret/rpc.txt", "/secret/upload.txt", "/secret/download.txt"} {
+			if strings.Contains(logged, path) != logPaths {
+				t.Errorf("LogPaths %v: %s logged = %v:\n%s", logPaths, path, !logPaths, logged)
+			}
+		}
+	}
+}
+
+func TestLogLevel(t *testing.T) {
+	var buf bytes.Buffer
+	l := StdLogger{Logger: log.New(&buf, "", 0), Level: LogWarn}
+	l.Log(LogInfo, "dropped")
+	l.Log(LogWarn, "kept", Field{"route", "get_metadata"}, Field{"status", 500})
+	if got, want := buf.String(), "[WARN] dropbox: kept route=get_metadata status=500\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestIsCredentialField(t *testing.T) {
+	for _, key := range []string{"access_token", "refresh_token", "id_token", "oauth1_token_secret", "password", "link_password"} {
+		if !IsCredentialField(key) {
+			t.Errorf("IsCredentialField(%q) = false", key)
+		}
+	}
+	for _, key := range []string{"path", "token_type", "name"} {
+		if IsCredentialField(key) {
+			t.Errorf("IsCredentialField(%q) = true", key)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"encoding/json"
+	"expvar"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RequestStats describes a call to a route, including any retries.
+type RequestStats struct {
+	// Status code of the last response (0 if the request failed without one)
+	StatusCode int
+	// Tags of the error returned by the route, e.g. "path/not_found"
+	ErrorTag string
+	// Number of times the request was sent again
+	Retries int
+	// Time until the last response or failure
+	Duration time.Duration
+	// Size of the request bodies sent
+	BytesSent int64
+}
+
+// Metrics receives measurements of the requests sent by the SDK.
+type Metrics interface {
+	// Request is called when a call to route completes, before its response
+	// body is read
+	Request(route RouteInfo, stats RequestStats)
+	// BytesReceived is called with the size of a response body once it
+	// has been closed
+	BytesReceived(route RouteInfo, n int64)
+}
+
+// measure sends req like do and reports it to Config.Metrics.
+func (c *Context) measure(req *http.Request) (*http.Response, error) {
+	route, _ := RouteFromContext(req.Context())
+	var stats RequestStats
+	attempts := 0
+	next := c.roundTrip()
+	start := time.Now()
+	resp, err := c.do(req, func(req *http.Request) (*http.Response, error) {
+		attempts++
+		var body *countingReader
+		if req.Body != nil && req.Body != http.NoBody {
+			body = &countingReader{ReadCloser: req.Body}
+			req.Body = body
+		}
+		resp, err := next(req)
+		if body != nil {
+			stats.BytesSent += body.n
+		}
+		return resp, err
+	})
+	stats.Duration = time.Since(start)
+	if attempts > 1 {
+		stats.Retries = attempts - 1
+	}
+	if resp != nil {
+		stats.StatusCode = resp.StatusCode
+		if resp.StatusCode >= 400 {
+			stats.ErrorTag = errorTag(peekBody(resp))
+		}
+		resp.Body = &countingReader{ReadCloser: resp.Body, onClose: func(n int64) {
+			c.Config.Metrics.BytesReceived(route, n)
+		}}
+	}
+	c.Config.Metrics.Request(route, stats)
+	return resp, err
+}
+
+// errorTag returns the tags of the error in an error response body, joined
+// with "/" for errors nested in the members of unions.
+func errorTag(body []byte) string {
+	var apiError struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if json.Unmarshal(body, &apiError) != nil {
+		return ""
+	}
+	var tag string
+	for raw := apiError.Error; raw != nil; {
+		var u map[string]json.RawMessage
+		if json.Unmarshal(raw, &u) != nil {
+			break
+		}
+		var t string
+		if json.Unmarshal(u[".tag"], &t) != nil {
+			break
+		}
+		if tag != "" {
+			tag += "/"
+		}
+		tag += t
+		raw = u[t]
+	}
+	return tag
+}
+
+// countingReader counts the bytes read from a body.
+type countingReader struct {
+	io.ReadCloser
+	n       int64
+	onClose func(n int64)
+	closed  bool
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+func (r *countingReader) Close() error {
+	if !r.closed && r.onClose != nil {
+		r.onClose(r.n)
+	}
+	r.closed = true
+	return r.ReadCloser.Close()
+}
+
+// ExpvarMetrics is a Metrics that publishes counters for every route as
+// an expvar.Map, e.g.
+//
+//	{"files/upload": {"requests": 2, "status_200": 1, "status_409": 1,
+//	 "error_path/conflict": 1, "retries": 0, "duration_ms": 310,
+//	 "bytes_sent": 1048576, "bytes_received": 420}}
+type ExpvarMetrics struct {
+	mu     sync.Mutex
+	vars   *expvar.Map
+	routes map[string]*expvar.Map
+}
+
+// NewExpvarMetrics returns an ExpvarMetrics published under name. Like
+// expvar.Publish it panics if name is already in use.
+func NewExpvarMetrics(name string) *ExpvarMetrics {
+	return &ExpvarMetrics{
+		vars:   expvar.NewMap(name),
+		routes: make(map[string]*expvar.Map),
+	}
+}
+
+// Request implements Metrics.
+func (m *ExpvarMetrics) Request(route RouteInfo, stats RequestStats) {
+	v := m.route(route)
+	v.Add("requests", 1)
+	if stats.StatusCode == 0 {
+		v.Add("failures", 1)
+	} else {
+		v.Add("status_"+strconv.Itoa(stats.StatusCode), 1)
+	}
+	if stats.ErrorTag != "" {
+		v.Add("error_"+stats.ErrorTag, 1)
+	}
+	v.Add("retries", int64(stats.Retries))
+	v.Add("duration_ms", int64(stats.Duration/time.Millisecond))
+	v.Add("bytes_sent", stats.BytesSent)
+}
+
+// BytesReceived implements Metrics.
+func (m *ExpvarMetrics) BytesReceived(route RouteInfo, n int64) {
+	m.route(route).Add("bytes_received", n)
+}
+
+// route returns the counters for route.
+func (m *ExpvarMetrics) route(route RouteInfo) *expvar.Map {
+	key := route.Namespace + "/" + route.Route
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.routes[key]
+	if !ok {
+		v = new(expvar.Map).Init()
+		m.routes[key] = v
+		m.vars.Set(key, v)
+	}
+	return v
+}
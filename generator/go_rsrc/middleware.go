@@ -0,0 +1,68 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"net/http"
+)
+
+// RouteInfo describes the route a request is sent to.
+type RouteInfo struct {
+	// Namespace of the route, e.g. "files"
+	Namespace string
+	// Name of the route, e.g. "upload_session/append_v2"
+	Route string
+	// Host type: "api", "content" or "notify"
+	Host string
+	// Style: "rpc", "upload" or "download"
+	Style string
+	// The route's `auth` attribute, e.g. "user" or "team"
+	Auth string
+}
+
+type routeInfoKey struct{}
+
+// RouteFromContext returns the route that a request with the given context
+// is sent to. Use it with the context of the request a Middleware receives.
+func RouteFromContext(ctx context.Context) (RouteInfo, bool) {
+	route, ok := ctx.Value(routeInfoKey{}).(RouteInfo)
+	return route, ok
+}
+
+// RoundTripFunc sends a request and returns its response.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the function that sends requests, e.g. to add tracing,
+// collect metrics or inject faults. It is called for every attempt at
+// sending a request, including retries, and can use RouteFromContext to find
+// out which route the request is for.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// roundTrip returns the Context's http.Client wrapped in
+// Config.Middleware.
+func (c *Context) roundTrip() RoundTripFunc {
+	send := RoundTripFunc(c.Client.Do)
+	for i := len(c.Config.Middleware) - 1; i >= 0; i-- {
+		send = c.Config.Middleware[i](send)
+	}
+	return send
+}
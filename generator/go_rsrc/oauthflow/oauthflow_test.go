@@ -0,0 +1,225 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package oauthflow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenServer returns a token endpoint that checks the PKCE verifier against
+// *challenge and replies with status and body.
+func tokenServer(t *testing.T, challenge *string, status int, body string) (*httptest.Server, *int32) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if challenge != nil {
+			sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+			if got := base64.RawURLEncoding.EncodeToString(sum[:]); got != *challenge {
+				t.Errorf("challenge of code_verifier = %q, want %q", got, *challenge)
+			}
+		}
+		if got := r.PostForm.Get("code"); got != "the-code" {
+			t.Errorf("code = %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+	return srv, &calls
+}
+
+func testConfig(srv *httptest.Server) Config {
+	return Config{
+		AppKey:   "key",
+		UsePKCE:  true,
+		Endpoint: oauth2.Endpoint{AuthURL: "https://example.com/authorize", TokenURL: srv.URL},
+		Client:   srv.Client(),
+	}
+}
+
+func TestAuthorizePKCE(t *testing.T) {
+	var challenge string
+	srv, calls := tokenServer(t, &challenge, http.StatusOK,
+		`{"access_token": "tok", "token_type": "bearer", "account_id": "dbid:1"}`)
+	defer srv.Close()
+
+	open := func(authURL string) error {
+		u, err := url.Parse(authURL)
+		if err != nil {
+			return err
+		}
+		q := u.Query()
+		if got := q.Get("code_challenge_method"); got != "S256" {
+			t.Errorf("code_challenge_method = %q", got)
+		}
+		challenge = q.Get("code_challenge")
+		redirect := q.Get("redirect_uri") + "?" + url.Values{"code": {"the-code"}, "state": {q.Get("state")}}.Encode()
+		go func() {
+			resp, err := http.Get(redirect)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	tok, err := Authorize(ctx, testConfig(srv), "127.0.0.1:0", open)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.AccessToken != "tok" || tok.AccountID != "dbid:1" {
+		t.Errorf("token = %+v", tok)
+	}
+	if challenge == "" || atomic.LoadInt32(calls) != 1 {
+		t.Errorf("challenge %q, %d token requests", challenge, *calls)
+	}
+}
+
+func TestExchangeStateMismatch(t *testing.T) {
+	srv, calls := tokenServer(t, nil, http.StatusOK, `{"access_token": "tok", "token_type": "bearer"}`)
+	defer srv.Close()
+	f, err := NewFlow(testConfig(srv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.Exchange(context.Background(), "the-code", f.State()+"x"); err == nil || !strings.Contains(err.Error(), "state mismatch") {
+		t.Errorf("err = %v, want state mismatch", err)
+	}
+	if n := atomic.LoadInt32(calls); n != 0 {
+		t.Errorf("%d token requests after a state mismatch", n)
+	}
+}
+
+func TestExchangeErrors(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		status int
+		body   string
+		want   string
+	}{
+		{"invalid grant", http.StatusBadRequest, `{"error": "invalid_grant", "error_description": "code doesn't exist or has expired"}`, "invalid_grant"},
+		{"server error", http.StatusInternalServerError, `{}`, "500"},
+		{"no access token", http.StatusOK, `{"token_type": "bearer"}`, "access_token"},
+		{"bad json", http.StatusOK, `{"access_token": `, ""},
+	} {
+		srv, _ := tokenServer(t, nil, test.status, test.body)
+		f, err := NewFlow(testConfig(srv))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tok, err := f.Exchange(context.Background(), "the-code", f.State())
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: got %+v, %v; want error containing %q", test.name, tok, err, test.want)
+		}
+		srv.Close()
+	}
+}
+
+func TestLoopback(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		query  string
+		status int
+		want   string
+	}{
+		{"code", "code=abc&state=xyz", http.StatusOK, ""},
+		{"denied", "error=access_denied&error_description=user+said+no", http.StatusBadRequest, "access_denied: user said no"},
+		{"no code", "state=xyz", http.StatusBadRequest, "no code"},
+	} {
+		lb, err := NewLoopback("127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.Get(lb.URL + "?" + test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != test.status {
+			t.Errorf("%s: status %d, want %d", test.name, resp.StatusCode, test.status)
+		}
+		res, err := lb.Wait(context.Background())
+		if test.want == "" {
+			if err != nil || res.Code != "abc" || res.State != "xyz" {
+				t.Errorf("%s: got %+v, %v", test.name, res, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: err = %v, want %q", test.name, err, test.want)
+		}
+		lb.Close()
+	}
+}
+
+func TestLoopbackURL(t *testing.T) {
+	for _, addr := range []string{"127.0.0.1:0", "localhost:0"} {
+		lb, err := NewLoopback(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		host := strings.TrimSuffix(addr, "0")
+		if !strings.HasPrefix(lb.URL, "http://"+host) || strings.HasSuffix(lb.URL, ":0/") {
+			t.Errorf("%s: URL = %q, want the host as given and the listening port", addr, lb.URL)
+		}
+		resp, err := http.Get(lb.URL + "?code=abc")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		lb.Close()
+	}
+}
+
+func TestLoopbackClose(t *testing.T) {
+	lb, err := NewLoopback("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = lb.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := http.Get(lb.URL + "?code=abc"); err == nil {
+		resp.Body.Close()
+		t.Error("server still answers after Close")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = lb.Wait(ctx); err != context.Canceled {
+		t.Errorf("Wait after Close = %v, want %v", err, context.Canceled)
+	}
+}
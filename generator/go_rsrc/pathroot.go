@@ -0,0 +1,51 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type pathRootKey struct{}
+
+// WithPathRoot returns a copy of ctx that makes the routes called with it use
+// root instead of Config.PathRoot. root is typically a *common.PathRoot.
+func WithPathRoot(ctx context.Context, root json.Marshaler) context.Context {
+	return context.WithValue(ctx, pathRootKey{}, root)
+}
+
+// PathRootHeader returns the value of the Dropbox-API-Path-Root header for a
+// request made with ctx, or "" if the request should use the default root.
+func (c *Context) PathRootHeader(ctx context.Context) (string, error) {
+	root := c.Config.PathRoot
+	if r, ok := ctx.Value(pathRootKey{}).(json.Marshaler); ok {
+		root = r
+	}
+	if root == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(root)
+	if err != nil || string(b) == "null" {
+		return "", err
+	}
+	return string(b), nil
+}
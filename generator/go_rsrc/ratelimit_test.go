@@ -0,0 +1,110 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+var (
+	readRoute  = RouteInfo{Namespace: "files", Route: "get_metadata", Host: "api"}
+	writeRoute = RouteInfo{Namespace: "files", Route: "delete_v2", Host: "api"}
+)
+
+// allowed reports whether l admits a request to route without waiting. The
+// request is made with a done context, so Wait fails if it would block.
+func allowed(l *RateLimiter, route RouteInfo) bool {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return l.Wait(ctx, route) == nil
+}
+
+func tooManyRequests(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestRateLimiterZero(t *testing.T) {
+	var l RateLimiter
+	for i := 0; i < 10; i++ {
+		if !allowed(&l, readRoute) {
+			t.Fatalf("request %d limited by a zero RateLimiter", i)
+		}
+	}
+	l.observe(readRoute, &http.Response{StatusCode: http.StatusOK})
+
+	var limited RateLimiter
+	limited.SetClassLimit(RouteClassWrite, 1, 1)
+	if !allowed(&limited, writeRoute) || allowed(&limited, writeRoute) {
+		t.Error("class limit of a zero RateLimiter not applied")
+	}
+}
+
+func TestRateLimiterGlobal(t *testing.T) {
+	l := NewRateLimiter(1, 2)
+	if !allowed(l, readRoute) || !allowed(l, writeRoute) {
+		t.Fatal("burst not allowed")
+	}
+	if allowed(l, readRoute) || allowed(l, writeRoute) {
+		t.Error("requests allowed beyond the global burst")
+	}
+}
+
+func TestRateLimiterClass(t *testing.T) {
+	l := NewRateLimiter(0, 0)
+	l.SetClassLimit(RouteClassWrite, 1, 1)
+	if !allowed(l, writeRoute) {
+		t.Fatal("first write not allowed")
+	}
+	if allowed(l, writeRoute) {
+		t.Error("write allowed beyond the class burst")
+	}
+	for i := 0; i < 10; i++ {
+		if !allowed(l, readRoute) {
+			t.Fatalf("read %d limited by the write class", i)
+		}
+	}
+}
+
+func TestRateLimiterPenalty(t *testing.T) {
+	l := NewRateLimiter(0, 0)
+	l.observe(writeRoute, tooManyRequests(`{"error_summary": "too_many_write_operations/..",
+		"error": {"reason": {".tag": "too_many_write_operations"}, "retry_after": 60}}`))
+	if allowed(l, writeRoute) {
+		t.Error("write allowed while its class is paused")
+	}
+	if !allowed(l, readRoute) {
+		t.Error("read paused by too_many_write_operations")
+	}
+
+	l.observe(readRoute, tooManyRequests(`{"error_summary": "too_many_requests/..",
+		"error": {"reason": {".tag": "too_many_requests"}, "retry_after": 60}}`))
+	if allowed(l, readRoute) {
+		t.Error("read allowed while the global limit is paused")
+	}
+}
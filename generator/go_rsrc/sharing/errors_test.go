@@ -0,0 +1,67 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sharing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ncw/dropbox-sdk-go-unofficial/dropbox/files"
+)
+
+// decode decodes the body of a 409 response like the routes do.
+func decode(t *testing.T, body string, apiError interface{}) {
+	if err := json.Unmarshal([]byte(body), apiError); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsAccessDenied(t *testing.T) {
+	var addMember AddFolderMemberAPIError
+	decode(t, `{"error_summary": "no_permission/..", "error": {".tag": "no_permission"}}`, &addMember)
+	var fileMetadata GetFileMetadataAPIError
+	decode(t, `{"error_summary": "access_error/no_permission/..", "error": {".tag": "access_error",
+		"access_error": {".tag": "no_permission"}}}`, &fileMetadata)
+	var link CreateSharedLinkWithSettingsAPIError
+	decode(t, `{"error_summary": "access_denied/..", "error": {".tag": "access_denied"}}`, &link)
+	var notMember AddFolderMemberAPIError
+	decode(t, `{"error_summary": "access_error/not_a_member/..", "error": {".tag": "access_error",
+		"access_error": {".tag": "not_a_member"}}}`, &notMember)
+	// no_permission of a union outside of sharing
+	var copyRef files.CopyReferenceSaveAPIError
+	decode(t, `{"error_summary": "no_permission/..", "error": {".tag": "no_permission"}}`, &copyRef)
+
+	for _, test := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"route error", addMember, true},
+		{"nested access error", fileMetadata, true},
+		{"shared link", link, true},
+		{"other access error", notMember, false},
+		{"no_permission of another namespace", copyRef, false},
+	} {
+		if got := IsAccessDenied(test.err); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenSource is the oauth2.TokenSource behind a Context's http.Client. It
+// reports new tokens to Config.OnTokenRefresh and allows discarding a token
+// that the server reported as expired.
+type tokenSource struct {
+	mu        sync.Mutex
+	src       oauth2.TokenSource
+	tok       *oauth2.Token
+	expired   bool
+	onRefresh func(*oauth2.Token)
+}
+
+func newTokenSource(c Config, endpoint oauth2.Endpoint) *tokenSource {
+	s := &tokenSource{onRefresh: c.OnTokenRefresh}
+	switch {
+	case c.TokenSource != nil:
+		s.src = c.TokenSource
+	case c.RefreshToken != "":
+		s.src = &refresher{
+			conf: &oauth2.Config{
+				ClientID:     c.AppKey,
+				ClientSecret: c.AppSecret,
+				Endpoint:     endpoint,
+			},
+			refreshToken: c.RefreshToken,
+		}
+		if c.Token != "" {
+			s.tok = &oauth2.Token{AccessToken: c.Token, RefreshToken: c.RefreshToken}
+		}
+	default:
+		s.src = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: c.Token})
+	}
+	return s
+}
+
+// Token implements oauth2.TokenSource.
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tok != nil && s.tok.Valid() && !s.expired {
+		return s.tok, nil
+	}
+	tok, err := s.src.Token()
+	if err != nil {
+		return nil, err
+	}
+	if s.onRefresh != nil && (s.tok == nil || tok.AccessToken != s.tok.AccessToken) {
+		s.onRefresh(tok)
+	}
+	s.tok, s.expired = tok, false
+	return tok, nil
+}
+
+// refresh discards accessToken if it is still the current token, and reports
+// whether a different token is now available. It returns the error of a
+// failed refresh.
+func (s *tokenSource) refresh(accessToken string) (bool, error) {
+	s.mu.Lock()
+	if s.tok != nil && s.tok.AccessToken == accessToken {
+		s.expired = true
+	}
+	s.mu.Unlock()
+	tok, err := s.Token()
+	if err != nil {
+		return false, err
+	}
+	return tok.AccessToken != accessToken, nil
+}
+
+// refresher obtains a new access token from the refresh token on every call.
+type refresher struct {
+	conf         *oauth2.Config
+	refreshToken string
+}
+
+func (r *refresher) Token() (*oauth2.Token, error) {
+	tok, err := r.conf.TokenSource(context.Background(),
+		&oauth2.Token{RefreshToken: r.refreshToken}).Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok.RefreshToken != "" {
+		r.refreshToken = tok.RefreshToken
+	}
+	return tok, nil
+}
+
+// hasAuth reports whether the `auth` attribute allows the given type, e.g.
+// "app" for "app, user".
+func hasAuth(attr, typ string) bool {
+	for _, t := range strings.Split(attr, ",") {
+		if strings.TrimSpace(t) == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// authTransport adds OAuth2 tokens to requests except to those that use app
+// authentication or none at all.
+type authTransport struct {
+	oauth *oauth2.Transport
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !usesToken(req) {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	return t.oauth.RoundTrip(req)
+}
+
+// usesToken reports whether req is authenticated with an OAuth2 token.
+func usesToken(req *http.Request) bool {
+	var auth string
+	if route, ok := RouteFromContext(req.Context()); ok {
+		auth = route.Auth
+	}
+	if auth == "noauth" {
+		return false
+	}
+	return !hasAuth(auth, "app") || req.Header.Get("Authorization") == ""
+}
+
+// isExpiredToken reports whether resp rejected the access token as expired.
+// The body of resp remains readable.
+func isExpiredToken(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	var authError struct {
+		Error Tagged `json:"error"`
+	}
+	return json.Unmarshal(peekBody(resp), &authError) == nil &&
+		authError.Error.Tag == "expired_access_token"
+}
+
+// peekBody reads the body of resp and replaces it with an unread copy.
+func peekBody(resp *http.Response) []byte {
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body
+}
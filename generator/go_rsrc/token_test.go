@@ -0,0 +1,244 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenServer is a token endpoint that issues access tokens access1,
+// access2, ... and, if rotate is set, refresh tokens refresh1, refresh2, ...
+// It records the refresh tokens it is given.
+type tokenServer struct {
+	mu      sync.Mutex
+	issued  int
+	rotate  bool
+	fail    bool
+	delay   time.Duration
+	refresh []string
+}
+
+func (t *tokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	time.Sleep(t.delay)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.refresh = append(t.refresh, r.FormValue("refresh_token"))
+	w.Header().Set("Content-Type", "application/json")
+	if t.fail {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": "invalid_grant", "error_description": "refresh token is invalid or revoked"}`)
+		return
+	}
+	t.issued++
+	resp := fmt.Sprintf(`{"access_token": "access%d", "token_type": "bearer", "expires_in": 14400`, t.issued)
+	if t.rotate {
+		resp += fmt.Sprintf(`, "refresh_token": "refresh%d"`, t.issued)
+	}
+	fmt.Fprint(w, resp+"}")
+}
+
+func (t *tokenServer) calls() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.refresh...)
+}
+
+// newTokenTestServer serves /oauth2/token with tokens and answers every other
+// request with an expired_access_token error unless its access token is
+// valid(token).
+func newTokenTestServer(tokens *tokenServer, valid func(token string) bool) *testServer {
+	return newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/token" {
+			tokens.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !valid(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")) {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error_summary": "expired_access_token/..", "error": {".tag": "expired_access_token"}}`)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+}
+
+// refreshingContext is like context but the token endpoint is s.
+func (s *testServer) refreshingContext(conf Config) Context {
+	c := s.context(conf)
+	c.tokens = newTokenSource(c.Config, oauth2.Endpoint{TokenURL: s.URL + "/oauth2/token"})
+	c.Client = &http.Client{Transport: &authTransport{&oauth2.Transport{Source: c.tokens}}}
+	return c
+}
+
+func tokenConfig() Config {
+	return Config{Token: "access0", RefreshToken: "refresh0", AppKey: "key", AppSecret: "secret"}
+}
+
+func TestTokenRefresh(t *testing.T) {
+	tokens := &tokenServer{}
+	s := newTokenTestServer(tokens, func(token string) bool { return token == "access1" })
+	defer s.Close()
+	var refreshed []string
+	conf := tokenConfig()
+	conf.OnTokenRefresh = func(tok *oauth2.Token) {
+		refreshed = append(refreshed, tok.AccessToken)
+	}
+	c := s.refreshingContext(conf)
+
+	for i := 0; i < 2; i++ {
+		resp, err := rpc(context.Background(), c, strings.NewReader(`{"path": "/a"}`))
+		if err != nil || resp.StatusCode != http.StatusOK {
+			t.Fatalf("request %d: %v, %v", i, resp, err)
+		}
+		resp.Body.Close()
+	}
+	// The first request is sent again with the new token, the second one
+	// uses it straight away
+	if s.count() != 4 {
+		t.Errorf("%d requests, want 4", s.count())
+	}
+	if got := tokens.calls(); len(got) != 1 || got[0] != "refresh0" {
+		t.Errorf("token endpoint called with %q, want [refresh0]", got)
+	}
+	if len(refreshed) != 1 || refreshed[0] != "access1" {
+		t.Errorf("OnTokenRefresh called with %q, want [access1]", refreshed)
+	}
+}
+
+func TestTokenRefreshOnce(t *testing.T) {
+	tokens := &tokenServer{}
+	s := newTokenTestServer(tokens, func(string) bool { return false })
+	defer s.Close()
+	c := s.refreshingContext(tokenConfig())
+
+	resp, err := rpc(context.Background(), c, strings.NewReader(`{"path": "/a"}`))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("got %v, %v; want the second 401", resp, err)
+	}
+	resp.Body.Close()
+	// 2 API requests and 1 token request
+	if s.count() != 3 {
+		t.Errorf("%d requests, want 3", s.count())
+	}
+}
+
+func TestTokenRotation(t *testing.T) {
+	tokens := &tokenServer{rotate: true}
+	// Each access token is only accepted by the request that obtained it
+	var valid int32 = 1
+	s := newTokenTestServer(tokens, func(token string) bool {
+		return token == fmt.Sprintf("access%d", atomic.LoadInt32(&valid))
+	})
+	defer s.Close()
+	c := s.refreshingContext(tokenConfig())
+
+	for i := 0; i < 3; i++ {
+		resp, err := rpc(context.Background(), c, strings.NewReader(`{"path": "/a"}`))
+		if err != nil || resp.StatusCode != http.StatusOK {
+			t.Fatalf("request %d: %v, %v", i, resp, err)
+		}
+		resp.Body.Close()
+		atomic.AddInt32(&valid, 1)
+	}
+	want := []string{"refresh0", "refresh1", "refresh2"}
+	if got := tokens.calls(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("token endpoint called with %q, want %q", got, want)
+	}
+}
+
+func TestTokenRefreshConcurrent(t *testing.T) {
+	tokens := &tokenServer{delay: 50 * time.Millisecond}
+	s := newTokenTestServer(tokens, func(token string) bool { return token == "access1" })
+	defer s.Close()
+	var refreshed int32
+	conf := tokenConfig()
+	conf.OnTokenRefresh = func(*oauth2.Token) { atomic.AddInt32(&refreshed, 1) }
+	c := s.refreshingContext(conf)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := rpc(context.Background(), c, strings.NewReader(`{"path": "/a"}`))
+			if err == nil {
+				resp.Body.Close()
+				if resp.StatusCode != http.StatusOK {
+					err = fmt.Errorf("status %d", resp.StatusCode)
+				}
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if got := tokens.calls(); len(got) != 1 {
+		t.Errorf("%d token requests, want 1", len(got))
+	}
+	if refreshed != 1 {
+		t.Errorf("OnTokenRefresh called %d times, want 1", refreshed)
+	}
+}
+
+func TestTokenRefreshError(t *testing.T) {
+	tokens := &tokenServer{fail: true}
+	s := newTokenTestServer(tokens, func(string) bool { return false })
+	defer s.Close()
+	conf := tokenConfig()
+	conf.Retry = RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond}
+	c := s.refreshingContext(conf)
+
+	resp, err := rpc(context.Background(), c, strings.NewReader(`{"path": "/a"}`))
+	if _, ok := err.(*oauth2.RetrieveError); !ok {
+		t.Fatalf("got %v, %v; want an *oauth2.RetrieveError", resp, err)
+	}
+	// 1 API request and 1 refresh, which oauth2 tries with the client
+	// credentials in the header and then in the body
+	if s.count() != 3 {
+		t.Errorf("%d requests, want 3", s.count())
+	}
+
+	// A request whose first token cannot be obtained fails the same way
+	c = s.refreshingContext(Config{RefreshToken: "refresh0", AppKey: "key", Retry: conf.Retry})
+	c.tokens.tok = nil
+	_, err = rpc(context.Background(), c, strings.NewReader(`{"path": "/a"}`))
+	if err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Errorf("got %v, want invalid_grant", err)
+	}
+	if n := len(tokens.calls()); n != 4 {
+		t.Errorf("%d token requests, want 4", n)
+	}
+}
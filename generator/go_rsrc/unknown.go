@@ -0,0 +1,136 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// MarshalUnion serializes a union whose tag has no value: a void tag, or a
+// tag unknown to the SDK, in which case raw, the JSON the union was decoded
+// from, is returned as it was.
+func MarshalUnion(t Tagged, raw json.RawMessage) ([]byte, error) {
+	if raw != nil {
+		var decoded Tagged
+		if json.Unmarshal(raw, &decoded) == nil && decoded.Tag == t.Tag {
+			return raw, nil
+		}
+	}
+	return json.Marshal(struct {
+		Tag string `json:".tag"`
+	}{t.Tag})
+}
+
+// UnmarshalStruct deserializes body into v, a pointer to a struct, keeping
+// the fields that v doesn't have in extra. It is used by the UnmarshalJSON
+// methods of structs, and doesn't call those of the structs v embeds.
+func UnmarshalStruct(body []byte, v interface{}, extra *map[string]json.RawMessage) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return err
+	}
+	var err error
+	eachField(reflect.ValueOf(v).Elem(), func(name string, _ bool, f reflect.Value) {
+		raw, ok := fields[name]
+		if !ok {
+			return
+		}
+		delete(fields, name)
+		if err == nil {
+			err = json.Unmarshal(raw, f.Addr().Interface())
+		}
+	})
+	if err != nil {
+		return err
+	}
+	// The tag of a struct subtype or of a union member is known
+	delete(fields, ".tag")
+	*extra = nil
+	if len(fields) > 0 {
+		*extra = fields
+	}
+	return nil
+}
+
+// MarshalStruct serializes v, a pointer to a struct, together with the
+// unknown fields in extra. A non-empty tag is added as the ".tag". It
+// doesn't call the MarshalJSON methods of the structs v embeds.
+func MarshalStruct(tag string, v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	fields := make(map[string]json.RawMessage, len(extra))
+	for name, raw := range extra {
+		fields[name] = raw
+	}
+	var err error
+	eachField(reflect.ValueOf(v).Elem(), func(name string, omitEmpty bool, f reflect.Value) {
+		if err != nil || omitEmpty && isEmpty(f) {
+			return
+		}
+		fields[name], err = json.Marshal(f.Interface())
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tag != "" {
+		fields[".tag"], _ = json.Marshal(tag)
+	}
+	return json.Marshal(fields)
+}
+
+// eachField calls fn with the JSON name of every serialized field of the
+// struct v, including those of the structs it embeds.
+func eachField(v reflect.Value, fn func(name string, omitEmpty bool, f reflect.Value)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if sf.Anonymous && tag == "" && sf.Type.Kind() == reflect.Struct {
+			eachField(v.Field(i), fn)
+			continue
+		}
+		if tag == "" || tag == "-" || sf.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		fn(name, strings.HasSuffix(tag, ",omitempty"), v.Field(i))
+	}
+}
+
+// isEmpty reports whether encoding/json omits f from a field tagged
+// omitempty.
+func isEmpty(f reflect.Value) bool {
+	switch f.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return f.Len() == 0
+	case reflect.Bool:
+		return !f.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return f.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return f.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return f.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return f.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// ValidationError is returned as a *ValidationError, without sending a
+// request, for an argument that violates a constraint of the API spec, such
+// as a pattern or a bound.
+type ValidationError struct {
+	// Path of the offending field, e.g. "entries[2].path"
+	Field string
+	// The constraint that was violated, e.g. "must be at most 1000"
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("dropbox: invalid argument: %s %s", e.Field, e.Reason)
+}
+
+// NestValidationError prefixes the field of a ValidationError with the
+// field that contains it. Other errors are returned unchanged.
+func NestValidationError(field string, err error) error {
+	if e, ok := err.(*ValidationError); ok {
+		return &ValidationError{Field: field + "." + e.Field, Reason: e.Reason}
+	}
+	return err
+}
+
+var (
+	patternsMu sync.Mutex
+	patterns   = make(map[string]*regexp.Regexp)
+)
+
+// MatchPattern reports whether the whole of s matches pattern, a regular
+// expression from the API spec. Patterns that Go cannot compile match
+// everything, leaving the check to the server.
+func MatchPattern(s, pattern string) bool {
+	patternsMu.Lock()
+	re, ok := patterns[pattern]
+	if !ok {
+		re, _ = regexp.Compile(`^(?:` + pattern + `)$`)
+		patterns[pattern] = re
+	}
+	patternsMu.Unlock()
+	return re == nil || re.MatchString(s)
+}
@@ -4,11 +4,15 @@ import shutil
 from stone.generator import CodeGenerator
 from stone.data_type import (
     is_boolean_type,
+    is_list_type,
     is_nullable_type,
+    is_numeric_type,
     is_primitive_type,
+    is_string_type,
     is_struct_type,
     is_union_type,
     is_void_type,
+    unwrap_nullable,
 )
 
 from go_helpers import (
@@ -16,20 +20,31 @@ from go_helpers import (
     fmt_type,
     fmt_var,
     generate_doc,
+    needs_base_type,
 )
 
 
 class GoTypesGenerator(CodeGenerator):
     def generate(self, api):
         rsrc_folder = os.path.join(os.path.dirname(__file__), 'go_rsrc')
-        shutil.copy(os.path.join(rsrc_folder, 'sdk.go'),
-                    self.target_folder_path)
+        self._copy_rsrc(rsrc_folder, self.target_folder_path)
+        self._validated = _validated_types(api)
         for namespace in api.namespaces.values():
             self._generate_namespace(namespace)
-            if namespace.name == 'files' or namespace.name == 'sharing':
-                self.logger.info('Copying metadata.go to files')
-                shutil.copy(os.path.join(rsrc_folder, namespace.name, 'metadata.go'),
-                            os.path.join(self.target_folder_path, namespace.name))
+        # Per-namespace resources, and hand-written packages such as oauthflow
+        for name in sorted(os.listdir(rsrc_folder)):
+            src = os.path.join(rsrc_folder, name)
+            if os.path.isdir(src):
+                dst = os.path.join(self.target_folder_path, name)
+                if not os.path.isdir(dst):
+                    os.makedirs(dst)
+                self._copy_rsrc(src, dst)
+
+    def _copy_rsrc(self, src, dst):
+        for name in sorted(os.listdir(src)):
+            if name.endswith('.go'):
+                self.logger.info('Copying %s to %s', name, dst)
+                shutil.copy(os.path.join(src, name), dst)
 
     def _generate_namespace(self, namespace):
         file_name = os.path.join(self.target_folder_path, namespace.name,
@@ -89,7 +104,19 @@ class GoTypesGenerator(CodeGenerator):
             if struct.name in ('DownloadArg',):
                 self.emit('// ExtraHeaders can be used to pass Range, If-None-Match headers')
                 self.emit('ExtraHeaders map[string]string `json:"-"`')
+            if _keeps_extra(struct):
+                self.emit('// Extra : Fields unknown to the SDK, serialized back as they were')
+                self.emit('Extra map[string]json.RawMessage `json:"-"`')
         self._generate_struct_builder(struct)
+        tag = _subtype_tag(struct)
+        if tag is not None:
+            self._generate_subtype_marshaler(struct, tag)
+        elif _keeps_extra(struct):
+            self._generate_struct_marshaler(struct)
+        if _keeps_extra(struct):
+            self._generate_struct_unmarshaler(struct)
+        if id(struct) in self._validated:
+            self._generate_validate(struct)
 
     def _generate_struct_builder(self, struct):
         fields = ["%s %s" % (fmt_var(field.name),
@@ -112,7 +139,7 @@ class GoTypesGenerator(CodeGenerator):
                             default = str(default).lower()
                         self.emit('s.{0} = {1}'.format(fmt_var(field.name), default))
                     elif is_union_type(field.data_type):
-                        self.emit('s.%s = &%s{Tagged:dropbox.Tagged{"%s"}}' %
+                        self.emit('s.%s = &%s{Tagged: dropbox.Tagged{Tag: "%s"}}' %
                                   (fmt_var(field.name),
                                    fmt_type(field.data_type, struct.namespace).lstrip('*'),
                                    field.default.tag_name))
@@ -133,11 +160,25 @@ class GoTypesGenerator(CodeGenerator):
 
     def _generate_union(self, union):
         self._generate_union_helper(union)
+        self._generate_union_marshaler(union)
+        if id(union) in self._validated:
+            self._generate_validate(union)
+        if union.name.endswith('Error'):
+            self._generate_error_predicate(union)
+
+    def _generate_error_predicate(self, union):
+        name = union.name
+        self.emit('// Is{0} reports whether err carries a {0}, either as the endpoint'.format(name))
+        self.emit('// error of a route or nested inside it, whose tag is one of tags (or any')
+        self.emit('// tag if none are given).')
+        with self.block('func Is{0}(err error, tags ...string) bool'.format(name)):
+            self.emit('return dropbox.IsEndpointError(err, (*{0})(nil), tags...)'.format(name))
+        self.emit()
 
     def _generate_union_helper(self, u):
         name = u.name
         namespace = u.namespace
-        fields = u.fields
+        fields = u.all_fields
         if is_struct_type(u) and u.has_enumerated_subtypes():
             name = fmt_var(name, export=False) + 'Union'
             fields = u.get_enumerated_subtypes()
@@ -149,6 +190,16 @@ class GoTypesGenerator(CodeGenerator):
                     continue
                 self._generate_field(field, union_field=True,
                                      namespace=namespace)
+            # A string rather than a json.RawMessage keeps unions comparable
+            self.emit('raw string')
+        self.emit()
+        self.emit('// Raw returns the JSON that u was decoded from if its tag is unknown to')
+        self.emit('// this version of the SDK, e.g. a variant added to the API since, and nil')
+        self.emit('// otherwise. It is serialized back as is.')
+        with self.block('func (u %s) Raw() json.RawMessage' % name):
+            with self.block('if u.raw == ""'):
+                self.emit('return nil')
+            self.emit('return json.RawMessage(u.raw)')
         self.emit()
         self.emit('// Valid tag values for %s' % fmt_var(u.name))
         with self.block('const', delim=('(', ')')):
@@ -156,18 +207,12 @@ class GoTypesGenerator(CodeGenerator):
                 self.emit('%s%s = "%s"' % (fmt_var(u.name), fmt_var(field.name), field.name))
         self.emit()
 
-        num_void_fields = sum([is_void_type(f.data_type) for f in fields])
-        # Simple structure, no need in UnmarshalJSON
-        if len(fields) == num_void_fields:
-            return
-
         self.emit('// UnmarshalJSON deserializes into a %s instance' % name)
         with self.block('func (u *%s) UnmarshalJSON(body []byte) error' % name):
             with self.block('type wrap struct'):
                 self.emit('dropbox.Tagged')
                 for field in fields:
-                    if is_void_type(field.data_type) or \
-                            is_primitive_type(field.data_type):
+                    if is_void_type(field.data_type):
                         continue
                     self._generate_field(field, union_field=True,
                                          namespace=namespace, raw=True)
@@ -175,24 +220,245 @@ class GoTypesGenerator(CodeGenerator):
             self.emit('var err error')
             with self.block('if err = json.Unmarshal(body, &w); err != nil'):
                 self.emit('return err')
-            self.emit('u.Tag = w.Tag')
+            self.emit('u.Tagged, u.raw = dropbox.Tagged{Tag: w.Tag}, ""')
             with self.block('switch u.Tag'):
                 for field in fields:
                     if is_void_type(field.data_type):
                         continue
                     field_name = fmt_var(field.name)
+                    data_type, _ = unwrap_nullable(field.data_type)
                     with self.block('case "%s":' % field.name, delim=(None, None)):
-                        if is_union_type(field.data_type):
-                            self.emit('err = json.Unmarshal(w.{0}, &u.{0})'
+                        if _is_flattened(data_type):
+                            self.emit('err = json.Unmarshal(body, &u.{0})'
                                             .format(field_name))
-                        elif is_struct_type(field.data_type) and \
-                            field.data_type.has_enumerated_subtypes():
-                            self.emit("u.{0}, err = Is{1}FromJSON(body)"
-                                      .format(field_name, field.data_type.name))
+                        elif is_struct_type(data_type):
+                            self.emit("u.{0}, err = Is{1}FromJSON(w.{0})"
+                                      .format(field_name, data_type.name))
                         else:
-                            self.emit('err = json.Unmarshal(body, &u.{0})'
+                            self.emit('err = json.Unmarshal(w.{0}, &u.{0})'
                                             .format(field_name))
                     with self.block("if err != nil"):
                         self.emit("return err")
+                void_tags = ['"%s"' % f.name for f in fields if is_void_type(f.data_type)]
+                if void_tags:
+                    self.emit('case %s:' % ', '.join(void_tags))
+                with self.block('default:', delim=(None, None)):
+                    self.emit('// Keep what this version of the SDK cannot decode')
+                    self.emit('u.raw = string(body)')
             self.emit('return nil')
         self.emit()
+
+    def _generate_union_marshaler(self, u):
+        fields = u.all_fields
+        self.emit('// MarshalJSON serializes a %s instance' % u.name)
+        with self.block('func (u %s) MarshalJSON() ([]byte, error)' % u.name):
+            if any(not is_void_type(f.data_type) for f in fields):
+                self._generate_union_marshal_cases(u, fields)
+            self.emit('return dropbox.MarshalUnion(u.Tagged, u.Raw())')
+        self.emit()
+
+    def _generate_union_marshal_cases(self, u, fields):
+        with self.block('switch u.Tag'):
+            for field in fields:
+                if is_void_type(field.data_type):
+                    continue
+                field_name = fmt_var(field.name)
+                data_type, _ = unwrap_nullable(field.data_type)
+                with self.block('case "%s":' % field.name, delim=(None, None)):
+                    if _is_flattened(data_type):
+                        self.emit('return dropbox.MarshalTagged(u.Tag, u.%s)' % field_name)
+                        continue
+                    json_tag = field.name
+                    if is_nullable_type(field.data_type):
+                        json_tag += ',omitempty'
+                    self.emit('return json.Marshal(struct {')
+                    with self.indent():
+                        self.emit('dropbox.Tagged')
+                        self.emit('%s %s `json:"%s"`' % (
+                            field_name,
+                            fmt_type(field.data_type, u.namespace, use_interface=True),
+                            json_tag))
+                    self.emit('}{u.Tagged, u.%s})' % field_name)
+
+    def _generate_subtype_marshaler(self, struct, tag):
+        self.emit('// MarshalJSON serializes a {0} instance, tagged as a `{1}`'.format(
+            struct.name, struct.parent_type.name))
+        with self.block('func (u %s) MarshalJSON() ([]byte, error)' % struct.name):
+            extra = 'u.Extra' if _keeps_extra(struct) else 'nil'
+            self.emit('return dropbox.MarshalStruct("%s", &u, %s)' % (tag, extra))
+        self.emit()
+
+    def _generate_struct_marshaler(self, struct):
+        self.emit('// MarshalJSON serializes a %s instance' % struct.name)
+        with self.block('func (u %s) MarshalJSON() ([]byte, error)' % struct.name):
+            self.emit('return dropbox.MarshalStruct("", &u, u.Extra)')
+        self.emit()
+
+    def _generate_struct_unmarshaler(self, struct):
+        self.emit('// UnmarshalJSON deserializes into a %s instance' % struct.name)
+        with self.block('func (u *%s) UnmarshalJSON(body []byte) error' % struct.name):
+            self.emit('return dropbox.UnmarshalStruct(body, u, &u.Extra)')
+        self.emit()
+
+    def _generate_validate(self, data_type):
+        recv = 'u' if is_union_type(data_type) else 's'
+        self.emit('// Validate checks that {0} satisfies the constraints of the API spec'
+                  .format(recv))
+        with self.block('func ({0} *{1}) Validate() error'.format(recv, data_type.name)):
+            fields = [f for f in data_type.all_fields
+                      if _needs_check(f.data_type, self._validated)]
+            if fields:
+                # Routes validate their argument before sending it, and
+                # a nil argument is sent as null like before
+                with self.block('if %s == nil' % recv):
+                    self.emit('return nil')
+            if is_union_type(data_type) and fields:
+                with self.block('switch u.Tag'):
+                    for field in fields:
+                        with self.block('case "%s":' % field.name, delim=(None, None)):
+                            self._generate_checks(field, 'u.' + fmt_var(field.name))
+            else:
+                for field in fields:
+                    self._generate_checks(field, 's.' + fmt_var(field.name))
+            self.emit('return nil')
+        self.emit()
+
+    def _generate_checks(self, field, expr):
+        data_type, nullable = unwrap_nullable(field.data_type)
+        name = '"%s"' % field.name
+        if nullable and is_primitive_type(data_type):
+            # Absent optional values are omitted rather than sent
+            zero = '""' if is_string_type(data_type) else '0'
+            with self.block('if %s != %s' % (expr, zero)):
+                self._generate_value_checks(data_type, expr, name)
+        else:
+            self._generate_value_checks(data_type, expr, name)
+
+    def _generate_value_checks(self, data_type, expr, name):
+        def fail(reason):
+            with self.block('return &dropbox.ValidationError'):
+                self.emit('Field: %s,' % name)
+                self.emit('Reason: %s,' % reason)
+
+        # The constraints are those of the field's type, which is often an
+        # alias, e.g. files.SearchArg.path is a PathROrId, whose pattern
+        # is checked here
+        if is_string_type(data_type):
+            length = 'utf8.RuneCountInString(%s)' % expr
+            min_length = getattr(data_type, 'min_length', None)
+            max_length = getattr(data_type, 'max_length', None)
+            pattern = getattr(data_type, 'pattern', None)
+            if min_length is not None:
+                with self.block('if %s < %d' % (length, min_length)):
+                    fail('"must have at least %d characters"' % min_length)
+            if max_length is not None:
+                with self.block('if %s > %d' % (length, max_length)):
+                    fail('"must have at most %d characters"' % max_length)
+            if pattern is not None:
+                with self.block('if !dropbox.MatchPattern(%s, %s)' % (expr, _go_string(pattern))):
+                    fail(_go_string('must match ' + pattern))
+        elif is_numeric_type(data_type):
+            min_value = getattr(data_type, 'min_value', None)
+            max_value = getattr(data_type, 'max_value', None)
+            if min_value is not None:
+                with self.block('if %s < %s' % (expr, min_value)):
+                    fail('"must be at least %s"' % min_value)
+            if max_value is not None:
+                with self.block('if %s > %s' % (expr, max_value)):
+                    fail('"must be at most %s"' % max_value)
+        elif is_list_type(data_type):
+            min_items = getattr(data_type, 'min_items', None)
+            max_items = getattr(data_type, 'max_items', None)
+            if min_items is not None:
+                with self.block('if len(%s) < %d' % (expr, min_items)):
+                    fail('"must have at least %d items"' % min_items)
+            if max_items is not None:
+                with self.block('if len(%s) > %d' % (expr, max_items)):
+                    fail('"must have at most %d items"' % max_items)
+            # Items are checked against the constraints of the item type,
+            # e.g. the 40 characters of users_common.AccountId for
+            # users.GetAccountBatchArg.account_ids
+            if _needs_check(data_type.data_type, self._validated, items=True):
+                with self.block('for i, v := range %s' % expr):
+                    self._generate_value_checks(
+                        unwrap_nullable(data_type.data_type)[0], 'v',
+                        'fmt.Sprintf("%s[%%d]", i)' % name.strip('"'))
+        else:
+            with self.block('if %s != nil' % expr):
+                with self.block('if err := %s.Validate(); err != nil' % expr):
+                    self.emit('return dropbox.NestValidationError(%s, err)' % name)
+
+
+def _constraints(data_type):
+    """Returns the constraints that Stone defines on a primitive or list."""
+    names = ('min_length', 'max_length', 'pattern', 'min_value', 'max_value',
+             'min_items', 'max_items')
+    return [n for n in names if getattr(data_type, n, None) is not None]
+
+
+def _needs_check(data_type, validated, items=False):
+    """Reports whether values of data_type must be validated. For list items
+    only the checks of the items themselves are considered."""
+    data_type, _ = unwrap_nullable(data_type)
+    if is_list_type(data_type):
+        return (not items and bool(_constraints(data_type))) or \
+            _needs_check(data_type.data_type, validated, items=True)
+    if is_primitive_type(data_type):
+        return bool(_constraints(data_type))
+    if is_struct_type(data_type) and data_type.has_enumerated_subtypes():
+        # Held as an interface, which has no Validate method
+        return False
+    return id(data_type) in validated
+
+
+def _validated_types(api):
+    """Returns the ids of the types that get a Validate method: route
+    arguments and the types with fields that need checking."""
+    validated = set()
+    for namespace in api.namespaces.values():
+        for route in namespace.routes:
+            arg = route.arg_data_type
+            if is_union_type(arg) or \
+                    (is_struct_type(arg) and not arg.has_enumerated_subtypes()):
+                validated.add(id(arg))
+    types = [t for namespace in api.namespaces.values()
+             for t in namespace.linearize_data_types()
+             if is_union_type(t) or not t.has_enumerated_subtypes()]
+    changed = True
+    while changed:
+        changed = False
+        for t in types:
+            if id(t) not in validated and \
+                    any(_needs_check(f.data_type, validated) for f in t.all_fields):
+                validated.add(id(t))
+                changed = True
+    return validated
+
+
+def _go_string(s):
+    """Formats s as a Go string literal."""
+    if '`' not in s:
+        return '`%s`' % s
+    return '"%s"' % s.replace('\\', '\\\\').replace('"', '\\"')
+
+
+def _keeps_extra(struct):
+    """Structs keep unknown fields unless they hold structs with enumerated
+    subtypes, which can't be decoded generically."""
+    return not needs_base_type(struct)
+
+
+def _is_flattened(data_type):
+    """Struct union members are serialized inline with the tag, except for
+    structs with enumerated subtypes whose own tag would clash."""
+    return is_struct_type(data_type) and not data_type.has_enumerated_subtypes()
+
+
+def _subtype_tag(struct):
+    parent = struct.parent_type
+    if parent is None or not parent.has_enumerated_subtypes():
+        return None
+    for subtype in parent.get_enumerated_subtypes():
+        if subtype.data_type is struct:
+            return subtype.name
+    return None